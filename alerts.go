@@ -17,6 +17,7 @@ package main
 
 import (
 	"os"
+	"time"
 
 	"github.com/newrelic/go-agent/v3/newrelic"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
@@ -25,18 +26,21 @@ import (
 	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
 	"github.com/newrelic/newrelic-kubernetes-operator/controllers"
 	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/concurrency"
 	// +kubebuilder:scaffold:imports
 )
 
-func registerAlerts(mgr *ctrl.Manager, nrApp *newrelic.Application) error {
+func registerAlerts(mgr *ctrl.Manager, nrApp *newrelic.Application, maxConcurrentReconciles *concurrency.MaxConcurrentReconciles, resyncInterval time.Duration, correctDrift bool) error {
 
 	// nrqlalertcondition
 	nrqlAlertConditionReconciler := &controllers.NrqlAlertConditionReconciler{
-		Client:          (*mgr).GetClient(),
-		Log:             ctrl.Log.WithName("controllers").WithName("NrqlAlertCondition"),
-		Scheme:          (*mgr).GetScheme(),
-		AlertClientFunc: interfaces.InitializeAlertsClient,
-		NewRelicAgent:   *nrApp,
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("NrqlAlertCondition"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("nrqlalertcondition-controller"),
+		AlertClientFunc:         interfaces.InitializeAlertsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("NrqlAlertCondition"),
 	}
 
 	if err := nrqlAlertConditionReconciler.SetupWithManager(*mgr); err != nil {
@@ -52,11 +56,15 @@ func registerAlerts(mgr *ctrl.Manager, nrApp *newrelic.Application) error {
 
 	// alertsnrqlcondition
 	alertsNrqlConditionReconciler := &controllers.AlertsNrqlConditionReconciler{
-		Client:          (*mgr).GetClient(),
-		Log:             ctrl.Log.WithName("controllers").WithName("AlertsNrqlCondition"),
-		Scheme:          (*mgr).GetScheme(),
-		AlertClientFunc: interfaces.InitializeAlertsClient,
-		NewRelicAgent:   *nrApp,
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("AlertsNrqlCondition"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("alertsnrqlcondition-controller"),
+		AlertClientFunc:         interfaces.InitializeAlertsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("AlertsNrqlCondition"),
+		ResyncInterval:          resyncInterval,
+		CorrectDrift:            correctDrift,
 	}
 
 	if err := alertsNrqlConditionReconciler.SetupWithManager(*mgr); err != nil {
@@ -72,11 +80,13 @@ func registerAlerts(mgr *ctrl.Manager, nrApp *newrelic.Application) error {
 
 	// apmalertcondition
 	apmReconciler := &controllers.ApmAlertConditionReconciler{
-		Client:          (*mgr).GetClient(),
-		Log:             ctrl.Log.WithName("controllers").WithName("ApmAlertCondition"),
-		Scheme:          (*mgr).GetScheme(),
-		AlertClientFunc: interfaces.InitializeAlertsClient,
-		NewRelicAgent:   *nrApp,
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("ApmAlertCondition"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("apmalertcondition-controller"),
+		AlertClientFunc:         interfaces.InitializeAlertsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("ApmAlertCondition"),
 	}
 
 	if err := apmReconciler.SetupWithManager(*mgr); err != nil {
@@ -92,11 +102,15 @@ func registerAlerts(mgr *ctrl.Manager, nrApp *newrelic.Application) error {
 
 	// alertsapmcondition
 	alertsAPMReconciler := &controllers.AlertsAPMConditionReconciler{
-		Client:          (*mgr).GetClient(),
-		Log:             ctrl.Log.WithName("controllers").WithName("AlertsAPMCondition"),
-		Scheme:          (*mgr).GetScheme(),
-		AlertClientFunc: interfaces.InitializeAlertsClient,
-		NewRelicAgent:   *nrApp,
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("AlertsAPMCondition"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("alertsapmcondition-controller"),
+		AlertClientFunc:         interfaces.InitializeAlertsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("AlertsAPMCondition"),
+		ResyncInterval:          resyncInterval,
+		CorrectDrift:            correctDrift,
 	}
 
 	if err := alertsAPMReconciler.SetupWithManager(*mgr); err != nil {
@@ -110,13 +124,87 @@ func registerAlerts(mgr *ctrl.Manager, nrApp *newrelic.Application) error {
 		os.Exit(1)
 	}
 
+	// alertsinfracondition
+	alertsInfraConditionReconciler := &controllers.AlertsInfraConditionReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("AlertsInfraCondition"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("alertsinfracondition-controller"),
+		AlertClientFunc:         interfaces.InitializeAlertsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("AlertsInfraCondition"),
+		ResyncInterval:          resyncInterval,
+		CorrectDrift:            correctDrift,
+	}
+
+	if err := alertsInfraConditionReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AlertsInfraCondition")
+		os.Exit(1)
+	}
+
+	alertsInfraCondition := &nrv1.AlertsInfraCondition{}
+	if err := alertsInfraCondition.SetupWebhookWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "AlertsInfraCondition")
+		os.Exit(1)
+	}
+
+	// alertsexternalservicecondition
+	alertsExternalServiceConditionReconciler := &controllers.AlertsExternalServiceConditionReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("AlertsExternalServiceCondition"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("alertsexternalservicecondition-controller"),
+		AlertClientFunc:         interfaces.InitializeAlertsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("AlertsExternalServiceCondition"),
+		ResyncInterval:          resyncInterval,
+		CorrectDrift:            correctDrift,
+	}
+
+	if err := alertsExternalServiceConditionReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AlertsExternalServiceCondition")
+		os.Exit(1)
+	}
+
+	alertsExternalServiceCondition := &nrv1.AlertsExternalServiceCondition{}
+	if err := alertsExternalServiceCondition.SetupWebhookWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "AlertsExternalServiceCondition")
+		os.Exit(1)
+	}
+
+	// alertssyntheticscondition
+	alertsSyntheticsConditionReconciler := &controllers.AlertsSyntheticsConditionReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("AlertsSyntheticsCondition"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("alertssyntheticscondition-controller"),
+		AlertClientFunc:         interfaces.InitializeAlertsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("AlertsSyntheticsCondition"),
+		ResyncInterval:          resyncInterval,
+		CorrectDrift:            correctDrift,
+	}
+
+	if err := alertsSyntheticsConditionReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AlertsSyntheticsCondition")
+		os.Exit(1)
+	}
+
+	alertsSyntheticsCondition := &nrv1.AlertsSyntheticsCondition{}
+	if err := alertsSyntheticsCondition.SetupWebhookWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "AlertsSyntheticsCondition")
+		os.Exit(1)
+	}
+
 	// policy
 	policyReconciler := &controllers.PolicyReconciler{
-		Client:          (*mgr).GetClient(),
-		Log:             ctrl.Log.WithName("controllers").WithName("Policy"),
-		Scheme:          (*mgr).GetScheme(),
-		AlertClientFunc: interfaces.InitializeAlertsClient,
-		NewRelicAgent:   *nrApp,
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("Policy"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("policy-controller"),
+		AlertClientFunc:         interfaces.InitializeAlertsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("Policy"),
 	}
 
 	if err := policyReconciler.SetupWithManager(*mgr); err != nil {
@@ -132,11 +220,15 @@ func registerAlerts(mgr *ctrl.Manager, nrApp *newrelic.Application) error {
 
 	//alertsChannel
 	alertsChannelReconciler := &controllers.AlertsChannelReconciler{
-		Client:          (*mgr).GetClient(),
-		Log:             ctrl.Log.WithName("controllers").WithName("alertsChannel"),
-		Scheme:          (*mgr).GetScheme(),
-		AlertClientFunc: interfaces.InitializeAlertsClient,
-		NewRelicAgent:   *nrApp,
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("alertsChannel"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("alertschannel-controller"),
+		AlertClientFunc:         interfaces.InitializeAlertsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("AlertsChannel"),
+		ResyncInterval:          resyncInterval,
+		CorrectDrift:            correctDrift,
 	}
 
 	if err := alertsChannelReconciler.SetupWithManager(*mgr); err != nil {
@@ -152,11 +244,15 @@ func registerAlerts(mgr *ctrl.Manager, nrApp *newrelic.Application) error {
 
 	// alertspolicy
 	alertsPolicyReconciler := &controllers.AlertsPolicyReconciler{
-		Client:          (*mgr).GetClient(),
-		Log:             ctrl.Log.WithName("controllers").WithName("AlertsPolicy"),
-		Scheme:          (*mgr).GetScheme(),
-		AlertClientFunc: interfaces.InitializeAlertsClient,
-		NewRelicAgent:   *nrApp,
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("AlertsPolicy"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("alertspolicy-controller"),
+		AlertClientFunc:         interfaces.InitializeAlertsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("AlertsPolicy"),
+		ResyncInterval:          resyncInterval,
+		CorrectDrift:            correctDrift,
 	}
 	if err := alertsPolicyReconciler.SetupWithManager(*mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AlertsPolicy")
@@ -169,5 +265,118 @@ func registerAlerts(mgr *ctrl.Manager, nrApp *newrelic.Application) error {
 		os.Exit(1)
 	}
 
+	// alertsmutingrule
+	alertsMutingRuleReconciler := &controllers.AlertsMutingRuleReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("AlertsMutingRule"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("alertsmutingrule-controller"),
+		AlertClientFunc:         interfaces.InitializeAlertsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("AlertsMutingRule"),
+		ResyncInterval:          resyncInterval,
+		CorrectDrift:            correctDrift,
+	}
+
+	if err := alertsMutingRuleReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AlertsMutingRule")
+		os.Exit(1)
+	}
+
+	alertsMutingRule := &nrv1.AlertsMutingRule{}
+	if err := alertsMutingRule.SetupWebhookWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "AlertsMutingRule")
+		os.Exit(1)
+	}
+
+	// muting rules for rollouts of annotated workloads
+	deploymentRolloutReconciler := &controllers.DeploymentRolloutReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("DeploymentRollout"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("deployment-rollout-controller"),
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("DeploymentRollout"),
+	}
+
+	if err := deploymentRolloutReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DeploymentRollout")
+		os.Exit(1)
+	}
+
+	statefulSetRolloutReconciler := &controllers.StatefulSetRolloutReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("StatefulSetRollout"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("statefulset-rollout-controller"),
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("StatefulSetRollout"),
+	}
+
+	if err := statefulSetRolloutReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "StatefulSetRollout")
+		os.Exit(1)
+	}
+
+	daemonSetRolloutReconciler := &controllers.DaemonSetRolloutReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("DaemonSetRollout"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("daemonset-rollout-controller"),
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("DaemonSetRollout"),
+	}
+
+	if err := daemonSetRolloutReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DaemonSetRollout")
+		os.Exit(1)
+	}
+
+	// newrelicaccount
+	newRelicAccountReconciler := &controllers.NewRelicAccountReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("NewRelicAccount"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("newrelicaccount-controller"),
+		AlertClientFunc:         interfaces.InitializeAlertsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("NewRelicAccount"),
+		ResyncInterval:          resyncInterval,
+	}
+
+	if err := newRelicAccountReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NewRelicAccount")
+		os.Exit(1)
+	}
+
+	newRelicAccount := &nrv1.NewRelicAccount{}
+	if err := newRelicAccount.SetupWebhookWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "NewRelicAccount")
+		os.Exit(1)
+	}
+
+	// clusternewrelicaccount
+	clusterNewRelicAccountReconciler := &controllers.ClusterNewRelicAccountReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("ClusterNewRelicAccount"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("clusternewrelicaccount-controller"),
+		AlertClientFunc:         interfaces.InitializeAlertsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("ClusterNewRelicAccount"),
+		ResyncInterval:          resyncInterval,
+	}
+
+	if err := clusterNewRelicAccountReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterNewRelicAccount")
+		os.Exit(1)
+	}
+
+	clusterNewRelicAccount := &nrv1.ClusterNewRelicAccount{}
+	if err := clusterNewRelicAccount.SetupWebhookWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ClusterNewRelicAccount")
+		os.Exit(1)
+	}
+
 	return nil
 }
@@ -2,6 +2,8 @@ package v1
 
 import (
 	"encoding/json"
+	"fmt"
+	"reflect"
 
 	"github.com/newrelic/newrelic-client-go/pkg/alerts"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,12 +26,15 @@ type AlertsAPMSpecificSpec struct {
 
 // AlertsAPMConditionStatus defines the observed state of AlertsAPMCondition
 type AlertsAPMConditionStatus struct {
-	AppliedSpec *AlertsAPMConditionSpec `json:"applied_spec"`
+	AppliedSpec *AlertsAPMConditionSpec `json:"applied_spec,omitempty"`
 	ConditionID int                     `json:"condition_id"`
+	Conditions  []Condition             `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Created",type="boolean",JSONPath=".status.created"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
 
 // AlertsAPMCondition is the Schema for the alertsapmconditions API
 type AlertsAPMCondition struct {
@@ -53,6 +58,16 @@ func init() {
 	SchemeBuilder.Register(&AlertsAPMCondition{}, &AlertsAPMConditionList{})
 }
 
+// GetConditions returns the status conditions of the AlertsAPMCondition
+func (in *AlertsAPMCondition) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the AlertsAPMCondition
+func (in *AlertsAPMCondition) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
 func (in AlertsAPMConditionSpec) APICondition() alerts.Condition {
 	jsonString, _ := json.Marshal(in)
 	var APICondition alerts.Condition
@@ -71,3 +86,56 @@ func (in AlertsAPMConditionSpec) APICondition() alerts.Condition {
 
 	return APICondition
 }
+
+// Diff lists the fields of the condition in New Relic that no longer match the spec.
+// Optional settings left empty in the spec are defaulted by New Relic and are not compared.
+func (in AlertsAPMConditionSpec) Diff(remote alerts.Condition) []string {
+	desired := in.APICondition()
+	differences := []string{}
+
+	if desired.Name != remote.Name {
+		differences = append(differences, fmt.Sprintf("name is %q, expected %q", remote.Name, desired.Name))
+	}
+
+	if desired.Enabled != remote.Enabled {
+		differences = append(differences, fmt.Sprintf("enabled is %t, expected %t", remote.Enabled, desired.Enabled))
+	}
+
+	if desired.Type != remote.Type {
+		differences = append(differences, fmt.Sprintf("type is %q, expected %q", remote.Type, desired.Type))
+	}
+
+	if desired.Metric != remote.Metric {
+		differences = append(differences, fmt.Sprintf("metric is %q, expected %q", remote.Metric, desired.Metric))
+	}
+
+	if desired.RunbookURL != remote.RunbookURL {
+		differences = append(differences, fmt.Sprintf("runbook_url is %q, expected %q", remote.RunbookURL, desired.RunbookURL))
+	}
+
+	if !reflect.DeepEqual(sortedStrings(desired.Entities), sortedStrings(remote.Entities)) {
+		differences = append(differences, "entities differ")
+	}
+
+	if !reflect.DeepEqual(desired.Terms, remote.Terms) {
+		differences = append(differences, "terms differ")
+	}
+
+	if desired.UserDefined != (alerts.ConditionUserDefined{}) && desired.UserDefined != remote.UserDefined {
+		differences = append(differences, "user_defined differs")
+	}
+
+	if desired.Scope != "" && desired.Scope != remote.Scope {
+		differences = append(differences, fmt.Sprintf("condition_scope is %q, expected %q", remote.Scope, desired.Scope))
+	}
+
+	if desired.GCMetric != "" && desired.GCMetric != remote.GCMetric {
+		differences = append(differences, fmt.Sprintf("gc_metric is %q, expected %q", remote.GCMetric, desired.GCMetric))
+	}
+
+	if desired.ViolationCloseTimer != 0 && desired.ViolationCloseTimer != remote.ViolationCloseTimer {
+		differences = append(differences, fmt.Sprintf("violation_close_timer is %d, expected %d", remote.ViolationCloseTimer, desired.ViolationCloseTimer))
+	}
+
+	return differences
+}
@@ -82,4 +82,46 @@ var _ = Describe("AlertsAPMConditionSpec", func() {
 			Expect(userDefinedCondition.ValueFunction).To(Equal(alerts.ValueFunctionTypes.Average))
 		})
 	})
+
+	Describe("Diff", func() {
+		var remote alerts.Condition
+
+		BeforeEach(func() {
+			remote = condition.APICondition()
+			remote.ID = 888
+			remote.Entities = []string{"333"}
+		})
+
+		It("finds no differences in a matching condition", func() {
+			Expect(condition.Diff(remote)).To(BeEmpty())
+		})
+
+		It("ignores the order of the entities", func() {
+			condition.Entities = []string{"333", "444"}
+			remote.Entities = []string{"444", "333"}
+
+			Expect(condition.Diff(remote)).To(BeEmpty())
+		})
+
+		It("lists the differences", func() {
+			remote.Enabled = false
+			remote.Metric = alerts.MetricTypes.ErrorPercentage
+			remote.Terms[0].Threshold = 2
+
+			Expect(condition.Diff(remote)).To(ConsistOf(
+				"enabled is false, expected true",
+				`metric is "error_percentage", expected "apdex"`,
+				"terms differ",
+			))
+		})
+
+		It("doesn't compare optional settings left empty in the spec", func() {
+			condition.Scope = ""
+			condition.ViolationCloseTimer = 0
+			remote.Scope = "instance"
+			remote.ViolationCloseTimer = 24
+
+			Expect(condition.Diff(remote)).To(BeEmpty())
+		})
+	})
 })
@@ -21,9 +21,7 @@ import (
 	"strings"
 
 	"github.com/newrelic/newrelic-client-go/pkg/alerts"
-	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -58,6 +56,8 @@ func (r *AlertsAPMCondition) Default() {
 		alertsapmconditionlog.Info("Setting null Applied Spec to empty interface")
 		r.Status.AppliedSpec = &AlertsAPMConditionSpec{}
 	}
+
+	DefaultAccountRef(&r.Spec.AccountRef)
 }
 
 // +kubebuilder:webhook:verbs=create;update,path=/validate-nr-k8s-newrelic-com-v1-alertsapmcondition,mutating=false,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=alertsapmconditions,versions=v1,name=valertsapmcondition.kb.io,sideEffects=None
@@ -238,33 +238,24 @@ func (r *AlertsAPMCondition) ValidateUserDefinedValueFunction() InvalidAttribute
 func (r *AlertsAPMCondition) CheckExistingPolicyID() error {
 	alertsapmconditionlog.Info("Checking existing", "policyId", r.Spec.ExistingPolicyID)
 	ctx := context.Background()
-	var apiKey string
-	if r.Spec.APIKey == "" {
-		key := types.NamespacedName{Namespace: r.Spec.APIKeySecret.Namespace, Name: r.Spec.APIKeySecret.Name}
-		var apiKeySecret v1.Secret
-		getErr := k8Client.Get(ctx, key, &apiKeySecret)
-		if getErr != nil {
-			alertsapmconditionlog.Error(getErr, "Error getting secret")
-			return getErr
-		}
-		apiKey = string(apiKeySecret.Data[r.Spec.APIKeySecret.KeyName])
-
-	} else {
-		apiKey = r.Spec.APIKey
+	credentials, getErr := ResolveCredentials(ctx, k8Client, r.Namespace, r.GetAccountSettings())
+	if getErr != nil {
+		alertsapmconditionlog.Error(getErr, "Error getting credentials")
+		return getErr
 	}
 
-	alertsClient, errAlertClient := alertClientFunc(apiKey, r.Spec.Region)
+	alertsClient, errAlertClient := alertClientFunc(credentials.APIKey, credentials.Region)
 	if errAlertClient != nil {
 		alertsapmconditionlog.Error(errAlertClient, "failed to get policy",
 			"policyId", r.Spec.ExistingPolicyID,
-			"API Key", interfaces.PartialAPIKey(apiKey),
-			"accountID", r.Spec.AccountID,
-			"region", r.Spec.Region,
+			"API Key", interfaces.PartialAPIKey(credentials.APIKey),
+			"accountID", credentials.AccountID,
+			"region", credentials.Region,
 		)
 		return errAlertClient
 	}
 
-	alertPolicy, errAlertPolicy := alertsClient.QueryPolicy(r.Spec.AccountID, r.Spec.ExistingPolicyID)
+	alertPolicy, errAlertPolicy := alertsClient.QueryPolicy(credentials.AccountID, r.Spec.ExistingPolicyID)
 	if errAlertPolicy != nil {
 		if r.GetDeletionTimestamp() != nil {
 			alertsapmconditionlog.Info("Deleting resource", "errAlertPolicy", errAlertPolicy)
@@ -275,8 +266,8 @@ func (r *AlertsAPMCondition) CheckExistingPolicyID() error {
 		}
 		alertsapmconditionlog.Error(errAlertPolicy, "failed to get policy",
 			"policyId", r.Spec.ExistingPolicyID,
-			"API Key", interfaces.PartialAPIKey(apiKey),
-			"region", r.Spec.Region,
+			"API Key", interfaces.PartialAPIKey(credentials.APIKey),
+			"region", credentials.Region,
 		)
 		return errAlertPolicy
 	}
@@ -289,21 +280,14 @@ func (r *AlertsAPMCondition) CheckExistingPolicyID() error {
 }
 
 func (r *AlertsAPMCondition) CheckForAPIKeyOrSecret() error {
-	if r.Spec.APIKey != "" {
-		return nil
-	}
-	if r.Spec.APIKeySecret != (NewRelicAPIKeySecret{}) {
-		if r.Spec.APIKeySecret.Name != "" && r.Spec.APIKeySecret.Namespace != "" && r.Spec.APIKeySecret.KeyName != "" {
-			return nil
-		}
-	}
-	return errors.New("either api_key or api_key_secret must be set")
+	return CheckForAccount(r.Namespace, r.GetAccountSettings())
 }
 
 func (r *AlertsAPMCondition) CheckRequiredFields() error {
 
 	missingFields := []string{}
-	if r.Spec.Region == "" {
+	// the region of a referenced account is used when the condition does not set one
+	if r.Spec.Region == "" && r.Spec.AccountRef.Name == "" {
 		missingFields = append(missingFields, "region")
 	}
 	if r.Spec.ExistingPolicyID == "" {
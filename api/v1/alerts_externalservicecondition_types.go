@@ -0,0 +1,146 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/externalservice"
+)
+
+// AlertsExternalServiceConditionSpec defines the desired state of AlertsExternalServiceCondition
+type AlertsExternalServiceConditionSpec struct {
+	AlertsGenericConditionSpec        `json:",inline"`
+	AlertsExternalServiceSpecificSpec `json:",inline"`
+	// Metric is response_time_average, response_time_minimum, response_time_maximum or throughput
+	Metric string `json:"metric,omitempty"`
+	// Entities are the IDs of the APM or mobile applications calling the external service
+	Entities []string `json:"entities,omitempty"`
+}
+
+// AlertsExternalServiceSpecificSpec holds the fields only used by external service conditions
+type AlertsExternalServiceSpecificSpec struct {
+	// ExternalServiceURL is the host of the external service without a scheme, e.g. api.example.com
+	ExternalServiceURL string `json:"external_service_url,omitempty"`
+}
+
+// AlertsExternalServiceConditionStatus defines the observed state of AlertsExternalServiceCondition
+type AlertsExternalServiceConditionStatus struct {
+	AppliedSpec *AlertsExternalServiceConditionSpec `json:"applied_spec,omitempty"`
+	ConditionID int                                 `json:"condition_id"`
+	Conditions  []Condition                         `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+
+// AlertsExternalServiceCondition is the Schema for the alertsexternalserviceconditions API
+type AlertsExternalServiceCondition struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AlertsExternalServiceConditionSpec   `json:"spec,omitempty"`
+	Status AlertsExternalServiceConditionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AlertsExternalServiceConditionList contains a list of AlertsExternalServiceCondition
+type AlertsExternalServiceConditionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AlertsExternalServiceCondition `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AlertsExternalServiceCondition{}, &AlertsExternalServiceConditionList{})
+}
+
+// GetConditions returns the status conditions of the AlertsExternalServiceCondition
+func (in *AlertsExternalServiceCondition) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the AlertsExternalServiceCondition
+func (in *AlertsExternalServiceCondition) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
+// IsExternalServiceConditionType returns true if conditionType is the type of an external service condition
+func IsExternalServiceConditionType(conditionType string) bool {
+	switch conditionType {
+	case externalservice.ConditionTypeAPM, externalservice.ConditionTypeMobile:
+		return true
+	}
+
+	return false
+}
+
+// APICondition returns the condition in the format of the REST API. The terms are taken from
+// the APMTerms, like the terms of an AlertsAPMCondition.
+func (in AlertsExternalServiceConditionSpec) APICondition() externalservice.Condition {
+	condition := externalservice.Condition{
+		ID:                 in.ID,
+		Type:               string(in.Type),
+		Name:               in.Name,
+		Enabled:            in.Enabled,
+		Entities:           in.Entities,
+		ExternalServiceURL: in.ExternalServiceURL,
+		Metric:             in.Metric,
+		RunbookURL:         in.RunbookURL,
+	}
+
+	for _, term := range in.APMTerms {
+		jsonString, _ := json.Marshal(term)
+		var apiTerm alerts.ConditionTerm
+		json.Unmarshal(jsonString, &apiTerm) //nolint
+
+		condition.Terms = append(condition.Terms, apiTerm)
+	}
+
+	return condition
+}
+
+// Diff lists the fields of the condition in New Relic that no longer match the spec
+func (in AlertsExternalServiceConditionSpec) Diff(remote externalservice.Condition) []string {
+	desired := in.APICondition()
+	differences := []string{}
+
+	if desired.Name != remote.Name {
+		differences = append(differences, fmt.Sprintf("name is %q, expected %q", remote.Name, desired.Name))
+	}
+
+	if desired.Enabled != remote.Enabled {
+		differences = append(differences, fmt.Sprintf("enabled is %t, expected %t", remote.Enabled, desired.Enabled))
+	}
+
+	if desired.Type != remote.Type {
+		differences = append(differences, fmt.Sprintf("type is %q, expected %q", remote.Type, desired.Type))
+	}
+
+	if desired.ExternalServiceURL != remote.ExternalServiceURL {
+		differences = append(differences, fmt.Sprintf("external_service_url is %q, expected %q", remote.ExternalServiceURL, desired.ExternalServiceURL))
+	}
+
+	if desired.Metric != remote.Metric {
+		differences = append(differences, fmt.Sprintf("metric is %q, expected %q", remote.Metric, desired.Metric))
+	}
+
+	if desired.RunbookURL != remote.RunbookURL {
+		differences = append(differences, fmt.Sprintf("runbook_url is %q, expected %q", remote.RunbookURL, desired.RunbookURL))
+	}
+
+	if !reflect.DeepEqual(sortedStrings(desired.Entities), sortedStrings(remote.Entities)) {
+		differences = append(differences, "entities differ")
+	}
+
+	if !reflect.DeepEqual(desired.Terms, remote.Terms) {
+		differences = append(differences, "terms differ")
+	}
+
+	return differences
+}
@@ -0,0 +1,107 @@
+package v1
+
+import (
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AlertsExternalServiceConditionSpec", func() {
+	var condition AlertsExternalServiceConditionSpec
+
+	BeforeEach(func() {
+		condition = AlertsExternalServiceConditionSpec{
+			AlertsGenericConditionSpec: AlertsGenericConditionSpec{
+				Type:             "apm_external_service",
+				Name:             "Slow payment provider",
+				RunbookURL:       "http://test.com/runbook",
+				Enabled:          true,
+				ExistingPolicyID: "42",
+				APMTerms: []AlertConditionTerm{
+					{
+						Duration:     "5",
+						Operator:     "above",
+						Priority:     "critical",
+						Threshold:    "1.5",
+						TimeFunction: "all",
+					},
+				},
+			},
+			AlertsExternalServiceSpecificSpec: AlertsExternalServiceSpecificSpec{
+				ExternalServiceURL: "api.payments.example.com",
+			},
+			Metric:   "response_time_average",
+			Entities: []string{"5950260"},
+		}
+	})
+
+	Describe("APICondition", func() {
+		It("converts AlertsExternalServiceConditionSpec object to the external service condition of the REST API, retaining field values", func() {
+			apiCondition := condition.APICondition()
+
+			Expect(apiCondition.Type).To(Equal("apm_external_service"))
+			Expect(apiCondition.Name).To(Equal("Slow payment provider"))
+			Expect(apiCondition.RunbookURL).To(Equal("http://test.com/runbook"))
+			Expect(apiCondition.Enabled).To(BeTrue())
+			Expect(apiCondition.Entities).To(Equal([]string{"5950260"}))
+			Expect(apiCondition.ExternalServiceURL).To(Equal("api.payments.example.com"))
+			Expect(apiCondition.Metric).To(Equal("response_time_average"))
+
+			Expect(apiCondition.Terms).To(Equal([]alerts.ConditionTerm{
+				{
+					Duration:     5,
+					Operator:     alerts.OperatorTypes.Above,
+					Priority:     alerts.PriorityTypes.Critical,
+					Threshold:    1.5,
+					TimeFunction: alerts.TimeFunctionTypes.All,
+				},
+			}))
+		})
+	})
+
+	Describe("Diff", func() {
+		It("finds no differences in a matching condition", func() {
+			remote := condition.APICondition()
+			remote.ID = 7
+
+			Expect(condition.Diff(remote)).To(BeEmpty())
+		})
+
+		It("lists the differences", func() {
+			remote := condition.APICondition()
+			remote.ExternalServiceURL = "api.other.example.com"
+			remote.Entities = []string{"5950260", "5950261"}
+
+			Expect(condition.Diff(remote)).To(ConsistOf(
+				`external_service_url is "api.other.example.com", expected "api.payments.example.com"`,
+				"entities differ",
+			))
+		})
+	})
+})
+
+var _ = Describe("AlertsPolicyCondition with an external service condition", func() {
+	It("round trips the external service fields", func() {
+		spec := AlertsExternalServiceConditionSpec{
+			AlertsGenericConditionSpec: AlertsGenericConditionSpec{
+				Type:    "mobile_external_service",
+				Name:    "Slow API from the app",
+				Enabled: true,
+				APMTerms: []AlertConditionTerm{
+					{Duration: "10", Operator: "above", Priority: "warning", Threshold: "100", TimeFunction: "any"},
+				},
+			},
+			AlertsExternalServiceSpecificSpec: AlertsExternalServiceSpecificSpec{
+				ExternalServiceURL: "api.example.com",
+			},
+			Metric:   "throughput",
+			Entities: []string{"1234"},
+		}
+
+		var condition AlertsPolicyCondition
+		condition.GenerateSpecFromExternalServiceConditionSpec(spec)
+
+		Expect(GetAlertsConditionType(condition)).To(Equal("AlertsExternalServiceCondition"))
+		Expect(condition.ReturnExternalServiceConditionSpec()).To(Equal(spec))
+	})
+})
@@ -0,0 +1,255 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+)
+
+// log is for logging in this package.
+var (
+	alertsexternalserviceconditionlog = logf.Log.WithName("alertsexternalservicecondition-resource")
+)
+
+// Metrics of external service conditions
+const (
+	ExternalServiceMetricResponseTimeAverage = "response_time_average"
+	ExternalServiceMetricResponseTimeMinimum = "response_time_minimum"
+	ExternalServiceMetricResponseTimeMaximum = "response_time_maximum"
+	ExternalServiceMetricThroughput          = "throughput"
+)
+
+// SetupWebhookWithManager - instantiates the Webhook
+func (r *AlertsExternalServiceCondition) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	alertClientFunc = interfaces.InitializeAlertsClient
+	k8Client = mgr.GetClient()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-nr-k8s-newrelic-com-v1-alertsexternalservicecondition,mutating=true,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=alertsexternalserviceconditions,verbs=create;update,versions=v1,name=malertsexternalservicecondition.kb.io,sideEffects=None
+
+var _ webhook.Defaulter = &AlertsExternalServiceCondition{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *AlertsExternalServiceCondition) Default() {
+	alertsexternalserviceconditionlog.Info("default", "name", r.Name)
+
+	if r.Status.AppliedSpec == nil {
+		alertsexternalserviceconditionlog.Info("Setting null Applied Spec to empty interface")
+		r.Status.AppliedSpec = &AlertsExternalServiceConditionSpec{}
+	}
+
+	DefaultAccountRef(&r.Spec.AccountRef)
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-nr-k8s-newrelic-com-v1-alertsexternalservicecondition,mutating=false,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=alertsexternalserviceconditions,versions=v1,name=valertsexternalservicecondition.kb.io,sideEffects=None
+
+var _ webhook.Validator = &AlertsExternalServiceCondition{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *AlertsExternalServiceCondition) ValidateCreate() error {
+	alertsexternalserviceconditionlog.Info("validate create", "name", r.Name)
+
+	return r.ValidateAlertsExternalServiceCondition()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *AlertsExternalServiceCondition) ValidateUpdate(old runtime.Object) error {
+	alertsexternalserviceconditionlog.Info("validate update", "name", r.Name)
+
+	return r.ValidateAlertsExternalServiceCondition()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *AlertsExternalServiceCondition) ValidateDelete() error {
+	alertsexternalserviceconditionlog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
+
+// ValidateAlertsExternalServiceCondition checks the credentials, the required fields, the values of
+// the attributes and that the policy the condition is added to exists
+func (r *AlertsExternalServiceCondition) ValidateAlertsExternalServiceCondition() error {
+	err := r.CheckForAPIKeyOrSecret()
+	if err != nil {
+		return err
+	}
+
+	err = r.CheckRequiredFields()
+	if err != nil {
+		return err
+	}
+
+	var invalidAttributes InvalidAttributeSlice
+
+	invalidAttributes = append(invalidAttributes, r.ValidateType()...)
+	invalidAttributes = append(invalidAttributes, r.ValidateMetric()...)
+	invalidAttributes = append(invalidAttributes, r.ValidateExternalServiceURL()...)
+	invalidAttributes = append(invalidAttributes, r.ValidateTerms()...)
+
+	if len(invalidAttributes) > 0 {
+		return errors.New("error with invalid attributes: \n" + invalidAttributes.errorString())
+	}
+
+	return r.CheckExistingPolicyID()
+}
+
+func (r *AlertsExternalServiceCondition) ValidateType() InvalidAttributeSlice {
+	if IsExternalServiceConditionType(string(r.Spec.Type)) {
+		return []invalidAttribute{}
+	}
+
+	alertsexternalserviceconditionlog.Info("Invalid Type attribute", "Type", r.Spec.Type)
+
+	return []invalidAttribute{{attribute: "Type", value: string(r.Spec.Type)}}
+}
+
+func (r *AlertsExternalServiceCondition) ValidateMetric() InvalidAttributeSlice {
+	switch r.Spec.Metric {
+	case ExternalServiceMetricResponseTimeAverage,
+		ExternalServiceMetricResponseTimeMinimum,
+		ExternalServiceMetricResponseTimeMaximum,
+		ExternalServiceMetricThroughput:
+		return []invalidAttribute{}
+	}
+
+	alertsexternalserviceconditionlog.Info("Invalid Metric attribute", "Metric", r.Spec.Metric)
+
+	return []invalidAttribute{{attribute: "Metric", value: r.Spec.Metric}}
+}
+
+// ValidateExternalServiceURL checks that the external service is given as a host, New Relic
+// rejects URLs with a scheme or a path
+func (r *AlertsExternalServiceCondition) ValidateExternalServiceURL() InvalidAttributeSlice {
+	if !strings.ContainsAny(r.Spec.ExternalServiceURL, "/ \t") {
+		return []invalidAttribute{}
+	}
+
+	alertsexternalserviceconditionlog.Info("Invalid ExternalServiceURL attribute", "ExternalServiceURL", r.Spec.ExternalServiceURL)
+
+	return []invalidAttribute{{attribute: "ExternalServiceURL", value: r.Spec.ExternalServiceURL}}
+}
+
+func (r *AlertsExternalServiceCondition) ValidateTerms() InvalidAttributeSlice {
+	var invalidTerms InvalidAttributeSlice
+
+	for _, term := range r.Spec.APMTerms {
+		switch alerts.TimeFunctionType(term.TimeFunction) {
+		case alerts.TimeFunctionTypes.All, alerts.TimeFunctionTypes.Any:
+		default:
+			alertsexternalserviceconditionlog.Info("Invalid Term.TimeFunction passed", "Term.TimeFunction", term.TimeFunction)
+			invalidTerms = append(invalidTerms, invalidAttribute{attribute: "Term.TimeFunction", value: term.TimeFunction})
+		}
+
+		switch alerts.OperatorType(term.Operator) {
+		case alerts.OperatorTypes.Equal, alerts.OperatorTypes.Above, alerts.OperatorTypes.Below:
+		default:
+			alertsexternalserviceconditionlog.Info("Invalid Term.Operator passed", "Term.Operator", term.Operator)
+			invalidTerms = append(invalidTerms, invalidAttribute{attribute: "Term.Operator", value: term.Operator})
+		}
+
+		switch alerts.PriorityType(term.Priority) {
+		case alerts.PriorityTypes.Critical, alerts.PriorityTypes.Warning:
+		default:
+			alertsexternalserviceconditionlog.Info("Invalid Term.Priority passed", "Term.Priority", term.Priority)
+			invalidTerms = append(invalidTerms, invalidAttribute{attribute: "Term.Priority", value: term.Priority})
+		}
+
+		if _, err := strconv.Atoi(term.Duration); err != nil {
+			alertsexternalserviceconditionlog.Info("Invalid Term.Duration passed", "Term.Duration", term.Duration)
+			invalidTerms = append(invalidTerms, invalidAttribute{attribute: "Term.Duration", value: term.Duration})
+		}
+
+		if _, err := strconv.ParseFloat(term.Threshold, 64); err != nil {
+			alertsexternalserviceconditionlog.Info("Invalid Term.Threshold passed", "Term.Threshold", term.Threshold)
+			invalidTerms = append(invalidTerms, invalidAttribute{attribute: "Term.Threshold", value: term.Threshold})
+		}
+	}
+
+	return invalidTerms
+}
+
+func (r *AlertsExternalServiceCondition) CheckExistingPolicyID() error {
+	alertsexternalserviceconditionlog.Info("Checking existing", "policyId", r.Spec.ExistingPolicyID)
+	ctx := context.Background()
+	credentials, getErr := ResolveCredentials(ctx, k8Client, r.Namespace, r.GetAccountSettings())
+	if getErr != nil {
+		alertsexternalserviceconditionlog.Error(getErr, "Error getting credentials")
+		return getErr
+	}
+
+	alertsClient, errAlertClient := alertClientFunc(credentials.APIKey, credentials.Region)
+	if errAlertClient != nil {
+		alertsexternalserviceconditionlog.Error(errAlertClient, "failed to get policy",
+			"policyId", r.Spec.ExistingPolicyID,
+			"API Key", interfaces.PartialAPIKey(credentials.APIKey),
+			"accountID", credentials.AccountID,
+			"region", credentials.Region,
+		)
+		return errAlertClient
+	}
+
+	alertPolicy, errAlertPolicy := alertsClient.QueryPolicy(credentials.AccountID, r.Spec.ExistingPolicyID)
+	if errAlertPolicy != nil {
+		if r.GetDeletionTimestamp() != nil {
+			alertsexternalserviceconditionlog.Info("Deleting resource", "errAlertPolicy", errAlertPolicy)
+			return nil
+		}
+		alertsexternalserviceconditionlog.Error(errAlertPolicy, "failed to get policy",
+			"policyId", r.Spec.ExistingPolicyID,
+			"API Key", interfaces.PartialAPIKey(credentials.APIKey),
+			"region", credentials.Region,
+		)
+		return errAlertPolicy
+	}
+
+	if alertPolicy.ID != r.Spec.ExistingPolicyID {
+		alertsexternalserviceconditionlog.Info("Alert policy returned by the API failed to match provided policy ID")
+		return errors.New("alert policy returned by API did not match")
+	}
+
+	return nil
+}
+
+func (r *AlertsExternalServiceCondition) CheckForAPIKeyOrSecret() error {
+	return CheckForAccount(r.Namespace, r.GetAccountSettings())
+}
+
+// CheckRequiredFields checks the fields every external service condition needs
+func (r *AlertsExternalServiceCondition) CheckRequiredFields() error {
+	missingFields := []string{}
+	// the region of a referenced account is used when the condition does not set one
+	if r.Spec.Region == "" && r.Spec.AccountRef.Name == "" {
+		missingFields = append(missingFields, "region")
+	}
+	if r.Spec.ExistingPolicyID == "" {
+		missingFields = append(missingFields, "existing_policy_id")
+	}
+	if r.Spec.Metric == "" {
+		missingFields = append(missingFields, "metric")
+	}
+	if r.Spec.ExternalServiceURL == "" {
+		missingFields = append(missingFields, "external_service_url")
+	}
+	if len(r.Spec.Entities) == 0 {
+		missingFields = append(missingFields, "entities")
+	}
+	if len(r.Spec.APMTerms) == 0 {
+		missingFields = append(missingFields, "apm_terms")
+	}
+	if len(missingFields) > 0 {
+		return errors.New(strings.Join(missingFields, " and ") + " must be set")
+	}
+	return nil
+}
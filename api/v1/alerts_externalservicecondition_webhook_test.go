@@ -0,0 +1,138 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+)
+
+var _ = Describe("alertsExternalServiceCondition_webhook", func() {
+	var (
+		r            AlertsExternalServiceCondition
+		alertsClient *interfacesfakes.FakeNewRelicAlertsClient
+	)
+
+	BeforeEach(func() {
+		k8Client = testk8sClient
+		alertsClient = &interfacesfakes.FakeNewRelicAlertsClient{}
+		alertClientFunc = func(string, string) (interfaces.NewRelicAlertsClient, error) {
+			return alertsClient, nil
+		}
+		r = AlertsExternalServiceCondition{
+			ObjectMeta: v1.ObjectMeta{
+				Name: "test external service condition",
+			},
+			Spec: AlertsExternalServiceConditionSpec{
+				AlertsGenericConditionSpec: AlertsGenericConditionSpec{
+					Type:             "apm_external_service",
+					Name:             "K8s generated external service alert condition",
+					Enabled:          true,
+					ExistingPolicyID: "46286",
+					APIKey:           "111222333",
+					Region:           "staging",
+					APMTerms: []AlertConditionTerm{
+						{
+							Duration:     "5",
+							Operator:     "above",
+							Priority:     "critical",
+							Threshold:    "1.5",
+							TimeFunction: "all",
+						},
+					},
+				},
+				AlertsExternalServiceSpecificSpec: AlertsExternalServiceSpecificSpec{
+					ExternalServiceURL: "api.payments.example.com",
+				},
+				Metric:   "response_time_average",
+				Entities: []string{"5950260"},
+			},
+		}
+
+		alertsClient.QueryPolicyStub = func(int, string) (*alerts.AlertsPolicy, error) {
+			return &alerts.AlertsPolicy{
+				ID: "46286",
+			}, nil
+		}
+	})
+
+	Context("ValidateCreate", func() {
+		Context("With a valid external service condition", func() {
+			It("Should create the external service condition", func() {
+				err := r.ValidateCreate()
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("With an invalid Type and Metric", func() {
+			BeforeEach(func() {
+				r.Spec.Type = "burritos"
+				r.Spec.Metric = "tacos"
+			})
+
+			It("Should reject the external service condition creation", func() {
+				err := r.ValidateCreate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("burritos"))
+				Expect(err.Error()).To(ContainSubstring("tacos"))
+			})
+		})
+
+		Context("With an external service URL including the scheme", func() {
+			BeforeEach(func() {
+				r.Spec.ExternalServiceURL = "https://api.payments.example.com"
+			})
+
+			It("Should reject the external service condition creation", func() {
+				err := r.ValidateCreate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("ExternalServiceURL"))
+			})
+		})
+
+		Context("With invalid terms", func() {
+			BeforeEach(func() {
+				r.Spec.APMTerms[0].Operator = "sideways"
+				r.Spec.APMTerms[0].Threshold = "lots"
+			})
+
+			It("Should reject the external service condition creation", func() {
+				err := r.ValidateCreate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("sideways"))
+				Expect(err.Error()).To(ContainSubstring("lots"))
+			})
+		})
+
+		Context("Without the required fields", func() {
+			BeforeEach(func() {
+				r.Spec.Metric = ""
+				r.Spec.ExternalServiceURL = ""
+				r.Spec.Entities = nil
+			})
+
+			It("Should reject the external service condition creation", func() {
+				err := r.ValidateCreate()
+				Expect(err).To(MatchError("metric and external_service_url and entities must be set"))
+			})
+		})
+
+		Context("With a policy that does not exist", func() {
+			BeforeEach(func() {
+				alertsClient.QueryPolicyStub = func(int, string) (*alerts.AlertsPolicy, error) {
+					return nil, errors.New("no alert policy found for id 46286")
+				}
+			})
+
+			It("Should reject the external service condition creation", func() {
+				err := r.ValidateCreate()
+				Expect(err).To(MatchError("no alert policy found for id 46286"))
+			})
+		})
+	})
+})
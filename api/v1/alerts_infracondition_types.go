@@ -0,0 +1,195 @@
+package v1
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Types of infrastructure conditions, set in the type of the generic condition spec
+const (
+	InfraConditionTypeMetric           = "infra_metric"
+	InfraConditionTypeProcessRunning   = "infra_process_running"
+	InfraConditionTypeHostNotReporting = "infra_host_not_reporting"
+)
+
+// AlertsInfraConditionSpec defines the desired state of AlertsInfraCondition
+type AlertsInfraConditionSpec struct {
+	AlertsGenericConditionSpec `json:",inline"`
+	AlertsInfraSpecificSpec    `json:",inline"`
+}
+
+// AlertsInfraSpecificSpec holds the fields only used by infrastructure conditions
+type AlertsInfraSpecificSpec struct {
+	// Comparison is above, below or equal. It is not used by infra_host_not_reporting conditions.
+	Comparison string `json:"comparison,omitempty"`
+	// EventType is the event the select_value of an infra_metric condition is read from, e.g. SystemSample
+	EventType string `json:"event_type,omitempty"`
+	// SelectValue is the attribute of the event that is compared with the thresholds, e.g. cpuPercent
+	SelectValue         string                         `json:"select_value,omitempty"`
+	WhereClause         string                         `json:"where_clause,omitempty"`
+	ProcessWhereClause  string                         `json:"process_where_clause,omitempty"`
+	IntegrationProvider string                         `json:"integration_provider,omitempty"`
+	CriticalThreshold   *AlertsInfraConditionThreshold `json:"critical_threshold,omitempty"`
+	WarningThreshold    *AlertsInfraConditionThreshold `json:"warning_threshold,omitempty"`
+}
+
+// AlertsInfraConditionThreshold - copy of alerts.InfrastructureConditionThreshold
+type AlertsInfraConditionThreshold struct {
+	DurationMinutes int    `json:"duration_minutes"`
+	TimeFunction    string `json:"time_function,omitempty"`
+	// Value is a number, it is not used by infra_host_not_reporting conditions
+	Value string `json:"value,omitempty"`
+}
+
+// AlertsInfraConditionStatus defines the observed state of AlertsInfraCondition
+type AlertsInfraConditionStatus struct {
+	AppliedSpec *AlertsInfraConditionSpec `json:"applied_spec,omitempty"`
+	ConditionID int                       `json:"condition_id"`
+	Conditions  []Condition               `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+
+// AlertsInfraCondition is the Schema for the alertsinfraconditions API
+type AlertsInfraCondition struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AlertsInfraConditionSpec   `json:"spec,omitempty"`
+	Status AlertsInfraConditionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AlertsInfraConditionList contains a list of AlertsInfraCondition
+type AlertsInfraConditionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AlertsInfraCondition `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AlertsInfraCondition{}, &AlertsInfraConditionList{})
+}
+
+// GetConditions returns the status conditions of the AlertsInfraCondition
+func (in *AlertsInfraCondition) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the AlertsInfraCondition
+func (in *AlertsInfraCondition) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
+// IsInfraConditionType returns true if conditionType is the type of an infrastructure condition
+func IsInfraConditionType(conditionType string) bool {
+	switch conditionType {
+	case InfraConditionTypeMetric, InfraConditionTypeProcessRunning, InfraConditionTypeHostNotReporting:
+		return true
+	}
+
+	return false
+}
+
+// APICondition returns the condition in the format of the Infrastructure API. The thresholds are
+// validated by the webhook, values that are not numbers are left unset.
+func (in AlertsInfraConditionSpec) APICondition() alerts.InfrastructureCondition {
+	policyID, _ := strconv.Atoi(in.ExistingPolicyID)
+
+	return alerts.InfrastructureCondition{
+		Comparison:          in.Comparison,
+		Critical:            in.CriticalThreshold.apiThreshold(),
+		Enabled:             in.Enabled,
+		Event:               in.EventType,
+		IntegrationProvider: in.IntegrationProvider,
+		Name:                in.Name,
+		PolicyID:            policyID,
+		ProcessWhere:        in.ProcessWhereClause,
+		RunbookURL:          in.RunbookURL,
+		Select:              in.SelectValue,
+		Type:                string(in.Type),
+		Warning:             in.WarningThreshold.apiThreshold(),
+		Where:               in.WhereClause,
+	}
+}
+
+func (in *AlertsInfraConditionThreshold) apiThreshold() *alerts.InfrastructureConditionThreshold {
+	if in == nil {
+		return nil
+	}
+
+	threshold := &alerts.InfrastructureConditionThreshold{
+		Duration: in.DurationMinutes,
+		Function: in.TimeFunction,
+	}
+
+	if value, err := strconv.ParseFloat(in.Value, 64); err == nil {
+		threshold.Value = &value
+	}
+
+	return threshold
+}
+
+// Diff lists the fields of the condition in New Relic that no longer match the spec.
+// Optional settings left empty in the spec are defaulted by New Relic and are not compared.
+func (in AlertsInfraConditionSpec) Diff(remote alerts.InfrastructureCondition) []string {
+	desired := in.APICondition()
+	differences := []string{}
+
+	if desired.Name != remote.Name {
+		differences = append(differences, fmt.Sprintf("name is %q, expected %q", remote.Name, desired.Name))
+	}
+
+	if desired.Enabled != remote.Enabled {
+		differences = append(differences, fmt.Sprintf("enabled is %t, expected %t", remote.Enabled, desired.Enabled))
+	}
+
+	if desired.Type != remote.Type {
+		differences = append(differences, fmt.Sprintf("type is %q, expected %q", remote.Type, desired.Type))
+	}
+
+	if desired.Comparison != "" && desired.Comparison != remote.Comparison {
+		differences = append(differences, fmt.Sprintf("comparison is %q, expected %q", remote.Comparison, desired.Comparison))
+	}
+
+	if desired.Event != "" && desired.Event != remote.Event {
+		differences = append(differences, fmt.Sprintf("event_type is %q, expected %q", remote.Event, desired.Event))
+	}
+
+	if desired.Select != "" && desired.Select != remote.Select {
+		differences = append(differences, fmt.Sprintf("select_value is %q, expected %q", remote.Select, desired.Select))
+	}
+
+	if desired.IntegrationProvider != "" && desired.IntegrationProvider != remote.IntegrationProvider {
+		differences = append(differences, fmt.Sprintf("integration_provider is %q, expected %q", remote.IntegrationProvider, desired.IntegrationProvider))
+	}
+
+	if desired.Where != remote.Where {
+		differences = append(differences, fmt.Sprintf("where_clause is %q, expected %q", remote.Where, desired.Where))
+	}
+
+	if desired.ProcessWhere != remote.ProcessWhere {
+		differences = append(differences, fmt.Sprintf("process_where_clause is %q, expected %q", remote.ProcessWhere, desired.ProcessWhere))
+	}
+
+	if desired.RunbookURL != remote.RunbookURL {
+		differences = append(differences, fmt.Sprintf("runbook_url is %q, expected %q", remote.RunbookURL, desired.RunbookURL))
+	}
+
+	if !reflect.DeepEqual(desired.Critical, remote.Critical) {
+		differences = append(differences, "critical_threshold differs")
+	}
+
+	if !reflect.DeepEqual(desired.Warning, remote.Warning) {
+		differences = append(differences, "warning_threshold differs")
+	}
+
+	return differences
+}
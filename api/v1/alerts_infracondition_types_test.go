@@ -0,0 +1,111 @@
+package v1
+
+import (
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AlertsInfraConditionSpec", func() {
+	var condition AlertsInfraConditionSpec
+
+	BeforeEach(func() {
+		condition = AlertsInfraConditionSpec{
+			AlertsGenericConditionSpec{
+				Type:             InfraConditionTypeMetric,
+				Name:             "High CPU",
+				RunbookURL:       "http://test.com/runbook",
+				Enabled:          true,
+				ExistingPolicyID: "42",
+			},
+			AlertsInfraSpecificSpec{
+				Comparison:  "above",
+				EventType:   "SystemSample",
+				SelectValue: "cpuPercent",
+				WhereClause: "(hostname LIKE '%prod%')",
+				CriticalThreshold: &AlertsInfraConditionThreshold{
+					DurationMinutes: 5,
+					TimeFunction:    "all",
+					Value:           "90.5",
+				},
+			},
+		}
+	})
+
+	Describe("APICondition", func() {
+		It("converts AlertsInfraConditionSpec object to InfrastructureCondition object from go client, retaining field values", func() {
+			apiCondition := condition.APICondition()
+
+			Expect(apiCondition.Type).To(Equal("infra_metric"))
+			Expect(apiCondition.Name).To(Equal("High CPU"))
+			Expect(apiCondition.RunbookURL).To(Equal("http://test.com/runbook"))
+			Expect(apiCondition.PolicyID).To(Equal(42))
+			Expect(apiCondition.Enabled).To(BeTrue())
+			Expect(apiCondition.Comparison).To(Equal("above"))
+			Expect(apiCondition.Event).To(Equal("SystemSample"))
+			Expect(apiCondition.Select).To(Equal("cpuPercent"))
+			Expect(apiCondition.Where).To(Equal("(hostname LIKE '%prod%')"))
+
+			Expect(apiCondition.Critical.Duration).To(Equal(5))
+			Expect(apiCondition.Critical.Function).To(Equal("all"))
+			Expect(*apiCondition.Critical.Value).To(Equal(90.5))
+			Expect(apiCondition.Warning).To(BeNil())
+		})
+
+		It("leaves the value of a host not reporting threshold unset", func() {
+			condition.Type = InfraConditionTypeHostNotReporting
+			condition.CriticalThreshold = &AlertsInfraConditionThreshold{DurationMinutes: 10}
+
+			apiCondition := condition.APICondition()
+
+			Expect(apiCondition.Critical.Duration).To(Equal(10))
+			Expect(apiCondition.Critical.Value).To(BeNil())
+		})
+	})
+
+	Describe("Diff", func() {
+		It("finds no differences in a matching condition", func() {
+			remote := condition.APICondition()
+			remote.ID = 7
+
+			Expect(condition.Diff(remote)).To(BeEmpty())
+		})
+
+		It("lists the differences", func() {
+			remote := condition.APICondition()
+			remote.Where = ""
+			remote.Critical.Duration = 10
+			warning := 80.0
+			remote.Warning = &alerts.InfrastructureConditionThreshold{Duration: 5, Function: "all", Value: &warning}
+
+			Expect(condition.Diff(remote)).To(ConsistOf(
+				`where_clause is "", expected "(hostname LIKE '%prod%')"`,
+				"critical_threshold differs",
+				"warning_threshold differs",
+			))
+		})
+	})
+})
+
+var _ = Describe("AlertsPolicyCondition with an infrastructure condition", func() {
+	It("round trips the infrastructure fields", func() {
+		spec := AlertsInfraConditionSpec{
+			AlertsGenericConditionSpec{
+				Type:    InfraConditionTypeProcessRunning,
+				Name:    "nginx is not running",
+				Enabled: true,
+			},
+			AlertsInfraSpecificSpec{
+				Comparison:         "equal",
+				ProcessWhereClause: "(commandName = 'nginx')",
+				CriticalThreshold:  &AlertsInfraConditionThreshold{DurationMinutes: 5, Value: "0"},
+			},
+		}
+
+		var condition AlertsPolicyCondition
+		condition.GenerateSpecFromInfraConditionSpec(spec)
+
+		Expect(GetAlertsConditionType(condition)).To(Equal("AlertsInfraCondition"))
+		Expect(condition.ReturnInfraConditionSpec()).To(Equal(spec))
+	})
+})
@@ -0,0 +1,306 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+)
+
+// log is for logging in this package.
+var (
+	alertsinfraconditionlog = logf.Log.WithName("alertsinfracondition-resource")
+)
+
+// infraSelectValuePattern matches the attribute names an infra_metric condition can select
+var infraSelectValuePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+// SetupWebhookWithManager - instantiates the Webhook
+func (r *AlertsInfraCondition) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	alertClientFunc = interfaces.InitializeAlertsClient
+	k8Client = mgr.GetClient()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-nr-k8s-newrelic-com-v1-alertsinfracondition,mutating=true,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=alertsinfraconditions,verbs=create;update,versions=v1,name=malertsinfracondition.kb.io,sideEffects=None
+
+var _ webhook.Defaulter = &AlertsInfraCondition{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *AlertsInfraCondition) Default() {
+	alertsinfraconditionlog.Info("default", "name", r.Name)
+
+	if r.Status.AppliedSpec == nil {
+		alertsinfraconditionlog.Info("Setting null Applied Spec to empty interface")
+		r.Status.AppliedSpec = &AlertsInfraConditionSpec{}
+	}
+
+	r.Spec.Comparison = strings.ToLower(r.Spec.Comparison)
+	DefaultAccountRef(&r.Spec.AccountRef)
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-nr-k8s-newrelic-com-v1-alertsinfracondition,mutating=false,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=alertsinfraconditions,versions=v1,name=valertsinfracondition.kb.io,sideEffects=None
+
+var _ webhook.Validator = &AlertsInfraCondition{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *AlertsInfraCondition) ValidateCreate() error {
+	alertsinfraconditionlog.Info("validate create", "name", r.Name)
+
+	return r.ValidateAlertsInfraCondition()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *AlertsInfraCondition) ValidateUpdate(old runtime.Object) error {
+	alertsinfraconditionlog.Info("validate update", "name", r.Name)
+
+	return r.ValidateAlertsInfraCondition()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *AlertsInfraCondition) ValidateDelete() error {
+	alertsinfraconditionlog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
+
+// ValidateAlertsInfraCondition checks the credentials, the fields required by the type of the
+// condition, the values of its attributes and that the policy it is added to exists
+func (r *AlertsInfraCondition) ValidateAlertsInfraCondition() error {
+	err := r.CheckForAPIKeyOrSecret()
+	if err != nil {
+		return err
+	}
+
+	err = r.CheckRequiredFields()
+	if err != nil {
+		return err
+	}
+
+	var invalidAttributes InvalidAttributeSlice
+
+	invalidAttributes = append(invalidAttributes, r.ValidateType()...)
+	invalidAttributes = append(invalidAttributes, r.ValidateComparison()...)
+	invalidAttributes = append(invalidAttributes, r.ValidateEventType()...)
+	invalidAttributes = append(invalidAttributes, r.ValidateSelectValue()...)
+	invalidAttributes = append(invalidAttributes, r.ValidateThresholds()...)
+
+	if len(invalidAttributes) > 0 {
+		return errors.New("error with invalid attributes: \n" + invalidAttributes.errorString())
+	}
+
+	return r.CheckExistingPolicyID()
+}
+
+func (r *AlertsInfraCondition) ValidateType() InvalidAttributeSlice {
+	if IsInfraConditionType(string(r.Spec.Type)) {
+		return []invalidAttribute{}
+	}
+
+	alertsinfraconditionlog.Info("Invalid Type attribute", "Type", r.Spec.Type)
+
+	return []invalidAttribute{{attribute: "Type", value: string(r.Spec.Type)}}
+}
+
+func (r *AlertsInfraCondition) ValidateComparison() InvalidAttributeSlice {
+	switch alerts.OperatorType(r.Spec.Comparison) {
+	case alerts.OperatorTypes.Above, alerts.OperatorTypes.Below, alerts.OperatorTypes.Equal:
+		return []invalidAttribute{}
+	case "":
+		if r.Spec.Type == InfraConditionTypeHostNotReporting {
+			return []invalidAttribute{}
+		}
+	}
+
+	alertsinfraconditionlog.Info("Invalid Comparison attribute", "Comparison", r.Spec.Comparison)
+
+	return []invalidAttribute{{attribute: "Comparison", value: r.Spec.Comparison}}
+}
+
+// ValidateEventType checks that an infra_metric condition selects its value from a sample event,
+// e.g. SystemSample or K8sContainerSample
+func (r *AlertsInfraCondition) ValidateEventType() InvalidAttributeSlice {
+	if r.Spec.Type != InfraConditionTypeMetric && r.Spec.EventType == "" {
+		return []invalidAttribute{}
+	}
+
+	if r.Spec.Type == InfraConditionTypeMetric && strings.HasSuffix(r.Spec.EventType, "Sample") && !strings.ContainsAny(r.Spec.EventType, " \t") {
+		return []invalidAttribute{}
+	}
+
+	alertsinfraconditionlog.Info("Invalid EventType attribute", "EventType", r.Spec.EventType)
+
+	return []invalidAttribute{{attribute: "EventType", value: r.Spec.EventType}}
+}
+
+func (r *AlertsInfraCondition) ValidateSelectValue() InvalidAttributeSlice {
+	if r.Spec.Type != InfraConditionTypeMetric && r.Spec.SelectValue == "" {
+		return []invalidAttribute{}
+	}
+
+	if r.Spec.Type == InfraConditionTypeMetric && infraSelectValuePattern.MatchString(r.Spec.SelectValue) {
+		return []invalidAttribute{}
+	}
+
+	alertsinfraconditionlog.Info("Invalid SelectValue attribute", "SelectValue", r.Spec.SelectValue)
+
+	return []invalidAttribute{{attribute: "SelectValue", value: r.Spec.SelectValue}}
+}
+
+// ValidateThresholds checks the critical and warning thresholds. Only infra_metric conditions have a
+// warning threshold and a time function, infra_host_not_reporting conditions have no value.
+func (r *AlertsInfraCondition) ValidateThresholds() InvalidAttributeSlice {
+	invalidThresholds := r.validateThreshold("CriticalThreshold", r.Spec.CriticalThreshold)
+
+	if r.Spec.WarningThreshold != nil {
+		if r.Spec.Type != InfraConditionTypeMetric {
+			alertsinfraconditionlog.Info("Warning threshold passed for condition type", "Type", r.Spec.Type)
+			invalidThresholds = append(invalidThresholds, invalidAttribute{
+				attribute: "WarningThreshold",
+				value:     "only infra_metric conditions have a warning threshold",
+			})
+		} else {
+			invalidThresholds = append(invalidThresholds, r.validateThreshold("WarningThreshold", r.Spec.WarningThreshold)...)
+		}
+	}
+
+	return invalidThresholds
+}
+
+func (r *AlertsInfraCondition) validateThreshold(attribute string, threshold *AlertsInfraConditionThreshold) InvalidAttributeSlice {
+	var invalidThreshold InvalidAttributeSlice
+
+	if threshold == nil {
+		return invalidThreshold
+	}
+
+	if threshold.DurationMinutes < 1 || threshold.DurationMinutes > 60 {
+		alertsinfraconditionlog.Info("Invalid threshold duration passed", attribute+".DurationMinutes", threshold.DurationMinutes)
+		invalidThreshold = append(invalidThreshold, invalidAttribute{
+			attribute: attribute + ".DurationMinutes",
+			value:     strconv.Itoa(threshold.DurationMinutes),
+		})
+	}
+
+	switch {
+	case r.Spec.Type == InfraConditionTypeMetric:
+		switch alerts.TimeFunctionType(threshold.TimeFunction) {
+		case alerts.TimeFunctionTypes.All, alerts.TimeFunctionTypes.Any:
+		default:
+			alertsinfraconditionlog.Info("Invalid threshold time function passed", attribute+".TimeFunction", threshold.TimeFunction)
+			invalidThreshold = append(invalidThreshold, invalidAttribute{
+				attribute: attribute + ".TimeFunction",
+				value:     threshold.TimeFunction,
+			})
+		}
+	case threshold.TimeFunction != "":
+		alertsinfraconditionlog.Info("Threshold time function passed for condition type", "Type", r.Spec.Type)
+		invalidThreshold = append(invalidThreshold, invalidAttribute{
+			attribute: attribute + ".TimeFunction",
+			value:     threshold.TimeFunction,
+		})
+	}
+
+	if r.Spec.Type == InfraConditionTypeHostNotReporting {
+		if threshold.Value != "" {
+			alertsinfraconditionlog.Info("Threshold value passed for condition type", "Type", r.Spec.Type)
+			invalidThreshold = append(invalidThreshold, invalidAttribute{
+				attribute: attribute + ".Value",
+				value:     threshold.Value,
+			})
+		}
+	} else if _, err := strconv.ParseFloat(threshold.Value, 64); err != nil {
+		alertsinfraconditionlog.Info("Invalid threshold value passed", attribute+".Value", threshold.Value)
+		invalidThreshold = append(invalidThreshold, invalidAttribute{
+			attribute: attribute + ".Value",
+			value:     threshold.Value,
+		})
+	}
+
+	return invalidThreshold
+}
+
+func (r *AlertsInfraCondition) CheckExistingPolicyID() error {
+	alertsinfraconditionlog.Info("Checking existing", "policyId", r.Spec.ExistingPolicyID)
+	ctx := context.Background()
+	credentials, getErr := ResolveCredentials(ctx, k8Client, r.Namespace, r.GetAccountSettings())
+	if getErr != nil {
+		alertsinfraconditionlog.Error(getErr, "Error getting credentials")
+		return getErr
+	}
+
+	alertsClient, errAlertClient := alertClientFunc(credentials.APIKey, credentials.Region)
+	if errAlertClient != nil {
+		alertsinfraconditionlog.Error(errAlertClient, "failed to get policy",
+			"policyId", r.Spec.ExistingPolicyID,
+			"API Key", interfaces.PartialAPIKey(credentials.APIKey),
+			"accountID", credentials.AccountID,
+			"region", credentials.Region,
+		)
+		return errAlertClient
+	}
+
+	alertPolicy, errAlertPolicy := alertsClient.QueryPolicy(credentials.AccountID, r.Spec.ExistingPolicyID)
+	if errAlertPolicy != nil {
+		if r.GetDeletionTimestamp() != nil {
+			alertsinfraconditionlog.Info("Deleting resource", "errAlertPolicy", errAlertPolicy)
+			return nil
+		}
+		alertsinfraconditionlog.Error(errAlertPolicy, "failed to get policy",
+			"policyId", r.Spec.ExistingPolicyID,
+			"API Key", interfaces.PartialAPIKey(credentials.APIKey),
+			"region", credentials.Region,
+		)
+		return errAlertPolicy
+	}
+
+	if alertPolicy.ID != r.Spec.ExistingPolicyID {
+		alertsinfraconditionlog.Info("Alert policy returned by the API failed to match provided policy ID")
+		return errors.New("alert policy returned by API did not match")
+	}
+
+	return nil
+}
+
+func (r *AlertsInfraCondition) CheckForAPIKeyOrSecret() error {
+	return CheckForAccount(r.Namespace, r.GetAccountSettings())
+}
+
+// CheckRequiredFields checks the fields every infrastructure condition needs and the fields of an
+// infra_metric condition
+func (r *AlertsInfraCondition) CheckRequiredFields() error {
+	missingFields := []string{}
+	// the region of a referenced account is used when the condition does not set one
+	if r.Spec.Region == "" && r.Spec.AccountRef.Name == "" {
+		missingFields = append(missingFields, "region")
+	}
+	if r.Spec.ExistingPolicyID == "" {
+		missingFields = append(missingFields, "existing_policy_id")
+	}
+	if r.Spec.CriticalThreshold == nil {
+		missingFields = append(missingFields, "critical_threshold")
+	}
+	if r.Spec.Type == InfraConditionTypeMetric {
+		if r.Spec.EventType == "" {
+			missingFields = append(missingFields, "event_type")
+		}
+		if r.Spec.SelectValue == "" {
+			missingFields = append(missingFields, "select_value")
+		}
+	}
+	if len(missingFields) > 0 {
+		return errors.New(strings.Join(missingFields, " and ") + " must be set")
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+)
+
+var _ = Describe("alertsInfraCondition_webhook", func() {
+	var (
+		r            AlertsInfraCondition
+		alertsClient *interfacesfakes.FakeNewRelicAlertsClient
+	)
+
+	BeforeEach(func() {
+		k8Client = testk8sClient
+		alertsClient = &interfacesfakes.FakeNewRelicAlertsClient{}
+		alertClientFunc = func(string, string) (interfaces.NewRelicAlertsClient, error) {
+			return alertsClient, nil
+		}
+		r = AlertsInfraCondition{
+			ObjectMeta: v1.ObjectMeta{
+				Name: "test infra condition",
+			},
+			Spec: AlertsInfraConditionSpec{
+				AlertsGenericConditionSpec{
+					Type:             InfraConditionTypeMetric,
+					Name:             "K8s generated infra alert condition",
+					Enabled:          true,
+					ExistingPolicyID: "46286",
+					APIKey:           "111222333",
+					Region:           "staging",
+				},
+				AlertsInfraSpecificSpec{
+					Comparison:  "above",
+					EventType:   "SystemSample",
+					SelectValue: "cpuPercent",
+					CriticalThreshold: &AlertsInfraConditionThreshold{
+						DurationMinutes: 5,
+						TimeFunction:    "all",
+						Value:           "90",
+					},
+					WarningThreshold: &AlertsInfraConditionThreshold{
+						DurationMinutes: 5,
+						TimeFunction:    "any",
+						Value:           "75",
+					},
+				},
+			},
+		}
+
+		alertsClient.QueryPolicyStub = func(int, string) (*alerts.AlertsPolicy, error) {
+			return &alerts.AlertsPolicy{
+				ID: "46286",
+			}, nil
+		}
+	})
+
+	Context("Default", func() {
+		It("lower cases the comparison", func() {
+			r.Spec.Comparison = "ABOVE"
+			r.Default()
+			Expect(r.Spec.Comparison).To(Equal("above"))
+			Expect(r.Status.AppliedSpec).ToNot(BeNil())
+		})
+	})
+
+	Context("ValidateCreate", func() {
+		Context("With a valid infra metric condition", func() {
+			It("Should create the infra condition", func() {
+				err := r.ValidateCreate()
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("With a valid host not reporting condition", func() {
+			BeforeEach(func() {
+				r.Spec.Type = InfraConditionTypeHostNotReporting
+				r.Spec.Comparison = ""
+				r.Spec.EventType = ""
+				r.Spec.SelectValue = ""
+				r.Spec.CriticalThreshold = &AlertsInfraConditionThreshold{DurationMinutes: 10}
+				r.Spec.WarningThreshold = nil
+			})
+
+			It("Should create the infra condition", func() {
+				err := r.ValidateCreate()
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("With an invalid Type", func() {
+			BeforeEach(func() {
+				r.Spec.Type = "burritos"
+			})
+
+			It("Should reject the infra condition creation", func() {
+				err := r.ValidateCreate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("burritos"))
+			})
+		})
+
+		Context("With an invalid Comparison, EventType and SelectValue", func() {
+			BeforeEach(func() {
+				r.Spec.Comparison = "moar burritos"
+				r.Spec.EventType = "moar tacos"
+				r.Spec.SelectValue = "moar hamburgers"
+			})
+
+			It("Should reject the infra condition creation", func() {
+				err := r.ValidateCreate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("moar burritos"))
+				Expect(err.Error()).To(ContainSubstring("moar tacos"))
+				Expect(err.Error()).To(ContainSubstring("moar hamburgers"))
+			})
+		})
+
+		Context("Without the fields of an infra metric condition", func() {
+			BeforeEach(func() {
+				r.Spec.EventType = ""
+				r.Spec.SelectValue = ""
+				r.Spec.CriticalThreshold = nil
+			})
+
+			It("Should reject the infra condition creation", func() {
+				err := r.ValidateCreate()
+				Expect(err).To(MatchError("critical_threshold and event_type and select_value must be set"))
+			})
+		})
+
+		Context("With invalid thresholds", func() {
+			BeforeEach(func() {
+				r.Spec.CriticalThreshold = &AlertsInfraConditionThreshold{
+					DurationMinutes: 61,
+					TimeFunction:    "sometimes",
+					Value:           "lots",
+				}
+			})
+
+			It("Should reject the infra condition creation", func() {
+				err := r.ValidateCreate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("CriticalThreshold.DurationMinutes"))
+				Expect(err.Error()).To(ContainSubstring("sometimes"))
+				Expect(err.Error()).To(ContainSubstring("lots"))
+			})
+		})
+
+		Context("With a warning threshold on a process running condition", func() {
+			BeforeEach(func() {
+				r.Spec.Type = InfraConditionTypeProcessRunning
+				r.Spec.Comparison = "equal"
+				r.Spec.EventType = ""
+				r.Spec.SelectValue = ""
+				r.Spec.CriticalThreshold = &AlertsInfraConditionThreshold{DurationMinutes: 5, Value: "0"}
+			})
+
+			It("Should reject the infra condition creation", func() {
+				err := r.ValidateCreate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("WarningThreshold"))
+			})
+		})
+
+		Context("With a policy that does not exist", func() {
+			BeforeEach(func() {
+				alertsClient.QueryPolicyStub = func(int, string) (*alerts.AlertsPolicy, error) {
+					return nil, errors.New("no alert policy found for id 46286")
+				}
+			})
+
+			It("Should reject the infra condition creation", func() {
+				err := r.ValidateCreate()
+				Expect(err).To(MatchError("no alert policy found for id 46286"))
+			})
+		})
+	})
+})
@@ -1,10 +1,14 @@
 package v1
 
 import (
+	"fmt"
+	"reflect"
 	"strconv"
 
 	"github.com/newrelic/newrelic-client-go/pkg/alerts"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/nrqlsignal"
 )
 
 // AlertsNrqlConditionSpec defines the desired state of AlertsNrqlCondition
@@ -19,6 +23,7 @@ type AlertsGenericConditionSpec struct {
 	Enabled          bool                      `json:"enabled"`
 	APIKey           string                    `json:"api_key,omitempty"`
 	APIKeySecret     NewRelicAPIKeySecret      `json:"api_key_secret,omitempty"`
+	AccountRef       NewRelicAccountReference  `json:"account_ref,omitempty"`
 	AccountID        int                       `json:"account_id,omitempty"`
 	ExistingPolicyID string                    `json:"existing_policy_id,omitempty"`
 	ID               int                       `json:"id,omitempty"`
@@ -32,9 +37,13 @@ type AlertsGenericConditionSpec struct {
 }
 
 type AlertsNrqlSpecificSpec struct {
-	Description        string                                 `json:"description,omitempty"`
-	Nrql               alerts.NrqlConditionQuery              `json:"nrql,omitempty"`
-	ValueFunction      *alerts.NrqlConditionValueFunction     `json:"valueFunction,omitempty"`
+	// ConditionType is STATIC, BASELINE or OUTLIER. When it is not set the condition is a BASELINE
+	// condition if baseline_direction is set and a STATIC condition otherwise.
+	ConditionType alerts.NrqlConditionType           `json:"condition_type,omitempty"`
+	Description   string                             `json:"description,omitempty"`
+	Nrql          alerts.NrqlConditionQuery          `json:"nrql,omitempty"`
+	ValueFunction *alerts.NrqlConditionValueFunction `json:"valueFunction,omitempty"`
+	// ExpectedGroups and IgnoreOverlap only apply to OUTLIER conditions
 	ExpectedGroups     int                                    `json:"expected_groups,omitempty"`
 	IgnoreOverlap      bool                                   `json:"ignore_overlap,omitempty"`
 	ViolationTimeLimit alerts.NrqlConditionViolationTimeLimit `json:"violationTimeLimit,omitempty"`
@@ -48,6 +57,14 @@ type AlertsNrqlConditionSignal struct {
 	EvaluationOffset  *int                     `json:"evaluation_offset,omitempty"`
 	FillOption        *alerts.AlertsFillOption `json:"fill_option,omitempty"`
 	FillValue         *string                  `json:"fill_value,omitempty"`
+	// AggregationMethod is EVENT_FLOW, EVENT_TIMER or CADENCE, New Relic uses EVENT_FLOW when it is not set
+	AggregationMethod *nrqlsignal.AggregationMethod `json:"aggregation_method,omitempty"`
+	// AggregationDelay is the number of seconds to wait for late data, it is used by EVENT_FLOW and CADENCE
+	AggregationDelay *int `json:"aggregation_delay,omitempty"`
+	// AggregationTimer is the number of seconds to wait for more data after the last data point, it is used by EVENT_TIMER
+	AggregationTimer *int `json:"aggregation_timer,omitempty"`
+	// SlideBy is the number of seconds the aggregation windows slide by, the aggregation window must be a multiple of it
+	SlideBy *int `json:"slide_by,omitempty"`
 }
 
 // AlertsNrqlConditionExpiration
@@ -73,12 +90,15 @@ type AlertsNrqlConditionTerm struct {
 
 // AlertsNrqlConditionStatus defines the observed state of AlertsNrqlCondition
 type AlertsNrqlConditionStatus struct {
-	AppliedSpec *AlertsNrqlConditionSpec `json:"applied_spec"`
+	AppliedSpec *AlertsNrqlConditionSpec `json:"applied_spec,omitempty"`
 	ConditionID string                   `json:"condition_id"`
+	Conditions  []Condition              `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Created",type="boolean",JSONPath=".status.created"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
 
 // AlertsNrqlCondition is the Schema for the alertsnrqlconditions API
 type AlertsNrqlCondition struct {
@@ -102,6 +122,32 @@ func init() {
 	SchemeBuilder.Register(&AlertsNrqlCondition{}, &AlertsNrqlConditionList{})
 }
 
+// GetConditions returns the status conditions of the AlertsNrqlCondition
+func (in *AlertsNrqlCondition) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the AlertsNrqlCondition
+func (in *AlertsNrqlCondition) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditionType returns the NerdGraph type of the condition, the condition_type or the type
+// implied by baseline_direction when it is not set
+func (in AlertsNrqlConditionSpec) GetConditionType() alerts.NrqlConditionType {
+	if in.ConditionType != "" {
+		return in.ConditionType
+	}
+
+	if in.BaselineDirection != nil {
+		return alerts.NrqlConditionTypes.Baseline
+	}
+
+	return alerts.NrqlConditionTypes.Static
+}
+
+// ToNrqlConditionInput returns the input of the NerdGraph mutations. Fields that only apply to
+// another type of condition are left unset, NerdGraph rejects them.
 func (in AlertsNrqlConditionSpec) ToNrqlConditionInput() alerts.NrqlConditionInput {
 	conditionInput := alerts.NrqlConditionInput{}
 	conditionInput.Description = in.Description
@@ -110,7 +156,20 @@ func (in AlertsNrqlConditionSpec) ToNrqlConditionInput() alerts.NrqlConditionInp
 	conditionInput.Nrql = in.Nrql
 	conditionInput.RunbookURL = in.RunbookURL
 	conditionInput.ViolationTimeLimit = in.ViolationTimeLimit
-	conditionInput.BaselineDirection = in.BaselineDirection
+
+	switch in.GetConditionType() {
+	case alerts.NrqlConditionTypes.Baseline:
+		conditionInput.BaselineDirection = in.BaselineDirection
+	case alerts.NrqlConditionTypes.Outlier:
+		if in.ExpectedGroups != 0 {
+			expectedGroups := in.ExpectedGroups
+			conditionInput.ExpectedGroups = &expectedGroups
+		}
+		openViolationOnGroupOverlap := !in.IgnoreOverlap
+		conditionInput.OpenViolationOnGroupOverlap = &openViolationOnGroupOverlap
+	default:
+		conditionInput.ValueFunction = in.ValueFunction
+	}
 
 	if in.Expiration != nil {
 		conditionInput.Expiration = &alerts.AlertsNrqlConditionExpiration{}
@@ -124,17 +183,7 @@ func (in AlertsNrqlConditionSpec) ToNrqlConditionInput() alerts.NrqlConditionInp
 		conditionInput.Signal.FillOption = in.Signal.FillOption
 		conditionInput.Signal.AggregationWindow = in.Signal.AggregationWindow
 		conditionInput.Signal.EvaluationOffset = in.Signal.EvaluationOffset
-		if in.Signal.FillValue != nil {
-			f, err := strconv.ParseFloat(*in.Signal.FillValue, 64)
-			if err != nil {
-				log.Error(err, "strconv.ParseFloat()", "signal.FillValue", in.Signal.FillValue)
-			}
-			conditionInput.Signal.FillValue = &f
-		}
-	}
-
-	if in.ValueFunction != nil {
-		conditionInput.ValueFunction = in.ValueFunction
+		conditionInput.Signal.FillValue = in.Signal.fillValue()
 	}
 
 	for _, term := range in.Terms {
@@ -158,3 +207,158 @@ func (in AlertsNrqlConditionSpec) ToNrqlConditionInput() alerts.NrqlConditionInp
 
 	return conditionInput
 }
+
+// HasStreamingSettings returns true when the signal sets one of the streaming aggregation settings
+// that are written separately from the rest of the condition
+func (in *AlertsNrqlConditionSignal) HasStreamingSettings() bool {
+	if in == nil {
+		return false
+	}
+
+	return in.AggregationMethod != nil || in.AggregationDelay != nil || in.AggregationTimer != nil || in.SlideBy != nil
+}
+
+func (in *AlertsNrqlConditionSignal) fillValue() *float64 {
+	if in.FillValue == nil {
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(*in.FillValue, 64)
+	if err != nil {
+		log.Error(err, "strconv.ParseFloat()", "signal.FillValue", in.FillValue)
+	}
+
+	return &f
+}
+
+// ManagesStreamingSettings returns true when the streaming settings of the signal are written to New Relic.
+// That is the case when the spec sets them, or when applied did and they have to be reset.
+func (in AlertsNrqlConditionSpec) ManagesStreamingSettings(applied *AlertsNrqlConditionSpec) bool {
+	return in.Signal.HasStreamingSettings() || (applied != nil && applied.Signal.HasStreamingSettings())
+}
+
+// ToSignalInput returns the complete signal of the condition, including the streaming settings
+// newrelic-client-go does not support. Without streaming settings in the spec they are reset to
+// the defaults of New Relic, the EVENT_FLOW aggregation method without any other setting.
+func (in AlertsNrqlConditionSpec) ToSignalInput() nrqlsignal.Signal {
+	signal := nrqlsignal.Signal{}
+
+	if in.Signal != nil {
+		signal = nrqlsignal.Signal{
+			AggregationWindow: in.Signal.AggregationWindow,
+			AggregationMethod: in.Signal.AggregationMethod,
+			AggregationDelay:  in.Signal.AggregationDelay,
+			AggregationTimer:  in.Signal.AggregationTimer,
+			EvaluationOffset:  in.Signal.EvaluationOffset,
+			FillOption:        in.Signal.FillOption,
+			FillValue:         in.Signal.fillValue(),
+			SlideBy:           in.Signal.SlideBy,
+		}
+	}
+
+	if !in.Signal.HasStreamingSettings() {
+		aggregationMethod := nrqlsignal.AggregationMethodEventFlow
+		signal.AggregationMethod = &aggregationMethod
+	}
+
+	return signal
+}
+
+// DiffSignal lists the streaming settings of the signal in New Relic that no longer match the spec.
+// Settings left empty in the spec are defaulted by New Relic and are not compared. Settings removed
+// from the spec after they were applied must have been reset, only the aggregation delay is then
+// left to the default of New Relic.
+func (in AlertsNrqlConditionSpec) DiffSignal(applied *AlertsNrqlConditionSpec, remote *nrqlsignal.Signal) []string {
+	differences := []string{}
+
+	if !in.ManagesStreamingSettings(applied) {
+		return differences
+	}
+
+	if remote == nil {
+		remote = &nrqlsignal.Signal{}
+	}
+
+	desired := in.ToSignalInput()
+	reset := !in.Signal.HasStreamingSettings()
+
+	if desired.AggregationMethod != nil && !reflect.DeepEqual(desired.AggregationMethod, remote.AggregationMethod) {
+		differences = append(differences, "signal aggregation_method differs")
+	}
+
+	if desired.AggregationDelay != nil && !reflect.DeepEqual(desired.AggregationDelay, remote.AggregationDelay) {
+		differences = append(differences, "signal aggregation_delay differs")
+	}
+
+	if (reset || desired.AggregationTimer != nil) && !reflect.DeepEqual(desired.AggregationTimer, remote.AggregationTimer) {
+		differences = append(differences, "signal aggregation_timer differs")
+	}
+
+	if (reset || desired.SlideBy != nil) && !reflect.DeepEqual(desired.SlideBy, remote.SlideBy) {
+		differences = append(differences, "signal slide_by differs")
+	}
+
+	return differences
+}
+
+// Diff lists the fields of the condition in New Relic that no longer match the spec.
+// Optional settings left empty in the spec are defaulted by New Relic and are not compared.
+func (in AlertsNrqlConditionSpec) Diff(remote alerts.NrqlAlertCondition) []string {
+	desired := in.ToNrqlConditionInput()
+	differences := []string{}
+
+	if desired.Name != remote.Name {
+		differences = append(differences, fmt.Sprintf("name is %q, expected %q", remote.Name, desired.Name))
+	}
+
+	if desired.Enabled != remote.Enabled {
+		differences = append(differences, fmt.Sprintf("enabled is %t, expected %t", remote.Enabled, desired.Enabled))
+	}
+
+	if desired.Description != remote.Description {
+		differences = append(differences, "description differs")
+	}
+
+	if desired.RunbookURL != remote.RunbookURL {
+		differences = append(differences, fmt.Sprintf("runbook_url is %q, expected %q", remote.RunbookURL, desired.RunbookURL))
+	}
+
+	if desired.Nrql.Query != remote.Nrql.Query {
+		differences = append(differences, fmt.Sprintf("nrql query is %q, expected %q", remote.Nrql.Query, desired.Nrql.Query))
+	}
+
+	if desired.ViolationTimeLimit != "" && desired.ViolationTimeLimit != remote.ViolationTimeLimit {
+		differences = append(differences, fmt.Sprintf("violationTimeLimit is %q, expected %q", remote.ViolationTimeLimit, desired.ViolationTimeLimit))
+	}
+
+	if !reflect.DeepEqual(desired.Terms, remote.Terms) {
+		differences = append(differences, "terms differ")
+	}
+
+	if desired.BaselineDirection != nil && !reflect.DeepEqual(desired.BaselineDirection, remote.BaselineDirection) {
+		differences = append(differences, "baseline_direction differs")
+	}
+
+	if desired.ValueFunction != nil && !reflect.DeepEqual(desired.ValueFunction, remote.ValueFunction) {
+		differences = append(differences, "valueFunction differs")
+	}
+
+	if desired.ExpectedGroups != nil && !reflect.DeepEqual(desired.ExpectedGroups, remote.ExpectedGroups) {
+		differences = append(differences, "expected_groups differs")
+	}
+
+	if desired.OpenViolationOnGroupOverlap != nil && remote.OpenViolationOnGroupOverlap != nil &&
+		*desired.OpenViolationOnGroupOverlap != *remote.OpenViolationOnGroupOverlap {
+		differences = append(differences, "ignore_overlap differs")
+	}
+
+	if desired.Expiration != nil && !reflect.DeepEqual(desired.Expiration, remote.Expiration) {
+		differences = append(differences, "expiration differs")
+	}
+
+	if desired.Signal != nil && !reflect.DeepEqual(desired.Signal, remote.Signal) {
+		differences = append(differences, "signal differs")
+	}
+
+	return differences
+}
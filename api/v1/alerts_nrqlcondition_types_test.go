@@ -8,6 +8,8 @@ import (
 
 	"github.com/newrelic/newrelic-client-go/pkg/alerts"
 
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/nrqlsignal"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -80,4 +82,261 @@ var _ = Describe("AlertsNrqlConditionSpec", func() {
 			//Expect(apiQuery.SinceValue).To(Equal("5"))
 		})
 	})
+
+	Describe("GetConditionType", func() {
+		It("defaults to a static condition", func() {
+			Expect(condition.GetConditionType()).To(Equal(alerts.NrqlConditionTypes.Static))
+		})
+
+		It("is a baseline condition when a baseline direction is set", func() {
+			condition.BaselineDirection = &alerts.NrqlBaselineDirections.UpperOnly
+
+			Expect(condition.GetConditionType()).To(Equal(alerts.NrqlConditionTypes.Baseline))
+		})
+
+		It("uses the condition type when it is set", func() {
+			condition.ConditionType = alerts.NrqlConditionTypes.Outlier
+
+			Expect(condition.GetConditionType()).To(Equal(alerts.NrqlConditionTypes.Outlier))
+		})
+	})
+
+	Describe("ToNrqlConditionInput for each condition type", func() {
+		It("only sets the value function of a static condition", func() {
+			condition.ConditionType = alerts.NrqlConditionTypes.Static
+
+			conditionInput := condition.ToNrqlConditionInput()
+
+			Expect(conditionInput.ValueFunction).To(Equal(&alerts.NrqlConditionValueFunctions.SingleValue))
+			Expect(conditionInput.BaselineDirection).To(BeNil())
+			Expect(conditionInput.ExpectedGroups).To(BeNil())
+			Expect(conditionInput.OpenViolationOnGroupOverlap).To(BeNil())
+		})
+
+		It("only sets the baseline direction of a baseline condition", func() {
+			condition.ConditionType = alerts.NrqlConditionTypes.Baseline
+			condition.BaselineDirection = &alerts.NrqlBaselineDirections.LowerOnly
+
+			conditionInput := condition.ToNrqlConditionInput()
+
+			Expect(conditionInput.BaselineDirection).To(Equal(&alerts.NrqlBaselineDirections.LowerOnly))
+			Expect(conditionInput.ValueFunction).To(BeNil())
+			Expect(conditionInput.ExpectedGroups).To(BeNil())
+			Expect(conditionInput.OpenViolationOnGroupOverlap).To(BeNil())
+		})
+
+		It("sets the expected groups and group overlap of an outlier condition", func() {
+			condition.ConditionType = alerts.NrqlConditionTypes.Outlier
+
+			conditionInput := condition.ToNrqlConditionInput()
+
+			expectedGroups := 2
+			openViolationOnGroupOverlap := false
+			Expect(conditionInput.ExpectedGroups).To(Equal(&expectedGroups))
+			Expect(conditionInput.OpenViolationOnGroupOverlap).To(Equal(&openViolationOnGroupOverlap))
+			Expect(conditionInput.ValueFunction).To(BeNil())
+			Expect(conditionInput.BaselineDirection).To(BeNil())
+		})
+	})
+
+	Describe("Diff", func() {
+		var remote alerts.NrqlAlertCondition
+
+		BeforeEach(func() {
+			conditionInput := condition.ToNrqlConditionInput()
+			remote = alerts.NrqlAlertCondition{
+				NrqlConditionBase: conditionInput.NrqlConditionBase,
+				ID:                "112",
+				PolicyID:          "42",
+				ValueFunction:     conditionInput.ValueFunction,
+			}
+		})
+
+		It("finds no differences when New Relic matches the spec", func() {
+			Expect(condition.Diff(remote)).To(BeEmpty())
+		})
+
+		It("ignores settings defaulted by New Relic", func() {
+			aggregationWindow := 60
+			remote.Signal = &alerts.AlertsNrqlConditionSignal{AggregationWindow: &aggregationWindow}
+
+			Expect(condition.Diff(remote)).To(BeEmpty())
+		})
+
+		It("reports a changed query", func() {
+			remote.Nrql.Query = "SELECT 2 FROM MyEvents"
+
+			Expect(condition.Diff(remote)).To(ConsistOf(`nrql query is "SELECT 2 FROM MyEvents", expected "SELECT 1 FROM MyEvents"`))
+		})
+
+		It("reports changed terms", func() {
+			threshold := 10.0
+			remote.Terms = []alerts.NrqlConditionTerm{{Threshold: &threshold}}
+
+			Expect(condition.Diff(remote)).To(ConsistOf("terms differ"))
+		})
+
+		It("reports a disabled condition", func() {
+			remote.Enabled = false
+
+			Expect(condition.Diff(remote)).To(ConsistOf("enabled is false, expected true"))
+		})
+
+		It("reports changed expected groups of an outlier condition", func() {
+			condition.ConditionType = alerts.NrqlConditionTypes.Outlier
+			conditionInput := condition.ToNrqlConditionInput()
+			expectedGroups := 3
+			remote.ValueFunction = nil
+			remote.ExpectedGroups = &expectedGroups
+			remote.OpenViolationOnGroupOverlap = conditionInput.OpenViolationOnGroupOverlap
+
+			Expect(condition.Diff(remote)).To(ConsistOf("expected_groups differs"))
+		})
+	})
+
+	Describe("ToSignalInput", func() {
+		It("resets the streaming settings without a signal", func() {
+			aggregationMethod := nrqlsignal.AggregationMethodEventFlow
+
+			Expect(condition.ToSignalInput()).To(Equal(nrqlsignal.Signal{AggregationMethod: &aggregationMethod}))
+		})
+
+		It("keeps the other settings when resetting the streaming settings", func() {
+			aggregationWindow := 120
+			condition.Signal = &AlertsNrqlConditionSignal{AggregationWindow: &aggregationWindow}
+
+			signal := condition.ToSignalInput()
+
+			Expect(*signal.AggregationWindow).To(Equal(120))
+			Expect(*signal.AggregationMethod).To(Equal(nrqlsignal.AggregationMethodEventFlow))
+			Expect(signal.AggregationDelay).To(BeNil())
+			Expect(signal.AggregationTimer).To(BeNil())
+			Expect(signal.SlideBy).To(BeNil())
+		})
+
+		It("sets the streaming settings of the signal", func() {
+			aggregationWindow, aggregationTimer, slideBy := 120, 60, 30
+			aggregationMethod := nrqlsignal.AggregationMethodEventTimer
+			fillValue := "0.5"
+			condition.Signal = &AlertsNrqlConditionSignal{
+				AggregationWindow: &aggregationWindow,
+				AggregationMethod: &aggregationMethod,
+				AggregationTimer:  &aggregationTimer,
+				FillOption:        &alerts.AlertsFillOptionTypes.STATIC,
+				FillValue:         &fillValue,
+				SlideBy:           &slideBy,
+			}
+
+			signal := condition.ToSignalInput()
+
+			Expect(*signal.AggregationWindow).To(Equal(120))
+			Expect(*signal.AggregationMethod).To(Equal(nrqlsignal.AggregationMethodEventTimer))
+			Expect(*signal.AggregationTimer).To(Equal(60))
+			Expect(signal.AggregationDelay).To(BeNil())
+			Expect(*signal.FillValue).To(Equal(0.5))
+			Expect(*signal.SlideBy).To(Equal(30))
+			Expect(condition.Signal.HasStreamingSettings()).To(BeTrue())
+		})
+
+		It("has no streaming settings when only the aggregation window is set", func() {
+			aggregationWindow := 120
+			condition.Signal = &AlertsNrqlConditionSignal{AggregationWindow: &aggregationWindow}
+
+			Expect(condition.Signal.HasStreamingSettings()).To(BeFalse())
+		})
+	})
+
+	Describe("ManagesStreamingSettings", func() {
+		var applied AlertsNrqlConditionSpec
+
+		BeforeEach(func() {
+			aggregationTimer := 60
+			aggregationMethod := nrqlsignal.AggregationMethodEventTimer
+			applied = condition
+			applied.Signal = &AlertsNrqlConditionSignal{
+				AggregationMethod: &aggregationMethod,
+				AggregationTimer:  &aggregationTimer,
+			}
+		})
+
+		It("is true when the spec sets streaming settings", func() {
+			Expect(applied.ManagesStreamingSettings(nil)).To(BeTrue())
+		})
+
+		It("is true when the streaming settings were removed after they were applied", func() {
+			Expect(condition.ManagesStreamingSettings(&applied)).To(BeTrue())
+		})
+
+		It("is false when neither the spec nor the applied spec set streaming settings", func() {
+			Expect(condition.ManagesStreamingSettings(nil)).To(BeFalse())
+			Expect(condition.ManagesStreamingSettings(&condition)).To(BeFalse())
+		})
+	})
+
+	Describe("DiffSignal", func() {
+		var remote nrqlsignal.Signal
+
+		BeforeEach(func() {
+			aggregationDelay := 120
+			aggregationMethod := nrqlsignal.AggregationMethodEventFlow
+			condition.Signal = &AlertsNrqlConditionSignal{
+				AggregationMethod: &aggregationMethod,
+				AggregationDelay:  &aggregationDelay,
+			}
+			remote = condition.ToSignalInput()
+		})
+
+		It("finds no differences when New Relic matches the spec", func() {
+			Expect(condition.DiffSignal(nil, &remote)).To(BeEmpty())
+		})
+
+		It("ignores settings defaulted by New Relic", func() {
+			aggregationWindow := 60
+			remote.AggregationWindow = &aggregationWindow
+
+			Expect(condition.DiffSignal(nil, &remote)).To(BeEmpty())
+		})
+
+		It("reports a changed aggregation method and delay", func() {
+			aggregationTimer := 60
+			aggregationMethod := nrqlsignal.AggregationMethodEventTimer
+			remote.AggregationMethod = &aggregationMethod
+			remote.AggregationDelay = nil
+			remote.AggregationTimer = &aggregationTimer
+
+			Expect(condition.DiffSignal(nil, &remote)).To(ConsistOf(
+				"signal aggregation_method differs",
+				"signal aggregation_delay differs",
+			))
+		})
+
+		It("reports all settings when New Relic returns no signal", func() {
+			Expect(condition.DiffSignal(nil, nil)).To(HaveLen(2))
+		})
+
+		It("reports settings that were not reset after they were removed from the spec", func() {
+			applied := condition
+			aggregationTimer := 60
+			aggregationMethod := nrqlsignal.AggregationMethodEventTimer
+			remote.AggregationMethod = &aggregationMethod
+			remote.AggregationTimer = &aggregationTimer
+			condition.Signal = nil
+
+			Expect(condition.DiffSignal(nil, &remote)).To(BeEmpty())
+			Expect(condition.DiffSignal(&applied, &remote)).To(ConsistOf(
+				"signal aggregation_method differs",
+				"signal aggregation_timer differs",
+			))
+		})
+
+		It("finds no differences once removed settings were reset", func() {
+			applied := condition
+			condition.Signal = nil
+			remote = condition.ToSignalInput()
+			aggregationDelay := 120
+			remote.AggregationDelay = &aggregationDelay
+
+			Expect(condition.DiffSignal(&applied, &remote)).To(BeEmpty())
+		})
+	})
 })
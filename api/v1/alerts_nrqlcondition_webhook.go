@@ -18,17 +18,17 @@ package v1
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
-	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
-
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/nrqlsignal"
 )
 
 // log is for logging in this package.
@@ -58,6 +58,8 @@ func (r *AlertsNrqlCondition) Default() {
 		alertsNrqlConditionLog.Info("Setting null Applied Spec to empty interface")
 		r.Status.AppliedSpec = &AlertsNrqlConditionSpec{}
 	}
+
+	DefaultAccountRef(&r.Spec.AccountRef)
 	alertsNrqlConditionLog.Info("r.Status.AppliedSpec after", "r.Status.AppliedSpec", r.Status.AppliedSpec)
 }
 
@@ -69,7 +71,6 @@ var _ webhook.Validator = &AlertsNrqlCondition{}
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (r *AlertsNrqlCondition) ValidateCreate() error {
 	alertsNrqlConditionLog.Info("validate create", "name", r.Name)
-	//TODO this should write this value TO a new secret so code path always reads from a secret
 	err := r.CheckForAPIKeyOrSecret()
 	if err != nil {
 		return err
@@ -79,6 +80,16 @@ func (r *AlertsNrqlCondition) ValidateCreate() error {
 	if err != nil {
 		return err
 	}
+
+	err = r.CheckConditionType()
+	if err != nil {
+		return err
+	}
+
+	err = r.CheckSignal()
+	if err != nil {
+		return err
+	}
 	return r.CheckExistingPolicyID()
 }
 
@@ -87,12 +98,16 @@ func (r *AlertsNrqlCondition) ValidateUpdate(old runtime.Object) error {
 	alertsNrqlConditionLog.Info("validate update", "name", r.Name)
 	prevCondition := old.(*AlertsNrqlCondition)
 
-	if (r.Spec.BaselineDirection == nil && prevCondition.Spec.BaselineDirection != nil) ||
-		(r.Spec.BaselineDirection != nil && prevCondition.Spec.BaselineDirection == nil) {
+	if r.Spec.GetConditionType() != prevCondition.Spec.GetConditionType() {
 		return errors.New("cannot change between condition types, you must delete and create a new alert")
 	}
 
-	return nil
+	err := r.CheckConditionType()
+	if err != nil {
+		return err
+	}
+
+	return r.CheckSignal()
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -105,36 +120,27 @@ func (r *AlertsNrqlCondition) ValidateDelete() error {
 
 func (r *AlertsNrqlCondition) CheckExistingPolicyID() error {
 	alertsNrqlConditionLog.Info("Checking existing", "policyId", r.Spec.ExistingPolicyID)
-	var apiKey string
-	if r.Spec.APIKey == "" {
-		key := types.NamespacedName{Namespace: r.Spec.APIKeySecret.Namespace, Name: r.Spec.APIKeySecret.Name}
-		var apiKeySecret v1.Secret
-		getErr := k8Client.Get(context.Background(), key, &apiKeySecret)
-		if getErr != nil {
-			alertsNrqlConditionLog.Error(getErr, "Error getting secret")
-			return getErr
-		}
-		apiKey = string(apiKeySecret.Data[r.Spec.APIKeySecret.KeyName])
-
-	} else {
-		apiKey = r.Spec.APIKey
+	credentials, getErr := ResolveCredentials(context.Background(), k8Client, r.Namespace, r.GetAccountSettings())
+	if getErr != nil {
+		alertsNrqlConditionLog.Error(getErr, "Error getting credentials")
+		return getErr
 	}
 
-	alertsClient, errAlertClient := alertClientFunc(apiKey, r.Spec.Region)
+	alertsClient, errAlertClient := alertClientFunc(credentials.APIKey, credentials.Region)
 	if errAlertClient != nil {
 		alertsNrqlConditionLog.Error(errAlertClient, "failed to get policy",
 			"policyId", r.Spec.ExistingPolicyID,
-			"API Key", interfaces.PartialAPIKey(apiKey),
-			"region", r.Spec.Region,
+			"API Key", interfaces.PartialAPIKey(credentials.APIKey),
+			"region", credentials.Region,
 		)
 		return errAlertClient
 	}
-	_, errAlertPolicy := alertsClient.QueryPolicy(r.Spec.AccountID, r.Spec.ExistingPolicyID)
+	_, errAlertPolicy := alertsClient.QueryPolicy(credentials.AccountID, r.Spec.ExistingPolicyID)
 	if errAlertPolicy != nil {
 		alertsNrqlConditionLog.Error(errAlertPolicy, "failed to get policy",
 			"policyId", r.Spec.ExistingPolicyID,
-			"API Key", interfaces.PartialAPIKey(apiKey),
-			"region", r.Spec.Region,
+			"API Key", interfaces.PartialAPIKey(credentials.APIKey),
+			"region", credentials.Region,
 		)
 		return errAlertPolicy
 	}
@@ -142,21 +148,14 @@ func (r *AlertsNrqlCondition) CheckExistingPolicyID() error {
 }
 
 func (r *AlertsNrqlCondition) CheckForAPIKeyOrSecret() error {
-	if r.Spec.APIKey != "" {
-		return nil
-	}
-	if r.Spec.APIKeySecret != (NewRelicAPIKeySecret{}) {
-		if r.Spec.APIKeySecret.Name != "" && r.Spec.APIKeySecret.Namespace != "" && r.Spec.APIKeySecret.KeyName != "" {
-			return nil
-		}
-	}
-	return errors.New("either api_key or api_key_secret must be set")
+	return CheckForAccount(r.Namespace, r.GetAccountSettings())
 }
 
 func (r *AlertsNrqlCondition) CheckRequiredFields() error {
 
 	missingFields := []string{}
-	if r.Spec.Region == "" {
+	// the region of a referenced account is used when the condition does not set one
+	if r.Spec.Region == "" && r.Spec.AccountRef.Name == "" {
 		missingFields = append(missingFields, "region")
 	}
 	if r.Spec.ExistingPolicyID == "" {
@@ -167,3 +166,84 @@ func (r *AlertsNrqlCondition) CheckRequiredFields() error {
 	}
 	return nil
 }
+
+// CheckConditionType checks the condition_type and the fields that only apply to some types of conditions
+func (r *AlertsNrqlCondition) CheckConditionType() error {
+	switch r.Spec.GetConditionType() {
+	case alerts.NrqlConditionTypes.Static:
+		if r.Spec.BaselineDirection != nil {
+			return errors.New("baseline_direction can only be set for BASELINE conditions")
+		}
+	case alerts.NrqlConditionTypes.Baseline:
+		if r.Spec.BaselineDirection == nil {
+			return errors.New("baseline_direction must be set for BASELINE conditions")
+		}
+	case alerts.NrqlConditionTypes.Outlier:
+		if r.Spec.BaselineDirection != nil {
+			return errors.New("baseline_direction can only be set for BASELINE conditions")
+		}
+		if r.Spec.ExpectedGroups < 1 {
+			return errors.New("expected_groups must be set for OUTLIER conditions")
+		}
+	default:
+		return errors.New("condition_type must be STATIC, BASELINE or OUTLIER")
+	}
+
+	return nil
+}
+
+// Limits of the streaming settings of a signal, in seconds
+const (
+	maxAggregationDelay = 1200
+	minAggregationTimer = 5
+	maxAggregationTimer = 1200
+)
+
+// CheckSignal checks that the streaming settings of the signal are used with an aggregation method
+// that supports them
+func (r *AlertsNrqlCondition) CheckSignal() error {
+	signal := r.Spec.Signal
+	if signal == nil {
+		return nil
+	}
+
+	// New Relic uses EVENT_FLOW when no aggregation method is set
+	method := nrqlsignal.AggregationMethodEventFlow
+	if signal.AggregationMethod != nil {
+		method = *signal.AggregationMethod
+	}
+
+	switch method {
+	case nrqlsignal.AggregationMethodEventFlow, nrqlsignal.AggregationMethodCadence:
+		if signal.AggregationTimer != nil {
+			return errors.New("aggregation_timer can only be set for the EVENT_TIMER aggregation_method")
+		}
+		if signal.AggregationDelay != nil && (*signal.AggregationDelay < 0 || *signal.AggregationDelay > maxAggregationDelay) {
+			return fmt.Errorf("aggregation_delay must be between 0 and %d seconds", maxAggregationDelay)
+		}
+	case nrqlsignal.AggregationMethodEventTimer:
+		if signal.AggregationDelay != nil {
+			return errors.New("aggregation_delay can't be set for the EVENT_TIMER aggregation_method")
+		}
+		if signal.AggregationTimer != nil && (*signal.AggregationTimer < minAggregationTimer || *signal.AggregationTimer > maxAggregationTimer) {
+			return fmt.Errorf("aggregation_timer must be between %d and %d seconds", minAggregationTimer, maxAggregationTimer)
+		}
+	default:
+		return errors.New("aggregation_method must be EVENT_FLOW, EVENT_TIMER or CADENCE")
+	}
+
+	if signal.EvaluationOffset != nil && (signal.AggregationMethod != nil || signal.AggregationDelay != nil || signal.AggregationTimer != nil) {
+		return errors.New("evaluation_offset can't be combined with aggregation_method, aggregation_delay or aggregation_timer")
+	}
+
+	if signal.SlideBy != nil {
+		if signal.AggregationWindow == nil {
+			return errors.New("aggregation_window must be set when slide_by is set")
+		}
+		if *signal.SlideBy <= 0 || *signal.SlideBy >= *signal.AggregationWindow || *signal.AggregationWindow%*signal.SlideBy != 0 {
+			return errors.New("slide_by must be smaller than aggregation_window and divide it evenly")
+		}
+	}
+
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/nrqlsignal"
 )
 
 var _ = Describe("ValidateCreate", func() {
@@ -171,6 +172,142 @@ var _ = Describe("ValidateCreate", func() {
 				Expect(err.Error()).To(Equal("cannot change between condition types, you must delete and create a new alert"))
 			})
 		})
+
+		Context("and changing the condition type from static to outlier", func() {
+			It("should fail validation", func() {
+				updated := r.DeepCopy()
+				updated.Spec.ConditionType = alerts.NrqlConditionTypes.Outlier
+				err := updated.ValidateUpdate(&r)
+				Expect(err).To(MatchError("cannot change between condition types, you must delete and create a new alert"))
+			})
+		})
+
+		Context("and setting the implied condition type", func() {
+			It("should pass validation", func() {
+				updated := r.DeepCopy()
+				updated.Spec.ConditionType = alerts.NrqlConditionTypes.Static
+				err := updated.ValidateUpdate(&r)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("CheckConditionType", func() {
+		It("accepts a baseline condition with a baseline direction", func() {
+			r.Spec.ConditionType = alerts.NrqlConditionTypes.Baseline
+			r.Spec.BaselineDirection = &alerts.NrqlBaselineDirections.UpperOnly
+			Expect(r.CheckConditionType()).To(Succeed())
+		})
+
+		It("rejects a baseline condition without a baseline direction", func() {
+			r.Spec.ConditionType = alerts.NrqlConditionTypes.Baseline
+			Expect(r.CheckConditionType()).To(MatchError("baseline_direction must be set for BASELINE conditions"))
+		})
+
+		It("rejects a static condition with a baseline direction", func() {
+			r.Spec.ConditionType = alerts.NrqlConditionTypes.Static
+			r.Spec.BaselineDirection = &alerts.NrqlBaselineDirections.UpperOnly
+			Expect(r.CheckConditionType()).To(MatchError("baseline_direction can only be set for BASELINE conditions"))
+		})
+
+		It("accepts an outlier condition with expected groups", func() {
+			r.Spec.ConditionType = alerts.NrqlConditionTypes.Outlier
+			Expect(r.CheckConditionType()).To(Succeed())
+		})
+
+		It("rejects an outlier condition without expected groups", func() {
+			r.Spec.ConditionType = alerts.NrqlConditionTypes.Outlier
+			r.Spec.ExpectedGroups = 0
+			Expect(r.CheckConditionType()).To(MatchError("expected_groups must be set for OUTLIER conditions"))
+		})
+
+		It("rejects an unknown condition type", func() {
+			r.Spec.ConditionType = "SOMETIMES"
+			Expect(r.CheckConditionType()).To(MatchError("condition_type must be STATIC, BASELINE or OUTLIER"))
+		})
+	})
+
+	Describe("CheckSignal", func() {
+		var (
+			eventFlow  = nrqlsignal.AggregationMethodEventFlow
+			eventTimer = nrqlsignal.AggregationMethodEventTimer
+			window     = 60
+		)
+
+		BeforeEach(func() {
+			r.Spec.Signal = &AlertsNrqlConditionSignal{AggregationWindow: &window}
+		})
+
+		It("accepts a condition without a signal", func() {
+			r.Spec.Signal = nil
+			Expect(r.CheckSignal()).To(Succeed())
+		})
+
+		It("accepts an aggregation delay for the EVENT_FLOW aggregation method", func() {
+			delay := 120
+			r.Spec.Signal.AggregationMethod = &eventFlow
+			r.Spec.Signal.AggregationDelay = &delay
+			Expect(r.CheckSignal()).To(Succeed())
+		})
+
+		It("accepts an aggregation timer for the EVENT_TIMER aggregation method", func() {
+			timer := 60
+			r.Spec.Signal.AggregationMethod = &eventTimer
+			r.Spec.Signal.AggregationTimer = &timer
+			Expect(r.CheckSignal()).To(Succeed())
+		})
+
+		It("rejects an aggregation timer without the EVENT_TIMER aggregation method", func() {
+			timer := 60
+			r.Spec.Signal.AggregationTimer = &timer
+			Expect(r.CheckSignal()).To(MatchError("aggregation_timer can only be set for the EVENT_TIMER aggregation_method"))
+		})
+
+		It("rejects an aggregation delay for the EVENT_TIMER aggregation method", func() {
+			delay := 120
+			r.Spec.Signal.AggregationMethod = &eventTimer
+			r.Spec.Signal.AggregationDelay = &delay
+			Expect(r.CheckSignal()).To(MatchError("aggregation_delay can't be set for the EVENT_TIMER aggregation_method"))
+		})
+
+		It("rejects an aggregation timer out of range", func() {
+			timer := 2
+			r.Spec.Signal.AggregationMethod = &eventTimer
+			r.Spec.Signal.AggregationTimer = &timer
+			Expect(r.CheckSignal()).To(MatchError("aggregation_timer must be between 5 and 1200 seconds"))
+		})
+
+		It("rejects an unknown aggregation method", func() {
+			method := nrqlsignal.AggregationMethod("SOMETIMES")
+			r.Spec.Signal.AggregationMethod = &method
+			Expect(r.CheckSignal()).To(MatchError("aggregation_method must be EVENT_FLOW, EVENT_TIMER or CADENCE"))
+		})
+
+		It("rejects an evaluation offset combined with an aggregation method", func() {
+			offset := 3
+			r.Spec.Signal.AggregationMethod = &eventFlow
+			r.Spec.Signal.EvaluationOffset = &offset
+			Expect(r.CheckSignal()).To(MatchError("evaluation_offset can't be combined with aggregation_method, aggregation_delay or aggregation_timer"))
+		})
+
+		It("accepts a slide by interval that divides the aggregation window", func() {
+			slideBy := 30
+			r.Spec.Signal.SlideBy = &slideBy
+			Expect(r.CheckSignal()).To(Succeed())
+		})
+
+		It("rejects a slide by interval that does not divide the aggregation window", func() {
+			slideBy := 45
+			r.Spec.Signal.SlideBy = &slideBy
+			Expect(r.CheckSignal()).To(MatchError("slide_by must be smaller than aggregation_window and divide it evenly"))
+		})
+
+		It("rejects a slide by interval without an aggregation window", func() {
+			slideBy := 30
+			r.Spec.Signal.AggregationWindow = nil
+			r.Spec.Signal.SlideBy = &slideBy
+			Expect(r.CheckSignal()).To(MatchError("aggregation_window must be set when slide_by is set"))
+		})
 	})
 
 	Describe("CheckExistingPolicyID", func() {
@@ -17,6 +17,7 @@ package v1
 
 import (
 	"encoding/json"
+	"fmt"
 	"hash/fnv"
 
 	"github.com/newrelic/newrelic-client-go/pkg/alerts"
@@ -29,14 +30,24 @@ import (
 
 // AlertsPolicySpec defines the desired state of AlertsPolicy
 type AlertsPolicySpec struct {
-	IncidentPreference string                  `json:"incidentPreference,omitempty"`
-	Name               string                  `json:"name"`
-	Region             string                  `json:"region"`
-	Conditions         []AlertsPolicyCondition `json:"conditions,omitempty"`
-	APIKey             string                  `json:"api_key,omitempty"`
-	APIKeySecret       NewRelicAPIKeySecret    `json:"api_key_secret,omitempty"`
-	AccountID          int                     `json:"account_id,omitempty"`
-	ChannelIDs         []int                   `json:"channel_ids,omitempty"`
+	IncidentPreference string                   `json:"incidentPreference,omitempty"`
+	Name               string                   `json:"name"`
+	Region             string                   `json:"region,omitempty"`
+	Conditions         []AlertsPolicyCondition  `json:"conditions,omitempty"`
+	APIKey             string                   `json:"api_key,omitempty"`
+	APIKeySecret       NewRelicAPIKeySecret     `json:"api_key_secret,omitempty"`
+	AccountRef         NewRelicAccountReference `json:"account_ref,omitempty"`
+	AccountID          int                      `json:"account_id,omitempty"`
+	ChannelIDs         []int                    `json:"channel_ids,omitempty"`
+	// ChannelRefs are AlertsChannels whose New Relic channels are added to the policy next to ChannelIDs
+	ChannelRefs []AlertsChannelReference `json:"channel_refs,omitempty"`
+}
+
+// AlertsChannelReference points an AlertsPolicy at an AlertsChannel. Namespace defaults to the
+// namespace of the policy.
+type AlertsChannelReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
 }
 
 //AlertsPolicyCondition defined the conditions contained within an AlertsPolicy
@@ -51,15 +62,24 @@ type AlertsPolicyConditionSpec struct {
 	AlertsNrqlSpecificSpec     `json:",inline"`
 	AlertsAPMSpecificSpec      `json:",inline"`
 	AlertsBaselineSpecificSpec `json:",inline"`
+	AlertsInfraSpecificSpec    `json:",inline"`
+	// external service and synthetics conditions share metric, entities and apm_terms with APM conditions
+	AlertsExternalServiceSpecificSpec `json:",inline"`
+	AlertsSyntheticsSpecificSpec      `json:",inline"`
 }
 
 // AlertsPolicyStatus defines the observed state of AlertsPolicy
 type AlertsPolicyStatus struct {
-	AppliedSpec *AlertsPolicySpec `json:"applied_spec"`
+	AppliedSpec *AlertsPolicySpec `json:"applied_spec,omitempty"`
 	PolicyID    string            `json:"policy_id"`
+	// ChannelIDs are the IDs of the channels referenced by channel_refs when the spec was applied
+	ChannelIDs []int       `json:"channel_ids,omitempty"`
+	Conditions []Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
 
 // AlertsPolicy is the Schema for the policies API
 type AlertsPolicy struct {
@@ -83,6 +103,16 @@ func init() {
 	SchemeBuilder.Register(&AlertsPolicy{}, &AlertsPolicyList{})
 }
 
+// GetConditions returns the status conditions of the AlertsPolicy
+func (in *AlertsPolicy) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the AlertsPolicy
+func (in *AlertsPolicy) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
 func (in AlertsPolicySpec) ToAlertsPolicy() alerts.AlertsPolicy {
 	jsonString, _ := json.Marshal(in)
 	var result alerts.AlertsPolicy
@@ -114,6 +144,7 @@ func (p *AlertsPolicyCondition) SpecHash() uint32 {
 	}
 	strippedAlertsPolicy.Spec.APIKeySecret = NewRelicAPIKeySecret{}
 	strippedAlertsPolicy.Spec.APIKey = ""
+	strippedAlertsPolicy.Spec.AccountRef = NewRelicAccountReference{}
 	strippedAlertsPolicy.Spec.Region = ""
 	strippedAlertsPolicy.Spec.ExistingPolicyID = ""
 	conditionTemplateSpecHasher := fnv.New32a()
@@ -146,6 +177,9 @@ func (in AlertsPolicySpec) Equals(policyToCompare AlertsPolicySpec) bool {
 	if in.APIKeySecret != policyToCompare.APIKeySecret {
 		return false
 	}
+	if in.AccountRef != policyToCompare.AccountRef {
+		return false
+	}
 	if len(in.Conditions) != len(policyToCompare.Conditions) {
 		return false
 	}
@@ -178,15 +212,69 @@ func (in AlertsPolicySpec) Equals(policyToCompare AlertsPolicySpec) bool {
 		}
 	}
 
+	if len(in.ChannelRefs) != len(policyToCompare.ChannelRefs) {
+		return false
+	}
+
+	checkedChannelRefs := make(map[AlertsChannelReference]bool)
+
+	for _, channelRef := range in.ChannelRefs {
+		checkedChannelRefs[channelRef] = true
+	}
+
+	for _, channelRefToCompare := range policyToCompare.ChannelRefs {
+		if _, ok := checkedChannelRefs[channelRefToCompare]; !ok {
+			return false
+		}
+	}
+
 	return true
 }
 
+// GetNamespacedName returns the name of the referenced AlertsChannel, in namespace unless the
+// reference names another one
+func (in AlertsChannelReference) GetNamespacedName(namespace string) types.NamespacedName {
+	if in.Namespace != "" {
+		namespace = in.Namespace
+	}
+
+	return types.NamespacedName{Namespace: namespace, Name: in.Name}
+}
+
+// Diff lists the fields of the policy in New Relic that no longer match the spec.
+// Fields left empty in the spec are defaulted by New Relic and are not compared.
+func (in AlertsPolicySpec) Diff(remote alerts.AlertsPolicy) []string {
+	differences := []string{}
+
+	if in.Name != remote.Name {
+		differences = append(differences, fmt.Sprintf("name is %q, expected %q", remote.Name, in.Name))
+	}
+
+	if in.IncidentPreference != "" && in.IncidentPreference != string(remote.IncidentPreference) {
+		differences = append(differences, fmt.Sprintf("incidentPreference is %q, expected %q", remote.IncidentPreference, in.IncidentPreference))
+	}
+
+	return differences
+}
+
 //GetAlertsConditionType - returns the string representative of the Condition type
 func GetAlertsConditionType(condition AlertsPolicyCondition) string {
 	if condition.Spec.Type == "NRQL" {
 		return "AlertsNrqlCondition"
 	}
 
+	if IsInfraConditionType(string(condition.Spec.Type)) {
+		return "AlertsInfraCondition"
+	}
+
+	if IsExternalServiceConditionType(string(condition.Spec.Type)) {
+		return "AlertsExternalServiceCondition"
+	}
+
+	if IsSyntheticsConditionType(string(condition.Spec.Type)) {
+		return "AlertsSyntheticsCondition"
+	}
+
 	return "AlertsAPMCondition"
 }
 
@@ -200,6 +288,21 @@ func (p *AlertsPolicyCondition) GenerateSpecFromApmConditionSpec(apmConditionSpe
 	json.Unmarshal(jsonString, &p.Spec) //nolint
 }
 
+func (p *AlertsPolicyCondition) GenerateSpecFromInfraConditionSpec(infraConditionSpec AlertsInfraConditionSpec) {
+	jsonString, _ := json.Marshal(infraConditionSpec)
+	json.Unmarshal(jsonString, &p.Spec) //nolint
+}
+
+func (p *AlertsPolicyCondition) GenerateSpecFromExternalServiceConditionSpec(externalServiceConditionSpec AlertsExternalServiceConditionSpec) {
+	jsonString, _ := json.Marshal(externalServiceConditionSpec)
+	json.Unmarshal(jsonString, &p.Spec) //nolint
+}
+
+func (p *AlertsPolicyCondition) GenerateSpecFromSyntheticsConditionSpec(syntheticsConditionSpec AlertsSyntheticsConditionSpec) {
+	jsonString, _ := json.Marshal(syntheticsConditionSpec)
+	json.Unmarshal(jsonString, &p.Spec) //nolint
+}
+
 func (p *AlertsPolicyCondition) ReturnNrqlConditionSpec() (nrqlConditionSpec AlertsNrqlConditionSpec) {
 	jsonString, _ := json.Marshal(p.Spec)
 	json.Unmarshal(jsonString, &nrqlConditionSpec) //nolint
@@ -213,3 +316,24 @@ func (p *AlertsPolicyCondition) ReturnApmConditionSpec() (apmConditionSpec Alert
 
 	return
 }
+
+func (p *AlertsPolicyCondition) ReturnInfraConditionSpec() (infraConditionSpec AlertsInfraConditionSpec) {
+	jsonString, _ := json.Marshal(p.Spec)
+	json.Unmarshal(jsonString, &infraConditionSpec) //nolint
+
+	return
+}
+
+func (p *AlertsPolicyCondition) ReturnExternalServiceConditionSpec() (externalServiceConditionSpec AlertsExternalServiceConditionSpec) {
+	jsonString, _ := json.Marshal(p.Spec)
+	json.Unmarshal(jsonString, &externalServiceConditionSpec) //nolint
+
+	return
+}
+
+func (p *AlertsPolicyCondition) ReturnSyntheticsConditionSpec() (syntheticsConditionSpec AlertsSyntheticsConditionSpec) {
+	jsonString, _ := json.Marshal(p.Spec)
+	json.Unmarshal(jsonString, &syntheticsConditionSpec) //nolint
+
+	return
+}
@@ -245,4 +245,76 @@ var _ = Describe("Equals", func() {
 			Expect(output).ToNot(BeTrue())
 		})
 	})
+
+	Context("When ChannelRefs are in a different order", func() {
+		It("should return true", func() {
+			p.ChannelRefs = []AlertsChannelReference{{Name: "email", Namespace: "default"}, {Name: "slack", Namespace: "default"}}
+			policyToCompare.ChannelRefs = []AlertsChannelReference{{Name: "slack", Namespace: "default"}, {Name: "email", Namespace: "default"}}
+
+			output = p.Equals(policyToCompare)
+			Expect(output).To(BeTrue())
+		})
+	})
+
+	Context("When ChannelRefs don't match", func() {
+		It("should return false", func() {
+			p.ChannelRefs = []AlertsChannelReference{{Name: "email", Namespace: "default"}}
+			policyToCompare.ChannelRefs = []AlertsChannelReference{{Name: "email", Namespace: "other"}}
+
+			output = p.Equals(policyToCompare)
+			Expect(output).ToNot(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("Diff", func() {
+	var (
+		p      AlertsPolicySpec
+		remote alerts.AlertsPolicy
+	)
+
+	BeforeEach(func() {
+		p = AlertsPolicySpec{
+			IncidentPreference: "PER_POLICY",
+			Name:               "test-policy",
+			Region:             "us",
+		}
+
+		remote = alerts.AlertsPolicy{
+			ID:                 "333",
+			IncidentPreference: alerts.AlertsIncidentPreferenceTypes.PER_POLICY,
+			Name:               "test-policy",
+		}
+	})
+
+	Context("When New Relic matches the spec", func() {
+		It("should return no differences", func() {
+			Expect(p.Diff(remote)).To(BeEmpty())
+		})
+	})
+
+	Context("When the name was changed in New Relic", func() {
+		It("should report the name", func() {
+			remote.Name = "renamed-policy"
+
+			Expect(p.Diff(remote)).To(ConsistOf(`name is "renamed-policy", expected "test-policy"`))
+		})
+	})
+
+	Context("When the incident preference was changed in New Relic", func() {
+		It("should report the incident preference", func() {
+			remote.IncidentPreference = alerts.AlertsIncidentPreferenceTypes.PER_CONDITION
+
+			Expect(p.Diff(remote)).To(ConsistOf(`incidentPreference is "PER_CONDITION", expected "PER_POLICY"`))
+		})
+	})
+
+	Context("When the spec leaves the incident preference to New Relic", func() {
+		It("should not compare it", func() {
+			p.IncidentPreference = ""
+			remote.IncidentPreference = alerts.AlertsIncidentPreferenceTypes.PER_CONDITION
+
+			Expect(p.Diff(remote)).To(BeEmpty())
+		})
+	})
 })
@@ -17,9 +17,11 @@ package v1
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -34,6 +36,7 @@ var AlertsPolicyLog = logf.Log.WithName("alerts-policy-resource")
 var defaultAlertsPolicyIncidentPreference = alerts.AlertsIncidentPreferenceTypes.PER_POLICY
 
 func (r *AlertsPolicy) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	k8Client = mgr.GetClient()
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
 		Complete()
@@ -55,6 +58,8 @@ func (r *AlertsPolicy) Default() {
 	}
 
 	r.DefaultIncidentPreference()
+	r.DefaultChannelRefs()
+	DefaultAccountRef(&r.Spec.AccountRef)
 }
 
 // TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
@@ -83,6 +88,11 @@ func (r *AlertsPolicy) ValidateCreate() error {
 		collectedErrors.Collect(err)
 	}
 
+	err = r.ValidateChannelRefs()
+	if err != nil {
+		collectedErrors.Collect(err)
+	}
+
 	if len(*collectedErrors) > 0 {
 		AlertsPolicyLog.Info("Errors encountered validating policy", "collectedErrors", collectedErrors)
 		return collectedErrors
@@ -112,6 +122,11 @@ func (r *AlertsPolicy) ValidateUpdate(old runtime.Object) error {
 		collectedErrors.Collect(err)
 	}
 
+	err = r.ValidateChannelRefs()
+	if err != nil {
+		collectedErrors.Collect(err)
+	}
+
 	if len(*collectedErrors) > 0 {
 		AlertsPolicyLog.Info("Errors encountered validating policy", "collectedErrors", collectedErrors)
 		return collectedErrors
@@ -140,6 +155,34 @@ func (r *AlertsPolicy) DefaultIncidentPreference() {
 	r.Spec.IncidentPreference = strings.ToUpper(r.Spec.IncidentPreference)
 }
 
+// DefaultChannelRefs sets the namespace of channel references to the namespace of the policy
+func (r *AlertsPolicy) DefaultChannelRefs() {
+	for i := range r.Spec.ChannelRefs {
+		if r.Spec.ChannelRefs[i].Namespace == "" {
+			r.Spec.ChannelRefs[i].Namespace = r.Namespace
+		}
+	}
+}
+
+// ValidateChannelRefs checks that every channel reference names a single AlertsChannel
+func (r *AlertsPolicy) ValidateChannelRefs() error {
+	refs := make(map[types.NamespacedName]bool)
+
+	for _, ref := range r.Spec.ChannelRefs {
+		if ref.Name == "" {
+			return errors.New("channel_refs must name an AlertsChannel")
+		}
+
+		name := ref.GetNamespacedName(r.Namespace)
+		if refs[name] {
+			return fmt.Errorf("AlertsChannel %s is referenced more than once in channel_refs", name)
+		}
+		refs[name] = true
+	}
+
+	return nil
+}
+
 func (r *AlertsPolicy) CheckForDuplicateConditions() error {
 	var conditionHashMap = make(map[uint32]bool)
 
@@ -167,15 +210,5 @@ func (r *AlertsPolicy) ValidateIncidentPreference() error {
 }
 
 func (r *AlertsPolicy) CheckForAPIKeyOrSecret() error {
-	if r.Spec.APIKey != "" {
-		return nil
-	}
-
-	if r.Spec.APIKeySecret != (NewRelicAPIKeySecret{}) {
-		if r.Spec.APIKeySecret.Name != "" && r.Spec.APIKeySecret.Namespace != "" && r.Spec.APIKeySecret.KeyName != "" {
-			return nil
-		}
-	}
-
-	return errors.New("either api_key or api_key_secret must be set")
+	return CheckForAccount(r.Namespace, r.GetAccountSettings())
 }
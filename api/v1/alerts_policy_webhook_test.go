@@ -4,9 +4,15 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
 
+	"k8s.io/api/admission/v1beta1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
 
@@ -15,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/redact"
 )
 
 var _ = Describe("AlertsPolicy_webhooks", func() {
@@ -92,6 +99,64 @@ var _ = Describe("AlertsPolicy_webhooks", func() {
 			})
 		})
 
+		Context("when given an account_ref", func() {
+			var account *NewRelicAccount
+
+			BeforeEach(func() {
+				r.Namespace = "default"
+				r.Spec.APIKey = ""
+				r.Spec.AccountRef = NewRelicAccountReference{Name: "my-account"}
+
+				account = &NewRelicAccount{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-account",
+						Namespace: "default",
+					},
+					Spec: NewRelicAccountSpec{
+						APIKeySecret: NewRelicAPIKeySecret{Name: "my-api-key-secret", KeyName: "my-api-key"},
+						Region:       "US",
+						AccountID:    12345,
+					},
+				}
+				Expect(k8Client.Create(context.Background(), account)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(k8Client.Delete(context.Background(), account)).To(Succeed())
+			})
+
+			It("defaults the kind of the reference", func() {
+				r.Default()
+				Expect(r.Spec.AccountRef.Kind).To(Equal(NewRelicAccountKind))
+			})
+
+			It("should not return an error when the account exists", func() {
+				err := r.ValidateCreate()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should return an error when the account does not exist", func() {
+				r.Spec.AccountRef.Name = "missing-account"
+				err := r.ValidateCreate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("NewRelicAccount default/missing-account not found"))
+			})
+
+			It("should return an error when combined with an api_key", func() {
+				r.Spec.APIKey = "api-key"
+				err := r.ValidateCreate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("account_ref cannot be combined with api_key or api_key_secret"))
+			})
+
+			It("should return an error for an unknown kind", func() {
+				r.Spec.AccountRef.Kind = "Account"
+				err := r.ValidateCreate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("account_ref.kind must be NewRelicAccount or ClusterNewRelicAccount"))
+			})
+		})
+
 		Context("when given a policy with an invalid incident_preference", func() {
 			It("should reject the policy", func() {
 				r.Spec.IncidentPreference = "totally bogus"
@@ -101,6 +166,35 @@ var _ = Describe("AlertsPolicy_webhooks", func() {
 			})
 		})
 
+		Context("when given channel_refs", func() {
+			BeforeEach(func() {
+				r.Namespace = "default"
+				r.Spec.ChannelRefs = []AlertsChannelReference{
+					{Name: "my-channel"},
+					{Name: "my-channel", Namespace: "other"},
+				}
+			})
+
+			It("should not return an error", func() {
+				err := r.ValidateCreate()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should reject a reference without a name", func() {
+				r.Spec.ChannelRefs = append(r.Spec.ChannelRefs, AlertsChannelReference{Namespace: "other"})
+				err := r.ValidateCreate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("channel_refs must name an AlertsChannel"))
+			})
+
+			It("should reject a channel referenced twice", func() {
+				r.Spec.ChannelRefs = append(r.Spec.ChannelRefs, AlertsChannelReference{Name: "my-channel", Namespace: "default"})
+				err := r.ValidateCreate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("AlertsChannel default/my-channel is referenced more than once in channel_refs"))
+			})
+		})
+
 		Context("when given a policy with duplicate conditions", func() {
 			BeforeEach(func() {
 				spec1 := AlertsPolicyConditionSpec{}
@@ -226,6 +320,21 @@ var _ = Describe("AlertsPolicy_webhooks", func() {
 			})
 		})
 
+		Context("when given channel_refs without a namespace", func() {
+			It("should set the namespace of the policy", func() {
+				r.Namespace = "default"
+				r.Spec.ChannelRefs = []AlertsChannelReference{
+					{Name: "my-channel"},
+					{Name: "other-channel", Namespace: "other"},
+				}
+				r.Default()
+				Expect(r.Spec.ChannelRefs).To(Equal([]AlertsChannelReference{
+					{Name: "my-channel", Namespace: "default"},
+					{Name: "other-channel", Namespace: "other"},
+				}))
+			})
+		})
+
 		Context("when given a policy with a lower case incident preference", func() {
 			It("should upcase the incident preference", func() {
 				r.Spec.IncidentPreference = "awesome-preference"
@@ -234,4 +343,157 @@ var _ = Describe("AlertsPolicy_webhooks", func() {
 			})
 		})
 	})
+
+	Describe("api_key webhook", func() {
+		var (
+			policy AlertsPolicy
+			writer *APIKeySecretWriter
+		)
+
+		BeforeEach(func() {
+			k8Client = testk8sClient
+			alertClientFunc = func(string, string) (interfaces.NewRelicAlertsClient, error) {
+				return &interfacesfakes.FakeNewRelicAlertsClient{}, nil
+			}
+			writer = &APIKeySecretWriter{Client: testk8sClient, Scheme: scheme.Scheme}
+
+			policy = AlertsPolicy{
+				TypeMeta:   metav1.TypeMeta{APIVersion: GroupVersion.String(), Kind: "AlertsPolicy"},
+				ObjectMeta: metav1.ObjectMeta{Name: "inline-key-policy", Namespace: "default"},
+				Spec: AlertsPolicySpec{
+					Name:   "Test AlertsPolicy",
+					APIKey: "inline-api-key",
+					Conditions: []AlertsPolicyCondition{
+						{Spec: AlertsPolicyConditionSpec{AlertsGenericConditionSpec: AlertsGenericConditionSpec{
+							Name:   "condition",
+							APIKey: "inline-api-key",
+						}}},
+					},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			secret := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "newrelic-api-key-" + redact.Fingerprint("inline-api-key"), Namespace: "default"}}
+			_ = testk8sClient.Delete(context.Background(), secret)
+		})
+
+		It("should move the api_key of the policy and its conditions to a secret", func() {
+			response := writer.Handle(context.Background(), apiKeyRequest(v1beta1.Create, &policy, nil))
+
+			Expect(response.Allowed).To(BeTrue())
+			Expect(patchedPaths(response)).To(HaveKeyWithValue("/spec/api_key", "remove"))
+			Expect(patchedPaths(response)).To(HaveKeyWithValue("/spec/conditions/0/spec/api_key", "remove"))
+			Expect(patchedPaths(response)).To(HaveKey("/spec/api_key_secret/name"))
+
+			var secret v1.Secret
+			key := types.NamespacedName{Name: "newrelic-api-key-" + redact.Fingerprint("inline-api-key"), Namespace: "default"}
+			Expect(testk8sClient.Get(context.Background(), key, &secret)).To(Succeed())
+			Expect(string(secret.Data[APIKeySecretKeyName])).To(Equal("inline-api-key"))
+			Expect(secret.Labels).To(HaveKeyWithValue(ManagedByLabel, ManagedBy))
+			// the policy has no UID before it is created, its reconciler adopts the secret
+			Expect(secret.OwnerReferences).To(BeEmpty())
+		})
+
+		It("should make an existing policy the owner of the secret", func() {
+			policy.UID = "policy-uid"
+			response := writer.Handle(context.Background(), apiKeyRequest(v1beta1.Update, &policy, &policy))
+			Expect(response.Allowed).To(BeTrue())
+
+			var secret v1.Secret
+			key := types.NamespacedName{Name: "newrelic-api-key-" + redact.Fingerprint("inline-api-key"), Namespace: "default"}
+			Expect(testk8sClient.Get(context.Background(), key, &secret)).To(Succeed())
+			Expect(secret.OwnerReferences).To(HaveLen(1))
+			Expect(secret.OwnerReferences[0].UID).To(Equal(types.UID("policy-uid")))
+			Expect(secret.OwnerReferences[0].Kind).To(Equal("AlertsPolicy"))
+		})
+
+		It("should remove the api_key from the last applied configuration", func() {
+			policy.Annotations = map[string]string{
+				v1.LastAppliedConfigAnnotation: `{"kind":"AlertsPolicy","spec":{"api_key":"inline-api-key","name":"Test AlertsPolicy"}}` + "\n",
+			}
+
+			response := writer.Handle(context.Background(), apiKeyRequest(v1beta1.Create, &policy, nil))
+
+			var lastApplied string
+			for _, patch := range response.Patches {
+				if patch.Path == "/metadata/annotations/kubectl.kubernetes.io~1last-applied-configuration" {
+					lastApplied = patch.Value.(string)
+				}
+			}
+			Expect(lastApplied).To(Equal(`{"kind":"AlertsPolicy","spec":{"name":"Test AlertsPolicy"}}` + "\n"))
+		})
+
+		It("should not write a secret for a dry run", func() {
+			request := apiKeyRequest(v1beta1.Create, &policy, nil)
+			dryRun := true
+			request.DryRun = &dryRun
+
+			response := writer.Handle(context.Background(), request)
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Patches).To(BeEmpty())
+
+			var secret v1.Secret
+			key := types.NamespacedName{Name: "newrelic-api-key-" + redact.Fingerprint("inline-api-key"), Namespace: "default"}
+			Expect(testk8sClient.Get(context.Background(), key, &secret)).ToNot(Succeed())
+		})
+
+		It("should not write a secret for a policy the validating webhook rejects", func() {
+			policy.Spec.IncidentPreference = "invalid"
+
+			response := writer.Handle(context.Background(), apiKeyRequest(v1beta1.Create, &policy, nil))
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Patches).To(BeEmpty())
+
+			var secret v1.Secret
+			key := types.NamespacedName{Name: "newrelic-api-key-" + redact.Fingerprint("inline-api-key"), Namespace: "default"}
+			Expect(testk8sClient.Get(context.Background(), key, &secret)).ToNot(Succeed())
+		})
+
+		It("should reject the policy when the secret holds another key", func() {
+			secret := &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "newrelic-api-key-" + redact.Fingerprint("other-api-key"), Namespace: "default"},
+				Data:       map[string][]byte{APIKeySecretKeyName: []byte("different-api-key")},
+			}
+			Expect(testk8sClient.Create(context.Background(), secret)).To(Succeed())
+			defer testk8sClient.Delete(context.Background(), secret)
+
+			policy.Spec.APIKey = "other-api-key"
+			policy.Spec.Conditions = nil
+
+			response := writer.Handle(context.Background(), apiKeyRequest(v1beta1.Create, &policy, nil))
+			Expect(response.Allowed).To(BeFalse())
+		})
+	})
 })
+
+// apiKeyRequest returns the admission request for operation on obj
+func apiKeyRequest(operation v1beta1.Operation, obj runtime.Object, old runtime.Object) admission.Request {
+	raw, err := json.Marshal(obj)
+	Expect(err).ToNot(HaveOccurred())
+
+	request := admission.Request{AdmissionRequest: v1beta1.AdmissionRequest{
+		Kind:      metav1.GroupVersionKind{Group: GroupVersion.Group, Version: GroupVersion.Version, Kind: obj.GetObjectKind().GroupVersionKind().Kind},
+		Namespace: "default",
+		Operation: operation,
+		Object:    runtime.RawExtension{Raw: raw},
+	}}
+
+	if old != nil {
+		oldRaw, err := json.Marshal(old)
+		Expect(err).ToNot(HaveOccurred())
+		request.OldObject = runtime.RawExtension{Raw: oldRaw}
+	}
+
+	return request
+}
+
+// patchedPaths returns the operations of the patches of response by path
+func patchedPaths(response admission.Response) map[string]string {
+	paths := map[string]string{}
+	for _, patch := range response.Patches {
+		paths[patch.Path] = patch.Operation
+	}
+
+	return paths
+}
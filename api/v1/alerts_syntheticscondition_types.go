@@ -0,0 +1,180 @@
+package v1
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Types of synthetics conditions, set in the type of the generic condition spec
+const (
+	SyntheticsConditionTypeSingle        = "synthetics"
+	SyntheticsConditionTypeMultiLocation = "synthetics_multi_location"
+)
+
+// AlertsSyntheticsConditionSpec defines the desired state of AlertsSyntheticsCondition
+type AlertsSyntheticsConditionSpec struct {
+	AlertsGenericConditionSpec   `json:",inline"`
+	AlertsSyntheticsSpecificSpec `json:",inline"`
+	// Entities are the IDs of the monitors of a synthetics_multi_location condition
+	Entities []string `json:"entities,omitempty"`
+}
+
+// AlertsSyntheticsSpecificSpec holds the fields only used by synthetics conditions. The terms of a
+// synthetics_multi_location condition are apm_terms with a priority and the number of failing
+// locations as threshold.
+type AlertsSyntheticsSpecificSpec struct {
+	// MonitorID is the ID of the monitor of a synthetics condition
+	MonitorID string `json:"monitor_id,omitempty"`
+	// ViolationTimeLimitSeconds closes the violations of a synthetics_multi_location condition after
+	// 3600, 7200, 14400, 28800, 43200 or 86400 seconds
+	ViolationTimeLimitSeconds int `json:"violation_time_limit_seconds,omitempty"`
+}
+
+// AlertsSyntheticsConditionStatus defines the observed state of AlertsSyntheticsCondition
+type AlertsSyntheticsConditionStatus struct {
+	AppliedSpec *AlertsSyntheticsConditionSpec `json:"applied_spec,omitempty"`
+	ConditionID int                            `json:"condition_id"`
+	Conditions  []Condition                    `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+
+// AlertsSyntheticsCondition is the Schema for the alertssyntheticsconditions API
+type AlertsSyntheticsCondition struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AlertsSyntheticsConditionSpec   `json:"spec,omitempty"`
+	Status AlertsSyntheticsConditionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AlertsSyntheticsConditionList contains a list of AlertsSyntheticsCondition
+type AlertsSyntheticsConditionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AlertsSyntheticsCondition `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AlertsSyntheticsCondition{}, &AlertsSyntheticsConditionList{})
+}
+
+// GetConditions returns the status conditions of the AlertsSyntheticsCondition
+func (in *AlertsSyntheticsCondition) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the AlertsSyntheticsCondition
+func (in *AlertsSyntheticsCondition) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
+// IsSyntheticsConditionType returns true if conditionType is the type of a synthetics condition
+func IsSyntheticsConditionType(conditionType string) bool {
+	switch conditionType {
+	case SyntheticsConditionTypeSingle, SyntheticsConditionTypeMultiLocation:
+		return true
+	}
+
+	return false
+}
+
+// IsMultiLocation returns true if the condition alerts on the failures of monitors in several locations
+func (in AlertsSyntheticsConditionSpec) IsMultiLocation() bool {
+	return in.Type == SyntheticsConditionTypeMultiLocation
+}
+
+// APISyntheticsCondition returns a synthetics condition in the format of the REST API
+func (in AlertsSyntheticsConditionSpec) APISyntheticsCondition() alerts.SyntheticsCondition {
+	return alerts.SyntheticsCondition{
+		ID:         in.ID,
+		Name:       in.Name,
+		Enabled:    in.Enabled,
+		RunbookURL: in.RunbookURL,
+		MonitorID:  in.MonitorID,
+	}
+}
+
+// APIMultiLocationSyntheticsCondition returns a synthetics_multi_location condition in the format of
+// the REST API. The thresholds are validated by the webhook, thresholds that are not numbers are left unset.
+func (in AlertsSyntheticsConditionSpec) APIMultiLocationSyntheticsCondition() alerts.MultiLocationSyntheticsCondition {
+	condition := alerts.MultiLocationSyntheticsCondition{
+		ID:                        in.ID,
+		Name:                      in.Name,
+		Enabled:                   in.Enabled,
+		RunbookURL:                in.RunbookURL,
+		Entities:                  in.Entities,
+		ViolationTimeLimitSeconds: in.ViolationTimeLimitSeconds,
+	}
+
+	for _, term := range in.APMTerms {
+		threshold, _ := strconv.Atoi(term.Threshold)
+
+		condition.Terms = append(condition.Terms, alerts.MultiLocationSyntheticsConditionTerm{
+			Priority:  term.Priority,
+			Threshold: threshold,
+		})
+	}
+
+	return condition
+}
+
+// DiffSyntheticsCondition lists the fields of the synthetics condition in New Relic that no longer match the spec
+func (in AlertsSyntheticsConditionSpec) DiffSyntheticsCondition(remote alerts.SyntheticsCondition) []string {
+	desired := in.APISyntheticsCondition()
+	differences := in.diffCommon(remote.Name, remote.Enabled, remote.RunbookURL)
+
+	if desired.MonitorID != remote.MonitorID {
+		differences = append(differences, fmt.Sprintf("monitor_id is %q, expected %q", remote.MonitorID, desired.MonitorID))
+	}
+
+	return differences
+}
+
+// DiffMultiLocationSyntheticsCondition lists the fields of the synthetics_multi_location condition in
+// New Relic that no longer match the spec
+func (in AlertsSyntheticsConditionSpec) DiffMultiLocationSyntheticsCondition(remote alerts.MultiLocationSyntheticsCondition) []string {
+	desired := in.APIMultiLocationSyntheticsCondition()
+	differences := in.diffCommon(remote.Name, remote.Enabled, remote.RunbookURL)
+
+	if !reflect.DeepEqual(sortedStrings(desired.Entities), sortedStrings(remote.Entities)) {
+		differences = append(differences, "entities differ")
+	}
+
+	if !reflect.DeepEqual(desired.Terms, remote.Terms) {
+		differences = append(differences, "terms differ")
+	}
+
+	if desired.ViolationTimeLimitSeconds != 0 && desired.ViolationTimeLimitSeconds != remote.ViolationTimeLimitSeconds {
+		differences = append(differences, fmt.Sprintf("violation_time_limit_seconds is %d, expected %d",
+			remote.ViolationTimeLimitSeconds, desired.ViolationTimeLimitSeconds))
+	}
+
+	return differences
+}
+
+func (in AlertsSyntheticsConditionSpec) diffCommon(name string, enabled bool, runbookURL string) []string {
+	differences := []string{}
+
+	if in.Name != name {
+		differences = append(differences, fmt.Sprintf("name is %q, expected %q", name, in.Name))
+	}
+
+	if in.Enabled != enabled {
+		differences = append(differences, fmt.Sprintf("enabled is %t, expected %t", enabled, in.Enabled))
+	}
+
+	if in.RunbookURL != runbookURL {
+		differences = append(differences, fmt.Sprintf("runbook_url is %q, expected %q", runbookURL, in.RunbookURL))
+	}
+
+	return differences
+}
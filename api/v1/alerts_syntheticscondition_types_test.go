@@ -0,0 +1,118 @@
+package v1
+
+import (
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AlertsSyntheticsConditionSpec", func() {
+	var condition AlertsSyntheticsConditionSpec
+
+	BeforeEach(func() {
+		condition = AlertsSyntheticsConditionSpec{
+			AlertsGenericConditionSpec: AlertsGenericConditionSpec{
+				Type:             SyntheticsConditionTypeSingle,
+				Name:             "Home page monitor failed",
+				RunbookURL:       "http://test.com/runbook",
+				Enabled:          true,
+				ExistingPolicyID: "42",
+			},
+			AlertsSyntheticsSpecificSpec: AlertsSyntheticsSpecificSpec{
+				MonitorID: "6a1c2f0e-4b7d-4d3c-9a2e-1f7b6c5d4e3a",
+			},
+		}
+	})
+
+	Describe("APISyntheticsCondition", func() {
+		It("converts AlertsSyntheticsConditionSpec object to SyntheticsCondition object from go client, retaining field values", func() {
+			Expect(condition.IsMultiLocation()).To(BeFalse())
+
+			apiCondition := condition.APISyntheticsCondition()
+
+			Expect(apiCondition.Name).To(Equal("Home page monitor failed"))
+			Expect(apiCondition.RunbookURL).To(Equal("http://test.com/runbook"))
+			Expect(apiCondition.Enabled).To(BeTrue())
+			Expect(apiCondition.MonitorID).To(Equal("6a1c2f0e-4b7d-4d3c-9a2e-1f7b6c5d4e3a"))
+		})
+
+		It("lists the differences from the condition in New Relic", func() {
+			remote := condition.APISyntheticsCondition()
+			Expect(condition.DiffSyntheticsCondition(remote)).To(BeEmpty())
+
+			remote.RunbookURL = ""
+			remote.MonitorID = "another-monitor"
+
+			Expect(condition.DiffSyntheticsCondition(remote)).To(ConsistOf(
+				`runbook_url is "", expected "http://test.com/runbook"`,
+				`monitor_id is "another-monitor", expected "6a1c2f0e-4b7d-4d3c-9a2e-1f7b6c5d4e3a"`,
+			))
+		})
+	})
+
+	Describe("APIMultiLocationSyntheticsCondition", func() {
+		BeforeEach(func() {
+			condition.Type = SyntheticsConditionTypeMultiLocation
+			condition.MonitorID = ""
+			condition.Entities = []string{"6a1c2f0e-4b7d-4d3c-9a2e-1f7b6c5d4e3a"}
+			condition.ViolationTimeLimitSeconds = 3600
+			condition.APMTerms = []AlertConditionTerm{
+				{Priority: "critical", Threshold: "2"},
+				{Priority: "warning", Threshold: "1"},
+			}
+		})
+
+		It("converts AlertsSyntheticsConditionSpec object to MultiLocationSyntheticsCondition object from go client, retaining field values", func() {
+			Expect(condition.IsMultiLocation()).To(BeTrue())
+
+			apiCondition := condition.APIMultiLocationSyntheticsCondition()
+
+			Expect(apiCondition.Name).To(Equal("Home page monitor failed"))
+			Expect(apiCondition.Enabled).To(BeTrue())
+			Expect(apiCondition.Entities).To(Equal([]string{"6a1c2f0e-4b7d-4d3c-9a2e-1f7b6c5d4e3a"}))
+			Expect(apiCondition.ViolationTimeLimitSeconds).To(Equal(3600))
+			Expect(apiCondition.Terms).To(Equal([]alerts.MultiLocationSyntheticsConditionTerm{
+				{Priority: "critical", Threshold: 2},
+				{Priority: "warning", Threshold: 1},
+			}))
+		})
+
+		It("lists the differences from the condition in New Relic", func() {
+			remote := condition.APIMultiLocationSyntheticsCondition()
+			Expect(condition.DiffMultiLocationSyntheticsCondition(remote)).To(BeEmpty())
+
+			remote.Enabled = false
+			remote.Terms = remote.Terms[:1]
+			remote.ViolationTimeLimitSeconds = 7200
+
+			Expect(condition.DiffMultiLocationSyntheticsCondition(remote)).To(ConsistOf(
+				"enabled is false, expected true",
+				"terms differ",
+				"violation_time_limit_seconds is 7200, expected 3600",
+			))
+		})
+	})
+})
+
+var _ = Describe("AlertsPolicyCondition with a synthetics condition", func() {
+	It("round trips the synthetics fields", func() {
+		spec := AlertsSyntheticsConditionSpec{
+			AlertsGenericConditionSpec: AlertsGenericConditionSpec{
+				Type:     SyntheticsConditionTypeMultiLocation,
+				Name:     "Home page failing in several locations",
+				Enabled:  true,
+				APMTerms: []AlertConditionTerm{{Priority: "critical", Threshold: "2"}},
+			},
+			AlertsSyntheticsSpecificSpec: AlertsSyntheticsSpecificSpec{
+				ViolationTimeLimitSeconds: 7200,
+			},
+			Entities: []string{"6a1c2f0e-4b7d-4d3c-9a2e-1f7b6c5d4e3a"},
+		}
+
+		var condition AlertsPolicyCondition
+		condition.GenerateSpecFromSyntheticsConditionSpec(spec)
+
+		Expect(GetAlertsConditionType(condition)).To(Equal("AlertsSyntheticsCondition"))
+		Expect(condition.ReturnSyntheticsConditionSpec()).To(Equal(spec))
+	})
+})
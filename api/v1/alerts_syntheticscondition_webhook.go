@@ -0,0 +1,243 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+)
+
+// log is for logging in this package.
+var (
+	alertssyntheticsconditionlog = logf.Log.WithName("alertssyntheticscondition-resource")
+)
+
+// syntheticsViolationTimeLimits are the violation time limits New Relic accepts for
+// synthetics_multi_location conditions
+var syntheticsViolationTimeLimits = []int{3600, 7200, 14400, 28800, 43200, 86400}
+
+// SetupWebhookWithManager - instantiates the Webhook
+func (r *AlertsSyntheticsCondition) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	alertClientFunc = interfaces.InitializeAlertsClient
+	k8Client = mgr.GetClient()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-nr-k8s-newrelic-com-v1-alertssyntheticscondition,mutating=true,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=alertssyntheticsconditions,verbs=create;update,versions=v1,name=malertssyntheticscondition.kb.io,sideEffects=None
+
+var _ webhook.Defaulter = &AlertsSyntheticsCondition{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *AlertsSyntheticsCondition) Default() {
+	alertssyntheticsconditionlog.Info("default", "name", r.Name)
+
+	if r.Status.AppliedSpec == nil {
+		alertssyntheticsconditionlog.Info("Setting null Applied Spec to empty interface")
+		r.Status.AppliedSpec = &AlertsSyntheticsConditionSpec{}
+	}
+
+	DefaultAccountRef(&r.Spec.AccountRef)
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-nr-k8s-newrelic-com-v1-alertssyntheticscondition,mutating=false,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=alertssyntheticsconditions,versions=v1,name=valertssyntheticscondition.kb.io,sideEffects=None
+
+var _ webhook.Validator = &AlertsSyntheticsCondition{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *AlertsSyntheticsCondition) ValidateCreate() error {
+	alertssyntheticsconditionlog.Info("validate create", "name", r.Name)
+
+	return r.ValidateAlertsSyntheticsCondition()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type. The
+// type of the condition can't be changed, single and multi-location conditions are separate
+// resources in New Relic.
+func (r *AlertsSyntheticsCondition) ValidateUpdate(old runtime.Object) error {
+	alertssyntheticsconditionlog.Info("validate update", "name", r.Name)
+
+	if oldCondition, ok := old.(*AlertsSyntheticsCondition); ok && oldCondition.Spec.Type != r.Spec.Type {
+		return errors.New("type can't be changed from " + string(oldCondition.Spec.Type) + " to " + string(r.Spec.Type))
+	}
+
+	return r.ValidateAlertsSyntheticsCondition()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *AlertsSyntheticsCondition) ValidateDelete() error {
+	alertssyntheticsconditionlog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
+
+// ValidateAlertsSyntheticsCondition checks the credentials, the fields required by the type of the
+// condition, the values of its attributes and that the policy it is added to exists
+func (r *AlertsSyntheticsCondition) ValidateAlertsSyntheticsCondition() error {
+	err := r.CheckForAPIKeyOrSecret()
+	if err != nil {
+		return err
+	}
+
+	err = r.CheckRequiredFields()
+	if err != nil {
+		return err
+	}
+
+	var invalidAttributes InvalidAttributeSlice
+
+	invalidAttributes = append(invalidAttributes, r.ValidateType()...)
+	invalidAttributes = append(invalidAttributes, r.ValidateViolationTimeLimit()...)
+	invalidAttributes = append(invalidAttributes, r.ValidateTerms()...)
+
+	if len(invalidAttributes) > 0 {
+		return errors.New("error with invalid attributes: \n" + invalidAttributes.errorString())
+	}
+
+	return r.CheckExistingPolicyID()
+}
+
+func (r *AlertsSyntheticsCondition) ValidateType() InvalidAttributeSlice {
+	if IsSyntheticsConditionType(string(r.Spec.Type)) {
+		return []invalidAttribute{}
+	}
+
+	alertssyntheticsconditionlog.Info("Invalid Type attribute", "Type", r.Spec.Type)
+
+	return []invalidAttribute{{attribute: "Type", value: string(r.Spec.Type)}}
+}
+
+// ValidateViolationTimeLimit checks the violation time limit of a synthetics_multi_location condition,
+// single location conditions don't have one
+func (r *AlertsSyntheticsCondition) ValidateViolationTimeLimit() InvalidAttributeSlice {
+	limit := r.Spec.ViolationTimeLimitSeconds
+
+	if !r.Spec.IsMultiLocation() && limit == 0 {
+		return []invalidAttribute{}
+	}
+
+	if r.Spec.IsMultiLocation() {
+		for _, validLimit := range syntheticsViolationTimeLimits {
+			if limit == validLimit {
+				return []invalidAttribute{}
+			}
+		}
+	}
+
+	alertssyntheticsconditionlog.Info("Invalid ViolationTimeLimitSeconds attribute", "ViolationTimeLimitSeconds", limit)
+
+	return []invalidAttribute{{attribute: "ViolationTimeLimitSeconds", value: strconv.Itoa(limit)}}
+}
+
+// ValidateTerms checks the terms of a synthetics_multi_location condition, their threshold is the
+// number of locations that have to fail
+func (r *AlertsSyntheticsCondition) ValidateTerms() InvalidAttributeSlice {
+	var invalidTerms InvalidAttributeSlice
+
+	if !r.Spec.IsMultiLocation() {
+		if len(r.Spec.APMTerms) > 0 {
+			alertssyntheticsconditionlog.Info("Terms passed to a synthetics condition", "Type", r.Spec.Type)
+			invalidTerms = append(invalidTerms, invalidAttribute{attribute: "APMTerms", value: string(r.Spec.Type)})
+		}
+
+		return invalidTerms
+	}
+
+	for _, term := range r.Spec.APMTerms {
+		switch alerts.PriorityType(term.Priority) {
+		case alerts.PriorityTypes.Critical, alerts.PriorityTypes.Warning:
+		default:
+			alertssyntheticsconditionlog.Info("Invalid Term.Priority passed", "Term.Priority", term.Priority)
+			invalidTerms = append(invalidTerms, invalidAttribute{attribute: "Term.Priority", value: term.Priority})
+		}
+
+		if threshold, err := strconv.Atoi(term.Threshold); err != nil || threshold < 1 {
+			alertssyntheticsconditionlog.Info("Invalid Term.Threshold passed", "Term.Threshold", term.Threshold)
+			invalidTerms = append(invalidTerms, invalidAttribute{attribute: "Term.Threshold", value: term.Threshold})
+		}
+	}
+
+	return invalidTerms
+}
+
+func (r *AlertsSyntheticsCondition) CheckExistingPolicyID() error {
+	alertssyntheticsconditionlog.Info("Checking existing", "policyId", r.Spec.ExistingPolicyID)
+	ctx := context.Background()
+	credentials, getErr := ResolveCredentials(ctx, k8Client, r.Namespace, r.GetAccountSettings())
+	if getErr != nil {
+		alertssyntheticsconditionlog.Error(getErr, "Error getting credentials")
+		return getErr
+	}
+
+	alertsClient, errAlertClient := alertClientFunc(credentials.APIKey, credentials.Region)
+	if errAlertClient != nil {
+		alertssyntheticsconditionlog.Error(errAlertClient, "failed to get policy",
+			"policyId", r.Spec.ExistingPolicyID,
+			"API Key", interfaces.PartialAPIKey(credentials.APIKey),
+			"accountID", credentials.AccountID,
+			"region", credentials.Region,
+		)
+		return errAlertClient
+	}
+
+	alertPolicy, errAlertPolicy := alertsClient.QueryPolicy(credentials.AccountID, r.Spec.ExistingPolicyID)
+	if errAlertPolicy != nil {
+		if r.GetDeletionTimestamp() != nil {
+			alertssyntheticsconditionlog.Info("Deleting resource", "errAlertPolicy", errAlertPolicy)
+			return nil
+		}
+		alertssyntheticsconditionlog.Error(errAlertPolicy, "failed to get policy",
+			"policyId", r.Spec.ExistingPolicyID,
+			"API Key", interfaces.PartialAPIKey(credentials.APIKey),
+			"region", credentials.Region,
+		)
+		return errAlertPolicy
+	}
+
+	if alertPolicy.ID != r.Spec.ExistingPolicyID {
+		alertssyntheticsconditionlog.Info("Alert policy returned by the API failed to match provided policy ID")
+		return errors.New("alert policy returned by API did not match")
+	}
+
+	return nil
+}
+
+func (r *AlertsSyntheticsCondition) CheckForAPIKeyOrSecret() error {
+	return CheckForAccount(r.Namespace, r.GetAccountSettings())
+}
+
+// CheckRequiredFields checks the fields every synthetics condition needs, the monitor of a synthetics
+// condition and the monitors and terms of a synthetics_multi_location condition
+func (r *AlertsSyntheticsCondition) CheckRequiredFields() error {
+	missingFields := []string{}
+	// the region of a referenced account is used when the condition does not set one
+	if r.Spec.Region == "" && r.Spec.AccountRef.Name == "" {
+		missingFields = append(missingFields, "region")
+	}
+	if r.Spec.ExistingPolicyID == "" {
+		missingFields = append(missingFields, "existing_policy_id")
+	}
+	if r.Spec.IsMultiLocation() {
+		if len(r.Spec.Entities) == 0 {
+			missingFields = append(missingFields, "entities")
+		}
+		if len(r.Spec.APMTerms) == 0 {
+			missingFields = append(missingFields, "apm_terms")
+		}
+	} else if r.Spec.MonitorID == "" {
+		missingFields = append(missingFields, "monitor_id")
+	}
+	if len(missingFields) > 0 {
+		return errors.New(strings.Join(missingFields, " and ") + " must be set")
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package v1
+
+import (
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+)
+
+var _ = Describe("alertsSyntheticsCondition_webhook", func() {
+	var (
+		r            AlertsSyntheticsCondition
+		alertsClient *interfacesfakes.FakeNewRelicAlertsClient
+	)
+
+	BeforeEach(func() {
+		k8Client = testk8sClient
+		alertsClient = &interfacesfakes.FakeNewRelicAlertsClient{}
+		alertClientFunc = func(string, string) (interfaces.NewRelicAlertsClient, error) {
+			return alertsClient, nil
+		}
+		r = AlertsSyntheticsCondition{
+			ObjectMeta: v1.ObjectMeta{
+				Name: "test synthetics condition",
+			},
+			Spec: AlertsSyntheticsConditionSpec{
+				AlertsGenericConditionSpec: AlertsGenericConditionSpec{
+					Type:             SyntheticsConditionTypeSingle,
+					Name:             "K8s generated synthetics alert condition",
+					Enabled:          true,
+					ExistingPolicyID: "46286",
+					APIKey:           "111222333",
+					Region:           "staging",
+				},
+				AlertsSyntheticsSpecificSpec: AlertsSyntheticsSpecificSpec{
+					MonitorID: "6a1c2f0e-4b7d-4d3c-9a2e-1f7b6c5d4e3a",
+				},
+			},
+		}
+
+		alertsClient.QueryPolicyStub = func(int, string) (*alerts.AlertsPolicy, error) {
+			return &alerts.AlertsPolicy{
+				ID: "46286",
+			}, nil
+		}
+	})
+
+	Context("ValidateCreate", func() {
+		Context("With a valid synthetics condition", func() {
+			It("Should create the synthetics condition", func() {
+				err := r.ValidateCreate()
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("With a valid synthetics_multi_location condition", func() {
+			BeforeEach(func() {
+				r.Spec.Type = SyntheticsConditionTypeMultiLocation
+				r.Spec.MonitorID = ""
+				r.Spec.Entities = []string{"6a1c2f0e-4b7d-4d3c-9a2e-1f7b6c5d4e3a"}
+				r.Spec.ViolationTimeLimitSeconds = 3600
+				r.Spec.APMTerms = []AlertConditionTerm{{Priority: "critical", Threshold: "2"}}
+			})
+
+			It("Should create the synthetics condition", func() {
+				err := r.ValidateCreate()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("and an invalid violation time limit and terms", func() {
+				BeforeEach(func() {
+					r.Spec.ViolationTimeLimitSeconds = 60
+					r.Spec.APMTerms = []AlertConditionTerm{{Priority: "urgent", Threshold: "0"}}
+				})
+
+				It("Should reject the synthetics condition creation", func() {
+					err := r.ValidateCreate()
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("ViolationTimeLimitSeconds"))
+					Expect(err.Error()).To(ContainSubstring("urgent"))
+					Expect(err.Error()).To(ContainSubstring("Term.Threshold"))
+				})
+			})
+
+			Context("without entities and terms", func() {
+				BeforeEach(func() {
+					r.Spec.Entities = nil
+					r.Spec.APMTerms = nil
+				})
+
+				It("Should reject the synthetics condition creation", func() {
+					err := r.ValidateCreate()
+					Expect(err).To(MatchError("entities and apm_terms must be set"))
+				})
+			})
+		})
+
+		Context("With an invalid Type", func() {
+			BeforeEach(func() {
+				r.Spec.Type = "burritos"
+			})
+
+			It("Should reject the synthetics condition creation", func() {
+				err := r.ValidateCreate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("burritos"))
+			})
+		})
+
+		Context("Without a monitor", func() {
+			BeforeEach(func() {
+				r.Spec.MonitorID = ""
+			})
+
+			It("Should reject the synthetics condition creation", func() {
+				err := r.ValidateCreate()
+				Expect(err).To(MatchError("monitor_id must be set"))
+			})
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("Should reject changing the type of the condition", func() {
+			old := r.DeepCopy()
+			r.Spec.Type = SyntheticsConditionTypeMultiLocation
+
+			err := r.ValidateUpdate(old)
+			Expect(err).To(MatchError("type can't be changed from synthetics to synthetics_multi_location"))
+		})
+
+		It("Should accept other changes", func() {
+			old := r.DeepCopy()
+			r.Spec.Name = "renamed"
+
+			err := r.ValidateUpdate(old)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -3,8 +3,10 @@ package v1
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	v1 "k8s.io/api/core/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -18,6 +20,7 @@ type AlertsChannelSpec struct {
 	Name          string                     `json:"name"`
 	APIKey        string                     `json:"api_key,omitempty"`
 	APIKeySecret  NewRelicAPIKeySecret       `json:"api_key_secret,omitempty"`
+	AccountRef    NewRelicAccountReference   `json:"account_ref,omitempty"`
 	Region        string                     `json:"region,omitempty"`
 	Type          string                     `json:"type,omitempty"`
 	Links         ChannelLinks               `json:"links,omitempty"`
@@ -33,9 +36,10 @@ type ChannelLinks struct {
 
 // AlertsChannelStatus defines the observed state of AlertsChannel
 type AlertsChannelStatus struct {
-	AppliedSpec      *AlertsChannelSpec `json:"applied_spec"`
+	AppliedSpec      *AlertsChannelSpec `json:"applied_spec,omitempty"`
 	ChannelID        int                `json:"channel_id"`
-	AppliedPolicyIDs []int              `json:"appliedPolicyIDs"`
+	AppliedPolicyIDs []int              `json:"appliedPolicyIDs,omitempty"`
+	Conditions       []Condition        `json:"conditions,omitempty"`
 }
 
 type ChannelHeader struct {
@@ -69,10 +73,41 @@ type AlertsChannelConfiguration struct {
 	Payload map[string]string `json:"payload,omitempty"`
 
 	Headers []ChannelHeader `json:"headers,omitempty"`
+
+	// ValueFrom reads sensitive configuration values from secrets. A value read from a secret takes
+	// precedence over the inline value of the same field.
+	ValueFrom *AlertsChannelConfigurationValueFrom `json:"value_from,omitempty"`
+}
+
+// AlertsChannelConfigurationValueFrom - the sources of the sensitive AlertsChannelConfiguration values
+type AlertsChannelConfigurationValueFrom struct {
+	AuthToken    *ChannelValueSource `json:"auth_token,omitempty"`
+	AuthPassword *ChannelValueSource `json:"auth_password,omitempty"`
+	APIKey       *ChannelValueSource `json:"api_key,omitempty"`
+	Key          *ChannelValueSource `json:"key,omitempty"`
+	RouteKey     *ChannelValueSource `json:"route_key,omitempty"`
+	ServiceKey   *ChannelValueSource `json:"service_key,omitempty"`
+	URL          *ChannelValueSource `json:"url,omitempty"`
+
+	Payload map[string]ChannelValueSource `json:"payload,omitempty"`
+}
+
+// ChannelValueSource - the source of a configuration value
+type ChannelValueSource struct {
+	SecretKeyRef *SecretKeyReference `json:"secret_key_ref,omitempty"`
+}
+
+// SecretKeyReference - selects a key of a secret. The namespace defaults to the namespace of the resource.
+type SecretKeyReference struct {
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	KeyName   string `json:"key_name,omitempty"`
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Created",type="boolean",JSONPath=".status.created"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
 
 // AlertsChannel is the Schema for the AlertsChannel API
 type AlertsChannel struct {
@@ -96,15 +131,34 @@ func init() {
 	SchemeBuilder.Register(&AlertsChannel{}, &AlertsChannelList{})
 }
 
+// GetConditions returns the status conditions of the AlertsChannel
+func (in *AlertsChannel) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the AlertsChannel
+func (in *AlertsChannel) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
 func getSecret(name types.NamespacedName, key string, k8sClient client.Client) (string, error) {
 	var apiKeySecret v1.Secret
 
 	err := k8sClient.Get(context.Background(), name, &apiKeySecret)
 	if err != nil {
+		if kErr.IsNotFound(err) {
+			return "", &SecretNotFoundError{Secret: name}
+		}
+
 		return "", err
 	}
 
-	return string(apiKeySecret.Data[key]), nil
+	value, ok := apiKeySecret.Data[key]
+	if !ok {
+		return "", &SecretKeyNotFoundError{Secret: name, Key: key}
+	}
+
+	return string(value), nil
 }
 
 // APIChannel - Converts AlertsChannelSpec object to alerts.Channel
@@ -141,5 +195,108 @@ func (in AlertsChannelSpec) APIChannel(k8sClient client.Client) (alerts.Channel,
 		}
 	}
 
+	if in.Configuration.ValueFrom != nil {
+		err = in.Configuration.ValueFrom.resolve(&APIChannel.Configuration, k8sClient)
+		if err != nil {
+			return alerts.Channel{}, err
+		}
+	}
+
 	return APIChannel, nil
 }
+
+// SecretKeyRefs returns the secret references of the sources by the path of the configuration value they set
+func (in *AlertsChannelConfigurationValueFrom) SecretKeyRefs() map[string]*SecretKeyReference {
+	refs := map[string]*SecretKeyReference{}
+
+	for path, source := range in.sources() {
+		if source.SecretKeyRef != nil {
+			refs[path] = source.SecretKeyRef
+		}
+	}
+
+	return refs
+}
+
+// sources returns the configured sources by the path of the configuration value they set
+func (in *AlertsChannelConfigurationValueFrom) sources() map[string]*ChannelValueSource {
+	sources := map[string]*ChannelValueSource{}
+
+	fields := map[string]*ChannelValueSource{
+		"auth_token":    in.AuthToken,
+		"auth_password": in.AuthPassword,
+		"api_key":       in.APIKey,
+		"key":           in.Key,
+		"route_key":     in.RouteKey,
+		"service_key":   in.ServiceKey,
+		"url":           in.URL,
+	}
+	for path, source := range fields {
+		if source != nil {
+			sources[path] = source
+		}
+	}
+
+	for name := range in.Payload {
+		source := in.Payload[name]
+		sources["payload."+name] = &source
+	}
+
+	return sources
+}
+
+// resolve sets the values read from secrets on config
+func (in *AlertsChannelConfigurationValueFrom) resolve(config *alerts.ChannelConfiguration, k8sClient client.Client) error {
+	fields := []struct {
+		source *ChannelValueSource
+		value  *string
+	}{
+		{in.AuthToken, &config.AuthToken},
+		{in.AuthPassword, &config.AuthPassword},
+		{in.APIKey, &config.APIKey},
+		{in.Key, &config.Key},
+		{in.RouteKey, &config.RouteKey},
+		{in.ServiceKey, &config.ServiceKey},
+		{in.URL, &config.URL},
+	}
+
+	for _, field := range fields {
+		if field.source == nil {
+			continue
+		}
+
+		value, err := field.source.value(k8sClient)
+		if err != nil {
+			return err
+		}
+		*field.value = value
+	}
+
+	if len(in.Payload) > 0 && config.Payload == nil {
+		config.Payload = map[string]interface{}{}
+	}
+
+	for name, source := range in.Payload {
+		value, err := source.value(k8sClient)
+		if err != nil {
+			return err
+		}
+		config.Payload[name] = value
+	}
+
+	return nil
+}
+
+// value reads the value of the source
+func (in ChannelValueSource) value(k8sClient client.Client) (string, error) {
+	if in.SecretKeyRef == nil {
+		return "", errors.New("secret_key_ref must be set")
+	}
+
+	name := types.NamespacedName{
+		Namespace: in.SecretKeyRef.Namespace,
+		Name:      in.SecretKeyRef.Name,
+	}
+
+	return getSecret(name, in.SecretKeyRef.KeyName, k8sClient)
+}
@@ -121,5 +121,82 @@ var _ = Describe("AlertsChannelSpec", func() {
 				Expect(apiConfiguration.Headers["SECRET"]).To(Equal("don't tell anyone"))
 			})
 		})
+
+		Context("slack channel with values from secrets", func() {
+			var alertsChannelSpec AlertsChannelSpec
+			BeforeEach(func() {
+				alertsChannelSpec = AlertsChannelSpec{
+					Name:   "my alert channel",
+					APIKey: "api-key",
+					Region: "US",
+					Type:   "slack",
+					Configuration: AlertsChannelConfiguration{
+						URL:     "https://hooks.slack.com/inline",
+						Channel: "alerts",
+						ValueFrom: &AlertsChannelConfigurationValueFrom{
+							URL: &ChannelValueSource{
+								SecretKeyRef: &SecretKeyReference{Name: "slack", Namespace: "default", KeyName: "url"},
+							},
+							Payload: map[string]ChannelValueSource{
+								"token": {
+									SecretKeyRef: &SecretKeyReference{Name: "payload", Namespace: "default", KeyName: "token"},
+								},
+							},
+						},
+					},
+				}
+			})
+
+			It("reads the values from the secrets, taking precedence over inline values", func() {
+				slackSecret := v1.Secret{
+					Data: map[string][]byte{
+						"url": []byte("https://hooks.slack.com/secret"),
+					},
+				}
+				client.EXPECT().
+					Get(gomock.Eq(context.Background()),
+						gomock.Eq(types.NamespacedName{Namespace: "default", Name: "slack"}),
+						gomock.AssignableToTypeOf(&slackSecret)).
+					SetArg(2, slackSecret)
+
+				payloadSecret := v1.Secret{
+					Data: map[string][]byte{
+						"token": []byte("payload token"),
+					},
+				}
+				client.EXPECT().
+					Get(gomock.Eq(context.Background()),
+						gomock.Eq(types.NamespacedName{Namespace: "default", Name: "payload"}),
+						gomock.AssignableToTypeOf(&payloadSecret)).
+					SetArg(2, payloadSecret)
+
+				apiChannel, err := alertsChannelSpec.APIChannel(client)
+				Expect(err).NotTo(HaveOccurred())
+
+				apiConfiguration := apiChannel.Configuration
+				Expect(apiConfiguration.URL).To(Equal("https://hooks.slack.com/secret"))
+				Expect(apiConfiguration.Channel).To(Equal("alerts"))
+				Expect(apiConfiguration.Payload["token"]).To(Equal("payload token"))
+			})
+
+			It("returns an error when a secret key is missing", func() {
+				alertsChannelSpec.Configuration.ValueFrom.Payload = nil
+
+				secret := v1.Secret{
+					Data: map[string][]byte{
+						"other": []byte("value"),
+					},
+				}
+				client.EXPECT().
+					Get(gomock.Eq(context.Background()),
+						gomock.Eq(types.NamespacedName{Namespace: "default", Name: "slack"}),
+						gomock.AssignableToTypeOf(&secret)).
+					SetArg(2, secret)
+
+				_, err := alertsChannelSpec.APIChannel(client)
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&SecretKeyNotFoundError{}))
+			})
+		})
 	})
 })
@@ -17,6 +17,8 @@ package v1
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -25,6 +27,7 @@ import (
 
 	"github.com/newrelic/newrelic-client-go/pkg/alerts"
 
+	customErrors "github.com/newrelic/newrelic-kubernetes-operator/errors"
 	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
 )
 
@@ -61,6 +64,16 @@ func (r *AlertsChannel) Default() {
 		log.Info("Setting null AppliedPolicyIDs to empty interface")
 		r.Status.AppliedPolicyIDs = []int{}
 	}
+
+	if r.Spec.Configuration.ValueFrom != nil {
+		for _, ref := range r.Spec.Configuration.ValueFrom.SecretKeyRefs() {
+			if ref.Namespace == "" {
+				ref.Namespace = r.Namespace
+			}
+		}
+	}
+
+	DefaultAccountRef(&r.Spec.AccountRef)
 }
 
 // +kubebuilder:webhook:verbs=create;update,path=/validate-nr-k8s-newrelic-com-v1-alertschannel,mutating=false,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=alertschannels,versions=v1,name=valertschannel.kb.io,sideEffects=None
@@ -91,12 +104,13 @@ func (r *AlertsChannel) ValidateDelete() error {
 
 // ValidateAlertsChannel - Validates create/update of AlertsChannel
 func (r *AlertsChannel) ValidateAlertsChannel() error {
-	err := CheckForAPIKeyOrSecret(r.Spec.APIKey, r.Spec.APIKeySecret)
+	err := CheckForAccount(r.Namespace, r.GetAccountSettings())
 	if err != nil {
 		return err
 	}
 
-	if !ValidRegion(r.Spec.Region) {
+	// the region of a referenced account is used when the channel does not set one
+	if !ValidRegion(r.Spec.Region) && !(r.Spec.Region == "" && r.Spec.AccountRef.Name != "") {
 		return errors.New("Invalid region set, value was: " + r.Spec.Region)
 	}
 
@@ -109,6 +123,42 @@ func (r *AlertsChannel) ValidateAlertsChannel() error {
 		return errors.New("error with invalid attributes: \n" + invalidAttributes.errorString())
 	}
 
+	return r.ValidateValueFrom()
+}
+
+// ValidateValueFrom - Validates that the secrets and keys the configuration values are read from exist
+func (r *AlertsChannel) ValidateValueFrom() error {
+	if r.Spec.Configuration.ValueFrom == nil {
+		return nil
+	}
+
+	sources := r.Spec.Configuration.ValueFrom.sources()
+
+	paths := make([]string, 0, len(sources))
+	for path := range sources {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	collectedErrors := new(customErrors.ErrorCollector)
+
+	for _, path := range paths {
+		ref := sources[path].SecretKeyRef
+		if ref == nil || ref.Name == "" || ref.KeyName == "" {
+			collectedErrors.Collect(fmt.Errorf("configuration.value_from.%s must set secret_key_ref.name and secret_key_ref.key_name", path))
+			continue
+		}
+
+		if _, err := sources[path].value(k8Client); err != nil {
+			collectedErrors.Collect(fmt.Errorf("configuration.value_from.%s: %w", path, err))
+		}
+	}
+
+	if len(*collectedErrors) > 0 {
+		alertschannellog.Info("Errors encountered validating value_from", "collectedErrors", collectedErrors)
+		return collectedErrors
+	}
+
 	return nil
 }
 
@@ -1,9 +1,12 @@
 package v1
 
 import (
+	"context"
+
 	"github.com/newrelic/newrelic-client-go/pkg/alerts"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
@@ -102,5 +105,74 @@ var _ = Describe("AlertsChannel_webhook", func() {
 				Expect(err.Error()).To(ContainSubstring("either api_key or api_key_secret must be set"))
 			})
 		})
+
+		Context("With configuration values from secrets", func() {
+			var secret *corev1.Secret
+
+			BeforeEach(func() {
+				secret = &corev1.Secret{
+					ObjectMeta: v1.ObjectMeta{Name: "pagerduty", Namespace: "default"},
+					Data:       map[string][]byte{"service-key": []byte("service key")},
+				}
+				Expect(k8Client.Create(context.Background(), secret)).To(Succeed())
+
+				r.Spec.Type = "pagerduty"
+				r.Spec.Configuration.ValueFrom = &AlertsChannelConfigurationValueFrom{
+					ServiceKey: &ChannelValueSource{
+						SecretKeyRef: &SecretKeyReference{Name: "pagerduty", Namespace: "default", KeyName: "service-key"},
+					},
+				}
+			})
+
+			AfterEach(func() {
+				Expect(k8Client.Delete(context.Background(), secret)).To(Succeed())
+			})
+
+			It("Should create the Alert Channel when the secret and key exist", func() {
+				err := r.ValidateCreate()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("Should reject a missing secret", func() {
+				r.Spec.Configuration.ValueFrom.ServiceKey.SecretKeyRef.Name = "missing"
+				err := r.ValidateCreate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("configuration.value_from.service_key: secret default/missing not found"))
+			})
+
+			It("Should reject a missing key", func() {
+				r.Spec.Configuration.ValueFrom.ServiceKey.SecretKeyRef.KeyName = "missing"
+				err := r.ValidateCreate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(`configuration.value_from.service_key: key "missing" not found in secret default/pagerduty`))
+			})
+
+			It("Should reject a reference without a key name", func() {
+				r.Spec.Configuration.ValueFrom.Payload = map[string]ChannelValueSource{
+					"token": {SecretKeyRef: &SecretKeyReference{Name: "pagerduty"}},
+				}
+				err := r.ValidateCreate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("configuration.value_from.payload.token must set secret_key_ref.name and secret_key_ref.key_name"))
+			})
+		})
+	})
+
+	Context("Default", func() {
+		It("Should default the namespace of secret references", func() {
+			r.Namespace = "my-namespace"
+			r.Spec.Configuration.ValueFrom = &AlertsChannelConfigurationValueFrom{
+				AuthToken: &ChannelValueSource{SecretKeyRef: &SecretKeyReference{Name: "token", KeyName: "token"}},
+				Payload: map[string]ChannelValueSource{
+					"password": {SecretKeyRef: &SecretKeyReference{Name: "password", Namespace: "other", KeyName: "password"}},
+				},
+			}
+			r.Spec.APIKey = ""
+
+			r.Default()
+
+			Expect(r.Spec.Configuration.ValueFrom.AuthToken.SecretKeyRef.Namespace).To(Equal("my-namespace"))
+			Expect(r.Spec.Configuration.ValueFrom.Payload["password"].SecretKeyRef.Namespace).To(Equal("other"))
+		})
 	})
 })
@@ -0,0 +1,420 @@
+package v1
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Repeat intervals of a muting rule schedule
+const (
+	MutingRuleRepeatDaily   = "DAILY"
+	MutingRuleRepeatWeekly  = "WEEKLY"
+	MutingRuleRepeatMonthly = "MONTHLY"
+)
+
+// MutingRuleTimeLayout is the format of the times of a muting rule schedule, they have no offset
+// and are interpreted in the time zone of the schedule
+const MutingRuleTimeLayout = "2006-01-02T15:04:05"
+
+// AlertsMutingRuleSpec defines the desired state of AlertsMutingRule
+type AlertsMutingRuleSpec struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Enabled rules mute matching violations while their schedule is active
+	Enabled   bool                           `json:"enabled"`
+	Condition AlertsMutingRuleConditionGroup `json:"condition"`
+	// Schedule limits when the rule is active, a rule without a schedule is active while it is enabled
+	Schedule     *AlertsMutingRuleSchedule `json:"schedule,omitempty"`
+	APIKey       string                    `json:"api_key,omitempty"`
+	APIKeySecret NewRelicAPIKeySecret      `json:"api_key_secret,omitempty"`
+	AccountRef   NewRelicAccountReference  `json:"account_ref,omitempty"`
+	Region       string                    `json:"region,omitempty"`
+	AccountID    int                       `json:"account_id,omitempty"`
+}
+
+// AlertsMutingRuleConditionGroup - copy of alerts.MutingRuleConditionGroup
+type AlertsMutingRuleConditionGroup struct {
+	// +kubebuilder:validation:Enum=AND;OR
+	Operator   string                      `json:"operator"`
+	Conditions []AlertsMutingRuleCondition `json:"conditions"`
+}
+
+// AlertsMutingRuleCondition - copy of alerts.MutingRuleCondition, matches an attribute of a violation
+// such as policyName, conditionName, entity.guid or a tag of the entity
+type AlertsMutingRuleCondition struct {
+	Attribute string `json:"attribute"`
+	// +kubebuilder:validation:Enum=ANY;CONTAINS;ENDS_WITH;EQUALS;IN;IS_BLANK;IS_NOT_BLANK;NOT_CONTAINS;NOT_ENDS_WITH;NOT_EQUALS;NOT_IN;NOT_STARTS_WITH;STARTS_WITH
+	Operator string   `json:"operator"`
+	Values   []string `json:"values,omitempty"`
+}
+
+// AlertsMutingRuleSchedule - copy of alerts.MutingRuleScheduleCreateInput, times use MutingRuleTimeLayout
+type AlertsMutingRuleSchedule struct {
+	StartTime string `json:"start_time"`
+	// EndTime is required when the schedule repeats, without it the rule stays active from start_time on
+	EndTime  string `json:"end_time,omitempty"`
+	TimeZone string `json:"time_zone"`
+	// +kubebuilder:validation:Enum=DAILY;WEEKLY;MONTHLY
+	Repeat string `json:"repeat,omitempty"`
+	// EndRepeat and RepeatCount stop a repeating schedule, RepeatCount includes the first occurrence
+	EndRepeat   string `json:"end_repeat,omitempty"`
+	RepeatCount int    `json:"repeat_count,omitempty"`
+	// WeeklyRepeatDays are the days a WEEKLY schedule repeats on, e.g. MONDAY
+	WeeklyRepeatDays []string `json:"weekly_repeat_days,omitempty"`
+}
+
+// AlertsMutingRuleStatus defines the observed state of AlertsMutingRule
+type AlertsMutingRuleStatus struct {
+	AppliedSpec  *AlertsMutingRuleSpec `json:"applied_spec,omitempty"`
+	MutingRuleID int                   `json:"muting_rule_id"`
+	// Active is true while the rule mutes violations
+	Active     bool        `json:"active"`
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Rule ID",type="integer",JSONPath=".status.muting_rule_id"
+// +kubebuilder:printcolumn:name="Active",type="boolean",JSONPath=".status.active"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+
+// AlertsMutingRule is the Schema for the alertsmutingrules API
+type AlertsMutingRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AlertsMutingRuleSpec   `json:"spec,omitempty"`
+	Status AlertsMutingRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AlertsMutingRuleList contains a list of AlertsMutingRule
+type AlertsMutingRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AlertsMutingRule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AlertsMutingRule{}, &AlertsMutingRuleList{})
+}
+
+// GetConditions returns the status conditions of the AlertsMutingRule
+func (in *AlertsMutingRule) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the AlertsMutingRule
+func (in *AlertsMutingRule) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
+// ToMutingRuleCreateInput - Converts AlertsMutingRuleSpec object to alerts.MutingRuleCreateInput
+func (in AlertsMutingRuleSpec) ToMutingRuleCreateInput() (alerts.MutingRuleCreateInput, error) {
+	input := alerts.MutingRuleCreateInput{
+		Name:        in.Name,
+		Description: in.Description,
+		Enabled:     in.Enabled,
+		Condition:   in.Condition.toConditionGroup(),
+	}
+
+	if in.Schedule != nil {
+		schedule, err := in.Schedule.toScheduleInput()
+		if err != nil {
+			return alerts.MutingRuleCreateInput{}, err
+		}
+
+		input.Schedule = &schedule
+	}
+
+	return input, nil
+}
+
+// ToMutingRuleUpdateInput - Converts AlertsMutingRuleSpec object to alerts.MutingRuleUpdateInput.
+// A schedule removed from the spec is removed from the rule as well.
+func (in AlertsMutingRuleSpec) ToMutingRuleUpdateInput() (alerts.MutingRuleUpdateInput, error) {
+	condition := in.Condition.toConditionGroup()
+
+	input := alerts.MutingRuleUpdateInput{
+		Name:        in.Name,
+		Description: in.Description,
+		Enabled:     in.Enabled,
+		Condition:   &condition,
+	}
+
+	if in.Schedule != nil {
+		schedule, err := in.Schedule.toScheduleInput()
+		if err != nil {
+			return alerts.MutingRuleUpdateInput{}, err
+		}
+
+		timeZone := schedule.TimeZone
+		input.Schedule = &alerts.MutingRuleScheduleUpdateInput{
+			StartTime:        schedule.StartTime,
+			EndTime:          schedule.EndTime,
+			TimeZone:         &timeZone,
+			Repeat:           schedule.Repeat,
+			EndRepeat:        schedule.EndRepeat,
+			RepeatCount:      schedule.RepeatCount,
+			WeeklyRepeatDays: schedule.WeeklyRepeatDays,
+		}
+	}
+
+	return input, nil
+}
+
+func (in AlertsMutingRuleConditionGroup) toConditionGroup() alerts.MutingRuleConditionGroup {
+	group := alerts.MutingRuleConditionGroup{
+		Operator:   in.Operator,
+		Conditions: []alerts.MutingRuleCondition{},
+	}
+
+	for _, condition := range in.Conditions {
+		group.Conditions = append(group.Conditions, alerts.MutingRuleCondition{
+			Attribute: condition.Attribute,
+			Operator:  condition.Operator,
+			Values:    append([]string{}, condition.Values...),
+		})
+	}
+
+	return group
+}
+
+func (in AlertsMutingRuleSchedule) toScheduleInput() (alerts.MutingRuleScheduleCreateInput, error) {
+	location, err := time.LoadLocation(in.TimeZone)
+	if err != nil {
+		return alerts.MutingRuleScheduleCreateInput{}, err
+	}
+
+	schedule := alerts.MutingRuleScheduleCreateInput{
+		TimeZone: in.TimeZone,
+	}
+
+	for _, field := range []struct {
+		value  string
+		target **alerts.NaiveDateTime
+	}{
+		{in.StartTime, &schedule.StartTime},
+		{in.EndTime, &schedule.EndTime},
+		{in.EndRepeat, &schedule.EndRepeat},
+	} {
+		if field.value == "" {
+			continue
+		}
+
+		parsed, err := time.ParseInLocation(MutingRuleTimeLayout, field.value, location)
+		if err != nil {
+			return alerts.MutingRuleScheduleCreateInput{}, err
+		}
+
+		*field.target = &alerts.NaiveDateTime{Time: parsed}
+	}
+
+	if in.Repeat != "" {
+		repeat := alerts.MutingRuleScheduleRepeat(in.Repeat)
+		schedule.Repeat = &repeat
+	}
+
+	if in.RepeatCount > 0 {
+		repeatCount := in.RepeatCount
+		schedule.RepeatCount = &repeatCount
+	}
+
+	if len(in.WeeklyRepeatDays) > 0 {
+		days := []alerts.DayOfWeek{}
+		for _, day := range in.WeeklyRepeatDays {
+			days = append(days, alerts.DayOfWeek(day))
+		}
+
+		schedule.WeeklyRepeatDays = &days
+	}
+
+	return schedule, nil
+}
+
+// ActiveAt reports whether the rule mutes violations at now and when that changes next.
+// The returned time is zero when the rule does not change on its own anymore.
+func (in AlertsMutingRuleSpec) ActiveAt(now time.Time) (bool, time.Time, error) {
+	if !in.Enabled {
+		return false, time.Time{}, nil
+	}
+
+	if in.Schedule == nil {
+		return true, time.Time{}, nil
+	}
+
+	return in.Schedule.activeAt(now)
+}
+
+func (in AlertsMutingRuleSchedule) activeAt(now time.Time) (bool, time.Time, error) {
+	location, err := time.LoadLocation(in.TimeZone)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+
+	start, err := time.ParseInLocation(MutingRuleTimeLayout, in.StartTime, location)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+
+	if in.EndTime == "" {
+		if now.Before(start) {
+			return false, start, nil
+		}
+
+		return true, time.Time{}, nil
+	}
+
+	end, err := time.ParseInLocation(MutingRuleTimeLayout, in.EndTime, location)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+
+	var endRepeat time.Time
+	if in.EndRepeat != "" {
+		endRepeat, err = time.ParseInLocation(MutingRuleTimeLayout, in.EndRepeat, location)
+		if err != nil {
+			return false, time.Time{}, err
+		}
+	}
+
+	duration := end.Sub(start)
+	windowStart := start
+
+	// walk the occurrences of the schedule until the one covering or following now
+	for occurrence := 1; ; occurrence++ {
+		if now.Before(windowStart) {
+			return false, windowStart, nil
+		}
+
+		if windowEnd := windowStart.Add(duration); now.Before(windowEnd) {
+			return true, windowEnd, nil
+		}
+
+		if in.Repeat == "" || (in.RepeatCount > 0 && occurrence >= in.RepeatCount) {
+			return false, time.Time{}, nil
+		}
+
+		windowStart = in.nextOccurrence(start, windowStart, occurrence)
+
+		if !endRepeat.IsZero() && windowStart.After(endRepeat) {
+			return false, time.Time{}, nil
+		}
+	}
+}
+
+// nextOccurrence returns the start of the occurrence following previous. Monthly occurrences are
+// computed from start and fall on the last day of months shorter than the day of start, e.g. on
+// February 28th for a schedule starting on January 31st, and on the 31st again in March.
+func (in AlertsMutingRuleSchedule) nextOccurrence(start time.Time, previous time.Time, occurrence int) time.Time {
+	switch in.Repeat {
+	case MutingRuleRepeatDaily:
+		return previous.AddDate(0, 0, 1)
+	case MutingRuleRepeatMonthly:
+		return addMonths(start, occurrence)
+	}
+
+	if len(in.WeeklyRepeatDays) == 0 {
+		return previous.AddDate(0, 0, 7)
+	}
+
+	next := previous.AddDate(0, 0, 1)
+	for i := 0; i < 6 && !in.repeatsOn(next.Weekday()); i++ {
+		next = next.AddDate(0, 0, 1)
+	}
+
+	return next
+}
+
+// addMonths adds months to t, clamping the day to the last day of the resulting month. AddDate would
+// normalize January 31st plus one month to March 2nd or 3rd instead.
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	firstOfMonth := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+
+	if lastDay := firstOfMonth.AddDate(0, 1, -1).Day(); day > lastDay {
+		day = lastDay
+	}
+
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
+
+func (in AlertsMutingRuleSchedule) repeatsOn(weekday time.Weekday) bool {
+	for _, day := range in.WeeklyRepeatDays {
+		if strings.EqualFold(day, weekday.String()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Diff lists the fields of the muting rule in New Relic that no longer match the spec
+func (in AlertsMutingRuleSpec) Diff(remote alerts.MutingRule) []string {
+	differences := []string{}
+
+	if in.Name != remote.Name {
+		differences = append(differences, fmt.Sprintf("name is %q, expected %q", remote.Name, in.Name))
+	}
+
+	if in.Description != remote.Description {
+		differences = append(differences, fmt.Sprintf("description is %q, expected %q", remote.Description, in.Description))
+	}
+
+	if in.Enabled != remote.Enabled {
+		differences = append(differences, fmt.Sprintf("enabled is %t, expected %t", remote.Enabled, in.Enabled))
+	}
+
+	if !reflect.DeepEqual(in.Condition.toConditionGroup(), remote.Condition) {
+		differences = append(differences, "condition differs")
+	}
+
+	switch {
+	case in.Schedule == nil && remote.Schedule != nil:
+		differences = append(differences, "schedule is set, expected none")
+	case in.Schedule != nil && remote.Schedule == nil:
+		differences = append(differences, "schedule is not set")
+	case in.Schedule != nil:
+		if in.Schedule.TimeZone != remote.Schedule.TimeZone {
+			differences = append(differences, fmt.Sprintf("schedule time_zone is %q, expected %q", remote.Schedule.TimeZone, in.Schedule.TimeZone))
+		}
+
+		var remoteRepeat string
+		if remote.Schedule.Repeat != nil {
+			remoteRepeat = string(*remote.Schedule.Repeat)
+		}
+
+		if in.Schedule.Repeat != remoteRepeat {
+			differences = append(differences, fmt.Sprintf("schedule repeat is %q, expected %q", remoteRepeat, in.Schedule.Repeat))
+		}
+
+		if in.Schedule.StartTime != formatMutingRuleTime(remote.Schedule.StartTime, remote.Schedule.TimeZone) {
+			differences = append(differences, "schedule start_time differs")
+		}
+
+		if in.Schedule.EndTime != formatMutingRuleTime(remote.Schedule.EndTime, remote.Schedule.TimeZone) {
+			differences = append(differences, "schedule end_time differs")
+		}
+	}
+
+	return differences
+}
+
+// formatMutingRuleTime formats a time read from New Relic like the times of the spec
+func formatMutingRuleTime(t *time.Time, timeZone string) string {
+	if t == nil {
+		return ""
+	}
+
+	if location, err := time.LoadLocation(timeZone); err == nil {
+		return t.In(location).Format(MutingRuleTimeLayout)
+	}
+
+	return t.Format(MutingRuleTimeLayout)
+}
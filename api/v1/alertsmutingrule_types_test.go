@@ -0,0 +1,280 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AlertsMutingRuleSpec", func() {
+	var spec AlertsMutingRuleSpec
+	var berlin *time.Location
+
+	// at returns the given local time in Berlin, the time zone of the schedules below
+	at := func(value string) time.Time {
+		t, err := time.ParseInLocation(MutingRuleTimeLayout, value, berlin)
+		Expect(err).ToNot(HaveOccurred())
+		return t
+	}
+
+	BeforeEach(func() {
+		var err error
+		berlin, err = time.LoadLocation("Europe/Berlin")
+		Expect(err).ToNot(HaveOccurred())
+
+		spec = AlertsMutingRuleSpec{
+			Name:    "maintenance",
+			Enabled: true,
+			Condition: AlertsMutingRuleConditionGroup{
+				Operator: "AND",
+				Conditions: []AlertsMutingRuleCondition{
+					{Attribute: "policyName", Operator: "EQUALS", Values: []string{"shop"}},
+					{Attribute: "tags.env", Operator: "IS_NOT_BLANK"},
+				},
+			},
+			Schedule: &AlertsMutingRuleSchedule{
+				StartTime: "2020-11-02T22:00:00",
+				EndTime:   "2020-11-03T02:00:00",
+				TimeZone:  "Europe/Berlin",
+			},
+		}
+	})
+
+	Describe("ToMutingRuleCreateInput", func() {
+		It("converts the condition and schedule", func() {
+			input, err := spec.ToMutingRuleCreateInput()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(input.Name).To(Equal("maintenance"))
+			Expect(input.Enabled).To(BeTrue())
+			Expect(input.Condition).To(Equal(alerts.MutingRuleConditionGroup{
+				Operator: "AND",
+				Conditions: []alerts.MutingRuleCondition{
+					{Attribute: "policyName", Operator: "EQUALS", Values: []string{"shop"}},
+					{Attribute: "tags.env", Operator: "IS_NOT_BLANK", Values: []string{}},
+				},
+			}))
+			Expect(input.Schedule.TimeZone).To(Equal("Europe/Berlin"))
+			Expect(input.Schedule.StartTime.Time).To(BeTemporally("==", at("2020-11-02T22:00:00")))
+			Expect(input.Schedule.EndTime.Time).To(BeTemporally("==", at("2020-11-03T02:00:00")))
+			Expect(input.Schedule.Repeat).To(BeNil())
+		})
+
+		It("converts a weekly repeat", func() {
+			spec.Schedule.Repeat = MutingRuleRepeatWeekly
+			spec.Schedule.WeeklyRepeatDays = []string{"MONDAY", "THURSDAY"}
+			spec.Schedule.RepeatCount = 4
+
+			input, err := spec.ToMutingRuleCreateInput()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*input.Schedule.Repeat).To(Equal(alerts.MutingRuleScheduleRepeat("WEEKLY")))
+			Expect(*input.Schedule.WeeklyRepeatDays).To(Equal([]alerts.DayOfWeek{"MONDAY", "THURSDAY"}))
+			Expect(*input.Schedule.RepeatCount).To(Equal(4))
+		})
+
+		It("leaves out a missing schedule", func() {
+			spec.Schedule = nil
+
+			input, err := spec.ToMutingRuleCreateInput()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(input.Schedule).To(BeNil())
+		})
+
+		It("fails on an unknown time zone", func() {
+			spec.Schedule.TimeZone = "Mars/Olympus_Mons"
+
+			_, err := spec.ToMutingRuleCreateInput()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("ToMutingRuleUpdateInput", func() {
+		It("converts the condition and schedule", func() {
+			input, err := spec.ToMutingRuleUpdateInput()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(input.Name).To(Equal("maintenance"))
+			Expect(input.Enabled).To(BeTrue())
+			Expect(input.Condition.Conditions).To(HaveLen(2))
+			Expect(*input.Schedule.TimeZone).To(Equal("Europe/Berlin"))
+			Expect(input.Schedule.StartTime.Time).To(BeTemporally("==", at("2020-11-02T22:00:00")))
+			Expect(input.Schedule.EndTime.Time).To(BeTemporally("==", at("2020-11-03T02:00:00")))
+		})
+
+		It("leaves out a missing schedule", func() {
+			spec.Schedule = nil
+
+			input, err := spec.ToMutingRuleUpdateInput()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(input.Schedule).To(BeNil())
+		})
+
+		It("fails on an unknown time zone", func() {
+			spec.Schedule.TimeZone = "Mars/Olympus_Mons"
+
+			_, err := spec.ToMutingRuleUpdateInput()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("ActiveAt", func() {
+		It("is inactive when disabled", func() {
+			spec.Enabled = false
+
+			active, next, err := spec.ActiveAt(at("2020-11-02T23:00:00"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(BeFalse())
+			Expect(next.IsZero()).To(BeTrue())
+		})
+
+		It("is always active without a schedule", func() {
+			spec.Schedule = nil
+
+			active, next, err := spec.ActiveAt(at("2020-11-02T23:00:00"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(BeTrue())
+			Expect(next.IsZero()).To(BeTrue())
+		})
+
+		It("follows a one time window", func() {
+			active, next, err := spec.ActiveAt(at("2020-11-02T21:00:00"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(BeFalse())
+			Expect(next).To(BeTemporally("==", at("2020-11-02T22:00:00")))
+
+			active, next, err = spec.ActiveAt(at("2020-11-02T23:00:00"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(BeTrue())
+			Expect(next).To(BeTemporally("==", at("2020-11-03T02:00:00")))
+
+			active, next, err = spec.ActiveAt(at("2020-11-03T02:00:00"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(BeFalse())
+			Expect(next.IsZero()).To(BeTrue())
+		})
+
+		It("stays active without an end time", func() {
+			spec.Schedule.EndTime = ""
+
+			active, next, err := spec.ActiveAt(at("2021-01-01T00:00:00"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(BeTrue())
+			Expect(next.IsZero()).To(BeTrue())
+		})
+
+		It("repeats daily until end_repeat", func() {
+			spec.Schedule.Repeat = MutingRuleRepeatDaily
+			spec.Schedule.EndRepeat = "2020-11-05T00:00:00"
+
+			active, next, err := spec.ActiveAt(at("2020-11-04T23:30:00"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(BeTrue())
+			Expect(next).To(BeTemporally("==", at("2020-11-05T02:00:00")))
+
+			active, next, err = spec.ActiveAt(at("2020-11-04T12:00:00"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(BeFalse())
+			Expect(next).To(BeTemporally("==", at("2020-11-04T22:00:00")))
+
+			active, _, err = spec.ActiveAt(at("2020-11-05T23:00:00"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(BeFalse())
+		})
+
+		It("repeats on the weekly repeat days", func() {
+			// 2020-11-02 is a Monday
+			spec.Schedule.Repeat = MutingRuleRepeatWeekly
+			spec.Schedule.WeeklyRepeatDays = []string{"MONDAY", "THURSDAY"}
+
+			active, next, err := spec.ActiveAt(at("2020-11-04T23:00:00"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(BeFalse())
+			Expect(next).To(BeTemporally("==", at("2020-11-05T22:00:00")))
+
+			active, _, err = spec.ActiveAt(at("2020-11-09T23:00:00"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(BeTrue())
+		})
+
+		It("stops after repeat_count occurrences", func() {
+			spec.Schedule.Repeat = MutingRuleRepeatDaily
+			spec.Schedule.RepeatCount = 2
+
+			active, _, err := spec.ActiveAt(at("2020-11-03T23:00:00"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(BeTrue())
+
+			active, next, err := spec.ActiveAt(at("2020-11-04T23:00:00"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(BeFalse())
+			Expect(next.IsZero()).To(BeTrue())
+		})
+
+		It("repeats monthly on the day of the start", func() {
+			spec.Schedule.Repeat = MutingRuleRepeatMonthly
+
+			active, next, err := spec.ActiveAt(at("2020-12-01T12:00:00"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(BeFalse())
+			Expect(next).To(BeTemporally("==", at("2020-12-02T22:00:00")))
+		})
+
+		It("repeats monthly on the last day of shorter months", func() {
+			spec.Schedule.StartTime = "2021-01-31T22:00:00"
+			spec.Schedule.EndTime = "2021-01-31T23:00:00"
+			spec.Schedule.Repeat = MutingRuleRepeatMonthly
+
+			active, next, err := spec.ActiveAt(at("2021-02-15T12:00:00"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(BeFalse())
+			Expect(next).To(BeTemporally("==", at("2021-02-28T22:00:00")))
+
+			active, next, err = spec.ActiveAt(at("2021-03-01T12:00:00"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(BeFalse())
+			Expect(next).To(BeTemporally("==", at("2021-03-31T22:00:00")))
+
+			active, _, err = spec.ActiveAt(at("2021-04-30T22:30:00"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(active).To(BeTrue())
+		})
+	})
+
+	Describe("Diff", func() {
+		var remote alerts.MutingRule
+
+		BeforeEach(func() {
+			start := at("2020-11-02T22:00:00").UTC()
+			end := at("2020-11-03T02:00:00").UTC()
+
+			remote = alerts.MutingRule{
+				Name:      "maintenance",
+				Enabled:   true,
+				Condition: spec.Condition.toConditionGroup(),
+				Schedule: &alerts.MutingRuleSchedule{
+					StartTime: &start,
+					EndTime:   &end,
+					TimeZone:  "Europe/Berlin",
+				},
+			}
+		})
+
+		It("finds no differences in a matching rule", func() {
+			Expect(spec.Diff(remote)).To(BeEmpty())
+		})
+
+		It("lists the differences", func() {
+			remote.Enabled = false
+			remote.Condition.Conditions[0].Values = []string{"checkout"}
+			remote.Schedule = nil
+
+			Expect(spec.Diff(remote)).To(ConsistOf(
+				"enabled is false, expected true",
+				"condition differs",
+				"schedule is not set",
+			))
+		})
+	})
+})
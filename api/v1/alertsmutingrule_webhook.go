@@ -0,0 +1,225 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	customErrors "github.com/newrelic/newrelic-kubernetes-operator/errors"
+)
+
+// log is for logging in this package.
+var (
+	alertsmutingrulelog = logf.Log.WithName("alertsmutingrule-resource")
+)
+
+// validMutingRuleWeekdays are the days a WEEKLY schedule can repeat on
+var validMutingRuleWeekdays = []string{"MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"}
+
+// SetupWebhookWithManager - instantiates the Webhook
+func (r *AlertsMutingRule) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	k8Client = mgr.GetClient()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-nr-k8s-newrelic-com-v1-alertsmutingrule,mutating=true,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=alertsmutingrules,verbs=create;update,versions=v1,name=malertsmutingrule.kb.io,sideEffects=None
+
+var _ webhook.Defaulter = &AlertsMutingRule{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *AlertsMutingRule) Default() {
+	alertsmutingrulelog.Info("default", "name", r.Name)
+
+	if r.Status.AppliedSpec == nil {
+		alertsmutingrulelog.Info("Setting null Applied Spec to empty interface")
+		r.Status.AppliedSpec = &AlertsMutingRuleSpec{}
+	}
+
+	if r.Spec.Condition.Operator == "" {
+		r.Spec.Condition.Operator = "AND"
+	}
+
+	if r.Spec.Schedule != nil {
+		for i, day := range r.Spec.Schedule.WeeklyRepeatDays {
+			r.Spec.Schedule.WeeklyRepeatDays[i] = strings.ToUpper(day)
+		}
+	}
+
+	DefaultAccountRef(&r.Spec.AccountRef)
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-nr-k8s-newrelic-com-v1-alertsmutingrule,mutating=false,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=alertsmutingrules,versions=v1,name=valertsmutingrule.kb.io,sideEffects=None
+
+var _ webhook.Validator = &AlertsMutingRule{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *AlertsMutingRule) ValidateCreate() error {
+	alertsmutingrulelog.Info("validate create", "name", r.Name)
+
+	return r.ValidateAlertsMutingRule()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *AlertsMutingRule) ValidateUpdate(old runtime.Object) error {
+	alertsmutingrulelog.Info("validate update", "name", r.Name)
+
+	return r.ValidateAlertsMutingRule()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *AlertsMutingRule) ValidateDelete() error {
+	alertsmutingrulelog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
+
+// ValidateAlertsMutingRule - Validates create/update of AlertsMutingRule
+func (r *AlertsMutingRule) ValidateAlertsMutingRule() error {
+	collectedErrors := new(customErrors.ErrorCollector)
+
+	if err := r.CheckForAPIKeyOrSecret(); err != nil {
+		collectedErrors.Collect(err)
+	}
+
+	// the region and account of a referenced account are used when the rule does not set them
+	if r.Spec.AccountRef.Name == "" {
+		if !ValidRegion(r.Spec.Region) {
+			collectedErrors.Collect(errors.New("Invalid region set, value was: " + r.Spec.Region))
+		}
+
+		if r.Spec.AccountID == 0 {
+			collectedErrors.Collect(errors.New("account_id must be set"))
+		}
+	}
+
+	if r.Spec.Name == "" {
+		collectedErrors.Collect(errors.New("name must be set"))
+	}
+
+	if len(r.Spec.Condition.Conditions) == 0 {
+		collectedErrors.Collect(errors.New("at least one condition must be set"))
+	}
+
+	for i, condition := range r.Spec.Condition.Conditions {
+		if condition.Attribute == "" {
+			collectedErrors.Collect(fmt.Errorf("condition.conditions[%d].attribute must be set", i))
+		}
+
+		if condition.Operator == "" {
+			collectedErrors.Collect(fmt.Errorf("condition.conditions[%d].operator must be set", i))
+		}
+	}
+
+	if r.Spec.Schedule != nil {
+		for _, err := range r.Spec.Schedule.validate() {
+			collectedErrors.Collect(err)
+		}
+	}
+
+	if len(*collectedErrors) > 0 {
+		alertsmutingrulelog.Info("Errors encountered validating muting rule", "collectedErrors", collectedErrors)
+		return collectedErrors
+	}
+
+	return nil
+}
+
+func (r *AlertsMutingRule) CheckForAPIKeyOrSecret() error {
+	return CheckForAccount(r.Namespace, r.GetAccountSettings())
+}
+
+func (in AlertsMutingRuleSchedule) validate() []error {
+	var errs []error
+
+	location, err := time.LoadLocation(in.TimeZone)
+	if in.TimeZone == "" || err != nil {
+		errs = append(errs, fmt.Errorf("schedule.time_zone must be a time zone like Europe/Berlin, got %q", in.TimeZone))
+		location = time.UTC
+	}
+
+	times := map[string]time.Time{}
+
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{"start_time", in.StartTime},
+		{"end_time", in.EndTime},
+		{"end_repeat", in.EndRepeat},
+	} {
+		if field.value == "" {
+			continue
+		}
+
+		parsed, err := time.ParseInLocation(MutingRuleTimeLayout, field.value, location)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("schedule.%s must be formatted as %s, got %q", field.name, MutingRuleTimeLayout, field.value))
+			continue
+		}
+
+		times[field.name] = parsed
+	}
+
+	if in.StartTime == "" {
+		errs = append(errs, errors.New("schedule.start_time must be set"))
+	}
+
+	start, hasStart := times["start_time"]
+	end, hasEnd := times["end_time"]
+
+	if hasStart && hasEnd && !end.After(start) {
+		errs = append(errs, errors.New("schedule.end_time must be after start_time"))
+	}
+
+	switch in.Repeat {
+	case "":
+		if in.EndRepeat != "" || in.RepeatCount != 0 || len(in.WeeklyRepeatDays) > 0 {
+			errs = append(errs, errors.New("schedule.end_repeat, repeat_count and weekly_repeat_days require repeat"))
+		}
+	case MutingRuleRepeatDaily, MutingRuleRepeatWeekly, MutingRuleRepeatMonthly:
+		if in.EndTime == "" {
+			errs = append(errs, errors.New("schedule.end_time must be set for a repeating schedule"))
+		}
+
+		if in.EndRepeat != "" && in.RepeatCount != 0 {
+			errs = append(errs, errors.New("only one of schedule.end_repeat and repeat_count can be set"))
+		}
+
+		if in.RepeatCount < 0 {
+			errs = append(errs, fmt.Errorf("schedule.repeat_count must be positive, got %d", in.RepeatCount))
+		}
+
+		if len(in.WeeklyRepeatDays) > 0 && in.Repeat != MutingRuleRepeatWeekly {
+			errs = append(errs, fmt.Errorf("schedule.weekly_repeat_days can only be set with repeat %s", MutingRuleRepeatWeekly))
+		}
+
+		for _, day := range in.WeeklyRepeatDays {
+			if !validMutingRuleWeekday(day) {
+				errs = append(errs, fmt.Errorf("schedule.weekly_repeat_days must be one of %v, got %q", validMutingRuleWeekdays, day))
+			}
+		}
+	default:
+		errs = append(errs, fmt.Errorf("schedule.repeat must be %s, %s or %s, got %q",
+			MutingRuleRepeatDaily, MutingRuleRepeatWeekly, MutingRuleRepeatMonthly, in.Repeat))
+	}
+
+	return errs
+}
+
+func validMutingRuleWeekday(day string) bool {
+	for _, valid := range validMutingRuleWeekdays {
+		if strings.EqualFold(day, valid) {
+			return true
+		}
+	}
+
+	return false
+}
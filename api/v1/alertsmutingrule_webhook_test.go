@@ -0,0 +1,116 @@
+package v1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("AlertsMutingRule_webhook", func() {
+	var r AlertsMutingRule
+
+	BeforeEach(func() {
+		k8Client = testk8sClient
+		r = AlertsMutingRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "maintenance",
+				Namespace: "default",
+			},
+			Spec: AlertsMutingRuleSpec{
+				Name:    "maintenance",
+				Enabled: true,
+				Condition: AlertsMutingRuleConditionGroup{
+					Operator: "AND",
+					Conditions: []AlertsMutingRuleCondition{
+						{Attribute: "policyName", Operator: "EQUALS", Values: []string{"shop"}},
+					},
+				},
+				Schedule: &AlertsMutingRuleSchedule{
+					StartTime: "2020-11-02T22:00:00",
+					EndTime:   "2020-11-03T02:00:00",
+					TimeZone:  "Europe/Berlin",
+					Repeat:    MutingRuleRepeatWeekly,
+				},
+				APIKey:    "api-key",
+				Region:    "US",
+				AccountID: 1,
+			},
+		}
+	})
+
+	Describe("Default", func() {
+		It("defaults the condition operator and upper cases the repeat days", func() {
+			r.Spec.Condition.Operator = ""
+			r.Spec.Schedule.WeeklyRepeatDays = []string{"monday"}
+
+			r.Default()
+			Expect(r.Spec.Condition.Operator).To(Equal("AND"))
+			Expect(r.Spec.Schedule.WeeklyRepeatDays).To(Equal([]string{"MONDAY"}))
+			Expect(r.Status.AppliedSpec).To(Equal(&AlertsMutingRuleSpec{}))
+		})
+	})
+
+	Describe("ValidateCreate", func() {
+		It("accepts a valid muting rule", func() {
+			Expect(r.ValidateCreate()).To(Succeed())
+		})
+
+		It("accepts a rule without a schedule", func() {
+			r.Spec.Schedule = nil
+			Expect(r.ValidateCreate()).To(Succeed())
+		})
+
+		It("requires a condition", func() {
+			r.Spec.Condition.Conditions = nil
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("at least one condition must be set")))
+		})
+
+		It("requires an attribute on every condition", func() {
+			r.Spec.Condition.Conditions[0].Attribute = ""
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("condition.conditions[0].attribute must be set")))
+		})
+
+		It("rejects an unknown time zone", func() {
+			r.Spec.Schedule.TimeZone = "Mars/Olympus_Mons"
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("schedule.time_zone must be a time zone")))
+		})
+
+		It("rejects times with an offset", func() {
+			r.Spec.Schedule.StartTime = "2020-11-02T22:00:00+01:00"
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("schedule.start_time must be formatted as 2006-01-02T15:04:05")))
+		})
+
+		It("requires the end to follow the start", func() {
+			r.Spec.Schedule.EndTime = "2020-11-02T21:00:00"
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("schedule.end_time must be after start_time")))
+		})
+
+		It("requires an end time for a repeating schedule", func() {
+			r.Spec.Schedule.EndTime = ""
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("schedule.end_time must be set for a repeating schedule")))
+		})
+
+		It("rejects end_repeat together with repeat_count", func() {
+			r.Spec.Schedule.EndRepeat = "2020-12-01T00:00:00"
+			r.Spec.Schedule.RepeatCount = 3
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("only one of schedule.end_repeat and repeat_count can be set")))
+		})
+
+		It("rejects repeat days of a daily schedule", func() {
+			r.Spec.Schedule.Repeat = MutingRuleRepeatDaily
+			r.Spec.Schedule.WeeklyRepeatDays = []string{"MONDAY"}
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("schedule.weekly_repeat_days can only be set with repeat WEEKLY")))
+		})
+
+		It("rejects unknown repeat days", func() {
+			r.Spec.Schedule.WeeklyRepeatDays = []string{"FUNDAY"}
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring(`got "FUNDAY"`)))
+		})
+
+		It("rejects repeat settings without repeat", func() {
+			r.Spec.Schedule.Repeat = ""
+			r.Spec.Schedule.RepeatCount = 3
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("require repeat")))
+		})
+	})
+})
@@ -0,0 +1,488 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/redact"
+)
+
+const (
+	// APIKeySecretKeyName is the key holding the API key in the secrets written for inline API keys
+	APIKeySecretKeyName = "api-key"
+
+	// ManagedByLabel marks the secrets written by the operator
+	ManagedByLabel = "app.kubernetes.io/managed-by"
+	// ManagedBy is the value of the ManagedByLabel of the secrets written by the operator
+	ManagedBy = "newrelic-kubernetes-operator"
+
+	apiKeySecretPrefix = "newrelic-api-key-"
+)
+
+// APIKeyObject is a resource whose inline api_key can be replaced by a reference to a secret
+type APIKeyObject interface {
+	runtime.Object
+	metav1.Object
+	// GetAPIKey returns the inline api_key of the resource
+	GetAPIKey() string
+	// SetAPIKeySecret clears the inline api_key of the resource and references secret instead
+	SetAPIKeySecret(secret NewRelicAPIKeySecret)
+}
+
+// WriteAPIKeySecret writes apiKey to an operator managed secret in the namespace of owner and returns the
+// reference to it. Secrets are named after a fingerprint of the key, so resources using the same key share a
+// secret. Each of them is added to the owners of the secret, which is garbage collected with the last one.
+// An owner that is being created has no UID yet, its reconciler adopts the secret with AdoptAPIKeySecrets.
+func WriteAPIKeySecret(ctx context.Context, k8sClient client.Client, owner APIKeyObject, apiKey string) (NewRelicAPIKeySecret, error) {
+	secret := v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      apiKeySecretPrefix + redact.Fingerprint(apiKey),
+			Namespace: owner.GetNamespace(),
+			Labels:    map[string]string{ManagedByLabel: ManagedBy},
+		},
+		Type: v1.SecretTypeOpaque,
+		Data: map[string][]byte{APIKeySecretKeyName: []byte(apiKey)},
+	}
+
+	var ownerRefs []metav1.OwnerReference
+	if owner.GetUID() != "" {
+		ownerRef, err := apiKeyOwnerReference(owner)
+		if err != nil {
+			return NewRelicAPIKeySecret{}, err
+		}
+
+		ownerRefs = append(ownerRefs, ownerRef)
+		secret.OwnerReferences = ownerRefs
+	}
+
+	err := k8sClient.Create(ctx, &secret)
+	if kErr.IsAlreadyExists(err) {
+		err = addAPIKeySecretOwners(ctx, k8sClient, types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}, apiKey, ownerRefs)
+	}
+
+	if err != nil {
+		return NewRelicAPIKeySecret{}, err
+	}
+
+	return NewRelicAPIKeySecret{Name: secret.Name, Namespace: secret.Namespace, KeyName: APIKeySecretKeyName}, nil
+}
+
+// MoveAPIKeysToSecrets writes the inline api_key of obj, and those of the conditions of a policy, to operator
+// managed secrets and replaces them by references to the secrets. The keys are also removed from the
+// configuration last applied by kubectl. It returns whether obj was changed.
+func MoveAPIKeysToSecrets(ctx context.Context, k8sClient client.Client, obj APIKeyObject) (bool, error) {
+	moved := false
+
+	if apiKey := obj.GetAPIKey(); apiKey != "" {
+		secret, err := WriteAPIKeySecret(ctx, k8sClient, obj, apiKey)
+		if err != nil {
+			return false, err
+		}
+
+		obj.SetAPIKeySecret(secret)
+		moved = true
+	}
+
+	for _, condition := range conditionAPIKeys(obj) {
+		if *condition.apiKey == "" {
+			continue
+		}
+
+		secret, err := WriteAPIKeySecret(ctx, k8sClient, obj, *condition.apiKey)
+		if err != nil {
+			return false, err
+		}
+
+		*condition.apiKey = ""
+		*condition.apiKeySecret = secret
+		moved = true
+	}
+
+	if moved {
+		removeLastAppliedAPIKeys(obj)
+	}
+
+	return moved, nil
+}
+
+// AdoptAPIKeySecrets adds obj to the owners of the operator managed secrets referenced by apiKeySecret and the
+// conditions of a policy. The webhook writes these secrets before obj has a UID when it is created.
+func AdoptAPIKeySecrets(ctx context.Context, k8sClient client.Client, obj APIKeyObject, apiKeySecret NewRelicAPIKeySecret) error {
+	refs := []NewRelicAPIKeySecret{apiKeySecret}
+	for _, condition := range conditionAPIKeys(obj) {
+		refs = append(refs, *condition.apiKeySecret)
+	}
+
+	ownerRef, err := apiKeyOwnerReference(obj)
+	if err != nil {
+		return err
+	}
+
+	for _, ref := range refs {
+		if !strings.HasPrefix(ref.Name, apiKeySecretPrefix) || ref.Namespace != obj.GetNamespace() {
+			continue
+		}
+
+		var secret v1.Secret
+
+		err := k8sClient.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, &secret)
+		if kErr.IsNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if secret.Labels[ManagedByLabel] != ManagedBy || hasOwner(secret.OwnerReferences, ownerRef) {
+			continue
+		}
+
+		secret.OwnerReferences = append(secret.OwnerReferences, ownerRef)
+		if err := k8sClient.Update(ctx, &secret); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// apiKeyFields points at the api_key and api_key_secret of a condition in the spec of a policy
+type apiKeyFields struct {
+	apiKey       *string
+	apiKeySecret *NewRelicAPIKeySecret
+}
+
+// conditionAPIKeys returns the api_key fields of the conditions of obj, if it is a policy
+func conditionAPIKeys(obj APIKeyObject) []apiKeyFields {
+	var fields []apiKeyFields
+
+	switch policy := obj.(type) {
+	case *AlertsPolicy:
+		for i := range policy.Spec.Conditions {
+			spec := &policy.Spec.Conditions[i].Spec
+			fields = append(fields, apiKeyFields{apiKey: &spec.APIKey, apiKeySecret: &spec.APIKeySecret})
+		}
+	case *Policy:
+		for i := range policy.Spec.Conditions {
+			spec := &policy.Spec.Conditions[i].Spec
+			fields = append(fields, apiKeyFields{apiKey: &spec.APIKey, apiKeySecret: &spec.APIKeySecret})
+		}
+	}
+
+	return fields
+}
+
+// removeLastAppliedAPIKeys removes every api_key from the configuration kubectl stores in the annotations of obj.
+// A later kubectl apply still sets the key from the manifest, and it is moved to a secret again.
+func removeLastAppliedAPIKeys(obj metav1.Object) {
+	annotations := obj.GetAnnotations()
+
+	lastApplied, ok := annotations[v1.LastAppliedConfigAnnotation]
+	if !ok {
+		return
+	}
+
+	var config interface{}
+	if err := json.Unmarshal([]byte(lastApplied), &config); err != nil {
+		// the annotation can't be rewritten, it must not keep the key either
+		delete(annotations, v1.LastAppliedConfigAnnotation)
+		obj.SetAnnotations(annotations)
+
+		return
+	}
+
+	removeAPIKeys(config)
+
+	rewritten, err := json.Marshal(config)
+	if err != nil {
+		delete(annotations, v1.LastAppliedConfigAnnotation)
+	} else {
+		annotations[v1.LastAppliedConfigAnnotation] = string(rewritten) + "\n"
+	}
+
+	obj.SetAnnotations(annotations)
+}
+
+// removeAPIKeys deletes the api_key fields from the objects nested in value
+func removeAPIKeys(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		delete(v, "api_key")
+
+		for _, nested := range v {
+			removeAPIKeys(nested)
+		}
+	case []interface{}:
+		for _, nested := range v {
+			removeAPIKeys(nested)
+		}
+	}
+}
+
+// addAPIKeySecretOwners adds owners to the owners of the existing secret, which must hold apiKey
+func addAPIKeySecretOwners(ctx context.Context, k8sClient client.Client, key types.NamespacedName, apiKey string, owners []metav1.OwnerReference) error {
+	var existing v1.Secret
+	if err := k8sClient.Get(ctx, key, &existing); err != nil {
+		return err
+	}
+
+	if !bytes.Equal(existing.Data[APIKeySecretKeyName], []byte(apiKey)) {
+		return fmt.Errorf("secret %s exists but does not hold the api key", key)
+	}
+
+	updated := false
+
+	for _, owner := range owners {
+		if !hasOwner(existing.OwnerReferences, owner) {
+			existing.OwnerReferences = append(existing.OwnerReferences, owner)
+			updated = true
+		}
+	}
+
+	if !updated {
+		return nil
+	}
+
+	return k8sClient.Update(ctx, &existing)
+}
+
+// hasOwner returns whether refs contain owner
+func hasOwner(refs []metav1.OwnerReference, owner metav1.OwnerReference) bool {
+	for _, ref := range refs {
+		if ref.UID == owner.UID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// apiKeyOwnerReference returns the owner reference to obj set on the secret written for its api_key
+func apiKeyOwnerReference(obj APIKeyObject) (metav1.OwnerReference, error) {
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		return metav1.OwnerReference{}, err
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return metav1.OwnerReference{}, err
+	}
+
+	return metav1.OwnerReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       obj.GetName(),
+		UID:        obj.GetUID(),
+	}, nil
+}
+
+// GetAPIKey returns the inline api_key of the AlertsPolicy
+func (in *AlertsPolicy) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the AlertsPolicy by a reference to secret
+func (in *AlertsPolicy) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
+
+// GetAPIKey returns the inline api_key of the AlertsNrqlCondition
+func (in *AlertsNrqlCondition) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the AlertsNrqlCondition by a reference to secret
+func (in *AlertsNrqlCondition) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
+
+// GetAPIKey returns the inline api_key of the AlertsAPMCondition
+func (in *AlertsAPMCondition) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the AlertsAPMCondition by a reference to secret
+func (in *AlertsAPMCondition) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
+
+// GetAPIKey returns the inline api_key of the AlertsInfraCondition
+func (in *AlertsInfraCondition) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the AlertsInfraCondition by a reference to secret
+func (in *AlertsInfraCondition) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
+
+// GetAPIKey returns the inline api_key of the AlertsExternalServiceCondition
+func (in *AlertsExternalServiceCondition) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the AlertsExternalServiceCondition by a reference to secret
+func (in *AlertsExternalServiceCondition) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
+
+// GetAPIKey returns the inline api_key of the AlertsSyntheticsCondition
+func (in *AlertsSyntheticsCondition) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the AlertsSyntheticsCondition by a reference to secret
+func (in *AlertsSyntheticsCondition) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
+
+// GetAPIKey returns the inline api_key of the AlertsChannel
+func (in *AlertsChannel) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the AlertsChannel by a reference to secret
+func (in *AlertsChannel) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
+
+// GetAPIKey returns the inline api_key of the SyntheticsMonitor
+func (in *SyntheticsMonitor) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the SyntheticsMonitor by a reference to secret
+func (in *SyntheticsMonitor) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
+
+// GetAPIKey returns the inline api_key of the Dashboard
+func (in *Dashboard) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the Dashboard by a reference to secret
+func (in *Dashboard) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
+
+// GetAPIKey returns the inline api_key of the AlertsMutingRule
+func (in *AlertsMutingRule) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the AlertsMutingRule by a reference to secret
+func (in *AlertsMutingRule) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
+
+// GetAPIKey returns the inline api_key of the NotificationDestination
+func (in *NotificationDestination) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the NotificationDestination by a reference to secret
+func (in *NotificationDestination) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
+
+// GetAPIKey returns the inline api_key of the NotificationChannel
+func (in *NotificationChannel) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the NotificationChannel by a reference to secret
+func (in *NotificationChannel) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
+
+// GetAPIKey returns the inline api_key of the Workflow
+func (in *Workflow) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the Workflow by a reference to secret
+func (in *Workflow) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
+
+// GetAPIKey returns the inline api_key of the ServiceLevel
+func (in *ServiceLevel) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the ServiceLevel by a reference to secret
+func (in *ServiceLevel) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
+
+// GetAPIKey returns the inline api_key of the BurnRateAlert
+func (in *BurnRateAlert) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the BurnRateAlert by a reference to secret
+func (in *BurnRateAlert) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
+
+// GetAPIKey returns the inline api_key of the Policy
+func (in *Policy) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the Policy by a reference to secret
+func (in *Policy) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
+
+// GetAPIKey returns the inline api_key of the NrqlAlertCondition
+func (in *NrqlAlertCondition) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the NrqlAlertCondition by a reference to secret
+func (in *NrqlAlertCondition) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
+
+// GetAPIKey returns the inline api_key of the ApmAlertCondition
+func (in *ApmAlertCondition) GetAPIKey() string {
+	return in.Spec.APIKey
+}
+
+// SetAPIKeySecret replaces the inline api_key of the ApmAlertCondition by a reference to secret
+func (in *ApmAlertCondition) SetAPIKeySecret(secret NewRelicAPIKeySecret) {
+	in.Spec.APIKey = ""
+	in.Spec.APIKeySecret = secret
+}
@@ -0,0 +1,124 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// apiKeyLog is for emitting logs of the api_key webhook
+var apiKeyLog = logf.Log.WithName("apikey-resource")
+
+const apiKeyWebhookPath = "/mutate-nr-k8s-newrelic-com-v1-apikey"
+
+// SetupAPIKeyWebhookWithManager registers the webhook moving the inline api_key of every resource to a secret
+func SetupAPIKeyWebhookWithManager(mgr ctrl.Manager) error {
+	mgr.GetWebhookServer().Register(apiKeyWebhookPath, &webhook.Admission{
+		Handler: &APIKeySecretWriter{Client: mgr.GetClient(), Scheme: mgr.GetScheme()},
+	})
+
+	return nil
+}
+
+// +kubebuilder:webhook:path=/mutate-nr-k8s-newrelic-com-v1-apikey,mutating=true,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=alertsapmconditions;alertschannels;alertsexternalserviceconditions;alertsinfraconditions;alertsmutingrules;alertsnrqlconditions;alertspolicies;alertssyntheticsconditions;apmalertconditions;burnratealerts;dashboards;notificationchannels;notificationdestinations;nrqlalertconditions;policies;servicelevels;syntheticsmonitors;workflows,verbs=create;update,versions=v1,name=mapikey.kb.io,sideEffects=NoneOnDryRun
+
+// APIKeySecretWriter moves the inline api_key of admitted resources to operator managed secrets, so the raw key
+// is never stored with the resource. Dry run requests are admitted unchanged, and so are resources the
+// validating webhooks are going to reject, so no secret is written for a resource that is never stored.
+type APIKeySecretWriter struct {
+	Client client.Client
+	Scheme *runtime.Scheme
+}
+
+var _ admission.Handler = &APIKeySecretWriter{}
+
+// Handle implements admission.Handler
+func (w *APIKeySecretWriter) Handle(ctx context.Context, req admission.Request) admission.Response {
+	obj, err := w.decode(req.Kind, req.Object.Raw)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if req.DryRun != nil && *req.DryRun {
+		return admission.Allowed("dry run")
+	}
+
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(req.Namespace)
+	}
+
+	if err := w.validate(req, obj); err != nil {
+		apiKeyLog.Info("not moving api_key of invalid resource", "name", obj.GetName(), "reason", err.Error())
+		return admission.Allowed("invalid resource")
+	}
+
+	moved, err := MoveAPIKeysToSecrets(ctx, w.Client, obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to move api_key to a secret: %w", err))
+	}
+
+	if !moved {
+		return admission.Allowed("")
+	}
+
+	marshaled, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
+
+// decode returns the resource of the given kind encoded in raw
+func (w *APIKeySecretWriter) decode(kind metav1.GroupVersionKind, raw []byte) (APIKeyObject, error) {
+	obj, err := w.Scheme.New(schema.GroupVersionKind{Group: kind.Group, Version: kind.Version, Kind: kind.Kind})
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeyObj, ok := obj.(APIKeyObject)
+	if !ok {
+		return nil, fmt.Errorf("%s has no api_key", kind.Kind)
+	}
+
+	if err := json.Unmarshal(raw, apiKeyObj); err != nil {
+		return nil, err
+	}
+
+	return apiKeyObj, nil
+}
+
+// validate runs the defaulting and validation of the resource webhooks on a copy of obj
+func (w *APIKeySecretWriter) validate(req admission.Request, obj APIKeyObject) error {
+	defaulted := obj.DeepCopyObject()
+	if defaulter, ok := defaulted.(webhook.Defaulter); ok {
+		defaulter.Default()
+	}
+
+	validator, ok := defaulted.(webhook.Validator)
+	if !ok {
+		return nil
+	}
+
+	if req.Operation != v1beta1.Update {
+		return validator.ValidateCreate()
+	}
+
+	old, err := w.decode(req.Kind, req.OldObject.Raw)
+	if err != nil {
+		return err
+	}
+
+	return validator.ValidateUpdate(old)
+}
@@ -24,12 +24,15 @@ type APMSpecificSpec struct {
 
 // ApmAlertConditionStatus defines the observed state of ApmAlertCondition
 type ApmAlertConditionStatus struct {
-	AppliedSpec *ApmAlertConditionSpec `json:"applied_spec"`
+	AppliedSpec *ApmAlertConditionSpec `json:"applied_spec,omitempty"`
 	ConditionID int                    `json:"condition_id"`
+	Conditions  []Condition            `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Created",type="boolean",JSONPath=".status.created"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
 
 // ApmAlertCondition is the Schema for the apmalertconditions API
 type ApmAlertCondition struct {
@@ -53,6 +56,16 @@ func init() {
 	SchemeBuilder.Register(&ApmAlertCondition{}, &ApmAlertConditionList{})
 }
 
+// GetConditions returns the status conditions of the ApmAlertCondition
+func (in *ApmAlertCondition) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the ApmAlertCondition
+func (in *ApmAlertCondition) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
 func (in ApmAlertConditionSpec) APICondition() alerts.Condition {
 	jsonString, _ := json.Marshal(in)
 	var APICondition alerts.Condition
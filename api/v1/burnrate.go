@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+)
+
+// Limits of New Relic on the signal and terms of the generated NRQL conditions
+const (
+	// maxBurnRateShortWindowMinutes is the longest aggregation window of a NRQL condition
+	maxBurnRateShortWindowMinutes = 360
+	// maxBurnRateWindowMinutes is the longest threshold duration of a NRQL condition
+	maxBurnRateWindowMinutes = 1440
+)
+
+// BurnRate alerts when the error budget of an objective is spent Rate times faster than it can be
+// sustained over the time window of the objective
+type BurnRate struct {
+	// Name is appended to the name of the generated condition, e.g. fast-burn
+	Name string `json:"name"`
+	// Rate is the multiple of the error rate allowed by the objective that opens a violation, e.g. "14.4"
+	Rate string `json:"rate"`
+	// WindowMinutes is the length of the long window the error rate has to stay above the burn rate for
+	WindowMinutes int `json:"window_minutes"`
+	// ShortWindowMinutes is the length of the windows the error rate is measured in, WindowMinutes must
+	// be a multiple of it. It defaults to about a twelfth of WindowMinutes.
+	ShortWindowMinutes int `json:"short_window_minutes,omitempty"`
+	// +kubebuilder:validation:Enum=CRITICAL;WARNING
+	Priority alerts.NrqlConditionPriority `json:"priority,omitempty"`
+}
+
+// GetShortWindowMinutes returns ShortWindowMinutes, or the longest window of at most a twelfth of
+// WindowMinutes that WindowMinutes is a multiple of
+func (in BurnRate) GetShortWindowMinutes() int {
+	if in.ShortWindowMinutes > 0 {
+		return in.ShortWindowMinutes
+	}
+
+	for short := in.WindowMinutes / 12; short > 1; short-- {
+		if in.WindowMinutes%short == 0 {
+			return short
+		}
+	}
+
+	return 1
+}
+
+// defaultBudgetSpent are the shares of the error budget the default burn rates alert on, 2% within an
+// hour or 5% within six hours
+var defaultBudgetSpent = []struct {
+	burnRate BurnRate
+	share    float64
+}{
+	{BurnRate{Name: "fast-burn", WindowMinutes: 60, Priority: alerts.NrqlConditionPriorities.Critical}, 0.02},
+	{BurnRate{Name: "slow-burn", WindowMinutes: 360, Priority: alerts.NrqlConditionPriorities.Warning}, 0.05},
+}
+
+// DefaultBurnRates alert when 2% of the error budget of a timeWindowDays objective are spent within an
+// hour or 5% within six hours, e.g. at rates of 14.4 and 6 for a 30 day objective
+func DefaultBurnRates(timeWindowDays int) []BurnRate {
+	burnRates := []BurnRate{}
+
+	for _, budget := range defaultBudgetSpent {
+		burnRate := budget.burnRate
+		rate := budget.share * float64(timeWindowDays*24*60) / float64(burnRate.WindowMinutes)
+		burnRate.Rate = strconv.FormatFloat(math.Round(rate*10000)/10000, 'f', -1, 64)
+		burnRates = append(burnRates, burnRate)
+	}
+
+	return burnRates
+}
+
+// BurnRateConditionSpec returns a static NRQL condition that opens a violation when the error
+// percentage returned by query exceeds the error percentage allowed by target, a percentage of good
+// events, multiplied by the burn rate. The long window is paired with the short window: the error
+// percentage is measured in short windows and has to exceed the threshold in all of them throughout
+// the long window, so the violation also closes as soon as the latest short window recovers. Only the
+// fields describing the alert are set, the caller adds the policy and account.
+func BurnRateConditionSpec(name string, query string, target float64, burnRate BurnRate) AlertsNrqlConditionSpec {
+	rate, _ := strconv.ParseFloat(burnRate.Rate, 64)
+	threshold := math.Round((100-target)*rate*10000) / 10000
+
+	priority := burnRate.Priority
+	if priority == "" {
+		priority = alerts.NrqlConditionPriorities.Critical
+	}
+
+	shortWindow := burnRate.GetShortWindowMinutes() * 60
+	signal := &AlertsNrqlConditionSignal{
+		AggregationWindow: &shortWindow,
+		FillOption:        &alerts.AlertsFillOptionTypes.NONE,
+	}
+
+	spec := AlertsNrqlConditionSpec{}
+	spec.Name = name + " " + burnRate.Name
+	spec.Enabled = true
+	spec.ConditionType = alerts.NrqlConditionTypes.Static
+	spec.Nrql = alerts.NrqlConditionQuery{Query: query}
+	spec.Signal = signal
+	spec.Terms = []AlertsNrqlConditionTerm{
+		{
+			Operator:             alerts.AlertsNRQLConditionTermsOperatorTypes.ABOVE,
+			Priority:             priority,
+			Threshold:            strconv.FormatFloat(threshold, 'f', -1, 64),
+			ThresholdDuration:    burnRate.WindowMinutes * 60,
+			ThresholdOccurrences: alerts.ThresholdOccurrences.All,
+		},
+	}
+
+	return spec
+}
+
+// CheckBurnRates returns the invalid attributes of the burn rates of generated alert conditions
+func CheckBurnRates(burnRates []BurnRate) InvalidAttributeSlice {
+	invalidAttributes := InvalidAttributeSlice{}
+	names := map[string]bool{}
+
+	for _, burnRate := range burnRates {
+		// the names tell the generated conditions apart and have to be unique
+		if burnRate.Name == "" || names[burnRate.Name] {
+			invalidAttributes = append(invalidAttributes, invalidAttribute{attribute: "burn_rates.name", value: burnRate.Name})
+		}
+		names[burnRate.Name] = true
+
+		if rate, err := strconv.ParseFloat(burnRate.Rate, 64); err != nil || rate <= 0 {
+			invalidAttributes = append(invalidAttributes, invalidAttribute{attribute: "burn_rates.rate", value: burnRate.Rate})
+		}
+
+		if burnRate.WindowMinutes < 1 || burnRate.WindowMinutes > maxBurnRateWindowMinutes {
+			invalidAttributes = append(invalidAttributes, invalidAttribute{attribute: "burn_rates.window_minutes", value: strconv.Itoa(burnRate.WindowMinutes)})
+			continue
+		}
+
+		// the short window is the aggregation window, the long window the threshold duration of the condition
+		shortWindow := burnRate.GetShortWindowMinutes()
+		if burnRate.ShortWindowMinutes < 0 || shortWindow > burnRate.WindowMinutes || shortWindow > maxBurnRateShortWindowMinutes || burnRate.WindowMinutes%shortWindow != 0 {
+			invalidAttributes = append(invalidAttributes, invalidAttribute{attribute: "burn_rates.short_window_minutes", value: strconv.Itoa(burnRate.ShortWindowMinutes)})
+		}
+	}
+
+	return invalidAttributes
+}
@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BurnRateAlertSpec defines the desired state of BurnRateAlert
+type BurnRateAlertSpec struct {
+	// Name is the prefix of the names of the generated conditions
+	Name string `json:"name"`
+	// AlertsPolicyRef is the name of the AlertsPolicy in the same namespace the conditions are added to
+	AlertsPolicyRef string              `json:"alerts_policy_ref"`
+	Objective       BurnRateObjective   `json:"objective"`
+	Events          BurnRateAlertEvents `json:"events"`
+	// BurnRates are the conditions generated for the objective, DefaultBurnRates are used when none are set
+	BurnRates    []BurnRate               `json:"burn_rates,omitempty"`
+	APIKey       string                   `json:"api_key,omitempty"`
+	APIKeySecret NewRelicAPIKeySecret     `json:"api_key_secret,omitempty"`
+	AccountRef   NewRelicAccountReference `json:"account_ref,omitempty"`
+	Region       string                   `json:"region,omitempty"`
+	AccountID    int                      `json:"account_id,omitempty"`
+}
+
+// BurnRateObjective is the percentage of good events aimed for over a time window
+type BurnRateObjective struct {
+	// Target is the percentage of good events, e.g. "99.5"
+	Target         string `json:"target"`
+	TimeWindowDays int    `json:"time_window_days"`
+}
+
+// BurnRateAlertEvents are the events the error rate is calculated from
+type BurnRateAlertEvents struct {
+	ValidEvents ServiceLevelEventsQuery `json:"valid_events"`
+	GoodEvents  ServiceLevelEventsQuery `json:"good_events"`
+}
+
+// BurnRateAlertStatus defines the observed state of BurnRateAlert
+type BurnRateAlertStatus struct {
+	AppliedSpec *BurnRateAlertSpec `json:"applied_spec,omitempty"`
+	// AlertsPolicyID is the New Relic policy the conditions have been added to
+	AlertsPolicyID string      `json:"alerts_policy_id,omitempty"`
+	Conditions     []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Policy ID",type="string",JSONPath=".status.alerts_policy_id"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+
+// BurnRateAlert is the Schema for the burnratealerts API
+type BurnRateAlert struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BurnRateAlertSpec   `json:"spec,omitempty"`
+	Status BurnRateAlertStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BurnRateAlertList contains a list of BurnRateAlert
+type BurnRateAlertList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BurnRateAlert `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BurnRateAlert{}, &BurnRateAlertList{})
+}
+
+// GetConditions returns the status conditions of the BurnRateAlert
+func (in *BurnRateAlert) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the BurnRateAlert
+func (in *BurnRateAlert) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetBurnRates returns the burn rates of the generated alert conditions, the default burn rates follow
+// the time window of the objective
+func (in BurnRateAlertSpec) GetBurnRates() []BurnRate {
+	if len(in.BurnRates) == 0 {
+		return DefaultBurnRates(in.Objective.TimeWindowDays)
+	}
+
+	return in.BurnRates
+}
+
+// Query returns the NRQL query returning the percentage of valid events that are not good events.
+// Both event types are queried at once when they differ.
+func (in BurnRateAlertSpec) Query() string {
+	from := in.Events.ValidEvents.From
+	if in.Events.GoodEvents.From != from {
+		from += ", " + in.Events.GoodEvents.From
+	}
+
+	return fmt.Sprintf("FROM %s SELECT 100 - clamp_max(filter(count(*), WHERE %s) / filter(count(*), WHERE %s) * 100, 100)",
+		from, in.Events.GoodEvents.eventFilter(), in.Events.ValidEvents.eventFilter())
+}
+
+// eventFilter returns the WHERE clause selecting the events of the query among all queried event types
+func (in ServiceLevelEventsQuery) eventFilter() string {
+	conditions := []string{fmt.Sprintf("eventType() = '%s'", in.From)}
+	if in.Where != "" {
+		conditions = append(conditions, "("+in.Where+")")
+	}
+
+	return strings.Join(conditions, " AND ")
+}
+
+// BurnRateConditionSpecs returns the AlertsNrqlConditions alerting on the error budget of the objective,
+// keyed by the name of their burn rate
+func (in BurnRateAlertSpec) BurnRateConditionSpecs() map[string]AlertsNrqlConditionSpec {
+	specs := map[string]AlertsNrqlConditionSpec{}
+
+	target, _ := strconv.ParseFloat(in.Objective.Target, 64)
+	query := in.Query()
+
+	for _, burnRate := range in.GetBurnRates() {
+		specs[burnRate.Name] = BurnRateConditionSpec(in.Name, query, target, burnRate)
+	}
+
+	return specs
+}
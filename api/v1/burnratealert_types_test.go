@@ -0,0 +1,69 @@
+package v1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BurnRateAlertSpec", func() {
+	var spec BurnRateAlertSpec
+
+	BeforeEach(func() {
+		spec = BurnRateAlertSpec{
+			Name:            "checkout errors",
+			AlertsPolicyRef: "checkout",
+			Objective:       BurnRateObjective{Target: "99.9", TimeWindowDays: 30},
+			Events: BurnRateAlertEvents{
+				ValidEvents: ServiceLevelEventsQuery{From: "Transaction", Where: "appName = 'checkout'"},
+				GoodEvents:  ServiceLevelEventsQuery{From: "Transaction", Where: "appName = 'checkout' AND error IS NULL"},
+			},
+		}
+	})
+
+	Describe("Query", func() {
+		It("filters the good and valid events of a single event type", func() {
+			Expect(spec.Query()).To(Equal("FROM Transaction SELECT 100 - clamp_max(" +
+				"filter(count(*), WHERE eventType() = 'Transaction' AND (appName = 'checkout' AND error IS NULL)) / " +
+				"filter(count(*), WHERE eventType() = 'Transaction' AND (appName = 'checkout')) * 100, 100)"))
+		})
+
+		It("queries both event types when they differ", func() {
+			spec.Events.GoodEvents = ServiceLevelEventsQuery{From: "PageView"}
+			Expect(spec.Query()).To(HavePrefix("FROM Transaction, PageView SELECT"))
+			Expect(spec.Query()).To(ContainSubstring("filter(count(*), WHERE eventType() = 'PageView') /"))
+		})
+	})
+
+	Describe("BurnRateConditionSpecs", func() {
+		It("generates a fast-burn and a slow-burn condition by default", func() {
+			specs := spec.BurnRateConditionSpecs()
+
+			Expect(specs).To(HaveLen(2))
+			Expect(specs["fast-burn"].Name).To(Equal("checkout errors fast-burn"))
+			Expect(specs["fast-burn"].Terms[0].Threshold).To(Equal("1.44"))
+			Expect(specs["slow-burn"].Name).To(Equal("checkout errors slow-burn"))
+			Expect(specs["slow-burn"].Terms[0].Threshold).To(Equal("0.6"))
+			Expect(specs["slow-burn"].Nrql.Query).To(Equal(spec.Query()))
+		})
+
+		It("follows a changed objective", func() {
+			spec.Objective.Target = "99"
+			Expect(spec.BurnRateConditionSpecs()["fast-burn"].Terms[0].Threshold).To(Equal("14.4"))
+		})
+
+		It("scales the default burn rates to the time window of the objective", func() {
+			spec.Objective.TimeWindowDays = 7
+
+			specs := spec.BurnRateConditionSpecs()
+			Expect(specs["fast-burn"].Terms[0].Threshold).To(Equal("0.336"))
+			Expect(specs["slow-burn"].Terms[0].Threshold).To(Equal("0.14"))
+		})
+
+		It("keeps configured burn rates when the time window changes", func() {
+			spec.BurnRates = []BurnRate{{Name: "page", Rate: "10", WindowMinutes: 5}}
+			spec.Objective.TimeWindowDays = 7
+
+			Expect(spec.BurnRateConditionSpecs()["page"].Terms[0].Threshold).To(Equal("1"))
+		})
+	})
+})
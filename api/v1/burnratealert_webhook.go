@@ -0,0 +1,130 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	customErrors "github.com/newrelic/newrelic-kubernetes-operator/errors"
+)
+
+// log is for logging in this package.
+var (
+	burnratealertlog = logf.Log.WithName("burnratealert-resource")
+)
+
+// SetupWebhookWithManager - instantiates the Webhook
+func (r *BurnRateAlert) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	k8Client = mgr.GetClient()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-nr-k8s-newrelic-com-v1-burnratealert,mutating=true,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=burnratealerts,verbs=create;update,versions=v1,name=mburnratealert.kb.io,sideEffects=None
+
+var _ webhook.Defaulter = &BurnRateAlert{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *BurnRateAlert) Default() {
+	burnratealertlog.Info("default", "name", r.Name)
+
+	if r.Status.AppliedSpec == nil {
+		burnratealertlog.Info("Setting null Applied Spec to empty interface")
+		r.Status.AppliedSpec = &BurnRateAlertSpec{}
+	}
+
+	DefaultAccountRef(&r.Spec.AccountRef)
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-nr-k8s-newrelic-com-v1-burnratealert,mutating=false,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=burnratealerts,versions=v1,name=vburnratealert.kb.io,sideEffects=None
+
+var _ webhook.Validator = &BurnRateAlert{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *BurnRateAlert) ValidateCreate() error {
+	burnratealertlog.Info("validate create", "name", r.Name)
+
+	return r.ValidateBurnRateAlert()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *BurnRateAlert) ValidateUpdate(old runtime.Object) error {
+	burnratealertlog.Info("validate update", "name", r.Name)
+
+	return r.ValidateBurnRateAlert()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *BurnRateAlert) ValidateDelete() error {
+	burnratealertlog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
+
+// ValidateBurnRateAlert - Validates create/update of BurnRateAlert
+func (r *BurnRateAlert) ValidateBurnRateAlert() error {
+	collectedErrors := new(customErrors.ErrorCollector)
+
+	if err := r.CheckForAPIKeyOrSecret(); err != nil {
+		collectedErrors.Collect(err)
+	}
+
+	// the region and account of a referenced account are used when the alert does not set them
+	if r.Spec.AccountRef.Name == "" {
+		if !ValidRegion(r.Spec.Region) {
+			collectedErrors.Collect(errors.New("Invalid region set, value was: " + r.Spec.Region))
+		}
+
+		if r.Spec.AccountID == 0 {
+			collectedErrors.Collect(errors.New("account_id must be set"))
+		}
+	}
+
+	if r.Spec.Name == "" {
+		collectedErrors.Collect(errors.New("name must be set"))
+	}
+
+	if r.Spec.AlertsPolicyRef == "" {
+		collectedErrors.Collect(errors.New("alerts_policy_ref must be set"))
+	}
+
+	if target, err := strconv.ParseFloat(r.Spec.Objective.Target, 64); err != nil || target <= 0 || target >= 100 {
+		collectedErrors.Collect(fmt.Errorf("objective.target must be a percentage between 0 and 100, value was: %s", r.Spec.Objective.Target))
+	}
+
+	if r.Spec.Objective.TimeWindowDays < 1 {
+		collectedErrors.Collect(errors.New("objective.time_window_days must be at least 1"))
+	}
+
+	if r.Spec.Events.ValidEvents.From == "" || r.Spec.Events.GoodEvents.From == "" {
+		collectedErrors.Collect(errors.New("events.valid_events.from and events.good_events.from must be set"))
+	}
+
+	if invalidAttributes := CheckBurnRates(r.Spec.BurnRates); len(invalidAttributes) > 0 {
+		collectedErrors.Collect(errors.New("error with invalid attributes: \n" + invalidAttributes.errorString()))
+	}
+
+	// a burn rate measured over the whole time window of the objective can't alert before the budget is spent
+	for _, burnRate := range r.Spec.GetBurnRates() {
+		if r.Spec.Objective.TimeWindowDays > 0 && burnRate.WindowMinutes >= r.Spec.Objective.TimeWindowDays*24*60 {
+			collectedErrors.Collect(fmt.Errorf("burn rate %s must use a window shorter than the time window of the objective", burnRate.Name))
+		}
+	}
+
+	if len(*collectedErrors) > 0 {
+		burnratealertlog.Info("Errors encountered validating burn rate alert", "collectedErrors", collectedErrors)
+		return collectedErrors
+	}
+
+	return nil
+}
+
+func (r *BurnRateAlert) CheckForAPIKeyOrSecret() error {
+	return CheckForAccount(r.Namespace, r.GetAccountSettings())
+}
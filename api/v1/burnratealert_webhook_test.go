@@ -0,0 +1,75 @@
+package v1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("BurnRateAlert_webhook", func() {
+	var r BurnRateAlert
+
+	BeforeEach(func() {
+		k8Client = testk8sClient
+		r = BurnRateAlert{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "checkout-errors",
+				Namespace: "default",
+			},
+			Spec: BurnRateAlertSpec{
+				Name:            "checkout errors",
+				AlertsPolicyRef: "checkout",
+				Objective:       BurnRateObjective{Target: "99.9", TimeWindowDays: 30},
+				Events: BurnRateAlertEvents{
+					ValidEvents: ServiceLevelEventsQuery{From: "Transaction"},
+					GoodEvents:  ServiceLevelEventsQuery{From: "Transaction", Where: "error IS NULL"},
+				},
+				APIKey:    "api-key",
+				Region:    "US",
+				AccountID: 1,
+			},
+		}
+	})
+
+	Describe("Default", func() {
+		It("sets an empty applied spec", func() {
+			r.Default()
+			Expect(r.Status.AppliedSpec).To(Equal(&BurnRateAlertSpec{}))
+		})
+	})
+
+	Describe("ValidateCreate", func() {
+		It("accepts a valid burn rate alert", func() {
+			Expect(r.ValidateCreate()).To(Succeed())
+		})
+
+		It("requires a policy", func() {
+			r.Spec.AlertsPolicyRef = ""
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("alerts_policy_ref must be set")))
+		})
+
+		It("rejects a target that is not a percentage", func() {
+			r.Spec.Objective.Target = "0"
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("objective.target must be a percentage")))
+		})
+
+		It("requires the good events", func() {
+			r.Spec.Events.GoodEvents.From = ""
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("events.valid_events.from and events.good_events.from must be set")))
+		})
+
+		It("rejects a burn rate window longer than the objective", func() {
+			r.Spec.Objective.TimeWindowDays = 1
+			r.Spec.BurnRates = []BurnRate{{Name: "daily", Rate: "1", WindowMinutes: 1440}}
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("burn rate daily must use a window shorter than the time window of the objective")))
+		})
+
+		It("rejects duplicate burn rates", func() {
+			r.Spec.BurnRates = []BurnRate{
+				{Name: "page", Rate: "10", WindowMinutes: 5},
+				{Name: "page", Rate: "2", WindowMinutes: 60},
+			}
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("burn_rates.name")))
+		})
+	})
+})
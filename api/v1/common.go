@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"context"
 	"errors"
 	"hash"
 
@@ -64,3 +65,30 @@ func CheckForAPIKeyOrSecret(apiKey string, secret NewRelicAPIKeySecret) error {
 
 	return errors.New("either api_key or api_key_secret must be set")
 }
+
+//DefaultAccountRef - defaults the kind of an account_ref that only names an account to NewRelicAccount
+func DefaultAccountRef(ref *NewRelicAccountReference) {
+	if ref.Name != "" && ref.Kind == "" {
+		ref.Kind = NewRelicAccountKind
+	}
+}
+
+//CheckForAccount - returns error if neither an account_ref nor an API KEY or k8 secret is passed in,
+//or if the referenced account does not exist
+func CheckForAccount(namespace string, settings AccountSettings) error {
+	if settings.AccountRef == (NewRelicAccountReference{}) {
+		return CheckForAPIKeyOrSecret(settings.APIKey, settings.APIKeySecret)
+	}
+
+	if settings.APIKey != "" || settings.APIKeySecret != (NewRelicAPIKeySecret{}) {
+		return errors.New("account_ref cannot be combined with api_key or api_key_secret")
+	}
+
+	if settings.AccountRef.Name == "" {
+		return errors.New("account_ref.name must be set")
+	}
+
+	_, err := GetAccount(context.Background(), k8Client, namespace, settings.AccountRef)
+
+	return err
+}
@@ -0,0 +1,209 @@
+package v1
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Condition types maintained on every New Relic resource managed by the operator.
+const (
+	// ConditionReady is true when the resource exists in New Relic and matches the spec.
+	ConditionReady = "Ready"
+	// ConditionSynced is true when the last reconciliation with the New Relic API succeeded.
+	ConditionSynced = "Synced"
+	// ConditionError is true when the last reconciliation failed, its message holds the error.
+	ConditionError = "Error"
+	// ConditionDrifted is true when the resource in New Relic no longer matches the applied spec,
+	// its message lists the differences. It is only maintained when a resync interval is configured.
+	ConditionDrifted = "Drifted"
+	// ConditionAuthenticated is only maintained on NewRelicAccount and ClusterNewRelicAccount resources,
+	// it is true when the New Relic API accepted the API key of the account.
+	ConditionAuthenticated = "Authenticated"
+)
+
+// Reasons set on the conditions above.
+const (
+	ReasonReconcileSuccess  = "ReconcileSuccess"
+	ReasonCredentialsError  = "CredentialsError"
+	ReasonCreateFailed      = "CreateFailed"
+	ReasonUpdateFailed      = "UpdateFailed"
+	ReasonDeleteFailed      = "DeleteFailed"
+	ReasonDriftDetected     = "DriftDetected"
+	ReasonInSync            = "InSync"
+	ReasonAuthenticated     = "Authenticated"
+	ReasonSecretNotFound    = "SecretNotFound"
+	ReasonSecretKeyNotFound = "SecretKeyNotFound"
+	// ReasonAlertsPolicyNotFound is used when a referenced AlertsPolicy does not exist or has no ID yet
+	ReasonAlertsPolicyNotFound = "AlertsPolicyNotFound"
+	// ReasonAlertsChannelNotFound is used when a referenced AlertsChannel does not exist or has no ID yet
+	ReasonAlertsChannelNotFound = "AlertsChannelNotFound"
+	// ReasonNotificationDestinationNotFound is used when a referenced NotificationDestination does not exist or has no ID yet
+	ReasonNotificationDestinationNotFound = "NotificationDestinationNotFound"
+	// ReasonNotificationChannelNotFound is used when a referenced NotificationChannel does not exist or has no ID yet
+	ReasonNotificationChannelNotFound = "NotificationChannelNotFound"
+)
+
+// Condition describes one aspect of the current state of a resource.
+// It mirrors metav1.Condition, which is not available in the apimachinery
+// version the operator is built against.
+type Condition struct {
+	Type               string             `json:"type"`
+	Status             v1.ConditionStatus `json:"status"`
+	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
+	LastTransitionTime metav1.Time        `json:"lastTransitionTime"`
+	Reason             string             `json:"reason,omitempty"`
+	Message            string             `json:"message,omitempty"`
+}
+
+// ConditionedObject is implemented by every resource whose status carries conditions.
+type ConditionedObject interface {
+	runtime.Object
+	metav1.Object
+	GetConditions() []Condition
+	SetConditions(conditions []Condition)
+}
+
+// FindCondition returns the condition of the given type, or nil if it is not present.
+func FindCondition(conditions []Condition, conditionType string) *Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// IsConditionTrue returns true if the condition of the given type is present and true.
+func IsConditionTrue(conditions []Condition, conditionType string) bool {
+	condition := FindCondition(conditions, conditionType)
+
+	return condition != nil && condition.Status == v1.ConditionTrue
+}
+
+// SetCondition adds newCondition or updates the existing condition of the same type.
+// LastTransitionTime is only moved when the status of the condition changes.
+func SetCondition(conditions *[]Condition, newCondition Condition) {
+	existing := FindCondition(*conditions, newCondition.Type)
+	if existing == nil {
+		if newCondition.LastTransitionTime.IsZero() {
+			newCondition.LastTransitionTime = metav1.Now()
+		}
+		*conditions = append(*conditions, newCondition)
+
+		return
+	}
+
+	if existing.Status != newCondition.Status {
+		existing.Status = newCondition.Status
+		existing.LastTransitionTime = newCondition.LastTransitionTime
+		if existing.LastTransitionTime.IsZero() {
+			existing.LastTransitionTime = metav1.Now()
+		}
+	}
+
+	existing.Reason = newCondition.Reason
+	existing.Message = newCondition.Message
+	existing.ObservedGeneration = newCondition.ObservedGeneration
+}
+
+// SetReadyConditions records a successful reconciliation of the given generation.
+func SetReadyConditions(conditions *[]Condition, generation int64) {
+	SetCondition(conditions, Condition{
+		Type:               ConditionReady,
+		Status:             v1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             ReasonReconcileSuccess,
+	})
+	SetCondition(conditions, Condition{
+		Type:               ConditionSynced,
+		Status:             v1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             ReasonReconcileSuccess,
+	})
+	SetCondition(conditions, Condition{
+		Type:               ConditionError,
+		Status:             v1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             ReasonReconcileSuccess,
+	})
+}
+
+// SetFailedConditions records a failed reconciliation of the given generation.
+func SetFailedConditions(conditions *[]Condition, generation int64, reason string, err error) {
+	message := ""
+	if err != nil {
+		message = err.Error()
+	}
+
+	SetCondition(conditions, Condition{
+		Type:               ConditionReady,
+		Status:             v1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	})
+	SetCondition(conditions, Condition{
+		Type:               ConditionSynced,
+		Status:             v1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	})
+	SetCondition(conditions, Condition{
+		Type:               ConditionError,
+		Status:             v1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// SetDriftedCondition records the differences found between the resource in New Relic
+// and its applied spec. An empty list of differences marks the resource as in sync.
+func SetDriftedCondition(conditions *[]Condition, generation int64, differences []string) {
+	if len(differences) == 0 {
+		SetCondition(conditions, Condition{
+			Type:               ConditionDrifted,
+			Status:             v1.ConditionFalse,
+			ObservedGeneration: generation,
+			Reason:             ReasonInSync,
+		})
+
+		return
+	}
+
+	SetCondition(conditions, Condition{
+		Type:               ConditionDrifted,
+		Status:             v1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             ReasonDriftDetected,
+		Message:            strings.Join(differences, "; "),
+	})
+}
+
+// SetAuthenticatedCondition records whether the New Relic API accepted the API key of an account.
+// reason describes why the account failed to authenticate and is ignored when err is nil.
+func SetAuthenticatedCondition(conditions *[]Condition, generation int64, reason string, err error) {
+	if err == nil {
+		SetCondition(conditions, Condition{
+			Type:               ConditionAuthenticated,
+			Status:             v1.ConditionTrue,
+			ObservedGeneration: generation,
+			Reason:             ReasonAuthenticated,
+		})
+
+		return
+	}
+
+	SetCondition(conditions, Condition{
+		Type:               ConditionAuthenticated,
+		Status:             v1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            err.Error(),
+	})
+}
@@ -0,0 +1,97 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Conditions", func() {
+	var conditions []Condition
+
+	BeforeEach(func() {
+		conditions = []Condition{}
+	})
+
+	Describe("SetCondition", func() {
+		It("adds a missing condition with a transition time", func() {
+			SetCondition(&conditions, Condition{Type: ConditionReady, Status: v1.ConditionTrue})
+
+			Expect(conditions).To(HaveLen(1))
+			Expect(conditions[0].LastTransitionTime.IsZero()).To(BeFalse())
+		})
+
+		It("keeps the transition time when the status does not change", func() {
+			past := metav1.NewTime(time.Now().Add(-time.Hour))
+			conditions = []Condition{{Type: ConditionReady, Status: v1.ConditionTrue, LastTransitionTime: past}}
+
+			SetCondition(&conditions, Condition{Type: ConditionReady, Status: v1.ConditionTrue, Reason: "Other"})
+
+			Expect(conditions).To(HaveLen(1))
+			Expect(conditions[0].LastTransitionTime).To(Equal(past))
+			Expect(conditions[0].Reason).To(Equal("Other"))
+		})
+
+		It("moves the transition time when the status changes", func() {
+			past := metav1.NewTime(time.Now().Add(-time.Hour))
+			conditions = []Condition{{Type: ConditionReady, Status: v1.ConditionTrue, LastTransitionTime: past}}
+
+			SetCondition(&conditions, Condition{Type: ConditionReady, Status: v1.ConditionFalse})
+
+			Expect(conditions[0].Status).To(Equal(v1.ConditionFalse))
+			Expect(conditions[0].LastTransitionTime).ToNot(Equal(past))
+		})
+	})
+
+	Describe("SetReadyConditions", func() {
+		It("marks the resource as ready and synced", func() {
+			SetReadyConditions(&conditions, 3)
+
+			Expect(IsConditionTrue(conditions, ConditionReady)).To(BeTrue())
+			Expect(IsConditionTrue(conditions, ConditionSynced)).To(BeTrue())
+			Expect(IsConditionTrue(conditions, ConditionError)).To(BeFalse())
+			Expect(FindCondition(conditions, ConditionReady).ObservedGeneration).To(Equal(int64(3)))
+		})
+	})
+
+	Describe("SetFailedConditions", func() {
+		It("records the error on the Error condition", func() {
+			SetReadyConditions(&conditions, 1)
+			SetFailedConditions(&conditions, 2, ReasonUpdateFailed, errors.New("api failure"))
+
+			Expect(IsConditionTrue(conditions, ConditionReady)).To(BeFalse())
+			Expect(IsConditionTrue(conditions, ConditionSynced)).To(BeFalse())
+
+			errorCondition := FindCondition(conditions, ConditionError)
+			Expect(errorCondition.Status).To(Equal(v1.ConditionTrue))
+			Expect(errorCondition.Reason).To(Equal(ReasonUpdateFailed))
+			Expect(errorCondition.Message).To(Equal("api failure"))
+			Expect(errorCondition.ObservedGeneration).To(Equal(int64(2)))
+		})
+	})
+
+	Describe("SetDriftedCondition", func() {
+		It("lists the differences on the Drifted condition", func() {
+			SetDriftedCondition(&conditions, 4, []string{"name differs", "terms differ"})
+
+			driftedCondition := FindCondition(conditions, ConditionDrifted)
+			Expect(driftedCondition.Status).To(Equal(v1.ConditionTrue))
+			Expect(driftedCondition.Reason).To(Equal(ReasonDriftDetected))
+			Expect(driftedCondition.Message).To(Equal("name differs; terms differ"))
+		})
+
+		It("marks the resource as in sync without differences", func() {
+			SetDriftedCondition(&conditions, 4, []string{"name differs"})
+			SetDriftedCondition(&conditions, 5, []string{})
+
+			driftedCondition := FindCondition(conditions, ConditionDrifted)
+			Expect(driftedCondition.Status).To(Equal(v1.ConditionFalse))
+			Expect(driftedCondition.Reason).To(Equal(ReasonInSync))
+			Expect(driftedCondition.Message).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,269 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AccountSettings holds the fields a resource uses to select the New Relic account it is managed in.
+// Either AccountRef or one of APIKey and APIKeySecret is set.
+type AccountSettings struct {
+	AccountRef   NewRelicAccountReference
+	APIKey       string
+	APIKeySecret NewRelicAPIKeySecret
+	Region       string
+	AccountID    int
+}
+
+// Credentials are the resolved settings used to talk to the New Relic API on behalf of a resource
+type Credentials struct {
+	APIKey    string
+	Region    string
+	AccountID int
+}
+
+// SecretNotFoundError is returned when a secret referenced by a resource does not exist
+type SecretNotFoundError struct {
+	Secret types.NamespacedName
+}
+
+func (e *SecretNotFoundError) Error() string {
+	return fmt.Sprintf("secret %s not found", e.Secret)
+}
+
+// SecretKeyNotFoundError is returned when a referenced secret exists but does not hold the referenced key
+type SecretKeyNotFoundError struct {
+	Secret types.NamespacedName
+	Key    string
+}
+
+func (e *SecretKeyNotFoundError) Error() string {
+	return fmt.Sprintf("key %q not found in secret %s", e.Key, e.Secret)
+}
+
+// GetAccountSettings returns the account settings of the AlertsPolicy
+func (in *AlertsPolicy) GetAccountSettings() AccountSettings {
+	return AccountSettings{
+		AccountRef:   in.Spec.AccountRef,
+		APIKey:       in.Spec.APIKey,
+		APIKeySecret: in.Spec.APIKeySecret,
+		Region:       in.Spec.Region,
+		AccountID:    in.Spec.AccountID,
+	}
+}
+
+// GetAccountSettings returns the account settings of the AlertsNrqlCondition
+func (in *AlertsNrqlCondition) GetAccountSettings() AccountSettings {
+	return in.Spec.AlertsGenericConditionSpec.accountSettings()
+}
+
+// GetAccountSettings returns the account settings of the AlertsAPMCondition
+func (in *AlertsAPMCondition) GetAccountSettings() AccountSettings {
+	return in.Spec.AlertsGenericConditionSpec.accountSettings()
+}
+
+// GetAccountSettings returns the account settings of the AlertsInfraCondition
+func (in *AlertsInfraCondition) GetAccountSettings() AccountSettings {
+	return in.Spec.AlertsGenericConditionSpec.accountSettings()
+}
+
+// GetAccountSettings returns the account settings of the AlertsExternalServiceCondition
+func (in *AlertsExternalServiceCondition) GetAccountSettings() AccountSettings {
+	return in.Spec.AlertsGenericConditionSpec.accountSettings()
+}
+
+// GetAccountSettings returns the account settings of the AlertsSyntheticsCondition
+func (in *AlertsSyntheticsCondition) GetAccountSettings() AccountSettings {
+	return in.Spec.AlertsGenericConditionSpec.accountSettings()
+}
+
+// GetAccountSettings returns the account settings of the AlertsChannel
+func (in *AlertsChannel) GetAccountSettings() AccountSettings {
+	return AccountSettings{
+		AccountRef:   in.Spec.AccountRef,
+		APIKey:       in.Spec.APIKey,
+		APIKeySecret: in.Spec.APIKeySecret,
+		Region:       in.Spec.Region,
+	}
+}
+
+// GetAccountSettings returns the account settings of the SyntheticsMonitor
+func (in *SyntheticsMonitor) GetAccountSettings() AccountSettings {
+	return AccountSettings{
+		AccountRef:   in.Spec.AccountRef,
+		APIKey:       in.Spec.APIKey,
+		APIKeySecret: in.Spec.APIKeySecret,
+		Region:       in.Spec.Region,
+		AccountID:    in.Spec.AccountID,
+	}
+}
+
+// GetAccountSettings returns the account settings of the Dashboard
+func (in *Dashboard) GetAccountSettings() AccountSettings {
+	return AccountSettings{
+		AccountRef:   in.Spec.AccountRef,
+		APIKey:       in.Spec.APIKey,
+		APIKeySecret: in.Spec.APIKeySecret,
+		Region:       in.Spec.Region,
+		AccountID:    in.Spec.AccountID,
+	}
+}
+
+// GetAccountSettings returns the account settings of the AlertsMutingRule
+func (in *AlertsMutingRule) GetAccountSettings() AccountSettings {
+	return AccountSettings{
+		AccountRef:   in.Spec.AccountRef,
+		APIKey:       in.Spec.APIKey,
+		APIKeySecret: in.Spec.APIKeySecret,
+		Region:       in.Spec.Region,
+		AccountID:    in.Spec.AccountID,
+	}
+}
+
+// GetAccountSettings returns the account settings of the NotificationDestination
+func (in *NotificationDestination) GetAccountSettings() AccountSettings {
+	return AccountSettings{
+		AccountRef:   in.Spec.AccountRef,
+		APIKey:       in.Spec.APIKey,
+		APIKeySecret: in.Spec.APIKeySecret,
+		Region:       in.Spec.Region,
+		AccountID:    in.Spec.AccountID,
+	}
+}
+
+// GetAccountSettings returns the account settings of the NotificationChannel
+func (in *NotificationChannel) GetAccountSettings() AccountSettings {
+	return AccountSettings{
+		AccountRef:   in.Spec.AccountRef,
+		APIKey:       in.Spec.APIKey,
+		APIKeySecret: in.Spec.APIKeySecret,
+		Region:       in.Spec.Region,
+		AccountID:    in.Spec.AccountID,
+	}
+}
+
+// GetAccountSettings returns the account settings of the Workflow
+func (in *Workflow) GetAccountSettings() AccountSettings {
+	return AccountSettings{
+		AccountRef:   in.Spec.AccountRef,
+		APIKey:       in.Spec.APIKey,
+		APIKeySecret: in.Spec.APIKeySecret,
+		Region:       in.Spec.Region,
+		AccountID:    in.Spec.AccountID,
+	}
+}
+
+// GetAccountSettings returns the account settings of the ServiceLevel
+func (in *ServiceLevel) GetAccountSettings() AccountSettings {
+	return AccountSettings{
+		AccountRef:   in.Spec.AccountRef,
+		APIKey:       in.Spec.APIKey,
+		APIKeySecret: in.Spec.APIKeySecret,
+		Region:       in.Spec.Region,
+		AccountID:    in.Spec.AccountID,
+	}
+}
+
+// GetAccountSettings returns the account settings of the BurnRateAlert
+func (in *BurnRateAlert) GetAccountSettings() AccountSettings {
+	return AccountSettings{
+		AccountRef:   in.Spec.AccountRef,
+		APIKey:       in.Spec.APIKey,
+		APIKeySecret: in.Spec.APIKeySecret,
+		Region:       in.Spec.Region,
+		AccountID:    in.Spec.AccountID,
+	}
+}
+
+func (in AlertsGenericConditionSpec) accountSettings() AccountSettings {
+	return AccountSettings{
+		AccountRef:   in.AccountRef,
+		APIKey:       in.APIKey,
+		APIKeySecret: in.APIKeySecret,
+		Region:       in.Region,
+		AccountID:    in.AccountID,
+	}
+}
+
+// GetAccount looks up the NewRelicAccount or ClusterNewRelicAccount referenced from a resource in namespace
+// and returns its spec. The secret namespace of a NewRelicAccount is always the namespace of the account.
+func GetAccount(ctx context.Context, k8sClient client.Client, namespace string, ref NewRelicAccountReference) (NewRelicAccountSpec, error) {
+	switch ref.Kind {
+	case "", NewRelicAccountKind:
+		var account NewRelicAccount
+
+		err := k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, &account)
+		if err != nil {
+			if kErr.IsNotFound(err) {
+				return NewRelicAccountSpec{}, fmt.Errorf("%s %s/%s not found", NewRelicAccountKind, namespace, ref.Name)
+			}
+
+			return NewRelicAccountSpec{}, err
+		}
+
+		account.Spec.APIKeySecret.Namespace = account.Namespace
+
+		return account.Spec, nil
+	case ClusterNewRelicAccountKind:
+		var account ClusterNewRelicAccount
+
+		err := k8sClient.Get(ctx, types.NamespacedName{Name: ref.Name}, &account)
+		if err != nil {
+			if kErr.IsNotFound(err) {
+				return NewRelicAccountSpec{}, fmt.Errorf("%s %s not found", ClusterNewRelicAccountKind, ref.Name)
+			}
+
+			return NewRelicAccountSpec{}, err
+		}
+
+		return account.Spec, nil
+	default:
+		return NewRelicAccountSpec{}, fmt.Errorf("account_ref.kind must be %s or %s, got %q", NewRelicAccountKind, ClusterNewRelicAccountKind, ref.Kind)
+	}
+}
+
+// ResolveCredentials returns the API key, region and account ID for a resource in namespace.
+// With an account_ref they come from the referenced account, a region or account ID set
+// on the resource itself takes precedence. Otherwise the inline api_key or api_key_secret is used.
+func ResolveCredentials(ctx context.Context, k8sClient client.Client, namespace string, settings AccountSettings) (Credentials, error) {
+	credentials := Credentials{
+		APIKey:    settings.APIKey,
+		Region:    settings.Region,
+		AccountID: settings.AccountID,
+	}
+
+	secret := settings.APIKeySecret
+
+	if settings.AccountRef.Name != "" {
+		account, err := GetAccount(ctx, k8sClient, namespace, settings.AccountRef)
+		if err != nil {
+			return Credentials{}, err
+		}
+
+		credentials.APIKey = ""
+		secret = account.APIKeySecret
+
+		if credentials.Region == "" {
+			credentials.Region = account.Region
+		}
+
+		if credentials.AccountID == 0 {
+			credentials.AccountID = account.AccountID
+		}
+	}
+
+	if credentials.APIKey == "" && secret != (NewRelicAPIKeySecret{}) {
+		apiKey, err := getSecret(types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}, secret.KeyName, k8sClient)
+		if err != nil {
+			return Credentials{}, err
+		}
+
+		credentials.APIKey = apiKey
+	}
+
+	return credentials, nil
+}
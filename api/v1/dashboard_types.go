@@ -0,0 +1,301 @@
+package v1
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/newrelic/newrelic-client-go/pkg/dashboards"
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/pkg/nrdb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Visualizations supported by dashboard widgets
+const (
+	DashboardVisualizationArea      = "viz.area"
+	DashboardVisualizationBar       = "viz.bar"
+	DashboardVisualizationBillboard = "viz.billboard"
+	DashboardVisualizationLine      = "viz.line"
+	DashboardVisualizationMarkdown  = "viz.markdown"
+	DashboardVisualizationPie       = "viz.pie"
+	DashboardVisualizationTable     = "viz.table"
+)
+
+// DashboardSpec defines the desired state of Dashboard
+type DashboardSpec struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// +kubebuilder:validation:Enum=PRIVATE;PUBLIC_READ_ONLY;PUBLIC_READ_WRITE
+	Permissions  string                   `json:"permissions,omitempty"`
+	Pages        []DashboardPage          `json:"pages"`
+	APIKey       string                   `json:"api_key,omitempty"`
+	APIKeySecret NewRelicAPIKeySecret     `json:"api_key_secret,omitempty"`
+	AccountRef   NewRelicAccountReference `json:"account_ref,omitempty"`
+	Region       string                   `json:"region,omitempty"`
+	AccountID    int                      `json:"account_id,omitempty"`
+}
+
+// DashboardPage - copy of dashboards.DashboardPageInput
+type DashboardPage struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Widgets     []DashboardWidget `json:"widgets,omitempty"`
+}
+
+// DashboardWidget - copy of dashboards.DashboardWidgetInput, the configuration is
+// chosen by the visualization
+type DashboardWidget struct {
+	Title string `json:"title,omitempty"`
+	// +kubebuilder:validation:Enum=viz.area;viz.bar;viz.billboard;viz.line;viz.markdown;viz.pie;viz.table
+	Visualization string                `json:"visualization"`
+	Layout        DashboardWidgetLayout `json:"layout,omitempty"`
+	// Queries are required by every visualization except viz.markdown
+	Queries []DashboardWidgetQuery `json:"queries,omitempty"`
+	// Text is the markdown shown by a viz.markdown widget
+	Text string `json:"text,omitempty"`
+}
+
+// DashboardWidgetLayout - copy of dashboards.DashboardWidgetLayoutInput
+type DashboardWidgetLayout struct {
+	Column int `json:"column,omitempty"`
+	Row    int `json:"row,omitempty"`
+	Width  int `json:"width,omitempty"`
+	Height int `json:"height,omitempty"`
+}
+
+// DashboardWidgetQuery - copy of dashboards.DashboardWidgetQueryInput,
+// AccountID defaults to the account of the dashboard
+type DashboardWidgetQuery struct {
+	AccountID int    `json:"account_id,omitempty"`
+	NRQL      string `json:"nrql"`
+}
+
+// DashboardStatus defines the observed state of Dashboard
+type DashboardStatus struct {
+	AppliedSpec   *DashboardSpec `json:"applied_spec,omitempty"`
+	DashboardGUID string         `json:"dashboard_guid"`
+	Permalink     string         `json:"permalink,omitempty"`
+	Conditions    []Condition    `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="GUID",type="string",JSONPath=".status.dashboard_guid"
+// +kubebuilder:printcolumn:name="Permalink",type="string",JSONPath=".status.permalink",priority=1
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+
+// Dashboard is the Schema for the dashboards API
+type Dashboard struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DashboardSpec   `json:"spec,omitempty"`
+	Status DashboardStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DashboardList contains a list of Dashboard
+type DashboardList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Dashboard `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Dashboard{}, &DashboardList{})
+}
+
+// GetConditions returns the status conditions of the Dashboard
+func (in *Dashboard) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the Dashboard
+func (in *Dashboard) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
+// ToDashboardInput - Converts DashboardSpec object to dashboards.DashboardInput. Queries without
+// an account ID run in accountID.
+func (in DashboardSpec) ToDashboardInput(accountID int) dashboards.DashboardInput {
+	input := dashboards.DashboardInput{
+		Name:        in.Name,
+		Description: in.Description,
+		Permissions: entities.DashboardPermissions(in.Permissions),
+		Pages:       []dashboards.DashboardPageInput{},
+	}
+
+	for _, page := range in.Pages {
+		pageInput := dashboards.DashboardPageInput{
+			Name:        page.Name,
+			Description: page.Description,
+			Widgets:     []dashboards.DashboardWidgetInput{},
+		}
+
+		for _, widget := range page.Widgets {
+			pageInput.Widgets = append(pageInput.Widgets, widget.toWidgetInput(accountID))
+		}
+
+		input.Pages = append(input.Pages, pageInput)
+	}
+
+	return input
+}
+
+func (in DashboardWidget) toWidgetInput(accountID int) dashboards.DashboardWidgetInput {
+	widget := dashboards.DashboardWidgetInput{
+		Title:         in.Title,
+		Visualization: dashboards.DashboardWidgetVisualizationInput{ID: in.Visualization},
+		Layout: dashboards.DashboardWidgetLayoutInput{
+			Column: in.Layout.Column,
+			Row:    in.Layout.Row,
+			Width:  in.Layout.Width,
+			Height: in.Layout.Height,
+		},
+	}
+
+	queries := []dashboards.DashboardWidgetNRQLQueryInput{}
+	for _, query := range in.withQueryAccount(accountID).Queries {
+		queries = append(queries, dashboards.DashboardWidgetNRQLQueryInput{
+			AccountID: query.AccountID,
+			Query:     nrdb.NRQL(query.NRQL),
+		})
+	}
+
+	switch in.Visualization {
+	case DashboardVisualizationArea:
+		widget.Configuration.Area = &dashboards.DashboardAreaWidgetConfigurationInput{NRQLQueries: queries}
+	case DashboardVisualizationBar:
+		widget.Configuration.Bar = &dashboards.DashboardBarWidgetConfigurationInput{NRQLQueries: queries}
+	case DashboardVisualizationBillboard:
+		widget.Configuration.Billboard = &dashboards.DashboardBillboardWidgetConfigurationInput{NRQLQueries: queries}
+	case DashboardVisualizationLine:
+		widget.Configuration.Line = &dashboards.DashboardLineWidgetConfigurationInput{NRQLQueries: queries}
+	case DashboardVisualizationMarkdown:
+		widget.Configuration.Markdown = &dashboards.DashboardMarkdownWidgetConfigurationInput{Text: in.Text}
+	case DashboardVisualizationPie:
+		widget.Configuration.Pie = &dashboards.DashboardPieWidgetConfigurationInput{NRQLQueries: queries}
+	case DashboardVisualizationTable:
+		widget.Configuration.Table = &dashboards.DashboardTableWidgetConfigurationInput{NRQLQueries: queries}
+	}
+
+	return widget
+}
+
+// withQueryAccount returns a copy of the widget whose queries without an account ID run in accountID
+func (in DashboardWidget) withQueryAccount(accountID int) DashboardWidget {
+	out := in
+	out.Queries = nil
+
+	for _, query := range in.Queries {
+		if query.AccountID == 0 {
+			query.AccountID = accountID
+		}
+
+		out.Queries = append(out.Queries, query)
+	}
+
+	return out
+}
+
+// Diff lists the parts of the dashboard in New Relic that no longer match the spec
+func (in DashboardSpec) Diff(remote entities.DashboardEntity, accountID int) []string {
+	differences := []string{}
+
+	if in.Name != remote.Name {
+		differences = append(differences, fmt.Sprintf("name is %q, expected %q", remote.Name, in.Name))
+	}
+
+	if in.Description != remote.Description {
+		differences = append(differences, fmt.Sprintf("description is %q, expected %q", remote.Description, in.Description))
+	}
+
+	if in.Permissions != "" && in.Permissions != string(remote.Permissions) {
+		differences = append(differences, fmt.Sprintf("permissions are %q, expected %q", remote.Permissions, in.Permissions))
+	}
+
+	if len(in.Pages) != len(remote.Pages) {
+		return append(differences, fmt.Sprintf("dashboard has %d pages, expected %d", len(remote.Pages), len(in.Pages)))
+	}
+
+	for i, page := range in.Pages {
+		remotePage := dashboardPageFromEntity(remote.Pages[i])
+
+		if page.Name != remotePage.Name || page.Description != remotePage.Description {
+			differences = append(differences, fmt.Sprintf("page %d is %q, expected %q", i+1, remotePage.Name, page.Name))
+		}
+
+		if len(page.Widgets) != len(remotePage.Widgets) {
+			differences = append(differences, fmt.Sprintf("page %q has %d widgets, expected %d", page.Name, len(remotePage.Widgets), len(page.Widgets)))
+			continue
+		}
+
+		for j, widget := range page.Widgets {
+			widget = widget.withQueryAccount(accountID)
+			remoteWidget := remotePage.Widgets[j]
+
+			// New Relic fills in a layout that is not set
+			if widget.Layout == (DashboardWidgetLayout{}) {
+				remoteWidget.Layout = DashboardWidgetLayout{}
+			}
+
+			if !reflect.DeepEqual(widget, remoteWidget) {
+				differences = append(differences, fmt.Sprintf("widget %d on page %q differs", j+1, page.Name))
+			}
+		}
+	}
+
+	return differences
+}
+
+// dashboardPageFromEntity converts a page read from New Relic to a DashboardPage
+func dashboardPageFromEntity(remote entities.DashboardPage) DashboardPage {
+	page := DashboardPage{
+		Name:        remote.Name,
+		Description: remote.Description,
+	}
+
+	for _, remoteWidget := range remote.Widgets {
+		widget := DashboardWidget{
+			Title:         remoteWidget.Title,
+			Visualization: remoteWidget.Visualization.ID,
+			Layout: DashboardWidgetLayout{
+				Column: remoteWidget.Layout.Column,
+				Row:    remoteWidget.Layout.Row,
+				Width:  remoteWidget.Layout.Width,
+				Height: remoteWidget.Layout.Height,
+			},
+		}
+
+		var queries []entities.DashboardWidgetNRQLQuery
+
+		switch widget.Visualization {
+		case DashboardVisualizationArea:
+			queries = remoteWidget.Configuration.Area.NRQLQueries
+		case DashboardVisualizationBar:
+			queries = remoteWidget.Configuration.Bar.NRQLQueries
+		case DashboardVisualizationBillboard:
+			queries = remoteWidget.Configuration.Billboard.NRQLQueries
+		case DashboardVisualizationLine:
+			queries = remoteWidget.Configuration.Line.NRQLQueries
+		case DashboardVisualizationMarkdown:
+			widget.Text = remoteWidget.Configuration.Markdown.Text
+		case DashboardVisualizationPie:
+			queries = remoteWidget.Configuration.Pie.NRQLQueries
+		case DashboardVisualizationTable:
+			queries = remoteWidget.Configuration.Table.NRQLQueries
+		}
+
+		for _, query := range queries {
+			widget.Queries = append(widget.Queries, DashboardWidgetQuery{
+				AccountID: query.AccountID,
+				NRQL:      string(query.Query),
+			})
+		}
+
+		page.Widgets = append(page.Widgets, widget)
+	}
+
+	return page
+}
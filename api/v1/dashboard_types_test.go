@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/pkg/nrdb"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DashboardSpec", func() {
+	var spec DashboardSpec
+	var remote entities.DashboardEntity
+
+	BeforeEach(func() {
+		spec = DashboardSpec{
+			Name:        "my dashboard",
+			Description: "shop overview",
+			Permissions: "PUBLIC_READ_ONLY",
+			Pages: []DashboardPage{
+				{
+					Name: "Overview",
+					Widgets: []DashboardWidget{
+						{
+							Title:         "Throughput",
+							Visualization: DashboardVisualizationLine,
+							Layout:        DashboardWidgetLayout{Column: 1, Row: 1, Width: 6, Height: 3},
+							Queries: []DashboardWidgetQuery{
+								{NRQL: "SELECT count(*) FROM Transaction TIMESERIES"},
+								{AccountID: 2, NRQL: "SELECT count(*) FROM Transaction TIMESERIES"},
+							},
+						},
+						{
+							Visualization: DashboardVisualizationMarkdown,
+							Text:          "# Shop",
+						},
+					},
+				},
+			},
+		}
+
+		remote = entities.DashboardEntity{
+			Name:        "my dashboard",
+			Description: "shop overview",
+			Permissions: "PUBLIC_READ_ONLY",
+			Pages: []entities.DashboardPage{
+				{
+					Name: "Overview",
+					Widgets: []entities.DashboardWidget{
+						{
+							Title:         "Throughput",
+							Visualization: entities.DashboardWidgetVisualization{ID: DashboardVisualizationLine},
+							Layout:        entities.DashboardWidgetLayout{Column: 1, Row: 1, Width: 6, Height: 3},
+							Configuration: entities.DashboardWidgetConfiguration{
+								Line: entities.DashboardLineWidgetConfiguration{
+									NRQLQueries: []entities.DashboardWidgetNRQLQuery{
+										{AccountID: 1, Query: nrdb.NRQL("SELECT count(*) FROM Transaction TIMESERIES")},
+										{AccountID: 2, Query: nrdb.NRQL("SELECT count(*) FROM Transaction TIMESERIES")},
+									},
+								},
+							},
+						},
+						{
+							Visualization: entities.DashboardWidgetVisualization{ID: DashboardVisualizationMarkdown},
+							Layout:        entities.DashboardWidgetLayout{Column: 7, Row: 1, Width: 4, Height: 3},
+							Configuration: entities.DashboardWidgetConfiguration{
+								Markdown: entities.DashboardMarkdownWidgetConfiguration{Text: "# Shop"},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("ToDashboardInput", func() {
+		It("converts the spec to a dashboards.DashboardInput", func() {
+			input := spec.ToDashboardInput(1)
+			Expect(input.Name).To(Equal("my dashboard"))
+			Expect(input.Description).To(Equal("shop overview"))
+			Expect(input.Permissions).To(Equal(entities.DashboardPermissions("PUBLIC_READ_ONLY")))
+			Expect(input.Pages).To(HaveLen(1))
+			Expect(input.Pages[0].Name).To(Equal("Overview"))
+			Expect(input.Pages[0].Widgets).To(HaveLen(2))
+
+			line := input.Pages[0].Widgets[0]
+			Expect(line.Title).To(Equal("Throughput"))
+			Expect(line.Visualization.ID).To(Equal(DashboardVisualizationLine))
+			Expect(line.Layout.Width).To(Equal(6))
+			Expect(line.Configuration.Line).ToNot(BeNil())
+			Expect(line.Configuration.Area).To(BeNil())
+			Expect(line.Configuration.Line.NRQLQueries).To(HaveLen(2))
+			Expect(line.Configuration.Line.NRQLQueries[0].AccountID).To(Equal(1))
+			Expect(line.Configuration.Line.NRQLQueries[0].Query).To(Equal(nrdb.NRQL("SELECT count(*) FROM Transaction TIMESERIES")))
+			Expect(line.Configuration.Line.NRQLQueries[1].AccountID).To(Equal(2))
+
+			markdown := input.Pages[0].Widgets[1]
+			Expect(markdown.Configuration.Markdown).ToNot(BeNil())
+			Expect(markdown.Configuration.Markdown.Text).To(Equal("# Shop"))
+		})
+
+		It("does not change the queries of the spec", func() {
+			spec.ToDashboardInput(1)
+			Expect(spec.Pages[0].Widgets[0].Queries[0].AccountID).To(BeZero())
+		})
+	})
+
+	Describe("Diff", func() {
+		It("reports no differences for a matching dashboard", func() {
+			Expect(spec.Diff(remote, 1)).To(BeEmpty())
+		})
+
+		It("reports changed dashboard fields", func() {
+			remote.Name = "renamed"
+			remote.Permissions = "PRIVATE"
+			Expect(spec.Diff(remote, 1)).To(ConsistOf(
+				`name is "renamed", expected "my dashboard"`,
+				`permissions are "PRIVATE", expected "PUBLIC_READ_ONLY"`,
+			))
+		})
+
+		It("reports a changed query", func() {
+			remote.Pages[0].Widgets[0].Configuration.Line.NRQLQueries[0].Query = "SELECT count(*) FROM PageView"
+			Expect(spec.Diff(remote, 1)).To(ConsistOf(`widget 1 on page "Overview" differs`))
+		})
+
+		It("reports a changed layout", func() {
+			remote.Pages[0].Widgets[0].Layout.Width = 12
+			Expect(spec.Diff(remote, 1)).To(ConsistOf(`widget 1 on page "Overview" differs`))
+		})
+
+		It("reports missing widgets and pages", func() {
+			remote.Pages[0].Widgets = remote.Pages[0].Widgets[:1]
+			Expect(spec.Diff(remote, 1)).To(ConsistOf(`page "Overview" has 1 widgets, expected 2`))
+
+			remote.Pages = nil
+			Expect(spec.Diff(remote, 1)).To(ConsistOf("dashboard has 0 pages, expected 1"))
+		})
+	})
+})
@@ -0,0 +1,153 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	customErrors "github.com/newrelic/newrelic-kubernetes-operator/errors"
+)
+
+// log is for logging in this package.
+var (
+	dashboardlog = logf.Log.WithName("dashboard-resource")
+)
+
+var defaultDashboardPermissions = entities.DashboardPermissionsTypes.PUBLIC_READ_WRITE
+
+// SetupWebhookWithManager - instantiates the Webhook
+func (r *Dashboard) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	k8Client = mgr.GetClient()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-nr-k8s-newrelic-com-v1-dashboard,mutating=true,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=dashboards,verbs=create;update,versions=v1,name=mdashboard.kb.io,sideEffects=None
+
+var _ webhook.Defaulter = &Dashboard{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *Dashboard) Default() {
+	dashboardlog.Info("default", "name", r.Name)
+
+	if r.Status.AppliedSpec == nil {
+		dashboardlog.Info("Setting null Applied Spec to empty interface")
+		r.Status.AppliedSpec = &DashboardSpec{}
+	}
+
+	if r.Spec.Permissions == "" {
+		r.Spec.Permissions = string(defaultDashboardPermissions)
+	}
+
+	DefaultAccountRef(&r.Spec.AccountRef)
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-nr-k8s-newrelic-com-v1-dashboard,mutating=false,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=dashboards,versions=v1,name=vdashboard.kb.io,sideEffects=None
+
+var _ webhook.Validator = &Dashboard{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Dashboard) ValidateCreate() error {
+	dashboardlog.Info("validate create", "name", r.Name)
+
+	return r.ValidateDashboard()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Dashboard) ValidateUpdate(old runtime.Object) error {
+	dashboardlog.Info("validate update", "name", r.Name)
+
+	return r.ValidateDashboard()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Dashboard) ValidateDelete() error {
+	dashboardlog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
+
+// ValidateDashboard - Validates create/update of Dashboard
+func (r *Dashboard) ValidateDashboard() error {
+	collectedErrors := new(customErrors.ErrorCollector)
+
+	if err := r.CheckForAPIKeyOrSecret(); err != nil {
+		collectedErrors.Collect(err)
+	}
+
+	// the region and account of a referenced account are used when the dashboard does not set them
+	if r.Spec.AccountRef.Name == "" {
+		if !ValidRegion(r.Spec.Region) {
+			collectedErrors.Collect(errors.New("Invalid region set, value was: " + r.Spec.Region))
+		}
+
+		if r.Spec.AccountID == 0 {
+			collectedErrors.Collect(errors.New("account_id must be set"))
+		}
+	}
+
+	if r.Spec.Name == "" {
+		collectedErrors.Collect(errors.New("name must be set"))
+	}
+
+	if len(r.Spec.Pages) == 0 {
+		collectedErrors.Collect(errors.New("at least one page must be set"))
+	}
+
+	for i, page := range r.Spec.Pages {
+		if page.Name == "" {
+			collectedErrors.Collect(fmt.Errorf("pages[%d].name must be set", i))
+		}
+
+		for j, widget := range page.Widgets {
+			if err := widget.validate(); err != nil {
+				collectedErrors.Collect(fmt.Errorf("pages[%d].widgets[%d]: %s", i, j, err))
+			}
+		}
+	}
+
+	if len(*collectedErrors) > 0 {
+		dashboardlog.Info("Errors encountered validating dashboard", "collectedErrors", collectedErrors)
+		return collectedErrors
+	}
+
+	return nil
+}
+
+func (r *Dashboard) CheckForAPIKeyOrSecret() error {
+	return CheckForAccount(r.Namespace, r.GetAccountSettings())
+}
+
+func (in DashboardWidget) validate() error {
+	switch in.Visualization {
+	case DashboardVisualizationMarkdown:
+		if in.Text == "" {
+			return fmt.Errorf("text must be set for %s widgets", in.Visualization)
+		}
+
+		if len(in.Queries) > 0 {
+			return fmt.Errorf("queries cannot be set for %s widgets", in.Visualization)
+		}
+	case DashboardVisualizationArea, DashboardVisualizationBar, DashboardVisualizationBillboard,
+		DashboardVisualizationLine, DashboardVisualizationPie, DashboardVisualizationTable:
+		if len(in.Queries) == 0 {
+			return fmt.Errorf("at least one query must be set for %s widgets", in.Visualization)
+		}
+
+		for _, query := range in.Queries {
+			if query.NRQL == "" {
+				return errors.New("nrql must be set for every query")
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported visualization %q", in.Visualization)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package v1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Dashboard_webhook", func() {
+	var r Dashboard
+
+	BeforeEach(func() {
+		k8Client = testk8sClient
+		r = Dashboard{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-dashboard",
+				Namespace: "default",
+			},
+			Spec: DashboardSpec{
+				Name: "my dashboard",
+				Pages: []DashboardPage{
+					{
+						Name: "Overview",
+						Widgets: []DashboardWidget{
+							{
+								Visualization: DashboardVisualizationBillboard,
+								Queries:       []DashboardWidgetQuery{{NRQL: "SELECT count(*) FROM Transaction"}},
+							},
+							{
+								Visualization: DashboardVisualizationMarkdown,
+								Text:          "# Shop",
+							},
+						},
+					},
+				},
+				APIKey:    "api-key",
+				Region:    "US",
+				AccountID: 1,
+			},
+		}
+	})
+
+	Describe("Default", func() {
+		It("makes the dashboard editable by everyone", func() {
+			r.Default()
+			Expect(r.Spec.Permissions).To(Equal("PUBLIC_READ_WRITE"))
+			Expect(r.Status.AppliedSpec).To(Equal(&DashboardSpec{}))
+		})
+
+		It("keeps explicit permissions", func() {
+			r.Spec.Permissions = "PRIVATE"
+			r.Default()
+			Expect(r.Spec.Permissions).To(Equal("PRIVATE"))
+		})
+	})
+
+	Describe("ValidateCreate", func() {
+		It("accepts a valid dashboard", func() {
+			Expect(r.ValidateCreate()).To(Succeed())
+		})
+
+		It("requires an account ID", func() {
+			r.Spec.AccountID = 0
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("account_id must be set")))
+		})
+
+		It("requires a page", func() {
+			r.Spec.Pages = nil
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("at least one page must be set")))
+		})
+
+		It("requires queries for chart widgets", func() {
+			r.Spec.Pages[0].Widgets[0].Queries = nil
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("pages[0].widgets[0]: at least one query must be set for viz.billboard widgets")))
+		})
+
+		It("requires text for markdown widgets", func() {
+			r.Spec.Pages[0].Widgets[1].Text = ""
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("pages[0].widgets[1]: text must be set for viz.markdown widgets")))
+		})
+
+		It("rejects unsupported visualizations", func() {
+			r.Spec.Pages[0].Widgets[0].Visualization = "viz.heatmap"
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring(`unsupported visualization "viz.heatmap"`)))
+		})
+	})
+})
@@ -0,0 +1,104 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Kinds that can be referenced from the account_ref of an alerts resource.
+const (
+	NewRelicAccountKind        = "NewRelicAccount"
+	ClusterNewRelicAccountKind = "ClusterNewRelicAccount"
+)
+
+// NewRelicAccountSpec defines the credentials and settings shared by all resources referencing the account
+type NewRelicAccountSpec struct {
+	APIKeySecret NewRelicAPIKeySecret `json:"api_key_secret"`
+	Region       string               `json:"region"`
+	AccountID    int                  `json:"account_id"`
+}
+
+// NewRelicAccountStatus defines the observed state of a NewRelicAccount or ClusterNewRelicAccount
+type NewRelicAccountStatus struct {
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// NewRelicAccountReference points an alerts resource at a NewRelicAccount in its own namespace
+// or at a ClusterNewRelicAccount. Kind defaults to NewRelicAccount.
+type NewRelicAccountReference struct {
+	Kind string `json:"kind,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.region"
+// +kubebuilder:printcolumn:name="Account ID",type="integer",JSONPath=".spec.account_id"
+// +kubebuilder:printcolumn:name="Authenticated",type="string",JSONPath=".status.conditions[?(@.type==\"Authenticated\")].status"
+
+// NewRelicAccount is the Schema for the newrelicaccounts API
+type NewRelicAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NewRelicAccountSpec   `json:"spec,omitempty"`
+	Status NewRelicAccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NewRelicAccountList contains a list of NewRelicAccount
+type NewRelicAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NewRelicAccount `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.region"
+// +kubebuilder:printcolumn:name="Account ID",type="integer",JSONPath=".spec.account_id"
+// +kubebuilder:printcolumn:name="Authenticated",type="string",JSONPath=".status.conditions[?(@.type==\"Authenticated\")].status"
+
+// ClusterNewRelicAccount is the Schema for the clusternewrelicaccounts API
+type ClusterNewRelicAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NewRelicAccountSpec   `json:"spec,omitempty"`
+	Status NewRelicAccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterNewRelicAccountList contains a list of ClusterNewRelicAccount
+type ClusterNewRelicAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterNewRelicAccount `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NewRelicAccount{}, &NewRelicAccountList{})
+	SchemeBuilder.Register(&ClusterNewRelicAccount{}, &ClusterNewRelicAccountList{})
+}
+
+// GetConditions returns the status conditions of the NewRelicAccount
+func (in *NewRelicAccount) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the NewRelicAccount
+func (in *NewRelicAccount) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the ClusterNewRelicAccount
+func (in *ClusterNewRelicAccount) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the ClusterNewRelicAccount
+func (in *ClusterNewRelicAccount) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
@@ -0,0 +1,154 @@
+package v1
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	customErrors "github.com/newrelic/newrelic-kubernetes-operator/errors"
+)
+
+// log is for logging in this package.
+var (
+	newrelicaccountlog = logf.Log.WithName("newrelicaccount-resource")
+)
+
+// SetupWebhookWithManager - instantiates the Webhook
+func (r *NewRelicAccount) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// SetupWebhookWithManager - instantiates the Webhook
+func (r *ClusterNewRelicAccount) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-nr-k8s-newrelic-com-v1-newrelicaccount,mutating=true,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=newrelicaccounts,verbs=create;update,versions=v1,name=mnewrelicaccount.kb.io,sideEffects=None
+
+var _ webhook.Defaulter = &NewRelicAccount{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *NewRelicAccount) Default() {
+	newrelicaccountlog.Info("default", "name", r.Name)
+
+	if r.Spec.APIKeySecret.Namespace == "" {
+		r.Spec.APIKeySecret.Namespace = r.Namespace
+	}
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-nr-k8s-newrelic-com-v1-newrelicaccount,mutating=false,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=newrelicaccounts,versions=v1,name=vnewrelicaccount.kb.io,sideEffects=None
+
+var _ webhook.Validator = &NewRelicAccount{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *NewRelicAccount) ValidateCreate() error {
+	newrelicaccountlog.Info("validate create", "name", r.Name)
+
+	return r.ValidateNewRelicAccount()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *NewRelicAccount) ValidateUpdate(old runtime.Object) error {
+	newrelicaccountlog.Info("validate update", "name", r.Name)
+
+	return r.ValidateNewRelicAccount()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *NewRelicAccount) ValidateDelete() error {
+	newrelicaccountlog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
+
+// ValidateNewRelicAccount - Validates create/update of NewRelicAccount
+func (r *NewRelicAccount) ValidateNewRelicAccount() error {
+	collectedErrors := r.Spec.validate()
+
+	// a namespaced account may only hand out secrets from its own namespace
+	if r.Spec.APIKeySecret.Namespace != "" && r.Spec.APIKeySecret.Namespace != r.Namespace {
+		collectedErrors.Collect(errors.New("api_key_secret.namespace must be the namespace of the NewRelicAccount"))
+	}
+
+	if len(*collectedErrors) > 0 {
+		newrelicaccountlog.Info("Errors encountered validating account", "collectedErrors", collectedErrors)
+		return collectedErrors
+	}
+
+	return nil
+}
+
+// +kubebuilder:webhook:path=/mutate-nr-k8s-newrelic-com-v1-clusternewrelicaccount,mutating=true,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=clusternewrelicaccounts,verbs=create;update,versions=v1,name=mclusternewrelicaccount.kb.io,sideEffects=None
+
+var _ webhook.Defaulter = &ClusterNewRelicAccount{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *ClusterNewRelicAccount) Default() {
+	newrelicaccountlog.Info("default", "name", r.Name)
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-nr-k8s-newrelic-com-v1-clusternewrelicaccount,mutating=false,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=clusternewrelicaccounts,versions=v1,name=vclusternewrelicaccount.kb.io,sideEffects=None
+
+var _ webhook.Validator = &ClusterNewRelicAccount{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterNewRelicAccount) ValidateCreate() error {
+	newrelicaccountlog.Info("validate create", "name", r.Name)
+
+	return r.ValidateClusterNewRelicAccount()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterNewRelicAccount) ValidateUpdate(old runtime.Object) error {
+	newrelicaccountlog.Info("validate update", "name", r.Name)
+
+	return r.ValidateClusterNewRelicAccount()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterNewRelicAccount) ValidateDelete() error {
+	newrelicaccountlog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
+
+// ValidateClusterNewRelicAccount - Validates create/update of ClusterNewRelicAccount
+func (r *ClusterNewRelicAccount) ValidateClusterNewRelicAccount() error {
+	collectedErrors := r.Spec.validate()
+
+	if r.Spec.APIKeySecret.Namespace == "" {
+		collectedErrors.Collect(errors.New("api_key_secret.namespace must be set"))
+	}
+
+	if len(*collectedErrors) > 0 {
+		newrelicaccountlog.Info("Errors encountered validating account", "collectedErrors", collectedErrors)
+		return collectedErrors
+	}
+
+	return nil
+}
+
+func (in NewRelicAccountSpec) validate() *customErrors.ErrorCollector {
+	collectedErrors := new(customErrors.ErrorCollector)
+
+	if in.APIKeySecret.Name == "" || in.APIKeySecret.KeyName == "" {
+		collectedErrors.Collect(errors.New("api_key_secret.name and api_key_secret.key_name must be set"))
+	}
+
+	if !ValidRegion(in.Region) {
+		collectedErrors.Collect(errors.New("Invalid region set, value was: " + in.Region))
+	}
+
+	if in.AccountID <= 0 {
+		collectedErrors.Collect(errors.New("account_id must be set"))
+	}
+
+	return collectedErrors
+}
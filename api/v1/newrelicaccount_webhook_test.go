@@ -0,0 +1,92 @@
+package v1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("NewRelicAccount_webhooks", func() {
+	var r NewRelicAccount
+
+	BeforeEach(func() {
+		r = NewRelicAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-account",
+				Namespace: "my-namespace",
+			},
+			Spec: NewRelicAccountSpec{
+				APIKeySecret: NewRelicAPIKeySecret{
+					Name:    "my-api-key-secret",
+					KeyName: "my-api-key",
+				},
+				Region:    "US",
+				AccountID: 12345,
+			},
+		}
+	})
+
+	Describe("Default", func() {
+		It("defaults the secret namespace to the namespace of the account", func() {
+			r.Default()
+			Expect(r.Spec.APIKeySecret.Namespace).To(Equal("my-namespace"))
+		})
+	})
+
+	Describe("ValidateCreate", func() {
+		It("accepts a complete account", func() {
+			r.Default()
+			Expect(r.ValidateCreate()).To(Succeed())
+		})
+
+		It("rejects a secret from another namespace", func() {
+			r.Spec.APIKeySecret.Namespace = "other-namespace"
+			err := r.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("api_key_secret.namespace must be the namespace of the NewRelicAccount"))
+		})
+
+		It("collects every missing field", func() {
+			r.Spec = NewRelicAccountSpec{}
+			err := r.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("api_key_secret.name and api_key_secret.key_name must be set"))
+			Expect(err.Error()).To(ContainSubstring("Invalid region set"))
+			Expect(err.Error()).To(ContainSubstring("account_id must be set"))
+		})
+	})
+})
+
+var _ = Describe("ClusterNewRelicAccount_webhooks", func() {
+	var r ClusterNewRelicAccount
+
+	BeforeEach(func() {
+		r = ClusterNewRelicAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "my-cluster-account",
+			},
+			Spec: NewRelicAccountSpec{
+				APIKeySecret: NewRelicAPIKeySecret{
+					Name:      "my-api-key-secret",
+					Namespace: "my-namespace",
+					KeyName:   "my-api-key",
+				},
+				Region:    "EU",
+				AccountID: 12345,
+			},
+		}
+	})
+
+	Describe("ValidateCreate", func() {
+		It("accepts a complete account", func() {
+			Expect(r.ValidateCreate()).To(Succeed())
+		})
+
+		It("requires the secret namespace", func() {
+			r.Spec.APIKeySecret.Namespace = ""
+			err := r.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("api_key_secret.namespace must be set"))
+		})
+	})
+})
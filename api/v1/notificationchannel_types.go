@@ -0,0 +1,81 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/notifications"
+)
+
+// NotificationChannelSpec defines the desired state of NotificationChannel
+type NotificationChannelSpec struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Enum=WEBHOOK;EMAIL;PAGERDUTY_SERVICE_INTEGRATION;SERVICENOW_INCIDENTS;JIRA_CLASSIC
+	Type string `json:"type"`
+	// DestinationRef is the name of the NotificationDestination in the namespace of the channel
+	DestinationRef string                   `json:"destination_ref"`
+	Properties     []NotificationProperty   `json:"properties,omitempty"`
+	APIKey         string                   `json:"api_key,omitempty"`
+	APIKeySecret   NewRelicAPIKeySecret     `json:"api_key_secret,omitempty"`
+	AccountRef     NewRelicAccountReference `json:"account_ref,omitempty"`
+	Region         string                   `json:"region,omitempty"`
+	AccountID      int                      `json:"account_id,omitempty"`
+}
+
+// NotificationChannelStatus defines the observed state of NotificationChannel
+type NotificationChannelStatus struct {
+	AppliedSpec *NotificationChannelSpec `json:"applied_spec,omitempty"`
+	ChannelID   string                   `json:"channel_id"`
+	// DestinationID is the ID of the referenced destination the channel was written with
+	DestinationID string      `json:"destination_id,omitempty"`
+	Conditions    []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.channel_id"
+// +kubebuilder:printcolumn:name="Destination",type="string",JSONPath=".spec.destination_ref"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+
+// NotificationChannel is the Schema for the notificationchannels API
+type NotificationChannel struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NotificationChannelSpec   `json:"spec,omitempty"`
+	Status NotificationChannelStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NotificationChannelList contains a list of NotificationChannel
+type NotificationChannelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NotificationChannel `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NotificationChannel{}, &NotificationChannelList{})
+}
+
+// GetConditions returns the status conditions of the NotificationChannel
+func (in *NotificationChannel) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the NotificationChannel
+func (in *NotificationChannel) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
+// ChannelInput - Converts NotificationChannelSpec object to notifications.ChannelInput for a channel
+// of workflows sending to destinationID
+func (in NotificationChannelSpec) ChannelInput(destinationID string) notifications.ChannelInput {
+	return notifications.ChannelInput{
+		Name:          in.Name,
+		Type:          in.Type,
+		Product:       notifications.ProductIINT,
+		DestinationID: destinationID,
+		Properties:    notificationProperties(in.Properties),
+	}
+}
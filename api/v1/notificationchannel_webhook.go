@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	customErrors "github.com/newrelic/newrelic-kubernetes-operator/errors"
+)
+
+// log is for logging in this package.
+var (
+	notificationchannellog = logf.Log.WithName("notificationchannel-resource")
+)
+
+// SetupWebhookWithManager - instantiates the Webhook
+func (r *NotificationChannel) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	k8Client = mgr.GetClient()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-nr-k8s-newrelic-com-v1-notificationchannel,mutating=true,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=notificationchannels,verbs=create;update,versions=v1,name=mnotificationchannel.kb.io,sideEffects=None
+
+var _ webhook.Defaulter = &NotificationChannel{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *NotificationChannel) Default() {
+	notificationchannellog.Info("default", "name", r.Name)
+
+	if r.Status.AppliedSpec == nil {
+		notificationchannellog.Info("Setting null Applied Spec to empty interface")
+		r.Status.AppliedSpec = &NotificationChannelSpec{}
+	}
+
+	DefaultAccountRef(&r.Spec.AccountRef)
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-nr-k8s-newrelic-com-v1-notificationchannel,mutating=false,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=notificationchannels,versions=v1,name=vnotificationchannel.kb.io,sideEffects=None
+
+var _ webhook.Validator = &NotificationChannel{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *NotificationChannel) ValidateCreate() error {
+	notificationchannellog.Info("validate create", "name", r.Name)
+
+	return r.ValidateNotificationChannel()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *NotificationChannel) ValidateUpdate(old runtime.Object) error {
+	notificationchannellog.Info("validate update", "name", r.Name)
+
+	collectedErrors := new(customErrors.ErrorCollector)
+
+	if oldChannel, ok := old.(*NotificationChannel); ok {
+		if oldChannel.Spec.Type != r.Spec.Type {
+			collectedErrors.Collect(errors.New("type cannot be changed"))
+		}
+
+		if oldChannel.Spec.DestinationRef != r.Spec.DestinationRef {
+			collectedErrors.Collect(errors.New("destination_ref cannot be changed"))
+		}
+	}
+
+	if err := r.ValidateNotificationChannel(); err != nil {
+		collectedErrors.Collect(err)
+	}
+
+	if len(*collectedErrors) > 0 {
+		return collectedErrors
+	}
+
+	return nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *NotificationChannel) ValidateDelete() error {
+	notificationchannellog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
+
+// ValidateNotificationChannel - Validates create/update of NotificationChannel
+func (r *NotificationChannel) ValidateNotificationChannel() error {
+	collectedErrors := new(customErrors.ErrorCollector)
+
+	if err := r.CheckForAPIKeyOrSecret(); err != nil {
+		collectedErrors.Collect(err)
+	}
+
+	// the region and account of a referenced account are used when the channel does not set them
+	if r.Spec.AccountRef.Name == "" {
+		if !ValidRegion(r.Spec.Region) {
+			collectedErrors.Collect(errors.New("Invalid region set, value was: " + r.Spec.Region))
+		}
+
+		if r.Spec.AccountID == 0 {
+			collectedErrors.Collect(errors.New("account_id must be set"))
+		}
+	}
+
+	if r.Spec.Name == "" {
+		collectedErrors.Collect(errors.New("name must be set"))
+	}
+
+	if r.Spec.Type == "" {
+		collectedErrors.Collect(errors.New("type must be set"))
+	}
+
+	if r.Spec.DestinationRef == "" {
+		collectedErrors.Collect(errors.New("destination_ref must be set"))
+	}
+
+	if err := validateNotificationProperties(r.Spec.Properties); err != nil {
+		collectedErrors.Collect(err)
+	}
+
+	if len(*collectedErrors) > 0 {
+		notificationchannellog.Info("Errors encountered validating notification channel", "collectedErrors", collectedErrors)
+		return collectedErrors
+	}
+
+	return nil
+}
+
+func (r *NotificationChannel) CheckForAPIKeyOrSecret() error {
+	return CheckForAccount(r.Namespace, r.GetAccountSettings())
+}
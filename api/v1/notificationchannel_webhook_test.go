@@ -0,0 +1,54 @@
+package v1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("NotificationChannel_webhook", func() {
+	var r NotificationChannel
+
+	BeforeEach(func() {
+		k8Client = testk8sClient
+		r = NotificationChannel{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ops-webhook",
+				Namespace: "default",
+			},
+			Spec: NotificationChannelSpec{
+				Name:           "ops webhook",
+				Type:           "WEBHOOK",
+				DestinationRef: "ops-webhook",
+				Properties:     []NotificationProperty{{Key: "payload", Value: `{"issue": "{{ issueTitle }}"}`}},
+				APIKey:         "api-key",
+				Region:         "US",
+				AccountID:      1,
+			},
+		}
+	})
+
+	Describe("ValidateCreate", func() {
+		It("accepts a valid channel", func() {
+			Expect(r.ValidateCreate()).To(Succeed())
+		})
+
+		It("requires a destination", func() {
+			r.Spec.DestinationRef = ""
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("destination_ref must be set")))
+		})
+
+		It("requires a key on every property", func() {
+			r.Spec.Properties[0].Key = ""
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("properties[0].key must be set")))
+		})
+	})
+
+	Describe("ValidateUpdate", func() {
+		It("rejects a change of the destination", func() {
+			old := r.DeepCopy()
+			r.Spec.DestinationRef = "other-webhook"
+			Expect(r.ValidateUpdate(old)).To(MatchError(ContainSubstring("destination_ref cannot be changed")))
+		})
+	})
+})
@@ -0,0 +1,137 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/notifications"
+)
+
+// Authentication types of a NotificationDestination
+const (
+	NotificationAuthTypeBasic = notifications.AuthTypeBasic
+	NotificationAuthTypeToken = notifications.AuthTypeToken
+)
+
+// NotificationDestinationSpec defines the desired state of NotificationDestination
+type NotificationDestinationSpec struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Enum=WEBHOOK;EMAIL;PAGERDUTY_SERVICE_INTEGRATION;SERVICE_NOW;JIRA
+	Type         string                       `json:"type"`
+	Properties   []NotificationProperty       `json:"properties,omitempty"`
+	Auth         *NotificationDestinationAuth `json:"auth,omitempty"`
+	APIKey       string                       `json:"api_key,omitempty"`
+	APIKeySecret NewRelicAPIKeySecret         `json:"api_key_secret,omitempty"`
+	AccountRef   NewRelicAccountReference     `json:"account_ref,omitempty"`
+	Region       string                       `json:"region,omitempty"`
+	AccountID    int                          `json:"account_id,omitempty"`
+}
+
+// NotificationProperty - copy of notifications.Property, e.g. the url of a WEBHOOK destination
+type NotificationProperty struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// NotificationDestinationAuth - the credentials a destination authenticates with. The password of BASIC
+// and the token of TOKEN authentication are read from a secret.
+type NotificationDestinationAuth struct {
+	// +kubebuilder:validation:Enum=BASIC;TOKEN
+	Type string `json:"type"`
+	// User is the user of BASIC authentication
+	User string `json:"user,omitempty"`
+	// Prefix is sent in front of the token of TOKEN authentication, e.g. Bearer
+	Prefix string `json:"prefix,omitempty"`
+	Secret string `json:"secret"`
+	// Namespace of the secret, defaults to the namespace of the destination
+	Namespace string `json:"namespace,omitempty"`
+	KeyName   string `json:"key_name"`
+}
+
+// NotificationDestinationStatus defines the observed state of NotificationDestination
+type NotificationDestinationStatus struct {
+	AppliedSpec   *NotificationDestinationSpec `json:"applied_spec,omitempty"`
+	DestinationID string                       `json:"destination_id"`
+	Conditions    []Condition                  `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.destination_id"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+
+// NotificationDestination is the Schema for the notificationdestinations API
+type NotificationDestination struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NotificationDestinationSpec   `json:"spec,omitempty"`
+	Status NotificationDestinationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NotificationDestinationList contains a list of NotificationDestination
+type NotificationDestinationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NotificationDestination `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NotificationDestination{}, &NotificationDestinationList{})
+}
+
+// GetConditions returns the status conditions of the NotificationDestination
+func (in *NotificationDestination) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the NotificationDestination
+func (in *NotificationDestination) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
+// DestinationInput - Converts NotificationDestinationSpec object to notifications.DestinationInput,
+// reading the credentials of the destination from their secret
+func (in NotificationDestinationSpec) DestinationInput(k8sClient client.Client) (notifications.DestinationInput, error) {
+	input := notifications.DestinationInput{
+		Name:       in.Name,
+		Type:       in.Type,
+		Properties: notificationProperties(in.Properties),
+	}
+
+	if in.Auth == nil {
+		return input, nil
+	}
+
+	name := types.NamespacedName{Namespace: in.Auth.Namespace, Name: in.Auth.Secret}
+
+	secret, err := getSecret(name, in.Auth.KeyName, k8sClient)
+	if err != nil {
+		return notifications.DestinationInput{}, err
+	}
+
+	input.Auth = &notifications.AuthInput{Type: in.Auth.Type}
+
+	switch in.Auth.Type {
+	case NotificationAuthTypeBasic:
+		input.Auth.Basic = &notifications.BasicAuthInput{User: in.Auth.User, Password: secret}
+	case NotificationAuthTypeToken:
+		input.Auth.Token = &notifications.TokenAuthInput{Prefix: in.Auth.Prefix, Token: secret}
+	}
+
+	return input, nil
+}
+
+func notificationProperties(properties []NotificationProperty) []notifications.Property {
+	result := make([]notifications.Property, 0, len(properties))
+
+	for _, property := range properties {
+		result = append(result, notifications.Property{Key: property.Key, Value: property.Value})
+	}
+
+	return result
+}
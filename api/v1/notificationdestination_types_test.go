@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/notifications"
+)
+
+var _ = Describe("NotificationDestinationSpec", func() {
+	Describe("DestinationInput", func() {
+		var client *interfacesfakes.MockClient
+		var spec NotificationDestinationSpec
+
+		BeforeEach(func() {
+			ctrl := gomock.NewController(GinkgoT())
+			defer ctrl.Finish()
+			client = interfacesfakes.NewMockClient(ctrl)
+
+			spec = NotificationDestinationSpec{
+				Name:       "ops webhook",
+				Type:       "WEBHOOK",
+				Properties: []NotificationProperty{{Key: "url", Value: "https://example.com/"}},
+			}
+		})
+
+		It("converts the destination without credentials", func() {
+			input, err := spec.DestinationInput(client)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(input).To(Equal(notifications.DestinationInput{
+				Name:       "ops webhook",
+				Type:       "WEBHOOK",
+				Properties: []notifications.Property{{Key: "url", Value: "https://example.com/"}},
+			}))
+		})
+
+		Context("with token authentication", func() {
+			BeforeEach(func() {
+				spec.Auth = &NotificationDestinationAuth{
+					Type:      NotificationAuthTypeToken,
+					Prefix:    "Bearer",
+					Secret:    "webhook-token",
+					Namespace: "default",
+					KeyName:   "token",
+				}
+			})
+
+			It("reads the token from the secret", func() {
+				secret := v1.Secret{
+					Data: map[string][]byte{
+						"token": []byte("don't tell anyone"),
+					},
+				}
+				key := types.NamespacedName{
+					Namespace: "default",
+					Name:      "webhook-token",
+				}
+				client.EXPECT().
+					Get(gomock.Eq(context.Background()),
+						gomock.Eq(key),
+						gomock.AssignableToTypeOf(&secret)).
+					SetArg(2, secret)
+
+				input, err := spec.DestinationInput(client)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(input.Auth.Type).To(Equal(NotificationAuthTypeToken))
+				Expect(input.Auth.Basic).To(BeNil())
+				Expect(input.Auth.Token).To(Equal(&notifications.TokenAuthInput{Prefix: "Bearer", Token: "don't tell anyone"}))
+			})
+
+			It("fails when the secret does not hold the key", func() {
+				client.EXPECT().
+					Get(gomock.Any(), gomock.Any(), gomock.Any()).
+					SetArg(2, v1.Secret{})
+
+				_, err := spec.DestinationInput(client)
+				Expect(err).To(BeAssignableToTypeOf(&SecretKeyNotFoundError{}))
+			})
+		})
+	})
+})
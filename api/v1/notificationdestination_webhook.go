@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	customErrors "github.com/newrelic/newrelic-kubernetes-operator/errors"
+)
+
+// log is for logging in this package.
+var (
+	notificationdestinationlog = logf.Log.WithName("notificationdestination-resource")
+)
+
+// SetupWebhookWithManager - instantiates the Webhook
+func (r *NotificationDestination) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	k8Client = mgr.GetClient()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-nr-k8s-newrelic-com-v1-notificationdestination,mutating=true,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=notificationdestinations,verbs=create;update,versions=v1,name=mnotificationdestination.kb.io,sideEffects=None
+
+var _ webhook.Defaulter = &NotificationDestination{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *NotificationDestination) Default() {
+	notificationdestinationlog.Info("default", "name", r.Name)
+
+	if r.Status.AppliedSpec == nil {
+		notificationdestinationlog.Info("Setting null Applied Spec to empty interface")
+		r.Status.AppliedSpec = &NotificationDestinationSpec{}
+	}
+
+	if r.Spec.Auth != nil && r.Spec.Auth.Namespace == "" {
+		r.Spec.Auth.Namespace = r.Namespace
+	}
+
+	DefaultAccountRef(&r.Spec.AccountRef)
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-nr-k8s-newrelic-com-v1-notificationdestination,mutating=false,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=notificationdestinations,versions=v1,name=vnotificationdestination.kb.io,sideEffects=None
+
+var _ webhook.Validator = &NotificationDestination{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *NotificationDestination) ValidateCreate() error {
+	notificationdestinationlog.Info("validate create", "name", r.Name)
+
+	return r.ValidateNotificationDestination()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *NotificationDestination) ValidateUpdate(old runtime.Object) error {
+	notificationdestinationlog.Info("validate update", "name", r.Name)
+
+	collectedErrors := new(customErrors.ErrorCollector)
+
+	if oldDestination, ok := old.(*NotificationDestination); ok && oldDestination.Spec.Type != r.Spec.Type {
+		collectedErrors.Collect(errors.New("type cannot be changed"))
+	}
+
+	if err := r.ValidateNotificationDestination(); err != nil {
+		collectedErrors.Collect(err)
+	}
+
+	if len(*collectedErrors) > 0 {
+		return collectedErrors
+	}
+
+	return nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *NotificationDestination) ValidateDelete() error {
+	notificationdestinationlog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
+
+// ValidateNotificationDestination - Validates create/update of NotificationDestination
+func (r *NotificationDestination) ValidateNotificationDestination() error {
+	collectedErrors := new(customErrors.ErrorCollector)
+
+	if err := r.CheckForAPIKeyOrSecret(); err != nil {
+		collectedErrors.Collect(err)
+	}
+
+	// the region and account of a referenced account are used when the destination does not set them
+	if r.Spec.AccountRef.Name == "" {
+		if !ValidRegion(r.Spec.Region) {
+			collectedErrors.Collect(errors.New("Invalid region set, value was: " + r.Spec.Region))
+		}
+
+		if r.Spec.AccountID == 0 {
+			collectedErrors.Collect(errors.New("account_id must be set"))
+		}
+	}
+
+	if r.Spec.Name == "" {
+		collectedErrors.Collect(errors.New("name must be set"))
+	}
+
+	if r.Spec.Type == "" {
+		collectedErrors.Collect(errors.New("type must be set"))
+	}
+
+	if err := validateNotificationProperties(r.Spec.Properties); err != nil {
+		collectedErrors.Collect(err)
+	}
+
+	if auth := r.Spec.Auth; auth != nil {
+		switch auth.Type {
+		case NotificationAuthTypeBasic:
+			if auth.User == "" {
+				collectedErrors.Collect(fmt.Errorf("auth.user must be set for %s authentication", auth.Type))
+			}
+		case NotificationAuthTypeToken:
+		default:
+			collectedErrors.Collect(fmt.Errorf("auth.type must be %s or %s", NotificationAuthTypeBasic, NotificationAuthTypeToken))
+		}
+
+		if auth.Secret == "" || auth.KeyName == "" {
+			collectedErrors.Collect(errors.New("auth.secret and auth.key_name must be set"))
+		}
+	}
+
+	if len(*collectedErrors) > 0 {
+		notificationdestinationlog.Info("Errors encountered validating notification destination", "collectedErrors", collectedErrors)
+		return collectedErrors
+	}
+
+	return nil
+}
+
+func (r *NotificationDestination) CheckForAPIKeyOrSecret() error {
+	return CheckForAccount(r.Namespace, r.GetAccountSettings())
+}
+
+// validateNotificationProperties checks that every property of a destination or channel has a key
+func validateNotificationProperties(properties []NotificationProperty) error {
+	for i, property := range properties {
+		if property.Key == "" {
+			return fmt.Errorf("properties[%d].key must be set", i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package v1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("NotificationDestination_webhook", func() {
+	var r NotificationDestination
+
+	BeforeEach(func() {
+		k8Client = testk8sClient
+		r = NotificationDestination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ops-webhook",
+				Namespace: "default",
+			},
+			Spec: NotificationDestinationSpec{
+				Name:       "ops webhook",
+				Type:       "WEBHOOK",
+				Properties: []NotificationProperty{{Key: "url", Value: "https://example.com/"}},
+				Auth: &NotificationDestinationAuth{
+					Type:    NotificationAuthTypeBasic,
+					User:    "ops",
+					Secret:  "webhook-password",
+					KeyName: "password",
+				},
+				APIKey:    "api-key",
+				Region:    "US",
+				AccountID: 1,
+			},
+		}
+	})
+
+	Describe("Default", func() {
+		It("defaults the namespace of the auth secret", func() {
+			r.Default()
+			Expect(r.Spec.Auth.Namespace).To(Equal("default"))
+			Expect(r.Status.AppliedSpec).To(Equal(&NotificationDestinationSpec{}))
+		})
+	})
+
+	Describe("ValidateCreate", func() {
+		It("accepts a valid destination", func() {
+			Expect(r.ValidateCreate()).To(Succeed())
+		})
+
+		It("requires a type", func() {
+			r.Spec.Type = ""
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("type must be set")))
+		})
+
+		It("requires a key on every property", func() {
+			r.Spec.Properties[0].Key = ""
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("properties[0].key must be set")))
+		})
+
+		It("requires the user of basic authentication", func() {
+			r.Spec.Auth.User = ""
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("auth.user must be set for BASIC authentication")))
+		})
+
+		It("requires the secret of the credentials", func() {
+			r.Spec.Auth.KeyName = ""
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("auth.secret and auth.key_name must be set")))
+		})
+
+		It("requires an account", func() {
+			r.Spec.AccountID = 0
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("account_id must be set")))
+		})
+	})
+
+	Describe("ValidateUpdate", func() {
+		It("rejects a change of the type", func() {
+			old := r.DeepCopy()
+			r.Spec.Type = "EMAIL"
+			Expect(r.ValidateUpdate(old)).To(MatchError(ContainSubstring("type cannot be changed")))
+		})
+	})
+})
@@ -43,12 +43,15 @@ type NrqlQuery struct {
 
 // NrqlAlertConditionStatus defines the observed state of NrqlAlertCondition
 type NrqlAlertConditionStatus struct {
-	AppliedSpec *NrqlAlertConditionSpec `json:"applied_spec"`
+	AppliedSpec *NrqlAlertConditionSpec `json:"applied_spec,omitempty"`
 	ConditionID int                     `json:"condition_id"`
+	Conditions  []Condition             `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Created",type="boolean",JSONPath=".status.created"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
 
 // NrqlAlertCondition is the Schema for the nrqlalertconditions API
 type NrqlAlertCondition struct {
@@ -72,6 +75,16 @@ func init() {
 	SchemeBuilder.Register(&NrqlAlertCondition{}, &NrqlAlertConditionList{})
 }
 
+// GetConditions returns the status conditions of the NrqlAlertCondition
+func (in *NrqlAlertCondition) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the NrqlAlertCondition
+func (in *NrqlAlertCondition) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
 func (in NrqlAlertConditionSpec) APICondition() alerts.NrqlCondition {
 	jsonString, _ := json.Marshal(in)
 	var APICondition alerts.NrqlCondition
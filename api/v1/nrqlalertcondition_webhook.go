@@ -67,7 +67,6 @@ var _ webhook.Validator = &NrqlAlertCondition{}
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (r *NrqlAlertCondition) ValidateCreate() error {
 	log.Info("validate create", "name", r.Name)
-	//TODO this should write this value TO a new secret so code path always reads from a secret
 	err := r.CheckForAPIKeyOrSecret()
 	if err != nil {
 		return err
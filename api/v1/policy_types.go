@@ -53,11 +53,14 @@ type ConditionSpec struct {
 
 // PolicyStatus defines the observed state of Policy
 type PolicyStatus struct {
-	AppliedSpec *PolicySpec `json:"applied_spec"`
+	AppliedSpec *PolicySpec `json:"applied_spec,omitempty"`
 	PolicyID    int         `json:"policy_id"`
+	Conditions  []Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
 
 // Policy is the Schema for the policies API
 type Policy struct {
@@ -81,6 +84,16 @@ func init() {
 	SchemeBuilder.Register(&Policy{}, &PolicyList{})
 }
 
+// GetConditions returns the status conditions of the Policy
+func (in *Policy) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the Policy
+func (in *Policy) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
 func (in PolicySpec) APIPolicy() alerts.Policy {
 	jsonString, _ := json.Marshal(in)
 	var APIPolicy alerts.Policy
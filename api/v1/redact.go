@@ -0,0 +1,152 @@
+package v1
+
+import (
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/redact"
+)
+
+// The specs are stored as the applied spec of their status with credentials redacted. Comparing the
+// redacted spec with the applied spec still detects changed credentials, as redacted values differ.
+
+// Redacted returns a copy of the AlertsAPMConditionSpec without credentials
+func (in *AlertsAPMConditionSpec) Redacted() *AlertsAPMConditionSpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
+
+// Redacted returns a copy of the AlertsChannelSpec without credentials
+func (in *AlertsChannelSpec) Redacted() *AlertsChannelSpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
+
+// Redacted returns a copy of the AlertsExternalServiceConditionSpec without credentials
+func (in *AlertsExternalServiceConditionSpec) Redacted() *AlertsExternalServiceConditionSpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
+
+// Redacted returns a copy of the AlertsInfraConditionSpec without credentials
+func (in *AlertsInfraConditionSpec) Redacted() *AlertsInfraConditionSpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
+
+// Redacted returns a copy of the AlertsMutingRuleSpec without credentials
+func (in *AlertsMutingRuleSpec) Redacted() *AlertsMutingRuleSpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
+
+// Redacted returns a copy of the AlertsNrqlConditionSpec without credentials
+func (in *AlertsNrqlConditionSpec) Redacted() *AlertsNrqlConditionSpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
+
+// Redacted returns a copy of the AlertsPolicySpec without credentials
+func (in *AlertsPolicySpec) Redacted() *AlertsPolicySpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
+
+// Redacted returns a copy of the AlertsSyntheticsConditionSpec without credentials
+func (in *AlertsSyntheticsConditionSpec) Redacted() *AlertsSyntheticsConditionSpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
+
+// Redacted returns a copy of the ApmAlertConditionSpec without credentials
+func (in *ApmAlertConditionSpec) Redacted() *ApmAlertConditionSpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
+
+// Redacted returns a copy of the BurnRateAlertSpec without credentials
+func (in *BurnRateAlertSpec) Redacted() *BurnRateAlertSpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
+
+// Redacted returns a copy of the DashboardSpec without credentials
+func (in *DashboardSpec) Redacted() *DashboardSpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
+
+// Redacted returns a copy of the NotificationChannelSpec without credentials
+func (in *NotificationChannelSpec) Redacted() *NotificationChannelSpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
+
+// Redacted returns a copy of the NotificationDestinationSpec without credentials
+func (in *NotificationDestinationSpec) Redacted() *NotificationDestinationSpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
+
+// Redacted returns a copy of the NrqlAlertConditionSpec without credentials
+func (in *NrqlAlertConditionSpec) Redacted() *NrqlAlertConditionSpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
+
+// Redacted returns a copy of the PolicySpec without credentials
+func (in *PolicySpec) Redacted() *PolicySpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
+
+// Redacted returns a copy of the ServiceLevelSpec without credentials
+func (in *ServiceLevelSpec) Redacted() *ServiceLevelSpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
+
+// Redacted returns a copy of the SyntheticsMonitorSpec without credentials
+func (in *SyntheticsMonitorSpec) Redacted() *SyntheticsMonitorSpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
+
+// Redacted returns a copy of the WorkflowSpec without credentials
+func (in *WorkflowSpec) Redacted() *WorkflowSpec {
+	out := in.DeepCopy()
+	redact.Secrets(out)
+
+	return out
+}
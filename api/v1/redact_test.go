@@ -0,0 +1,49 @@
+package v1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/redact"
+)
+
+var _ = Describe("Redacted", func() {
+	var spec AlertsChannelSpec
+
+	BeforeEach(func() {
+		spec = AlertsChannelSpec{
+			Name:   "pager",
+			Type:   "pagerduty",
+			APIKey: "NRAK-123456",
+			Configuration: AlertsChannelConfiguration{
+				ServiceKey: "service-key",
+				Headers:    []ChannelHeader{{Name: "X-Team", Value: "team-token"}},
+			},
+		}
+	})
+
+	It("redacts the credentials of the spec", func() {
+		redacted := spec.Redacted()
+
+		Expect(redacted.Name).To(Equal("pager"))
+		Expect(redacted.APIKey).To(Equal(redact.String("NRAK-123456")))
+		Expect(redacted.Configuration.ServiceKey).To(Equal(redact.String("service-key")))
+		Expect(redacted.Configuration.Headers[0].Name).To(Equal("X-Team"))
+		Expect(redacted.Configuration.Headers[0].Value).To(Equal(redact.String("team-token")))
+	})
+
+	It("leaves the spec unchanged", func() {
+		spec.Redacted()
+
+		Expect(spec.APIKey).To(Equal("NRAK-123456"))
+		Expect(spec.Configuration.Headers[0].Value).To(Equal("team-token"))
+	})
+
+	It("differs when a credential changes", func() {
+		redacted := spec.Redacted()
+		spec.Configuration.ServiceKey = "rotated-service-key"
+
+		Expect(spec.Redacted()).NotTo(Equal(redacted))
+		Expect(spec.Redacted().Redacted()).To(Equal(spec.Redacted()))
+	})
+})
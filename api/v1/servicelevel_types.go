@@ -0,0 +1,189 @@
+package v1
+
+import (
+	"fmt"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/servicelevels"
+)
+
+// ServiceLevelSpec defines the desired state of ServiceLevel
+type ServiceLevelSpec struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// EntityGUID is the GUID of the entity the service level belongs to
+	EntityGUID string `json:"entity_guid,omitempty"`
+	// APMApplicationName is the name of the APM application the service level belongs to, it is
+	// looked up in the account when entity_guid is not set
+	APMApplicationName string                  `json:"apm_application_name,omitempty"`
+	Events             ServiceLevelEvents      `json:"events"`
+	Objectives         []ServiceLevelObjective `json:"objectives"`
+	// BurnRateAlerts generates AlertsNrqlConditions alerting on the error budget of the first objective
+	BurnRateAlerts *ServiceLevelBurnRateAlerts `json:"burn_rate_alerts,omitempty"`
+	APIKey         string                      `json:"api_key,omitempty"`
+	APIKeySecret   NewRelicAPIKeySecret        `json:"api_key_secret,omitempty"`
+	AccountRef     NewRelicAccountReference    `json:"account_ref,omitempty"`
+	Region         string                      `json:"region,omitempty"`
+	AccountID      int                         `json:"account_id,omitempty"`
+}
+
+// ServiceLevelEvents are the events the service level indicator is calculated from. Either the good or
+// the bad events are set.
+type ServiceLevelEvents struct {
+	ValidEvents ServiceLevelEventsQuery  `json:"valid_events"`
+	GoodEvents  *ServiceLevelEventsQuery `json:"good_events,omitempty"`
+	BadEvents   *ServiceLevelEventsQuery `json:"bad_events,omitempty"`
+}
+
+// ServiceLevelEventsQuery selects events with the FROM and WHERE clauses of a NRQL query
+type ServiceLevelEventsQuery struct {
+	// From is the event type, e.g. Transaction
+	From  string `json:"from"`
+	Where string `json:"where,omitempty"`
+}
+
+// ServiceLevelObjective is the percentage of good events the service level aims for
+type ServiceLevelObjective struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Target is the percentage of good events, e.g. "99.5"
+	Target string `json:"target"`
+	// +kubebuilder:validation:Enum=1;7;28
+	TimeWindowDays int `json:"time_window_days"`
+}
+
+// ServiceLevelBurnRateAlerts adds conditions alerting on the error budget of the service level to an AlertsPolicy
+type ServiceLevelBurnRateAlerts struct {
+	// AlertsPolicyRef is the name of an AlertsPolicy in the same namespace
+	AlertsPolicyRef string `json:"alerts_policy_ref"`
+	// BurnRates are the conditions generated for the policy, DefaultBurnRates are used when none are set
+	BurnRates []BurnRate `json:"burn_rates,omitempty"`
+}
+
+// ServiceLevelStatus defines the observed state of ServiceLevel
+type ServiceLevelStatus struct {
+	AppliedSpec *ServiceLevelSpec `json:"applied_spec,omitempty"`
+	// EntityGUID is the entity the service level has been created on
+	EntityGUID string      `json:"entity_guid,omitempty"`
+	SLIID      string      `json:"sli_id,omitempty"`
+	SLIGUID    string      `json:"sli_guid,omitempty"`
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="SLI GUID",type="string",JSONPath=".status.sli_guid"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+
+// ServiceLevel is the Schema for the servicelevels API
+type ServiceLevel struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceLevelSpec   `json:"spec,omitempty"`
+	Status ServiceLevelStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceLevelList contains a list of ServiceLevel
+type ServiceLevelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceLevel `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ServiceLevel{}, &ServiceLevelList{})
+}
+
+// GetConditions returns the status conditions of the ServiceLevel
+func (in *ServiceLevel) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the ServiceLevel
+func (in *ServiceLevel) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
+// IndicatorInput - Converts ServiceLevelSpec object to servicelevels.IndicatorInput. The events are
+// queried from accountID. Targets are validated by the webhook, targets that are not numbers are sent as 0.
+func (in ServiceLevelSpec) IndicatorInput(accountID int) servicelevels.IndicatorInput {
+	input := servicelevels.IndicatorInput{
+		Name:        in.Name,
+		Description: in.Description,
+		Events: servicelevels.EventsInput{
+			AccountID:   accountID,
+			ValidEvents: in.Events.ValidEvents.eventsQueryInput(),
+			GoodEvents:  in.Events.GoodEvents.eventsQueryInputOrNil(),
+			BadEvents:   in.Events.BadEvents.eventsQueryInputOrNil(),
+		},
+		Objectives: make([]servicelevels.ObjectiveInput, 0, len(in.Objectives)),
+	}
+
+	for _, objective := range in.Objectives {
+		target, _ := strconv.ParseFloat(objective.Target, 64)
+
+		input.Objectives = append(input.Objectives, servicelevels.ObjectiveInput{
+			Name:        objective.Name,
+			Description: objective.Description,
+			Target:      target,
+			TimeWindow: servicelevels.TimeWindowInput{
+				Rolling: servicelevels.RollingTimeWindowInput{Count: objective.TimeWindowDays, Unit: servicelevels.TimeWindowUnitDay},
+			},
+		})
+	}
+
+	return input
+}
+
+func (in ServiceLevelEventsQuery) eventsQueryInput() servicelevels.EventsQueryInput {
+	return servicelevels.EventsQueryInput{From: in.From, Where: in.Where}
+}
+
+func (in *ServiceLevelEventsQuery) eventsQueryInputOrNil() *servicelevels.EventsQueryInput {
+	if in == nil {
+		return nil
+	}
+
+	query := in.eventsQueryInput()
+
+	return &query
+}
+
+// GetBurnRates returns the burn rates of the generated alert conditions, the default burn rates follow
+// the time window of the objective the conditions alert on
+func (in ServiceLevelBurnRateAlerts) GetBurnRates(timeWindowDays int) []BurnRate {
+	if len(in.BurnRates) == 0 {
+		return DefaultBurnRates(timeWindowDays)
+	}
+
+	return in.BurnRates
+}
+
+// BurnRateConditionSpecs returns the AlertsNrqlConditions alerting on the error budget of the first
+// objective of the service level indicator sliGUID, keyed by the name of their burn rate
+func (in ServiceLevelSpec) BurnRateConditionSpecs(sliGUID string) map[string]AlertsNrqlConditionSpec {
+	specs := map[string]AlertsNrqlConditionSpec{}
+
+	if in.BurnRateAlerts == nil || len(in.Objectives) == 0 {
+		return specs
+	}
+
+	target, _ := strconv.ParseFloat(in.Objectives[0].Target, 64)
+
+	// the percentage of bad events reported in the metrics New Relic records for the indicator
+	query := fmt.Sprintf("FROM Metric SELECT 100 - clamp_max(sum(newrelic.sli.good) / sum(newrelic.sli.valid) * 100, 100) WHERE sli.guid = '%s'", sliGUID)
+	if in.Events.BadEvents != nil {
+		query = fmt.Sprintf("FROM Metric SELECT clamp_max(sum(newrelic.sli.bad) / sum(newrelic.sli.valid) * 100, 100) WHERE sli.guid = '%s'", sliGUID)
+	}
+
+	for _, burnRate := range in.BurnRateAlerts.GetBurnRates(in.Objectives[0].TimeWindowDays) {
+		specs[burnRate.Name] = BurnRateConditionSpec(in.Name, query, target, burnRate)
+	}
+
+	return specs
+}
@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/servicelevels"
+)
+
+var _ = Describe("ServiceLevelSpec", func() {
+	var spec ServiceLevelSpec
+
+	BeforeEach(func() {
+		spec = ServiceLevelSpec{
+			Name: "checkout latency",
+			Events: ServiceLevelEvents{
+				ValidEvents: ServiceLevelEventsQuery{From: "Transaction", Where: "appName = 'checkout'"},
+				GoodEvents:  &ServiceLevelEventsQuery{From: "Transaction", Where: "appName = 'checkout' AND duration < 0.5"},
+			},
+			Objectives: []ServiceLevelObjective{
+				{Target: "99.5", TimeWindowDays: 7},
+			},
+		}
+	})
+
+	Describe("IndicatorInput", func() {
+		It("converts the events and objectives", func() {
+			Expect(spec.IndicatorInput(1)).To(Equal(servicelevels.IndicatorInput{
+				Name: "checkout latency",
+				Events: servicelevels.EventsInput{
+					AccountID:   1,
+					ValidEvents: servicelevels.EventsQueryInput{From: "Transaction", Where: "appName = 'checkout'"},
+					GoodEvents:  &servicelevels.EventsQueryInput{From: "Transaction", Where: "appName = 'checkout' AND duration < 0.5"},
+				},
+				Objectives: []servicelevels.ObjectiveInput{
+					{
+						Target: 99.5,
+						TimeWindow: servicelevels.TimeWindowInput{
+							Rolling: servicelevels.RollingTimeWindowInput{Count: 7, Unit: servicelevels.TimeWindowUnitDay},
+						},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("BurnRateConditionSpecs", func() {
+		It("returns no conditions without burn rate alerts", func() {
+			Expect(spec.BurnRateConditionSpecs("sli-guid")).To(BeEmpty())
+		})
+
+		Context("with burn rate alerts", func() {
+			BeforeEach(func() {
+				spec.BurnRateAlerts = &ServiceLevelBurnRateAlerts{AlertsPolicyRef: "checkout"}
+			})
+
+			It("uses the default burn rates of the time window", func() {
+				specs := spec.BurnRateConditionSpecs("sli-guid")
+
+				Expect(specs).To(HaveLen(2))
+				Expect(specs["fast-burn"].Name).To(Equal("checkout latency fast-burn"))
+				Expect(specs["fast-burn"].Terms[0].Threshold).To(Equal("1.68"))
+				Expect(specs["fast-burn"].Terms[0].Priority).To(Equal(alerts.NrqlConditionPriorities.Critical))
+				Expect(specs["slow-burn"].Terms[0].Threshold).To(Equal("0.7"))
+				Expect(specs["slow-burn"].Terms[0].Priority).To(Equal(alerts.NrqlConditionPriorities.Warning))
+			})
+
+			It("pairs the long window of each burn rate with a short window", func() {
+				specs := spec.BurnRateConditionSpecs("sli-guid")
+
+				Expect(*specs["fast-burn"].Signal.AggregationWindow).To(Equal(300))
+				Expect(specs["fast-burn"].Signal.SlideBy).To(BeNil())
+				Expect(specs["fast-burn"].Terms[0].ThresholdDuration).To(Equal(3600))
+				Expect(specs["fast-burn"].Terms[0].ThresholdOccurrences).To(Equal(alerts.ThresholdOccurrences.All))
+				Expect(*specs["slow-burn"].Signal.AggregationWindow).To(Equal(1800))
+				Expect(specs["slow-burn"].Terms[0].ThresholdDuration).To(Equal(21600))
+				Expect(specs["slow-burn"].Terms[0].ThresholdOccurrences).To(Equal(alerts.ThresholdOccurrences.All))
+			})
+
+			It("queries the good events of the indicator", func() {
+				specs := spec.BurnRateConditionSpecs("sli-guid")
+				Expect(specs["fast-burn"].Nrql.Query).To(ContainSubstring("sum(newrelic.sli.good)"))
+				Expect(specs["fast-burn"].Nrql.Query).To(ContainSubstring("WHERE sli.guid = 'sli-guid'"))
+			})
+
+			It("queries the bad events of the indicator", func() {
+				spec.Events.GoodEvents = nil
+				spec.Events.BadEvents = &ServiceLevelEventsQuery{From: "TransactionError"}
+
+				specs := spec.BurnRateConditionSpecs("sli-guid")
+				Expect(specs["fast-burn"].Nrql.Query).To(ContainSubstring("sum(newrelic.sli.bad)"))
+			})
+
+			It("uses the configured burn rates", func() {
+				spec.BurnRateAlerts.BurnRates = []BurnRate{{Name: "page", Rate: "10", WindowMinutes: 5}}
+
+				specs := spec.BurnRateConditionSpecs("sli-guid")
+				Expect(specs).To(HaveLen(1))
+				Expect(specs["page"].Terms[0].Threshold).To(Equal("5"))
+				Expect(specs["page"].Terms[0].ThresholdDuration).To(Equal(300))
+				Expect(*specs["page"].Signal.AggregationWindow).To(Equal(60))
+			})
+
+			It("uses the configured short window", func() {
+				spec.BurnRateAlerts.BurnRates = []BurnRate{{Name: "page", Rate: "10", WindowMinutes: 60, ShortWindowMinutes: 10}}
+
+				specs := spec.BurnRateConditionSpecs("sli-guid")
+				Expect(*specs["page"].Signal.AggregationWindow).To(Equal(600))
+				Expect(specs["page"].Terms[0].ThresholdDuration).To(Equal(3600))
+			})
+		})
+	})
+})
+
+var _ = Describe("CheckBurnRates", func() {
+	It("accepts the default burn rates", func() {
+		Expect(CheckBurnRates(DefaultBurnRates(30))).To(BeEmpty())
+	})
+
+	It("rejects duplicate names", func() {
+		invalid := CheckBurnRates([]BurnRate{
+			{Name: "page", Rate: "10", WindowMinutes: 5},
+			{Name: "page", Rate: "2", WindowMinutes: 60},
+		})
+		Expect(invalid).To(Equal(InvalidAttributeSlice{{attribute: "burn_rates.name", value: "page"}}))
+	})
+
+	It("rejects rates and windows that are not positive", func() {
+		invalid := CheckBurnRates([]BurnRate{{Name: "page", Rate: "fast", WindowMinutes: 0}})
+		Expect(invalid).To(HaveLen(2))
+	})
+
+	It("rejects long windows over a day", func() {
+		invalid := CheckBurnRates([]BurnRate{{Name: "page", Rate: "1", WindowMinutes: 2880}})
+		Expect(invalid).To(Equal(InvalidAttributeSlice{{attribute: "burn_rates.window_minutes", value: "2880"}}))
+	})
+
+	It("rejects short windows the long window is not a multiple of", func() {
+		invalid := CheckBurnRates([]BurnRate{{Name: "page", Rate: "1", WindowMinutes: 60, ShortWindowMinutes: 7}})
+		Expect(invalid).To(Equal(InvalidAttributeSlice{{attribute: "burn_rates.short_window_minutes", value: "7"}}))
+	})
+
+	It("rejects short windows over 6 hours", func() {
+		invalid := CheckBurnRates([]BurnRate{{Name: "page", Rate: "1", WindowMinutes: 1440, ShortWindowMinutes: 720}})
+		Expect(invalid).To(Equal(InvalidAttributeSlice{{attribute: "burn_rates.short_window_minutes", value: "720"}}))
+	})
+})
+
+var _ = Describe("BurnRate", func() {
+	It("defaults the short window to a twelfth of the long window", func() {
+		Expect(BurnRate{WindowMinutes: 60}.GetShortWindowMinutes()).To(Equal(5))
+		Expect(BurnRate{WindowMinutes: 360}.GetShortWindowMinutes()).To(Equal(30))
+		Expect(BurnRate{WindowMinutes: 1440}.GetShortWindowMinutes()).To(Equal(120))
+	})
+
+	It("defaults the short window to a window the long window is a multiple of", func() {
+		Expect(BurnRate{WindowMinutes: 100}.GetShortWindowMinutes()).To(Equal(5))
+		Expect(BurnRate{WindowMinutes: 5}.GetShortWindowMinutes()).To(Equal(1))
+	})
+})
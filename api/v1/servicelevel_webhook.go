@@ -0,0 +1,160 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	customErrors "github.com/newrelic/newrelic-kubernetes-operator/errors"
+)
+
+// log is for logging in this package.
+var (
+	servicelevellog = logf.Log.WithName("servicelevel-resource")
+)
+
+// SetupWebhookWithManager - instantiates the Webhook
+func (r *ServiceLevel) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	k8Client = mgr.GetClient()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-nr-k8s-newrelic-com-v1-servicelevel,mutating=true,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=servicelevels,verbs=create;update,versions=v1,name=mservicelevel.kb.io,sideEffects=None
+
+var _ webhook.Defaulter = &ServiceLevel{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *ServiceLevel) Default() {
+	servicelevellog.Info("default", "name", r.Name)
+
+	if r.Status.AppliedSpec == nil {
+		servicelevellog.Info("Setting null Applied Spec to empty interface")
+		r.Status.AppliedSpec = &ServiceLevelSpec{}
+	}
+
+	DefaultAccountRef(&r.Spec.AccountRef)
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-nr-k8s-newrelic-com-v1-servicelevel,mutating=false,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=servicelevels,versions=v1,name=vservicelevel.kb.io,sideEffects=None
+
+var _ webhook.Validator = &ServiceLevel{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ServiceLevel) ValidateCreate() error {
+	servicelevellog.Info("validate create", "name", r.Name)
+
+	return r.ValidateServiceLevel()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ServiceLevel) ValidateUpdate(old runtime.Object) error {
+	servicelevellog.Info("validate update", "name", r.Name)
+
+	collectedErrors := new(customErrors.ErrorCollector)
+
+	// a service level can't be moved to another entity, it has to be deleted and created again
+	if oldServiceLevel, ok := old.(*ServiceLevel); ok &&
+		(oldServiceLevel.Spec.EntityGUID != r.Spec.EntityGUID || oldServiceLevel.Spec.APMApplicationName != r.Spec.APMApplicationName) {
+		collectedErrors.Collect(errors.New("entity_guid and apm_application_name cannot be changed"))
+	}
+
+	if err := r.ValidateServiceLevel(); err != nil {
+		collectedErrors.Collect(err)
+	}
+
+	if len(*collectedErrors) > 0 {
+		return collectedErrors
+	}
+
+	return nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ServiceLevel) ValidateDelete() error {
+	servicelevellog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
+
+// ValidateServiceLevel - Validates create/update of ServiceLevel
+func (r *ServiceLevel) ValidateServiceLevel() error {
+	collectedErrors := new(customErrors.ErrorCollector)
+
+	if err := r.CheckForAPIKeyOrSecret(); err != nil {
+		collectedErrors.Collect(err)
+	}
+
+	// the region and account of a referenced account are used when the service level does not set them
+	if r.Spec.AccountRef.Name == "" {
+		if !ValidRegion(r.Spec.Region) {
+			collectedErrors.Collect(errors.New("Invalid region set, value was: " + r.Spec.Region))
+		}
+
+		if r.Spec.AccountID == 0 {
+			collectedErrors.Collect(errors.New("account_id must be set"))
+		}
+	}
+
+	if r.Spec.Name == "" {
+		collectedErrors.Collect(errors.New("name must be set"))
+	}
+
+	if (r.Spec.EntityGUID == "") == (r.Spec.APMApplicationName == "") {
+		collectedErrors.Collect(errors.New("either entity_guid or apm_application_name must be set"))
+	}
+
+	if r.Spec.Events.ValidEvents.From == "" {
+		collectedErrors.Collect(errors.New("events.valid_events.from must be set"))
+	}
+
+	if (r.Spec.Events.GoodEvents == nil) == (r.Spec.Events.BadEvents == nil) {
+		collectedErrors.Collect(errors.New("either events.good_events or events.bad_events must be set"))
+	} else if (r.Spec.Events.GoodEvents != nil && r.Spec.Events.GoodEvents.From == "") ||
+		(r.Spec.Events.BadEvents != nil && r.Spec.Events.BadEvents.From == "") {
+		collectedErrors.Collect(errors.New("from must be set for the good or bad events"))
+	}
+
+	if len(r.Spec.Objectives) == 0 {
+		collectedErrors.Collect(errors.New("at least one objective must be set"))
+	}
+
+	for i, objective := range r.Spec.Objectives {
+		if target, err := strconv.ParseFloat(objective.Target, 64); err != nil || target <= 0 || target >= 100 {
+			collectedErrors.Collect(fmt.Errorf("objectives[%d].target must be a percentage between 0 and 100, value was: %s", i, objective.Target))
+		}
+
+		switch objective.TimeWindowDays {
+		case 1, 7, 28:
+		default:
+			collectedErrors.Collect(fmt.Errorf("objectives[%d].time_window_days must be 1, 7 or 28", i))
+		}
+	}
+
+	if alerts := r.Spec.BurnRateAlerts; alerts != nil {
+		if alerts.AlertsPolicyRef == "" {
+			collectedErrors.Collect(errors.New("burn_rate_alerts.alerts_policy_ref must be set"))
+		}
+
+		if invalidAttributes := CheckBurnRates(alerts.BurnRates); len(invalidAttributes) > 0 {
+			collectedErrors.Collect(errors.New("error with invalid attributes: \n" + invalidAttributes.errorString()))
+		}
+	}
+
+	if len(*collectedErrors) > 0 {
+		servicelevellog.Info("Errors encountered validating service level", "collectedErrors", collectedErrors)
+		return collectedErrors
+	}
+
+	return nil
+}
+
+func (r *ServiceLevel) CheckForAPIKeyOrSecret() error {
+	return CheckForAccount(r.Namespace, r.GetAccountSettings())
+}
@@ -0,0 +1,98 @@
+package v1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ServiceLevel_webhook", func() {
+	var r ServiceLevel
+
+	BeforeEach(func() {
+		k8Client = testk8sClient
+		r = ServiceLevel{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "checkout-latency",
+				Namespace: "default",
+			},
+			Spec: ServiceLevelSpec{
+				Name:       "checkout latency",
+				EntityGUID: "entity-guid",
+				Events: ServiceLevelEvents{
+					ValidEvents: ServiceLevelEventsQuery{From: "Transaction"},
+					GoodEvents:  &ServiceLevelEventsQuery{From: "Transaction", Where: "duration < 0.5"},
+				},
+				Objectives: []ServiceLevelObjective{{Target: "99.5", TimeWindowDays: 7}},
+				APIKey:     "api-key",
+				Region:     "US",
+				AccountID:  1,
+			},
+		}
+	})
+
+	Describe("Default", func() {
+		It("sets an empty applied spec", func() {
+			r.Default()
+			Expect(r.Status.AppliedSpec).To(Equal(&ServiceLevelSpec{}))
+		})
+	})
+
+	Describe("ValidateCreate", func() {
+		It("accepts a valid service level", func() {
+			Expect(r.ValidateCreate()).To(Succeed())
+		})
+
+		It("requires an entity", func() {
+			r.Spec.EntityGUID = ""
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("either entity_guid or apm_application_name must be set")))
+		})
+
+		It("rejects an entity GUID together with an APM application", func() {
+			r.Spec.APMApplicationName = "checkout"
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("either entity_guid or apm_application_name must be set")))
+		})
+
+		It("requires either good or bad events", func() {
+			r.Spec.Events.BadEvents = &ServiceLevelEventsQuery{From: "TransactionError"}
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("either events.good_events or events.bad_events must be set")))
+		})
+
+		It("requires an objective", func() {
+			r.Spec.Objectives = nil
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("at least one objective must be set")))
+		})
+
+		It("rejects a target that is not a percentage", func() {
+			r.Spec.Objectives[0].Target = "100"
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("objectives[0].target must be a percentage")))
+		})
+
+		It("requires the policy of the burn rate alerts", func() {
+			r.Spec.BurnRateAlerts = &ServiceLevelBurnRateAlerts{}
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("burn_rate_alerts.alerts_policy_ref must be set")))
+		})
+
+		It("rejects invalid burn rates", func() {
+			r.Spec.BurnRateAlerts = &ServiceLevelBurnRateAlerts{
+				AlertsPolicyRef: "checkout",
+				BurnRates:       []BurnRate{{Name: "page", Rate: "0", WindowMinutes: 5}},
+			}
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("burn_rates.rate")))
+		})
+	})
+
+	Describe("ValidateUpdate", func() {
+		It("rejects a change of the entity", func() {
+			old := r.DeepCopy()
+			r.Spec.EntityGUID = "other-entity-guid"
+			Expect(r.ValidateUpdate(old)).To(MatchError(ContainSubstring("entity_guid and apm_application_name cannot be changed")))
+		})
+
+		It("accepts a change of the objectives", func() {
+			old := r.DeepCopy()
+			r.Spec.Objectives[0].Target = "99.9"
+			Expect(r.ValidateUpdate(old)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,188 @@
+package v1
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SyntheticsMonitorSpec defines the desired state of SyntheticsMonitor
+type SyntheticsMonitorSpec struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Enum=SIMPLE;BROWSER;SCRIPT_API
+	Type synthetics.MonitorType `json:"type"`
+	// Frequency is the number of minutes between checks
+	Frequency uint     `json:"frequency"`
+	URI       string   `json:"uri,omitempty"`
+	Locations []string `json:"locations"`
+	// +kubebuilder:validation:Enum=ENABLED;MUTED;DISABLED
+	Status       synthetics.MonitorStatusType `json:"status,omitempty"`
+	SLAThreshold string                       `json:"sla_threshold,omitempty"`
+	Options      SyntheticsMonitorOptions     `json:"options,omitempty"`
+	// Script is the plain text script of a SCRIPT_API monitor
+	Script       string                   `json:"script,omitempty"`
+	APIKey       string                   `json:"api_key,omitempty"`
+	APIKeySecret NewRelicAPIKeySecret     `json:"api_key_secret,omitempty"`
+	AccountRef   NewRelicAccountReference `json:"account_ref,omitempty"`
+	Region       string                   `json:"region,omitempty"`
+	// AccountID is the account SIMPLE monitors are created in through NerdGraph, it defaults to the
+	// account of account_ref
+	AccountID int `json:"account_id,omitempty"`
+	// AlertsPolicyRef is the name of an AlertsPolicy in the same namespace. A synthetics
+	// condition for the monitor is added to the policy once it exists in New Relic.
+	AlertsPolicyRef string `json:"alerts_policy_ref,omitempty"`
+}
+
+// SyntheticsMonitorOptions - copy of synthetics.MonitorOptions
+type SyntheticsMonitorOptions struct {
+	ValidationString       string `json:"validation_string,omitempty"`
+	VerifySSL              bool   `json:"verify_ssl,omitempty"`
+	BypassHEADRequest      bool   `json:"bypass_head_request,omitempty"`
+	TreatRedirectAsFailure bool   `json:"treat_redirect_as_failure,omitempty"`
+}
+
+// SyntheticsMonitorStatus defines the observed state of SyntheticsMonitor
+type SyntheticsMonitorStatus struct {
+	AppliedSpec *SyntheticsMonitorSpec `json:"applied_spec,omitempty"`
+	MonitorID   string                 `json:"monitor_id"`
+	// AlertsPolicyID and AlertsConditionID identify the synthetics condition created for alerts_policy_ref
+	AlertsPolicyID    string      `json:"alerts_policy_id,omitempty"`
+	AlertsConditionID int         `json:"alerts_condition_id,omitempty"`
+	Conditions        []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Monitor ID",type="string",JSONPath=".status.monitor_id"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+
+// SyntheticsMonitor is the Schema for the syntheticsmonitors API
+type SyntheticsMonitor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SyntheticsMonitorSpec   `json:"spec,omitempty"`
+	Status SyntheticsMonitorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SyntheticsMonitorList contains a list of SyntheticsMonitor
+type SyntheticsMonitorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SyntheticsMonitor `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SyntheticsMonitor{}, &SyntheticsMonitorList{})
+}
+
+// GetConditions returns the status conditions of the SyntheticsMonitor
+func (in *SyntheticsMonitor) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the SyntheticsMonitor
+func (in *SyntheticsMonitor) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
+// IsScripted returns true if the monitor runs a script instead of checking a URI
+func (in SyntheticsMonitorSpec) IsScripted() bool {
+	return in.Type == synthetics.MonitorTypes.APITest
+}
+
+// ToMonitor - Converts SyntheticsMonitorSpec object to synthetics.Monitor
+func (in SyntheticsMonitorSpec) ToMonitor() synthetics.Monitor {
+	monitor := synthetics.Monitor{
+		Name:      in.Name,
+		Type:      in.Type,
+		Frequency: in.Frequency,
+		URI:       in.URI,
+		Locations: in.Locations,
+		Status:    in.Status,
+		Options: synthetics.MonitorOptions{
+			ValidationString:       in.Options.ValidationString,
+			VerifySSL:              in.Options.VerifySSL,
+			BypassHEADRequest:      in.Options.BypassHEADRequest,
+			TreatRedirectAsFailure: in.Options.TreatRedirectAsFailure,
+		},
+	}
+
+	if in.SLAThreshold != "" {
+		f, err := strconv.ParseFloat(in.SLAThreshold, 64)
+		if err != nil {
+			syntheticsmonitorlog.Error(err, "strconv.ParseFloat()", "sla_threshold", in.SLAThreshold)
+		}
+
+		monitor.SLAThreshold = f
+	}
+
+	return monitor
+}
+
+// ToMonitorScript - Converts the script of a SCRIPT_API monitor to the base64 encoded synthetics.MonitorScript
+func (in SyntheticsMonitorSpec) ToMonitorScript() synthetics.MonitorScript {
+	return synthetics.MonitorScript{
+		Text: base64.StdEncoding.EncodeToString([]byte(in.Script)),
+	}
+}
+
+// Diff lists the fields of the monitor in New Relic that no longer match the spec.
+// remoteScript is only compared for scripted monitors and may be nil otherwise.
+func (in SyntheticsMonitorSpec) Diff(remote synthetics.Monitor, remoteScript *synthetics.MonitorScript) []string {
+	desired := in.ToMonitor()
+	differences := []string{}
+
+	if desired.Name != remote.Name {
+		differences = append(differences, fmt.Sprintf("name is %q, expected %q", remote.Name, desired.Name))
+	}
+
+	if desired.Type != remote.Type {
+		differences = append(differences, fmt.Sprintf("type is %q, expected %q", remote.Type, desired.Type))
+	}
+
+	if desired.Frequency != remote.Frequency {
+		differences = append(differences, fmt.Sprintf("frequency is %d, expected %d", remote.Frequency, desired.Frequency))
+	}
+
+	if desired.URI != remote.URI {
+		differences = append(differences, fmt.Sprintf("uri is %q, expected %q", remote.URI, desired.URI))
+	}
+
+	if desired.Status != "" && desired.Status != remote.Status {
+		differences = append(differences, fmt.Sprintf("status is %q, expected %q", remote.Status, desired.Status))
+	}
+
+	if in.SLAThreshold != "" && desired.SLAThreshold != remote.SLAThreshold {
+		differences = append(differences, fmt.Sprintf("sla_threshold is %g, expected %g", remote.SLAThreshold, desired.SLAThreshold))
+	}
+
+	if !reflect.DeepEqual(sortedStrings(desired.Locations), sortedStrings(remote.Locations)) {
+		differences = append(differences, "locations differ")
+	}
+
+	if desired.Options != remote.Options {
+		differences = append(differences, "options differ")
+	}
+
+	if in.IsScripted() && remoteScript != nil && in.ToMonitorScript().Text != remoteScript.Text {
+		differences = append(differences, "script differs")
+	}
+
+	return differences
+}
+
+func sortedStrings(in []string) []string {
+	out := append([]string{}, in...)
+	sort.Strings(out)
+
+	return out
+}
@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"encoding/base64"
+
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SyntheticsMonitorSpec", func() {
+	var spec SyntheticsMonitorSpec
+
+	BeforeEach(func() {
+		spec = SyntheticsMonitorSpec{
+			Name:         "my monitor",
+			Type:         synthetics.MonitorTypes.Ping,
+			Frequency:    5,
+			URI:          "https://example.com/",
+			Locations:    []string{"AWS_US_EAST_1", "AWS_EU_WEST_1"},
+			Status:       synthetics.MonitorStatus.Enabled,
+			SLAThreshold: "7.5",
+			Options: SyntheticsMonitorOptions{
+				ValidationString: "ok",
+				VerifySSL:        true,
+			},
+		}
+	})
+
+	Describe("ToMonitor", func() {
+		It("converts the spec to a synthetics.Monitor", func() {
+			monitor := spec.ToMonitor()
+			Expect(monitor.Name).To(Equal("my monitor"))
+			Expect(monitor.Type).To(Equal(synthetics.MonitorTypes.Ping))
+			Expect(monitor.Frequency).To(Equal(uint(5)))
+			Expect(monitor.URI).To(Equal("https://example.com/"))
+			Expect(monitor.Locations).To(Equal([]string{"AWS_US_EAST_1", "AWS_EU_WEST_1"}))
+			Expect(monitor.SLAThreshold).To(Equal(7.5))
+			Expect(monitor.Options.ValidationString).To(Equal("ok"))
+			Expect(monitor.Options.VerifySSL).To(BeTrue())
+		})
+	})
+
+	Describe("ToMonitorScript", func() {
+		It("base64 encodes the script", func() {
+			spec.Script = "$http.get('https://example.com/')"
+			script := spec.ToMonitorScript()
+			decoded, err := base64.StdEncoding.DecodeString(script.Text)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(decoded)).To(Equal("$http.get('https://example.com/')"))
+		})
+	})
+
+	Describe("Diff", func() {
+		It("reports no differences for a matching monitor", func() {
+			remote := spec.ToMonitor()
+			remote.ID = "abc"
+			remote.Locations = []string{"AWS_EU_WEST_1", "AWS_US_EAST_1"}
+			Expect(spec.Diff(remote, nil)).To(BeEmpty())
+		})
+
+		It("lists the changed fields", func() {
+			remote := spec.ToMonitor()
+			remote.Frequency = 10
+			remote.Status = synthetics.MonitorStatus.Disabled
+			remote.Locations = []string{"AWS_US_EAST_1"}
+			Expect(spec.Diff(remote, nil)).To(ConsistOf(
+				"frequency is 10, expected 5",
+				`status is "DISABLED", expected "ENABLED"`,
+				"locations differ",
+			))
+		})
+
+		It("compares the script of scripted monitors", func() {
+			spec.Type = synthetics.MonitorTypes.APITest
+			spec.Script = "new script"
+			remote := spec.ToMonitor()
+			remoteScript := &synthetics.MonitorScript{Text: base64.StdEncoding.EncodeToString([]byte("old script"))}
+			Expect(spec.Diff(remote, remoteScript)).To(ConsistOf("script differs"))
+		})
+	})
+})
@@ -0,0 +1,164 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	customErrors "github.com/newrelic/newrelic-kubernetes-operator/errors"
+)
+
+// log is for logging in this package.
+var (
+	syntheticsmonitorlog = logf.Log.WithName("syntheticsmonitor-resource")
+)
+
+// defaultSyntheticsMonitorSLAThreshold is the Apdex threshold New Relic applies to new monitors
+const defaultSyntheticsMonitorSLAThreshold = "7"
+
+// validSyntheticsMonitorFrequencies are the check intervals in minutes supported by New Relic
+var validSyntheticsMonitorFrequencies = []uint{1, 5, 10, 15, 30, 60, 360, 720, 1440}
+
+// SetupWebhookWithManager - instantiates the Webhook
+func (r *SyntheticsMonitor) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	k8Client = mgr.GetClient()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-nr-k8s-newrelic-com-v1-syntheticsmonitor,mutating=true,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=syntheticsmonitors,verbs=create;update,versions=v1,name=msyntheticsmonitor.kb.io,sideEffects=None
+
+var _ webhook.Defaulter = &SyntheticsMonitor{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *SyntheticsMonitor) Default() {
+	syntheticsmonitorlog.Info("default", "name", r.Name)
+
+	if r.Status.AppliedSpec == nil {
+		syntheticsmonitorlog.Info("Setting null Applied Spec to empty interface")
+		r.Status.AppliedSpec = &SyntheticsMonitorSpec{}
+	}
+
+	if r.Spec.Status == "" {
+		r.Spec.Status = synthetics.MonitorStatus.Enabled
+	}
+
+	if r.Spec.SLAThreshold == "" {
+		r.Spec.SLAThreshold = defaultSyntheticsMonitorSLAThreshold
+	}
+
+	DefaultAccountRef(&r.Spec.AccountRef)
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-nr-k8s-newrelic-com-v1-syntheticsmonitor,mutating=false,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=syntheticsmonitors,versions=v1,name=vsyntheticsmonitor.kb.io,sideEffects=None
+
+var _ webhook.Validator = &SyntheticsMonitor{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *SyntheticsMonitor) ValidateCreate() error {
+	syntheticsmonitorlog.Info("validate create", "name", r.Name)
+
+	return r.ValidateSyntheticsMonitor()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *SyntheticsMonitor) ValidateUpdate(old runtime.Object) error {
+	syntheticsmonitorlog.Info("validate update", "name", r.Name)
+	prevMonitor := old.(*SyntheticsMonitor)
+
+	if r.Spec.Type != prevMonitor.Spec.Type {
+		return errors.New("cannot change the type of a monitor, you must delete and create a new monitor")
+	}
+
+	return r.ValidateSyntheticsMonitor()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *SyntheticsMonitor) ValidateDelete() error {
+	syntheticsmonitorlog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
+
+// ValidateSyntheticsMonitor - Validates create/update of SyntheticsMonitor
+func (r *SyntheticsMonitor) ValidateSyntheticsMonitor() error {
+	collectedErrors := new(customErrors.ErrorCollector)
+
+	if err := r.CheckForAPIKeyOrSecret(); err != nil {
+		collectedErrors.Collect(err)
+	}
+
+	// the region of a referenced account is used when the monitor does not set one
+	if r.Spec.AccountRef.Name == "" && !ValidRegion(r.Spec.Region) {
+		collectedErrors.Collect(errors.New("Invalid region set, value was: " + r.Spec.Region))
+	}
+
+	if r.Spec.Name == "" {
+		collectedErrors.Collect(errors.New("name must be set"))
+	}
+
+	// ping monitors are created through NerdGraph, which needs the account
+	if r.Spec.Type == synthetics.MonitorTypes.Ping && r.Spec.AccountRef.Name == "" && r.Spec.AccountID == 0 {
+		collectedErrors.Collect(fmt.Errorf("account_id or account_ref must be set for %s monitors", r.Spec.Type))
+	}
+
+	switch r.Spec.Type {
+	case synthetics.MonitorTypes.Ping, synthetics.MonitorTypes.Browser:
+		if r.Spec.URI == "" {
+			collectedErrors.Collect(fmt.Errorf("uri must be set for %s monitors", r.Spec.Type))
+		}
+
+		if r.Spec.Script != "" {
+			collectedErrors.Collect(fmt.Errorf("script can only be set for %s monitors", synthetics.MonitorTypes.APITest))
+		}
+	case synthetics.MonitorTypes.APITest:
+		if r.Spec.Script == "" {
+			collectedErrors.Collect(fmt.Errorf("script must be set for %s monitors", r.Spec.Type))
+		}
+	default:
+		collectedErrors.Collect(fmt.Errorf("type must be %s, %s or %s, got %q",
+			synthetics.MonitorTypes.Ping, synthetics.MonitorTypes.Browser, synthetics.MonitorTypes.APITest, r.Spec.Type))
+	}
+
+	if !validSyntheticsMonitorFrequency(r.Spec.Frequency) {
+		collectedErrors.Collect(fmt.Errorf("frequency must be one of %v minutes, got %d", validSyntheticsMonitorFrequencies, r.Spec.Frequency))
+	}
+
+	if len(r.Spec.Locations) == 0 {
+		collectedErrors.Collect(errors.New("at least one location must be set"))
+	}
+
+	if r.Spec.SLAThreshold != "" {
+		if _, err := strconv.ParseFloat(r.Spec.SLAThreshold, 64); err != nil {
+			collectedErrors.Collect(fmt.Errorf("sla_threshold must be a number, got %q", r.Spec.SLAThreshold))
+		}
+	}
+
+	if len(*collectedErrors) > 0 {
+		syntheticsmonitorlog.Info("Errors encountered validating monitor", "collectedErrors", collectedErrors)
+		return collectedErrors
+	}
+
+	return nil
+}
+
+func (r *SyntheticsMonitor) CheckForAPIKeyOrSecret() error {
+	return CheckForAccount(r.Namespace, r.GetAccountSettings())
+}
+
+func validSyntheticsMonitorFrequency(frequency uint) bool {
+	for _, valid := range validSyntheticsMonitorFrequencies {
+		if frequency == valid {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("SyntheticsMonitor_webhook", func() {
+	var r SyntheticsMonitor
+
+	BeforeEach(func() {
+		k8Client = testk8sClient
+		r = SyntheticsMonitor{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-monitor",
+				Namespace: "default",
+			},
+			Spec: SyntheticsMonitorSpec{
+				Name:      "my monitor",
+				Type:      synthetics.MonitorTypes.Ping,
+				Frequency: 5,
+				URI:       "https://example.com/",
+				Locations: []string{"AWS_US_EAST_1"},
+				APIKey:    "api-key",
+				Region:    "US",
+				AccountID: 12345,
+			},
+		}
+	})
+
+	Describe("Default", func() {
+		It("enables the monitor and sets the default SLA threshold", func() {
+			r.Default()
+			Expect(r.Spec.Status).To(Equal(synthetics.MonitorStatus.Enabled))
+			Expect(r.Spec.SLAThreshold).To(Equal("7"))
+			Expect(r.Status.AppliedSpec).To(Equal(&SyntheticsMonitorSpec{}))
+		})
+
+		It("keeps an explicit status", func() {
+			r.Spec.Status = synthetics.MonitorStatus.Muted
+			r.Default()
+			Expect(r.Spec.Status).To(Equal(synthetics.MonitorStatus.Muted))
+		})
+	})
+
+	Describe("ValidateCreate", func() {
+		It("accepts a ping monitor", func() {
+			Expect(r.ValidateCreate()).To(Succeed())
+		})
+
+		It("requires the account of ping monitors", func() {
+			r.Spec.AccountID = 0
+			err := r.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("account_id or account_ref must be set for SIMPLE monitors"))
+		})
+
+		It("accepts a scripted API monitor without a uri", func() {
+			r.Spec.Type = synthetics.MonitorTypes.APITest
+			r.Spec.URI = ""
+			r.Spec.Script = "$http.get('https://example.com/')"
+			Expect(r.ValidateCreate()).To(Succeed())
+		})
+
+		It("requires a uri for ping and browser monitors", func() {
+			r.Spec.Type = synthetics.MonitorTypes.Browser
+			r.Spec.URI = ""
+			err := r.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("uri must be set for BROWSER monitors"))
+		})
+
+		It("requires a script for scripted API monitors", func() {
+			r.Spec.Type = synthetics.MonitorTypes.APITest
+			err := r.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("script must be set for SCRIPT_API monitors"))
+		})
+
+		It("rejects scripted browser monitors", func() {
+			r.Spec.Type = synthetics.MonitorTypes.ScriptedBrowser
+			err := r.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`type must be SIMPLE, BROWSER or SCRIPT_API, got "SCRIPT_BROWSER"`))
+		})
+
+		It("collects every invalid field", func() {
+			r.Spec.Frequency = 2
+			r.Spec.Locations = nil
+			r.Spec.SLAThreshold = "fast"
+			r.Spec.Region = ""
+			err := r.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("frequency must be one of [1 5 10 15 30 60 360 720 1440] minutes, got 2"))
+			Expect(err.Error()).To(ContainSubstring("at least one location must be set"))
+			Expect(err.Error()).To(ContainSubstring(`sla_threshold must be a number, got "fast"`))
+			Expect(err.Error()).To(ContainSubstring("Invalid region set"))
+		})
+
+		It("requires credentials", func() {
+			r.Spec.APIKey = ""
+			err := r.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("either api_key or api_key_secret must be set"))
+		})
+	})
+
+	Describe("ValidateUpdate", func() {
+		It("rejects a change of the monitor type", func() {
+			old := r.DeepCopy()
+			r.Spec.Type = synthetics.MonitorTypes.Browser
+			err := r.ValidateUpdate(old)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("cannot change the type of a monitor, you must delete and create a new monitor"))
+		})
+	})
+})
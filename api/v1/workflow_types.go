@@ -0,0 +1,134 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/notifications"
+)
+
+// Muting rules handling of a Workflow
+const (
+	WorkflowNotifyAllIssues                       = "NOTIFY_ALL_ISSUES"
+	WorkflowDontNotifyFullyMutedIssues            = "DONT_NOTIFY_FULLY_MUTED_ISSUES"
+	WorkflowDontNotifyFullyOrPartiallyMutedIssues = "DONT_NOTIFY_FULLY_OR_PARTIALLY_MUTED_ISSUES"
+)
+
+// WorkflowSpec defines the desired state of Workflow
+type WorkflowSpec struct {
+	Name string `json:"name"`
+	// Enabled defaults to true
+	Enabled *bool `json:"enabled,omitempty"`
+	// ChannelRefs are the names of the NotificationChannels in the namespace of the workflow that are notified
+	ChannelRefs  []string             `json:"channel_refs"`
+	IssuesFilter WorkflowIssuesFilter `json:"issues_filter,omitempty"`
+	// +kubebuilder:validation:Enum=NOTIFY_ALL_ISSUES;DONT_NOTIFY_FULLY_MUTED_ISSUES;DONT_NOTIFY_FULLY_OR_PARTIALLY_MUTED_ISSUES
+	MutingRulesHandling string                   `json:"muting_rules_handling,omitempty"`
+	APIKey              string                   `json:"api_key,omitempty"`
+	APIKeySecret        NewRelicAPIKeySecret     `json:"api_key_secret,omitempty"`
+	AccountRef          NewRelicAccountReference `json:"account_ref,omitempty"`
+	Region              string                   `json:"region,omitempty"`
+	AccountID           int                      `json:"account_id,omitempty"`
+}
+
+// WorkflowIssuesFilter selects the issues a workflow notifies about, an issue has to match
+// the policies and every predicate
+type WorkflowIssuesFilter struct {
+	// PolicyRefs are the names of the AlertsPolicies in the namespace of the workflow whose issues are selected
+	PolicyRefs []string            `json:"policy_refs,omitempty"`
+	Predicates []WorkflowPredicate `json:"predicates,omitempty"`
+}
+
+// WorkflowPredicate - copy of notifications.Predicate
+type WorkflowPredicate struct {
+	Attribute string `json:"attribute"`
+	// +kubebuilder:validation:Enum=CONTAINS;DOES_NOT_CONTAIN;DOES_NOT_EXACTLY_MATCH;ENDS_WITH;EQUAL;EXACTLY_MATCHES;GREATER_OR_EQUAL;GREATER_THAN;IS;IS_NOT;LESS_OR_EQUAL;LESS_THAN;NOT_EQUAL;STARTS_WITH
+	Operator string   `json:"operator"`
+	Values   []string `json:"values"`
+}
+
+// WorkflowStatus defines the observed state of Workflow
+type WorkflowStatus struct {
+	AppliedSpec *WorkflowSpec `json:"applied_spec,omitempty"`
+	WorkflowID  string        `json:"workflow_id"`
+	// ChannelIDs and PolicyIDs are the IDs of the referenced channels and policies the workflow was written with
+	ChannelIDs []string    `json:"channel_ids,omitempty"`
+	PolicyIDs  []string    `json:"policy_ids,omitempty"`
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.workflow_id"
+// +kubebuilder:printcolumn:name="Enabled",type="boolean",JSONPath=".spec.enabled"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+
+// Workflow is the Schema for the workflows API
+type Workflow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkflowSpec   `json:"spec,omitempty"`
+	Status WorkflowStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkflowList contains a list of Workflow
+type WorkflowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Workflow `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Workflow{}, &WorkflowList{})
+}
+
+// GetConditions returns the status conditions of the Workflow
+func (in *Workflow) GetConditions() []Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions replaces the status conditions of the Workflow
+func (in *Workflow) SetConditions(conditions []Condition) {
+	in.Status.Conditions = conditions
+}
+
+// WorkflowInput - Converts WorkflowSpec object to notifications.WorkflowInput. channelIDs and policyIDs
+// are the IDs of the channels and policies referenced by the spec.
+func (in WorkflowSpec) WorkflowInput(channelIDs []string, policyIDs []string) notifications.WorkflowInput {
+	input := notifications.WorkflowInput{
+		Name:                      in.Name,
+		WorkflowEnabled:           in.Enabled == nil || *in.Enabled,
+		DestinationsEnabled:       true,
+		MutingRulesHandling:       in.MutingRulesHandling,
+		DestinationConfigurations: make([]notifications.DestinationConfiguration, 0, len(channelIDs)),
+		IssuesFilter: notifications.IssuesFilter{
+			Name:       in.Name,
+			Type:       "FILTER",
+			Predicates: make([]notifications.Predicate, 0, len(in.IssuesFilter.Predicates)+1),
+		},
+	}
+
+	for _, channelID := range channelIDs {
+		input.DestinationConfigurations = append(input.DestinationConfigurations, notifications.DestinationConfiguration{ChannelID: channelID})
+	}
+
+	if len(policyIDs) > 0 {
+		input.IssuesFilter.Predicates = append(input.IssuesFilter.Predicates, notifications.Predicate{
+			Attribute: notifications.PolicyIDsAttribute,
+			Operator:  notifications.OperatorExactlyMatches,
+			Values:    policyIDs,
+		})
+	}
+
+	for _, predicate := range in.IssuesFilter.Predicates {
+		input.IssuesFilter.Predicates = append(input.IssuesFilter.Predicates, notifications.Predicate{
+			Attribute: predicate.Attribute,
+			Operator:  predicate.Operator,
+			Values:    predicate.Values,
+		})
+	}
+
+	return input
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/notifications"
+)
+
+var _ = Describe("WorkflowSpec", func() {
+	var spec WorkflowSpec
+
+	BeforeEach(func() {
+		spec = WorkflowSpec{
+			Name:                "shop",
+			ChannelRefs:         []string{"ops-webhook"},
+			MutingRulesHandling: WorkflowDontNotifyFullyMutedIssues,
+			IssuesFilter: WorkflowIssuesFilter{
+				PolicyRefs: []string{"shop-policy"},
+				Predicates: []WorkflowPredicate{
+					{Attribute: "priority", Operator: "EQUAL", Values: []string{"CRITICAL"}},
+				},
+			},
+		}
+	})
+
+	Describe("WorkflowInput", func() {
+		It("notifies the channels about the issues of the policies", func() {
+			input := spec.WorkflowInput([]string{"channel-id"}, []string{"10", "11"})
+
+			Expect(input.Name).To(Equal("shop"))
+			Expect(input.WorkflowEnabled).To(BeTrue())
+			Expect(input.DestinationsEnabled).To(BeTrue())
+			Expect(input.MutingRulesHandling).To(Equal(WorkflowDontNotifyFullyMutedIssues))
+			Expect(input.DestinationConfigurations).To(Equal([]notifications.DestinationConfiguration{{ChannelID: "channel-id"}}))
+			Expect(input.IssuesFilter.Type).To(Equal("FILTER"))
+			Expect(input.IssuesFilter.Predicates).To(Equal([]notifications.Predicate{
+				{Attribute: notifications.PolicyIDsAttribute, Operator: notifications.OperatorExactlyMatches, Values: []string{"10", "11"}},
+				{Attribute: "priority", Operator: "EQUAL", Values: []string{"CRITICAL"}},
+			}))
+		})
+
+		It("does not filter on policies without policy IDs", func() {
+			input := spec.WorkflowInput([]string{"channel-id"}, nil)
+
+			Expect(input.IssuesFilter.Predicates).To(HaveLen(1))
+			Expect(input.IssuesFilter.Predicates[0].Attribute).To(Equal("priority"))
+		})
+
+		It("disables the workflow", func() {
+			enabled := false
+			spec.Enabled = &enabled
+
+			Expect(spec.WorkflowInput(nil, nil).WorkflowEnabled).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	customErrors "github.com/newrelic/newrelic-kubernetes-operator/errors"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/notifications"
+)
+
+// log is for logging in this package.
+var (
+	workflowlog = logf.Log.WithName("workflow-resource")
+)
+
+// SetupWebhookWithManager - instantiates the Webhook
+func (r *Workflow) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	k8Client = mgr.GetClient()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-nr-k8s-newrelic-com-v1-workflow,mutating=true,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=workflows,verbs=create;update,versions=v1,name=mworkflow.kb.io,sideEffects=None
+
+var _ webhook.Defaulter = &Workflow{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *Workflow) Default() {
+	workflowlog.Info("default", "name", r.Name)
+
+	if r.Status.AppliedSpec == nil {
+		workflowlog.Info("Setting null Applied Spec to empty interface")
+		r.Status.AppliedSpec = &WorkflowSpec{}
+	}
+
+	if r.Spec.Enabled == nil {
+		enabled := true
+		r.Spec.Enabled = &enabled
+	}
+
+	if r.Spec.MutingRulesHandling == "" {
+		r.Spec.MutingRulesHandling = WorkflowDontNotifyFullyMutedIssues
+	}
+
+	DefaultAccountRef(&r.Spec.AccountRef)
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-nr-k8s-newrelic-com-v1-workflow,mutating=false,failurePolicy=fail,groups=nr.k8s.newrelic.com,resources=workflows,versions=v1,name=vworkflow.kb.io,sideEffects=None
+
+var _ webhook.Validator = &Workflow{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Workflow) ValidateCreate() error {
+	workflowlog.Info("validate create", "name", r.Name)
+
+	return r.ValidateWorkflow()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Workflow) ValidateUpdate(old runtime.Object) error {
+	workflowlog.Info("validate update", "name", r.Name)
+
+	return r.ValidateWorkflow()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Workflow) ValidateDelete() error {
+	workflowlog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
+
+// ValidateWorkflow - Validates create/update of Workflow
+func (r *Workflow) ValidateWorkflow() error {
+	collectedErrors := new(customErrors.ErrorCollector)
+
+	if err := r.CheckForAPIKeyOrSecret(); err != nil {
+		collectedErrors.Collect(err)
+	}
+
+	// the region and account of a referenced account are used when the workflow does not set them
+	if r.Spec.AccountRef.Name == "" {
+		if !ValidRegion(r.Spec.Region) {
+			collectedErrors.Collect(errors.New("Invalid region set, value was: " + r.Spec.Region))
+		}
+
+		if r.Spec.AccountID == 0 {
+			collectedErrors.Collect(errors.New("account_id must be set"))
+		}
+	}
+
+	if r.Spec.Name == "" {
+		collectedErrors.Collect(errors.New("name must be set"))
+	}
+
+	if len(r.Spec.ChannelRefs) == 0 {
+		collectedErrors.Collect(errors.New("at least one channel_ref must be set"))
+	}
+
+	for i, ref := range r.Spec.ChannelRefs {
+		if ref == "" {
+			collectedErrors.Collect(fmt.Errorf("channel_refs[%d] must not be empty", i))
+		}
+	}
+
+	for i, ref := range r.Spec.IssuesFilter.PolicyRefs {
+		if ref == "" {
+			collectedErrors.Collect(fmt.Errorf("issues_filter.policy_refs[%d] must not be empty", i))
+		}
+	}
+
+	for i, predicate := range r.Spec.IssuesFilter.Predicates {
+		if predicate.Attribute == "" || predicate.Operator == "" || len(predicate.Values) == 0 {
+			collectedErrors.Collect(fmt.Errorf("issues_filter.predicates[%d] must set attribute, operator and values", i))
+		}
+
+		// the policies are filtered through policy_refs, a second filter on them would never match
+		if predicate.Attribute == notifications.PolicyIDsAttribute && len(r.Spec.IssuesFilter.PolicyRefs) > 0 {
+			collectedErrors.Collect(fmt.Errorf("issues_filter.predicates[%d] cannot filter on %s together with policy_refs", i, notifications.PolicyIDsAttribute))
+		}
+	}
+
+	if len(*collectedErrors) > 0 {
+		workflowlog.Info("Errors encountered validating workflow", "collectedErrors", collectedErrors)
+		return collectedErrors
+	}
+
+	return nil
+}
+
+func (r *Workflow) CheckForAPIKeyOrSecret() error {
+	return CheckForAccount(r.Namespace, r.GetAccountSettings())
+}
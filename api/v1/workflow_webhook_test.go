@@ -0,0 +1,78 @@
+package v1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/notifications"
+)
+
+var _ = Describe("Workflow_webhook", func() {
+	var r Workflow
+
+	BeforeEach(func() {
+		k8Client = testk8sClient
+		r = Workflow{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "shop",
+				Namespace: "default",
+			},
+			Spec: WorkflowSpec{
+				Name:        "shop",
+				ChannelRefs: []string{"ops-webhook"},
+				IssuesFilter: WorkflowIssuesFilter{
+					PolicyRefs: []string{"shop-policy"},
+				},
+				APIKey:    "api-key",
+				Region:    "US",
+				AccountID: 1,
+			},
+		}
+	})
+
+	Describe("Default", func() {
+		It("enables the workflow and defaults the muting rules handling", func() {
+			r.Default()
+			Expect(*r.Spec.Enabled).To(BeTrue())
+			Expect(r.Spec.MutingRulesHandling).To(Equal(WorkflowDontNotifyFullyMutedIssues))
+			Expect(r.Status.AppliedSpec).To(Equal(&WorkflowSpec{}))
+		})
+
+		It("keeps a disabled workflow disabled", func() {
+			enabled := false
+			r.Spec.Enabled = &enabled
+
+			r.Default()
+			Expect(*r.Spec.Enabled).To(BeFalse())
+		})
+	})
+
+	Describe("ValidateCreate", func() {
+		It("accepts a valid workflow", func() {
+			Expect(r.ValidateCreate()).To(Succeed())
+		})
+
+		It("requires a channel", func() {
+			r.Spec.ChannelRefs = nil
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("at least one channel_ref must be set")))
+		})
+
+		It("rejects empty policy references", func() {
+			r.Spec.IssuesFilter.PolicyRefs = []string{""}
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("issues_filter.policy_refs[0] must not be empty")))
+		})
+
+		It("requires complete predicates", func() {
+			r.Spec.IssuesFilter.Predicates = []WorkflowPredicate{{Attribute: "priority", Operator: "EQUAL"}}
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("issues_filter.predicates[0] must set attribute, operator and values")))
+		})
+
+		It("rejects a policy predicate together with policy_refs", func() {
+			r.Spec.IssuesFilter.Predicates = []WorkflowPredicate{
+				{Attribute: notifications.PolicyIDsAttribute, Operator: "EXACTLY_MATCHES", Values: []string{"10"}},
+			}
+			Expect(r.ValidateCreate()).To(MatchError(ContainSubstring("cannot filter on labels.policyIds together with policy_refs")))
+		})
+	})
+})
@@ -21,6 +21,7 @@ package v1
 
 import (
 	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/nrqlsignal"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -46,6 +47,23 @@ func (in *APMSpecificSpec) DeepCopy() *APMSpecificSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountSettings) DeepCopyInto(out *AccountSettings) {
+	*out = *in
+	out.AccountRef = in.AccountRef
+	out.APIKeySecret = in.APIKeySecret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountSettings.
+func (in *AccountSettings) DeepCopy() *AccountSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertConditionTerm) DeepCopyInto(out *AlertConditionTerm) {
 	*out = *in
@@ -145,6 +163,13 @@ func (in *AlertsAPMConditionStatus) DeepCopyInto(out *AlertsAPMConditionStatus)
 		*out = new(AlertsAPMConditionSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsAPMConditionStatus.
@@ -240,6 +265,11 @@ func (in *AlertsChannelConfiguration) DeepCopyInto(out *AlertsChannelConfigurati
 		*out = make([]ChannelHeader, len(*in))
 		copy(*out, *in)
 	}
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(AlertsChannelConfigurationValueFrom)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsChannelConfiguration.
@@ -252,6 +282,63 @@ func (in *AlertsChannelConfiguration) DeepCopy() *AlertsChannelConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsChannelConfigurationValueFrom) DeepCopyInto(out *AlertsChannelConfigurationValueFrom) {
+	*out = *in
+	if in.AuthToken != nil {
+		in, out := &in.AuthToken, &out.AuthToken
+		*out = new(ChannelValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthPassword != nil {
+		in, out := &in.AuthPassword, &out.AuthPassword
+		*out = new(ChannelValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIKey != nil {
+		in, out := &in.APIKey, &out.APIKey
+		*out = new(ChannelValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(ChannelValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RouteKey != nil {
+		in, out := &in.RouteKey, &out.RouteKey
+		*out = new(ChannelValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceKey != nil {
+		in, out := &in.ServiceKey, &out.ServiceKey
+		*out = new(ChannelValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(ChannelValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make(map[string]ChannelValueSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsChannelConfigurationValueFrom.
+func (in *AlertsChannelConfigurationValueFrom) DeepCopy() *AlertsChannelConfigurationValueFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsChannelConfigurationValueFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertsChannelList) DeepCopyInto(out *AlertsChannelList) {
 	*out = *in
@@ -284,10 +371,26 @@ func (in *AlertsChannelList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsChannelReference) DeepCopyInto(out *AlertsChannelReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsChannelReference.
+func (in *AlertsChannelReference) DeepCopy() *AlertsChannelReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsChannelReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertsChannelSpec) DeepCopyInto(out *AlertsChannelSpec) {
 	*out = *in
 	out.APIKeySecret = in.APIKeySecret
+	out.AccountRef = in.AccountRef
 	in.Links.DeepCopyInto(&out.Links)
 	in.Configuration.DeepCopyInto(&out.Configuration)
 }
@@ -315,6 +418,13 @@ func (in *AlertsChannelStatus) DeepCopyInto(out *AlertsChannelStatus) {
 		*out = make([]int, len(*in))
 		copy(*out, *in)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsChannelStatus.
@@ -328,33 +438,7 @@ func (in *AlertsChannelStatus) DeepCopy() *AlertsChannelStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AlertsGenericConditionSpec) DeepCopyInto(out *AlertsGenericConditionSpec) {
-	*out = *in
-	out.APIKeySecret = in.APIKeySecret
-	if in.Terms != nil {
-		in, out := &in.Terms, &out.Terms
-		*out = make([]AlertsNrqlConditionTerm, len(*in))
-		copy(*out, *in)
-	}
-	if in.APMTerms != nil {
-		in, out := &in.APMTerms, &out.APMTerms
-		*out = make([]AlertConditionTerm, len(*in))
-		copy(*out, *in)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsGenericConditionSpec.
-func (in *AlertsGenericConditionSpec) DeepCopy() *AlertsGenericConditionSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(AlertsGenericConditionSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AlertsNrqlCondition) DeepCopyInto(out *AlertsNrqlCondition) {
+func (in *AlertsExternalServiceCondition) DeepCopyInto(out *AlertsExternalServiceCondition) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -362,18 +446,18 @@ func (in *AlertsNrqlCondition) DeepCopyInto(out *AlertsNrqlCondition) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsNrqlCondition.
-func (in *AlertsNrqlCondition) DeepCopy() *AlertsNrqlCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsExternalServiceCondition.
+func (in *AlertsExternalServiceCondition) DeepCopy() *AlertsExternalServiceCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(AlertsNrqlCondition)
+	out := new(AlertsExternalServiceCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AlertsNrqlCondition) DeepCopyObject() runtime.Object {
+func (in *AlertsExternalServiceCondition) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -381,51 +465,31 @@ func (in *AlertsNrqlCondition) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AlertsNrqlConditionExpiration) DeepCopyInto(out *AlertsNrqlConditionExpiration) {
-	*out = *in
-	if in.ExpirationDuration != nil {
-		in, out := &in.ExpirationDuration, &out.ExpirationDuration
-		*out = new(int)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsNrqlConditionExpiration.
-func (in *AlertsNrqlConditionExpiration) DeepCopy() *AlertsNrqlConditionExpiration {
-	if in == nil {
-		return nil
-	}
-	out := new(AlertsNrqlConditionExpiration)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AlertsNrqlConditionList) DeepCopyInto(out *AlertsNrqlConditionList) {
+func (in *AlertsExternalServiceConditionList) DeepCopyInto(out *AlertsExternalServiceConditionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AlertsNrqlCondition, len(*in))
+		*out = make([]AlertsExternalServiceCondition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsNrqlConditionList.
-func (in *AlertsNrqlConditionList) DeepCopy() *AlertsNrqlConditionList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsExternalServiceConditionList.
+func (in *AlertsExternalServiceConditionList) DeepCopy() *AlertsExternalServiceConditionList {
 	if in == nil {
 		return nil
 	}
-	out := new(AlertsNrqlConditionList)
+	out := new(AlertsExternalServiceConditionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AlertsNrqlConditionList) DeepCopyObject() runtime.Object {
+func (in *AlertsExternalServiceConditionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -433,126 +497,98 @@ func (in *AlertsNrqlConditionList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AlertsNrqlConditionSignal) DeepCopyInto(out *AlertsNrqlConditionSignal) {
-	*out = *in
-	if in.AggregationWindow != nil {
-		in, out := &in.AggregationWindow, &out.AggregationWindow
-		*out = new(int)
-		**out = **in
-	}
-	if in.EvaluationOffset != nil {
-		in, out := &in.EvaluationOffset, &out.EvaluationOffset
-		*out = new(int)
-		**out = **in
-	}
-	if in.FillOption != nil {
-		in, out := &in.FillOption, &out.FillOption
-		*out = new(alerts.AlertsFillOption)
-		**out = **in
-	}
-	if in.FillValue != nil {
-		in, out := &in.FillValue, &out.FillValue
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsNrqlConditionSignal.
-func (in *AlertsNrqlConditionSignal) DeepCopy() *AlertsNrqlConditionSignal {
-	if in == nil {
-		return nil
-	}
-	out := new(AlertsNrqlConditionSignal)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AlertsNrqlConditionSpec) DeepCopyInto(out *AlertsNrqlConditionSpec) {
+func (in *AlertsExternalServiceConditionSpec) DeepCopyInto(out *AlertsExternalServiceConditionSpec) {
 	*out = *in
 	in.AlertsGenericConditionSpec.DeepCopyInto(&out.AlertsGenericConditionSpec)
-	in.AlertsNrqlSpecificSpec.DeepCopyInto(&out.AlertsNrqlSpecificSpec)
-	in.AlertsBaselineSpecificSpec.DeepCopyInto(&out.AlertsBaselineSpecificSpec)
+	out.AlertsExternalServiceSpecificSpec = in.AlertsExternalServiceSpecificSpec
+	if in.Entities != nil {
+		in, out := &in.Entities, &out.Entities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsNrqlConditionSpec.
-func (in *AlertsNrqlConditionSpec) DeepCopy() *AlertsNrqlConditionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsExternalServiceConditionSpec.
+func (in *AlertsExternalServiceConditionSpec) DeepCopy() *AlertsExternalServiceConditionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AlertsNrqlConditionSpec)
+	out := new(AlertsExternalServiceConditionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AlertsNrqlConditionStatus) DeepCopyInto(out *AlertsNrqlConditionStatus) {
+func (in *AlertsExternalServiceConditionStatus) DeepCopyInto(out *AlertsExternalServiceConditionStatus) {
 	*out = *in
 	if in.AppliedSpec != nil {
 		in, out := &in.AppliedSpec, &out.AppliedSpec
-		*out = new(AlertsNrqlConditionSpec)
+		*out = new(AlertsExternalServiceConditionSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsNrqlConditionStatus.
-func (in *AlertsNrqlConditionStatus) DeepCopy() *AlertsNrqlConditionStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsExternalServiceConditionStatus.
+func (in *AlertsExternalServiceConditionStatus) DeepCopy() *AlertsExternalServiceConditionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AlertsNrqlConditionStatus)
+	out := new(AlertsExternalServiceConditionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AlertsNrqlConditionTerm) DeepCopyInto(out *AlertsNrqlConditionTerm) {
+func (in *AlertsExternalServiceSpecificSpec) DeepCopyInto(out *AlertsExternalServiceSpecificSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsNrqlConditionTerm.
-func (in *AlertsNrqlConditionTerm) DeepCopy() *AlertsNrqlConditionTerm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsExternalServiceSpecificSpec.
+func (in *AlertsExternalServiceSpecificSpec) DeepCopy() *AlertsExternalServiceSpecificSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AlertsNrqlConditionTerm)
+	out := new(AlertsExternalServiceSpecificSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AlertsNrqlSpecificSpec) DeepCopyInto(out *AlertsNrqlSpecificSpec) {
+func (in *AlertsGenericConditionSpec) DeepCopyInto(out *AlertsGenericConditionSpec) {
 	*out = *in
-	out.Nrql = in.Nrql
-	if in.ValueFunction != nil {
-		in, out := &in.ValueFunction, &out.ValueFunction
-		*out = new(alerts.NrqlConditionValueFunction)
-		**out = **in
-	}
-	if in.Expiration != nil {
-		in, out := &in.Expiration, &out.Expiration
-		*out = new(AlertsNrqlConditionExpiration)
-		(*in).DeepCopyInto(*out)
+	out.APIKeySecret = in.APIKeySecret
+	out.AccountRef = in.AccountRef
+	if in.Terms != nil {
+		in, out := &in.Terms, &out.Terms
+		*out = make([]AlertsNrqlConditionTerm, len(*in))
+		copy(*out, *in)
 	}
-	if in.Signal != nil {
-		in, out := &in.Signal, &out.Signal
-		*out = new(AlertsNrqlConditionSignal)
-		(*in).DeepCopyInto(*out)
+	if in.APMTerms != nil {
+		in, out := &in.APMTerms, &out.APMTerms
+		*out = make([]AlertConditionTerm, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsNrqlSpecificSpec.
-func (in *AlertsNrqlSpecificSpec) DeepCopy() *AlertsNrqlSpecificSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsGenericConditionSpec.
+func (in *AlertsGenericConditionSpec) DeepCopy() *AlertsGenericConditionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AlertsNrqlSpecificSpec)
+	out := new(AlertsGenericConditionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AlertsPolicy) DeepCopyInto(out *AlertsPolicy) {
+func (in *AlertsInfraCondition) DeepCopyInto(out *AlertsInfraCondition) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -560,18 +596,18 @@ func (in *AlertsPolicy) DeepCopyInto(out *AlertsPolicy) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsPolicy.
-func (in *AlertsPolicy) DeepCopy() *AlertsPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsInfraCondition.
+func (in *AlertsInfraCondition) DeepCopy() *AlertsInfraCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(AlertsPolicy)
+	out := new(AlertsInfraCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AlertsPolicy) DeepCopyObject() runtime.Object {
+func (in *AlertsInfraCondition) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -579,66 +615,142 @@ func (in *AlertsPolicy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AlertsPolicyCondition) DeepCopyInto(out *AlertsPolicyCondition) {
+func (in *AlertsInfraConditionList) DeepCopyInto(out *AlertsInfraConditionList) {
 	*out = *in
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertsInfraCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsPolicyCondition.
-func (in *AlertsPolicyCondition) DeepCopy() *AlertsPolicyCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsInfraConditionList.
+func (in *AlertsInfraConditionList) DeepCopy() *AlertsInfraConditionList {
 	if in == nil {
 		return nil
 	}
-	out := new(AlertsPolicyCondition)
+	out := new(AlertsInfraConditionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertsInfraConditionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AlertsPolicyConditionSpec) DeepCopyInto(out *AlertsPolicyConditionSpec) {
+func (in *AlertsInfraConditionSpec) DeepCopyInto(out *AlertsInfraConditionSpec) {
 	*out = *in
 	in.AlertsGenericConditionSpec.DeepCopyInto(&out.AlertsGenericConditionSpec)
-	in.AlertsNrqlSpecificSpec.DeepCopyInto(&out.AlertsNrqlSpecificSpec)
-	in.AlertsAPMSpecificSpec.DeepCopyInto(&out.AlertsAPMSpecificSpec)
-	in.AlertsBaselineSpecificSpec.DeepCopyInto(&out.AlertsBaselineSpecificSpec)
+	in.AlertsInfraSpecificSpec.DeepCopyInto(&out.AlertsInfraSpecificSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsPolicyConditionSpec.
-func (in *AlertsPolicyConditionSpec) DeepCopy() *AlertsPolicyConditionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsInfraConditionSpec.
+func (in *AlertsInfraConditionSpec) DeepCopy() *AlertsInfraConditionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AlertsPolicyConditionSpec)
+	out := new(AlertsInfraConditionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AlertsPolicyList) DeepCopyInto(out *AlertsPolicyList) {
+func (in *AlertsInfraConditionStatus) DeepCopyInto(out *AlertsInfraConditionStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]AlertsPolicy, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.AppliedSpec != nil {
+		in, out := &in.AppliedSpec, &out.AppliedSpec
+		*out = new(AlertsInfraConditionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsPolicyList.
-func (in *AlertsPolicyList) DeepCopy() *AlertsPolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsInfraConditionStatus.
+func (in *AlertsInfraConditionStatus) DeepCopy() *AlertsInfraConditionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AlertsPolicyList)
+	out := new(AlertsInfraConditionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsInfraConditionThreshold) DeepCopyInto(out *AlertsInfraConditionThreshold) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsInfraConditionThreshold.
+func (in *AlertsInfraConditionThreshold) DeepCopy() *AlertsInfraConditionThreshold {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsInfraConditionThreshold)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsInfraSpecificSpec) DeepCopyInto(out *AlertsInfraSpecificSpec) {
+	*out = *in
+	if in.CriticalThreshold != nil {
+		in, out := &in.CriticalThreshold, &out.CriticalThreshold
+		*out = new(AlertsInfraConditionThreshold)
+		**out = **in
+	}
+	if in.WarningThreshold != nil {
+		in, out := &in.WarningThreshold, &out.WarningThreshold
+		*out = new(AlertsInfraConditionThreshold)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsInfraSpecificSpec.
+func (in *AlertsInfraSpecificSpec) DeepCopy() *AlertsInfraSpecificSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsInfraSpecificSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsMutingRule) DeepCopyInto(out *AlertsMutingRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsMutingRule.
+func (in *AlertsMutingRule) DeepCopy() *AlertsMutingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsMutingRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AlertsPolicyList) DeepCopyObject() runtime.Object {
+func (in *AlertsMutingRule) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -646,55 +758,151 @@ func (in *AlertsPolicyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AlertsPolicySpec) DeepCopyInto(out *AlertsPolicySpec) {
+func (in *AlertsMutingRuleCondition) DeepCopyInto(out *AlertsMutingRuleCondition) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsMutingRuleCondition.
+func (in *AlertsMutingRuleCondition) DeepCopy() *AlertsMutingRuleCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsMutingRuleCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsMutingRuleConditionGroup) DeepCopyInto(out *AlertsMutingRuleConditionGroup) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]AlertsPolicyCondition, len(*in))
+		*out = make([]AlertsMutingRuleCondition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	out.APIKeySecret = in.APIKeySecret
-	if in.ChannelIDs != nil {
-		in, out := &in.ChannelIDs, &out.ChannelIDs
-		*out = make([]int, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsMutingRuleConditionGroup.
+func (in *AlertsMutingRuleConditionGroup) DeepCopy() *AlertsMutingRuleConditionGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsMutingRuleConditionGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsMutingRuleList) DeepCopyInto(out *AlertsMutingRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertsMutingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsMutingRuleList.
+func (in *AlertsMutingRuleList) DeepCopy() *AlertsMutingRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsMutingRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertsMutingRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsMutingRuleSchedule) DeepCopyInto(out *AlertsMutingRuleSchedule) {
+	*out = *in
+	if in.WeeklyRepeatDays != nil {
+		in, out := &in.WeeklyRepeatDays, &out.WeeklyRepeatDays
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsPolicySpec.
-func (in *AlertsPolicySpec) DeepCopy() *AlertsPolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsMutingRuleSchedule.
+func (in *AlertsMutingRuleSchedule) DeepCopy() *AlertsMutingRuleSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(AlertsPolicySpec)
+	out := new(AlertsMutingRuleSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AlertsPolicyStatus) DeepCopyInto(out *AlertsPolicyStatus) {
+func (in *AlertsMutingRuleSpec) DeepCopyInto(out *AlertsMutingRuleSpec) {
+	*out = *in
+	in.Condition.DeepCopyInto(&out.Condition)
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(AlertsMutingRuleSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	out.APIKeySecret = in.APIKeySecret
+	out.AccountRef = in.AccountRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsMutingRuleSpec.
+func (in *AlertsMutingRuleSpec) DeepCopy() *AlertsMutingRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsMutingRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsMutingRuleStatus) DeepCopyInto(out *AlertsMutingRuleStatus) {
 	*out = *in
 	if in.AppliedSpec != nil {
 		in, out := &in.AppliedSpec, &out.AppliedSpec
-		*out = new(AlertsPolicySpec)
+		*out = new(AlertsMutingRuleSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsPolicyStatus.
-func (in *AlertsPolicyStatus) DeepCopy() *AlertsPolicyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsMutingRuleStatus.
+func (in *AlertsMutingRuleStatus) DeepCopy() *AlertsMutingRuleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AlertsPolicyStatus)
+	out := new(AlertsMutingRuleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ApmAlertCondition) DeepCopyInto(out *ApmAlertCondition) {
+func (in *AlertsNrqlCondition) DeepCopyInto(out *AlertsNrqlCondition) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -702,50 +910,1871 @@ func (in *ApmAlertCondition) DeepCopyInto(out *ApmAlertCondition) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApmAlertCondition.
-func (in *ApmAlertCondition) DeepCopy() *ApmAlertCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsNrqlCondition.
+func (in *AlertsNrqlCondition) DeepCopy() *AlertsNrqlCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsNrqlCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertsNrqlCondition) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsNrqlConditionExpiration) DeepCopyInto(out *AlertsNrqlConditionExpiration) {
+	*out = *in
+	if in.ExpirationDuration != nil {
+		in, out := &in.ExpirationDuration, &out.ExpirationDuration
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsNrqlConditionExpiration.
+func (in *AlertsNrqlConditionExpiration) DeepCopy() *AlertsNrqlConditionExpiration {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsNrqlConditionExpiration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsNrqlConditionList) DeepCopyInto(out *AlertsNrqlConditionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertsNrqlCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsNrqlConditionList.
+func (in *AlertsNrqlConditionList) DeepCopy() *AlertsNrqlConditionList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsNrqlConditionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertsNrqlConditionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsNrqlConditionSignal) DeepCopyInto(out *AlertsNrqlConditionSignal) {
+	*out = *in
+	if in.AggregationWindow != nil {
+		in, out := &in.AggregationWindow, &out.AggregationWindow
+		*out = new(int)
+		**out = **in
+	}
+	if in.EvaluationOffset != nil {
+		in, out := &in.EvaluationOffset, &out.EvaluationOffset
+		*out = new(int)
+		**out = **in
+	}
+	if in.FillOption != nil {
+		in, out := &in.FillOption, &out.FillOption
+		*out = new(alerts.AlertsFillOption)
+		**out = **in
+	}
+	if in.FillValue != nil {
+		in, out := &in.FillValue, &out.FillValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.AggregationMethod != nil {
+		in, out := &in.AggregationMethod, &out.AggregationMethod
+		*out = new(nrqlsignal.AggregationMethod)
+		**out = **in
+	}
+	if in.AggregationDelay != nil {
+		in, out := &in.AggregationDelay, &out.AggregationDelay
+		*out = new(int)
+		**out = **in
+	}
+	if in.AggregationTimer != nil {
+		in, out := &in.AggregationTimer, &out.AggregationTimer
+		*out = new(int)
+		**out = **in
+	}
+	if in.SlideBy != nil {
+		in, out := &in.SlideBy, &out.SlideBy
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsNrqlConditionSignal.
+func (in *AlertsNrqlConditionSignal) DeepCopy() *AlertsNrqlConditionSignal {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsNrqlConditionSignal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsNrqlConditionSpec) DeepCopyInto(out *AlertsNrqlConditionSpec) {
+	*out = *in
+	in.AlertsGenericConditionSpec.DeepCopyInto(&out.AlertsGenericConditionSpec)
+	in.AlertsNrqlSpecificSpec.DeepCopyInto(&out.AlertsNrqlSpecificSpec)
+	in.AlertsBaselineSpecificSpec.DeepCopyInto(&out.AlertsBaselineSpecificSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsNrqlConditionSpec.
+func (in *AlertsNrqlConditionSpec) DeepCopy() *AlertsNrqlConditionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsNrqlConditionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsNrqlConditionStatus) DeepCopyInto(out *AlertsNrqlConditionStatus) {
+	*out = *in
+	if in.AppliedSpec != nil {
+		in, out := &in.AppliedSpec, &out.AppliedSpec
+		*out = new(AlertsNrqlConditionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsNrqlConditionStatus.
+func (in *AlertsNrqlConditionStatus) DeepCopy() *AlertsNrqlConditionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsNrqlConditionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsNrqlConditionTerm) DeepCopyInto(out *AlertsNrqlConditionTerm) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsNrqlConditionTerm.
+func (in *AlertsNrqlConditionTerm) DeepCopy() *AlertsNrqlConditionTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsNrqlConditionTerm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsNrqlSpecificSpec) DeepCopyInto(out *AlertsNrqlSpecificSpec) {
+	*out = *in
+	out.Nrql = in.Nrql
+	if in.ValueFunction != nil {
+		in, out := &in.ValueFunction, &out.ValueFunction
+		*out = new(alerts.NrqlConditionValueFunction)
+		**out = **in
+	}
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
+		*out = new(AlertsNrqlConditionExpiration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Signal != nil {
+		in, out := &in.Signal, &out.Signal
+		*out = new(AlertsNrqlConditionSignal)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsNrqlSpecificSpec.
+func (in *AlertsNrqlSpecificSpec) DeepCopy() *AlertsNrqlSpecificSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsNrqlSpecificSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsPolicy) DeepCopyInto(out *AlertsPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsPolicy.
+func (in *AlertsPolicy) DeepCopy() *AlertsPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertsPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsPolicyCondition) DeepCopyInto(out *AlertsPolicyCondition) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsPolicyCondition.
+func (in *AlertsPolicyCondition) DeepCopy() *AlertsPolicyCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsPolicyCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsPolicyConditionSpec) DeepCopyInto(out *AlertsPolicyConditionSpec) {
+	*out = *in
+	in.AlertsGenericConditionSpec.DeepCopyInto(&out.AlertsGenericConditionSpec)
+	in.AlertsNrqlSpecificSpec.DeepCopyInto(&out.AlertsNrqlSpecificSpec)
+	in.AlertsAPMSpecificSpec.DeepCopyInto(&out.AlertsAPMSpecificSpec)
+	in.AlertsBaselineSpecificSpec.DeepCopyInto(&out.AlertsBaselineSpecificSpec)
+	in.AlertsInfraSpecificSpec.DeepCopyInto(&out.AlertsInfraSpecificSpec)
+	out.AlertsExternalServiceSpecificSpec = in.AlertsExternalServiceSpecificSpec
+	out.AlertsSyntheticsSpecificSpec = in.AlertsSyntheticsSpecificSpec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsPolicyConditionSpec.
+func (in *AlertsPolicyConditionSpec) DeepCopy() *AlertsPolicyConditionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsPolicyConditionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsPolicyList) DeepCopyInto(out *AlertsPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertsPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsPolicyList.
+func (in *AlertsPolicyList) DeepCopy() *AlertsPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertsPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsPolicySpec) DeepCopyInto(out *AlertsPolicySpec) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]AlertsPolicyCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.APIKeySecret = in.APIKeySecret
+	out.AccountRef = in.AccountRef
+	if in.ChannelIDs != nil {
+		in, out := &in.ChannelIDs, &out.ChannelIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.ChannelRefs != nil {
+		in, out := &in.ChannelRefs, &out.ChannelRefs
+		*out = make([]AlertsChannelReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsPolicySpec.
+func (in *AlertsPolicySpec) DeepCopy() *AlertsPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsPolicyStatus) DeepCopyInto(out *AlertsPolicyStatus) {
+	*out = *in
+	if in.AppliedSpec != nil {
+		in, out := &in.AppliedSpec, &out.AppliedSpec
+		*out = new(AlertsPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChannelIDs != nil {
+		in, out := &in.ChannelIDs, &out.ChannelIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsPolicyStatus.
+func (in *AlertsPolicyStatus) DeepCopy() *AlertsPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsSyntheticsCondition) DeepCopyInto(out *AlertsSyntheticsCondition) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsSyntheticsCondition.
+func (in *AlertsSyntheticsCondition) DeepCopy() *AlertsSyntheticsCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsSyntheticsCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertsSyntheticsCondition) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsSyntheticsConditionList) DeepCopyInto(out *AlertsSyntheticsConditionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertsSyntheticsCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsSyntheticsConditionList.
+func (in *AlertsSyntheticsConditionList) DeepCopy() *AlertsSyntheticsConditionList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsSyntheticsConditionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertsSyntheticsConditionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsSyntheticsConditionSpec) DeepCopyInto(out *AlertsSyntheticsConditionSpec) {
+	*out = *in
+	in.AlertsGenericConditionSpec.DeepCopyInto(&out.AlertsGenericConditionSpec)
+	out.AlertsSyntheticsSpecificSpec = in.AlertsSyntheticsSpecificSpec
+	if in.Entities != nil {
+		in, out := &in.Entities, &out.Entities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsSyntheticsConditionSpec.
+func (in *AlertsSyntheticsConditionSpec) DeepCopy() *AlertsSyntheticsConditionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsSyntheticsConditionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsSyntheticsConditionStatus) DeepCopyInto(out *AlertsSyntheticsConditionStatus) {
+	*out = *in
+	if in.AppliedSpec != nil {
+		in, out := &in.AppliedSpec, &out.AppliedSpec
+		*out = new(AlertsSyntheticsConditionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsSyntheticsConditionStatus.
+func (in *AlertsSyntheticsConditionStatus) DeepCopy() *AlertsSyntheticsConditionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsSyntheticsConditionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsSyntheticsSpecificSpec) DeepCopyInto(out *AlertsSyntheticsSpecificSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsSyntheticsSpecificSpec.
+func (in *AlertsSyntheticsSpecificSpec) DeepCopy() *AlertsSyntheticsSpecificSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsSyntheticsSpecificSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApmAlertCondition) DeepCopyInto(out *ApmAlertCondition) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApmAlertCondition.
+func (in *ApmAlertCondition) DeepCopy() *ApmAlertCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ApmAlertCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApmAlertCondition) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApmAlertConditionList) DeepCopyInto(out *ApmAlertConditionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApmAlertCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApmAlertConditionList.
+func (in *ApmAlertConditionList) DeepCopy() *ApmAlertConditionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApmAlertConditionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApmAlertConditionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApmAlertConditionSpec) DeepCopyInto(out *ApmAlertConditionSpec) {
+	*out = *in
+	in.GenericConditionSpec.DeepCopyInto(&out.GenericConditionSpec)
+	in.APMSpecificSpec.DeepCopyInto(&out.APMSpecificSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApmAlertConditionSpec.
+func (in *ApmAlertConditionSpec) DeepCopy() *ApmAlertConditionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApmAlertConditionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApmAlertConditionStatus) DeepCopyInto(out *ApmAlertConditionStatus) {
+	*out = *in
+	if in.AppliedSpec != nil {
+		in, out := &in.AppliedSpec, &out.AppliedSpec
+		*out = new(ApmAlertConditionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApmAlertConditionStatus.
+func (in *ApmAlertConditionStatus) DeepCopy() *ApmAlertConditionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApmAlertConditionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BurnRate) DeepCopyInto(out *BurnRate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BurnRate.
+func (in *BurnRate) DeepCopy() *BurnRate {
+	if in == nil {
+		return nil
+	}
+	out := new(BurnRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BurnRateAlert) DeepCopyInto(out *BurnRateAlert) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BurnRateAlert.
+func (in *BurnRateAlert) DeepCopy() *BurnRateAlert {
+	if in == nil {
+		return nil
+	}
+	out := new(BurnRateAlert)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BurnRateAlert) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BurnRateAlertEvents) DeepCopyInto(out *BurnRateAlertEvents) {
+	*out = *in
+	out.ValidEvents = in.ValidEvents
+	out.GoodEvents = in.GoodEvents
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BurnRateAlertEvents.
+func (in *BurnRateAlertEvents) DeepCopy() *BurnRateAlertEvents {
+	if in == nil {
+		return nil
+	}
+	out := new(BurnRateAlertEvents)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BurnRateAlertList) DeepCopyInto(out *BurnRateAlertList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BurnRateAlert, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BurnRateAlertList.
+func (in *BurnRateAlertList) DeepCopy() *BurnRateAlertList {
+	if in == nil {
+		return nil
+	}
+	out := new(BurnRateAlertList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BurnRateAlertList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BurnRateAlertSpec) DeepCopyInto(out *BurnRateAlertSpec) {
+	*out = *in
+	out.Objective = in.Objective
+	out.Events = in.Events
+	if in.BurnRates != nil {
+		in, out := &in.BurnRates, &out.BurnRates
+		*out = make([]BurnRate, len(*in))
+		copy(*out, *in)
+	}
+	out.APIKeySecret = in.APIKeySecret
+	out.AccountRef = in.AccountRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BurnRateAlertSpec.
+func (in *BurnRateAlertSpec) DeepCopy() *BurnRateAlertSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BurnRateAlertSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BurnRateAlertStatus) DeepCopyInto(out *BurnRateAlertStatus) {
+	*out = *in
+	if in.AppliedSpec != nil {
+		in, out := &in.AppliedSpec, &out.AppliedSpec
+		*out = new(BurnRateAlertSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BurnRateAlertStatus.
+func (in *BurnRateAlertStatus) DeepCopy() *BurnRateAlertStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BurnRateAlertStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BurnRateObjective) DeepCopyInto(out *BurnRateObjective) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BurnRateObjective.
+func (in *BurnRateObjective) DeepCopy() *BurnRateObjective {
+	if in == nil {
+		return nil
+	}
+	out := new(BurnRateObjective)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelHeader) DeepCopyInto(out *ChannelHeader) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelHeader.
+func (in *ChannelHeader) DeepCopy() *ChannelHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelLinks) DeepCopyInto(out *ChannelLinks) {
+	*out = *in
+	if in.PolicyIDs != nil {
+		in, out := &in.PolicyIDs, &out.PolicyIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.PolicyNames != nil {
+		in, out := &in.PolicyNames, &out.PolicyNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PolicyKubernetesObjects != nil {
+		in, out := &in.PolicyKubernetesObjects, &out.PolicyKubernetesObjects
+		*out = make([]metav1.ObjectMeta, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelLinks.
+func (in *ChannelLinks) DeepCopy() *ChannelLinks {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelLinks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelValueSource) DeepCopyInto(out *ChannelValueSource) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(SecretKeyReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelValueSource.
+func (in *ChannelValueSource) DeepCopy() *ChannelValueSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelValueSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterNewRelicAccount) DeepCopyInto(out *ClusterNewRelicAccount) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNewRelicAccount.
+func (in *ClusterNewRelicAccount) DeepCopy() *ClusterNewRelicAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterNewRelicAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterNewRelicAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterNewRelicAccountList) DeepCopyInto(out *ClusterNewRelicAccountList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterNewRelicAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNewRelicAccountList.
+func (in *ClusterNewRelicAccountList) DeepCopy() *ClusterNewRelicAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterNewRelicAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterNewRelicAccountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Condition) DeepCopyInto(out *Condition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
+func (in *Condition) DeepCopy() *Condition {
+	if in == nil {
+		return nil
+	}
+	out := new(Condition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionSpec) DeepCopyInto(out *ConditionSpec) {
+	*out = *in
+	in.GenericConditionSpec.DeepCopyInto(&out.GenericConditionSpec)
+	out.NrqlSpecificSpec = in.NrqlSpecificSpec
+	in.APMSpecificSpec.DeepCopyInto(&out.APMSpecificSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionSpec.
+func (in *ConditionSpec) DeepCopy() *ConditionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Credentials) DeepCopyInto(out *Credentials) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Credentials.
+func (in *Credentials) DeepCopy() *Credentials {
+	if in == nil {
+		return nil
+	}
+	out := new(Credentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dashboard) DeepCopyInto(out *Dashboard) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dashboard.
+func (in *Dashboard) DeepCopy() *Dashboard {
+	if in == nil {
+		return nil
+	}
+	out := new(Dashboard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Dashboard) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardList) DeepCopyInto(out *DashboardList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Dashboard, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardList.
+func (in *DashboardList) DeepCopy() *DashboardList {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DashboardList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardPage) DeepCopyInto(out *DashboardPage) {
+	*out = *in
+	if in.Widgets != nil {
+		in, out := &in.Widgets, &out.Widgets
+		*out = make([]DashboardWidget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardPage.
+func (in *DashboardPage) DeepCopy() *DashboardPage {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardPage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardSpec) DeepCopyInto(out *DashboardSpec) {
+	*out = *in
+	if in.Pages != nil {
+		in, out := &in.Pages, &out.Pages
+		*out = make([]DashboardPage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.APIKeySecret = in.APIKeySecret
+	out.AccountRef = in.AccountRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardSpec.
+func (in *DashboardSpec) DeepCopy() *DashboardSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardStatus) DeepCopyInto(out *DashboardStatus) {
+	*out = *in
+	if in.AppliedSpec != nil {
+		in, out := &in.AppliedSpec, &out.AppliedSpec
+		*out = new(DashboardSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardStatus.
+func (in *DashboardStatus) DeepCopy() *DashboardStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardWidget) DeepCopyInto(out *DashboardWidget) {
+	*out = *in
+	out.Layout = in.Layout
+	if in.Queries != nil {
+		in, out := &in.Queries, &out.Queries
+		*out = make([]DashboardWidgetQuery, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardWidget.
+func (in *DashboardWidget) DeepCopy() *DashboardWidget {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardWidget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardWidgetLayout) DeepCopyInto(out *DashboardWidgetLayout) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardWidgetLayout.
+func (in *DashboardWidgetLayout) DeepCopy() *DashboardWidgetLayout {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardWidgetLayout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardWidgetQuery) DeepCopyInto(out *DashboardWidgetQuery) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardWidgetQuery.
+func (in *DashboardWidgetQuery) DeepCopy() *DashboardWidgetQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardWidgetQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GenericConditionSpec) DeepCopyInto(out *GenericConditionSpec) {
+	*out = *in
+	if in.Terms != nil {
+		in, out := &in.Terms, &out.Terms
+		*out = make([]AlertConditionTerm, len(*in))
+		copy(*out, *in)
+	}
+	out.APIKeySecret = in.APIKeySecret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericConditionSpec.
+func (in *GenericConditionSpec) DeepCopy() *GenericConditionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GenericConditionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in InvalidAttributeSlice) DeepCopyInto(out *InvalidAttributeSlice) {
+	{
+		in := &in
+		*out = make(InvalidAttributeSlice, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidAttributeSlice.
+func (in InvalidAttributeSlice) DeepCopy() InvalidAttributeSlice {
+	if in == nil {
+		return nil
+	}
+	out := new(InvalidAttributeSlice)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewRelicAPIKeySecret) DeepCopyInto(out *NewRelicAPIKeySecret) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewRelicAPIKeySecret.
+func (in *NewRelicAPIKeySecret) DeepCopy() *NewRelicAPIKeySecret {
+	if in == nil {
+		return nil
+	}
+	out := new(NewRelicAPIKeySecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewRelicAccount) DeepCopyInto(out *NewRelicAccount) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewRelicAccount.
+func (in *NewRelicAccount) DeepCopy() *NewRelicAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(NewRelicAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NewRelicAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewRelicAccountList) DeepCopyInto(out *NewRelicAccountList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NewRelicAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewRelicAccountList.
+func (in *NewRelicAccountList) DeepCopy() *NewRelicAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(NewRelicAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NewRelicAccountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewRelicAccountReference) DeepCopyInto(out *NewRelicAccountReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewRelicAccountReference.
+func (in *NewRelicAccountReference) DeepCopy() *NewRelicAccountReference {
+	if in == nil {
+		return nil
+	}
+	out := new(NewRelicAccountReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewRelicAccountSpec) DeepCopyInto(out *NewRelicAccountSpec) {
+	*out = *in
+	out.APIKeySecret = in.APIKeySecret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewRelicAccountSpec.
+func (in *NewRelicAccountSpec) DeepCopy() *NewRelicAccountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NewRelicAccountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewRelicAccountStatus) DeepCopyInto(out *NewRelicAccountStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewRelicAccountStatus.
+func (in *NewRelicAccountStatus) DeepCopy() *NewRelicAccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NewRelicAccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationChannel) DeepCopyInto(out *NotificationChannel) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationChannel.
+func (in *NotificationChannel) DeepCopy() *NotificationChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationChannel) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationChannelList) DeepCopyInto(out *NotificationChannelList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationChannel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationChannelList.
+func (in *NotificationChannelList) DeepCopy() *NotificationChannelList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationChannelList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationChannelList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationChannelSpec) DeepCopyInto(out *NotificationChannelSpec) {
+	*out = *in
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make([]NotificationProperty, len(*in))
+		copy(*out, *in)
+	}
+	out.APIKeySecret = in.APIKeySecret
+	out.AccountRef = in.AccountRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationChannelSpec.
+func (in *NotificationChannelSpec) DeepCopy() *NotificationChannelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationChannelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationChannelStatus) DeepCopyInto(out *NotificationChannelStatus) {
+	*out = *in
+	if in.AppliedSpec != nil {
+		in, out := &in.AppliedSpec, &out.AppliedSpec
+		*out = new(NotificationChannelSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationChannelStatus.
+func (in *NotificationChannelStatus) DeepCopy() *NotificationChannelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationChannelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDestination) DeepCopyInto(out *NotificationDestination) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDestination.
+func (in *NotificationDestination) DeepCopy() *NotificationDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationDestination) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDestinationAuth) DeepCopyInto(out *NotificationDestinationAuth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDestinationAuth.
+func (in *NotificationDestinationAuth) DeepCopy() *NotificationDestinationAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDestinationAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDestinationList) DeepCopyInto(out *NotificationDestinationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationDestination, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDestinationList.
+func (in *NotificationDestinationList) DeepCopy() *NotificationDestinationList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDestinationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationDestinationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDestinationSpec) DeepCopyInto(out *NotificationDestinationSpec) {
+	*out = *in
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make([]NotificationProperty, len(*in))
+		copy(*out, *in)
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(NotificationDestinationAuth)
+		**out = **in
+	}
+	out.APIKeySecret = in.APIKeySecret
+	out.AccountRef = in.AccountRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDestinationSpec.
+func (in *NotificationDestinationSpec) DeepCopy() *NotificationDestinationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDestinationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDestinationStatus) DeepCopyInto(out *NotificationDestinationStatus) {
+	*out = *in
+	if in.AppliedSpec != nil {
+		in, out := &in.AppliedSpec, &out.AppliedSpec
+		*out = new(NotificationDestinationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDestinationStatus.
+func (in *NotificationDestinationStatus) DeepCopy() *NotificationDestinationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDestinationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationProperty) DeepCopyInto(out *NotificationProperty) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationProperty.
+func (in *NotificationProperty) DeepCopy() *NotificationProperty {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationProperty)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NrqlAlertCondition) DeepCopyInto(out *NrqlAlertCondition) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NrqlAlertCondition.
+func (in *NrqlAlertCondition) DeepCopy() *NrqlAlertCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(NrqlAlertCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NrqlAlertCondition) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NrqlAlertConditionList) DeepCopyInto(out *NrqlAlertConditionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NrqlAlertCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NrqlAlertConditionList.
+func (in *NrqlAlertConditionList) DeepCopy() *NrqlAlertConditionList {
+	if in == nil {
+		return nil
+	}
+	out := new(NrqlAlertConditionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NrqlAlertConditionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NrqlAlertConditionSpec) DeepCopyInto(out *NrqlAlertConditionSpec) {
+	*out = *in
+	in.GenericConditionSpec.DeepCopyInto(&out.GenericConditionSpec)
+	out.NrqlSpecificSpec = in.NrqlSpecificSpec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NrqlAlertConditionSpec.
+func (in *NrqlAlertConditionSpec) DeepCopy() *NrqlAlertConditionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NrqlAlertConditionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NrqlAlertConditionStatus) DeepCopyInto(out *NrqlAlertConditionStatus) {
+	*out = *in
+	if in.AppliedSpec != nil {
+		in, out := &in.AppliedSpec, &out.AppliedSpec
+		*out = new(NrqlAlertConditionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NrqlAlertConditionStatus.
+func (in *NrqlAlertConditionStatus) DeepCopy() *NrqlAlertConditionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NrqlAlertConditionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NrqlQuery) DeepCopyInto(out *NrqlQuery) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NrqlQuery.
+func (in *NrqlQuery) DeepCopy() *NrqlQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(NrqlQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NrqlSpecificSpec) DeepCopyInto(out *NrqlSpecificSpec) {
+	*out = *in
+	out.Nrql = in.Nrql
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NrqlSpecificSpec.
+func (in *NrqlSpecificSpec) DeepCopy() *NrqlSpecificSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NrqlSpecificSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Policy) DeepCopyInto(out *Policy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policy.
+func (in *Policy) DeepCopy() *Policy {
+	if in == nil {
+		return nil
+	}
+	out := new(Policy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Policy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyCondition) DeepCopyInto(out *PolicyCondition) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyCondition.
+func (in *PolicyCondition) DeepCopy() *PolicyCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyList) DeepCopyInto(out *PolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Policy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyList.
+func (in *PolicyList) DeepCopy() *PolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySpec) DeepCopyInto(out *PolicySpec) {
+	*out = *in
+	out.APIKeySecret = in.APIKeySecret
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]PolicyCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySpec.
+func (in *PolicySpec) DeepCopy() *PolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
+	*out = *in
+	if in.AppliedSpec != nil {
+		in, out := &in.AppliedSpec, &out.AppliedSpec
+		*out = new(PolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
+func (in *PolicyStatus) DeepCopy() *PolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyNotFoundError) DeepCopyInto(out *SecretKeyNotFoundError) {
+	*out = *in
+	out.Secret = in.Secret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyNotFoundError.
+func (in *SecretKeyNotFoundError) DeepCopy() *SecretKeyNotFoundError {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyNotFoundError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyReference) DeepCopyInto(out *SecretKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyReference.
+func (in *SecretKeyReference) DeepCopy() *SecretKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretNotFoundError) DeepCopyInto(out *SecretNotFoundError) {
+	*out = *in
+	out.Secret = in.Secret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretNotFoundError.
+func (in *SecretNotFoundError) DeepCopy() *SecretNotFoundError {
 	if in == nil {
 		return nil
 	}
-	out := new(ApmAlertCondition)
+	out := new(SecretNotFoundError)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ApmAlertCondition) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ApmAlertConditionList) DeepCopyInto(out *ApmAlertConditionList) {
+func (in *ServiceLevel) DeepCopyInto(out *ServiceLevel) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ApmAlertCondition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApmAlertConditionList.
-func (in *ApmAlertConditionList) DeepCopy() *ApmAlertConditionList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevel.
+func (in *ServiceLevel) DeepCopy() *ServiceLevel {
 	if in == nil {
 		return nil
 	}
-	out := new(ApmAlertConditionList)
+	out := new(ServiceLevel)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ApmAlertConditionList) DeepCopyObject() runtime.Object {
+func (in *ServiceLevel) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -753,164 +2782,170 @@ func (in *ApmAlertConditionList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ApmAlertConditionSpec) DeepCopyInto(out *ApmAlertConditionSpec) {
+func (in *ServiceLevelBurnRateAlerts) DeepCopyInto(out *ServiceLevelBurnRateAlerts) {
 	*out = *in
-	in.GenericConditionSpec.DeepCopyInto(&out.GenericConditionSpec)
-	in.APMSpecificSpec.DeepCopyInto(&out.APMSpecificSpec)
+	if in.BurnRates != nil {
+		in, out := &in.BurnRates, &out.BurnRates
+		*out = make([]BurnRate, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApmAlertConditionSpec.
-func (in *ApmAlertConditionSpec) DeepCopy() *ApmAlertConditionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelBurnRateAlerts.
+func (in *ServiceLevelBurnRateAlerts) DeepCopy() *ServiceLevelBurnRateAlerts {
 	if in == nil {
 		return nil
 	}
-	out := new(ApmAlertConditionSpec)
+	out := new(ServiceLevelBurnRateAlerts)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ApmAlertConditionStatus) DeepCopyInto(out *ApmAlertConditionStatus) {
+func (in *ServiceLevelEvents) DeepCopyInto(out *ServiceLevelEvents) {
 	*out = *in
-	if in.AppliedSpec != nil {
-		in, out := &in.AppliedSpec, &out.AppliedSpec
-		*out = new(ApmAlertConditionSpec)
-		(*in).DeepCopyInto(*out)
+	out.ValidEvents = in.ValidEvents
+	if in.GoodEvents != nil {
+		in, out := &in.GoodEvents, &out.GoodEvents
+		*out = new(ServiceLevelEventsQuery)
+		**out = **in
+	}
+	if in.BadEvents != nil {
+		in, out := &in.BadEvents, &out.BadEvents
+		*out = new(ServiceLevelEventsQuery)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApmAlertConditionStatus.
-func (in *ApmAlertConditionStatus) DeepCopy() *ApmAlertConditionStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelEvents.
+func (in *ServiceLevelEvents) DeepCopy() *ServiceLevelEvents {
 	if in == nil {
 		return nil
 	}
-	out := new(ApmAlertConditionStatus)
+	out := new(ServiceLevelEvents)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChannelHeader) DeepCopyInto(out *ChannelHeader) {
+func (in *ServiceLevelEventsQuery) DeepCopyInto(out *ServiceLevelEventsQuery) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelHeader.
-func (in *ChannelHeader) DeepCopy() *ChannelHeader {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelEventsQuery.
+func (in *ServiceLevelEventsQuery) DeepCopy() *ServiceLevelEventsQuery {
 	if in == nil {
 		return nil
 	}
-	out := new(ChannelHeader)
+	out := new(ServiceLevelEventsQuery)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChannelLinks) DeepCopyInto(out *ChannelLinks) {
+func (in *ServiceLevelList) DeepCopyInto(out *ServiceLevelList) {
 	*out = *in
-	if in.PolicyIDs != nil {
-		in, out := &in.PolicyIDs, &out.PolicyIDs
-		*out = make([]int, len(*in))
-		copy(*out, *in)
-	}
-	if in.PolicyNames != nil {
-		in, out := &in.PolicyNames, &out.PolicyNames
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.PolicyKubernetesObjects != nil {
-		in, out := &in.PolicyKubernetesObjects, &out.PolicyKubernetesObjects
-		*out = make([]metav1.ObjectMeta, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceLevel, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelLinks.
-func (in *ChannelLinks) DeepCopy() *ChannelLinks {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelList.
+func (in *ServiceLevelList) DeepCopy() *ServiceLevelList {
 	if in == nil {
 		return nil
 	}
-	out := new(ChannelLinks)
+	out := new(ServiceLevelList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceLevelList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConditionSpec) DeepCopyInto(out *ConditionSpec) {
+func (in *ServiceLevelObjective) DeepCopyInto(out *ServiceLevelObjective) {
 	*out = *in
-	in.GenericConditionSpec.DeepCopyInto(&out.GenericConditionSpec)
-	out.NrqlSpecificSpec = in.NrqlSpecificSpec
-	in.APMSpecificSpec.DeepCopyInto(&out.APMSpecificSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionSpec.
-func (in *ConditionSpec) DeepCopy() *ConditionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjective.
+func (in *ServiceLevelObjective) DeepCopy() *ServiceLevelObjective {
 	if in == nil {
 		return nil
 	}
-	out := new(ConditionSpec)
+	out := new(ServiceLevelObjective)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GenericConditionSpec) DeepCopyInto(out *GenericConditionSpec) {
+func (in *ServiceLevelSpec) DeepCopyInto(out *ServiceLevelSpec) {
 	*out = *in
-	if in.Terms != nil {
-		in, out := &in.Terms, &out.Terms
-		*out = make([]AlertConditionTerm, len(*in))
+	in.Events.DeepCopyInto(&out.Events)
+	if in.Objectives != nil {
+		in, out := &in.Objectives, &out.Objectives
+		*out = make([]ServiceLevelObjective, len(*in))
 		copy(*out, *in)
 	}
+	if in.BurnRateAlerts != nil {
+		in, out := &in.BurnRateAlerts, &out.BurnRateAlerts
+		*out = new(ServiceLevelBurnRateAlerts)
+		(*in).DeepCopyInto(*out)
+	}
 	out.APIKeySecret = in.APIKeySecret
+	out.AccountRef = in.AccountRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericConditionSpec.
-func (in *GenericConditionSpec) DeepCopy() *GenericConditionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelSpec.
+func (in *ServiceLevelSpec) DeepCopy() *ServiceLevelSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GenericConditionSpec)
+	out := new(ServiceLevelSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in InvalidAttributeSlice) DeepCopyInto(out *InvalidAttributeSlice) {
-	{
-		in := &in
-		*out = make(InvalidAttributeSlice, len(*in))
-		copy(*out, *in)
+func (in *ServiceLevelStatus) DeepCopyInto(out *ServiceLevelStatus) {
+	*out = *in
+	if in.AppliedSpec != nil {
+		in, out := &in.AppliedSpec, &out.AppliedSpec
+		*out = new(ServiceLevelSpec)
+		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidAttributeSlice.
-func (in InvalidAttributeSlice) DeepCopy() InvalidAttributeSlice {
-	if in == nil {
-		return nil
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out := new(InvalidAttributeSlice)
-	in.DeepCopyInto(out)
-	return *out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NewRelicAPIKeySecret) DeepCopyInto(out *NewRelicAPIKeySecret) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewRelicAPIKeySecret.
-func (in *NewRelicAPIKeySecret) DeepCopy() *NewRelicAPIKeySecret {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelStatus.
+func (in *ServiceLevelStatus) DeepCopy() *ServiceLevelStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NewRelicAPIKeySecret)
+	out := new(ServiceLevelStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NrqlAlertCondition) DeepCopyInto(out *NrqlAlertCondition) {
+func (in *SyntheticsMonitor) DeepCopyInto(out *SyntheticsMonitor) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -918,18 +2953,18 @@ func (in *NrqlAlertCondition) DeepCopyInto(out *NrqlAlertCondition) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NrqlAlertCondition.
-func (in *NrqlAlertCondition) DeepCopy() *NrqlAlertCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyntheticsMonitor.
+func (in *SyntheticsMonitor) DeepCopy() *SyntheticsMonitor {
 	if in == nil {
 		return nil
 	}
-	out := new(NrqlAlertCondition)
+	out := new(SyntheticsMonitor)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NrqlAlertCondition) DeepCopyObject() runtime.Object {
+func (in *SyntheticsMonitor) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -937,31 +2972,31 @@ func (in *NrqlAlertCondition) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NrqlAlertConditionList) DeepCopyInto(out *NrqlAlertConditionList) {
+func (in *SyntheticsMonitorList) DeepCopyInto(out *SyntheticsMonitorList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NrqlAlertCondition, len(*in))
+		*out = make([]SyntheticsMonitor, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NrqlAlertConditionList.
-func (in *NrqlAlertConditionList) DeepCopy() *NrqlAlertConditionList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyntheticsMonitorList.
+func (in *SyntheticsMonitorList) DeepCopy() *SyntheticsMonitorList {
 	if in == nil {
 		return nil
 	}
-	out := new(NrqlAlertConditionList)
+	out := new(SyntheticsMonitorList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NrqlAlertConditionList) DeepCopyObject() runtime.Object {
+func (in *SyntheticsMonitorList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -969,75 +3004,72 @@ func (in *NrqlAlertConditionList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NrqlAlertConditionSpec) DeepCopyInto(out *NrqlAlertConditionSpec) {
+func (in *SyntheticsMonitorOptions) DeepCopyInto(out *SyntheticsMonitorOptions) {
 	*out = *in
-	in.GenericConditionSpec.DeepCopyInto(&out.GenericConditionSpec)
-	out.NrqlSpecificSpec = in.NrqlSpecificSpec
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NrqlAlertConditionSpec.
-func (in *NrqlAlertConditionSpec) DeepCopy() *NrqlAlertConditionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyntheticsMonitorOptions.
+func (in *SyntheticsMonitorOptions) DeepCopy() *SyntheticsMonitorOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(NrqlAlertConditionSpec)
+	out := new(SyntheticsMonitorOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NrqlAlertConditionStatus) DeepCopyInto(out *NrqlAlertConditionStatus) {
+func (in *SyntheticsMonitorSpec) DeepCopyInto(out *SyntheticsMonitorSpec) {
 	*out = *in
-	if in.AppliedSpec != nil {
-		in, out := &in.AppliedSpec, &out.AppliedSpec
-		*out = new(NrqlAlertConditionSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Locations != nil {
+		in, out := &in.Locations, &out.Locations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
+	out.Options = in.Options
+	out.APIKeySecret = in.APIKeySecret
+	out.AccountRef = in.AccountRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NrqlAlertConditionStatus.
-func (in *NrqlAlertConditionStatus) DeepCopy() *NrqlAlertConditionStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyntheticsMonitorSpec.
+func (in *SyntheticsMonitorSpec) DeepCopy() *SyntheticsMonitorSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NrqlAlertConditionStatus)
+	out := new(SyntheticsMonitorSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NrqlQuery) DeepCopyInto(out *NrqlQuery) {
+func (in *SyntheticsMonitorStatus) DeepCopyInto(out *SyntheticsMonitorStatus) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NrqlQuery.
-func (in *NrqlQuery) DeepCopy() *NrqlQuery {
-	if in == nil {
-		return nil
+	if in.AppliedSpec != nil {
+		in, out := &in.AppliedSpec, &out.AppliedSpec
+		*out = new(SyntheticsMonitorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out := new(NrqlQuery)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NrqlSpecificSpec) DeepCopyInto(out *NrqlSpecificSpec) {
-	*out = *in
-	out.Nrql = in.Nrql
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NrqlSpecificSpec.
-func (in *NrqlSpecificSpec) DeepCopy() *NrqlSpecificSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyntheticsMonitorStatus.
+func (in *SyntheticsMonitorStatus) DeepCopy() *SyntheticsMonitorStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NrqlSpecificSpec)
+	out := new(SyntheticsMonitorStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Policy) DeepCopyInto(out *Policy) {
+func (in *Workflow) DeepCopyInto(out *Workflow) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1045,18 +3077,18 @@ func (in *Policy) DeepCopyInto(out *Policy) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policy.
-func (in *Policy) DeepCopy() *Policy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Workflow.
+func (in *Workflow) DeepCopy() *Workflow {
 	if in == nil {
 		return nil
 	}
-	out := new(Policy)
+	out := new(Workflow)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Policy) DeepCopyObject() runtime.Object {
+func (in *Workflow) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1064,47 +3096,58 @@ func (in *Policy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyCondition) DeepCopyInto(out *PolicyCondition) {
+func (in *WorkflowIssuesFilter) DeepCopyInto(out *WorkflowIssuesFilter) {
 	*out = *in
-	in.Spec.DeepCopyInto(&out.Spec)
+	if in.PolicyRefs != nil {
+		in, out := &in.PolicyRefs, &out.PolicyRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Predicates != nil {
+		in, out := &in.Predicates, &out.Predicates
+		*out = make([]WorkflowPredicate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyCondition.
-func (in *PolicyCondition) DeepCopy() *PolicyCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowIssuesFilter.
+func (in *WorkflowIssuesFilter) DeepCopy() *WorkflowIssuesFilter {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyCondition)
+	out := new(WorkflowIssuesFilter)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyList) DeepCopyInto(out *PolicyList) {
+func (in *WorkflowList) DeepCopyInto(out *WorkflowList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Policy, len(*in))
+		*out = make([]Workflow, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyList.
-func (in *PolicyList) DeepCopy() *PolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowList.
+func (in *WorkflowList) DeepCopy() *WorkflowList {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyList)
+	out := new(WorkflowList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PolicyList) DeepCopyObject() runtime.Object {
+func (in *WorkflowList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1112,44 +3155,86 @@ func (in *PolicyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicySpec) DeepCopyInto(out *PolicySpec) {
+func (in *WorkflowPredicate) DeepCopyInto(out *WorkflowPredicate) {
 	*out = *in
-	out.APIKeySecret = in.APIKeySecret
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]PolicyCondition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySpec.
-func (in *PolicySpec) DeepCopy() *PolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowPredicate.
+func (in *WorkflowPredicate) DeepCopy() *WorkflowPredicate {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicySpec)
+	out := new(WorkflowPredicate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
+func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ChannelRefs != nil {
+		in, out := &in.ChannelRefs, &out.ChannelRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.IssuesFilter.DeepCopyInto(&out.IssuesFilter)
+	out.APIKeySecret = in.APIKeySecret
+	out.AccountRef = in.AccountRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowSpec.
+func (in *WorkflowSpec) DeepCopy() *WorkflowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowStatus) DeepCopyInto(out *WorkflowStatus) {
 	*out = *in
 	if in.AppliedSpec != nil {
 		in, out := &in.AppliedSpec, &out.AppliedSpec
-		*out = new(PolicySpec)
+		*out = new(WorkflowSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ChannelIDs != nil {
+		in, out := &in.ChannelIDs, &out.ChannelIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PolicyIDs != nil {
+		in, out := &in.PolicyIDs, &out.PolicyIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
-func (in *PolicyStatus) DeepCopy() *PolicyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowStatus.
+func (in *WorkflowStatus) DeepCopy() *WorkflowStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyStatus)
+	out := new(WorkflowStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,47 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"os"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/controllers"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/concurrency"
+)
+
+func registerAPM(mgr *ctrl.Manager, nrApp *newrelic.Application, maxConcurrentReconciles *concurrency.MaxConcurrentReconciles) error {
+
+	// deployment markers for rollouts of annotated Deployments
+	deploymentMarkerReconciler := &controllers.DeploymentMarkerReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("DeploymentMarker"),
+		Recorder:                (*mgr).GetEventRecorderFor("deployment-marker-controller"),
+		APMClientFunc:           interfaces.InitializeAPMClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("DeploymentMarker"),
+	}
+
+	if err := deploymentMarkerReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DeploymentMarker")
+		os.Exit(1)
+	}
+
+	return nil
+}
@@ -20,13 +20,17 @@ LDFLAGS_UNIT ?= '-X $(PROJECT_MODULE)/internal/version.GitTag=$(PROJECT_VER_TAGG
 GOTOOLS += github.com/stretchr/testify/assert
 
 test: test-only
-test-only: test-unit test-integration
+test-only: test-unit test-race test-integration
 
 test-unit:
 	@echo "=== $(PROJECT_NAME) === [ test-unit        ]: running unit tests..."
 	@mkdir -p $(COVERAGE_DIR)
 	@$(GO) test -v -ldflags=$(LDFLAGS_UNIT) -parallel 4 -tags unit -covermode=$(COVERMODE) -coverprofile $(COVERAGE_DIR)/unit.tmp $(GO_PKGS)
 
+test-race:
+	@echo "=== $(PROJECT_NAME) === [ test-race        ]: running tests with the race detector..."
+	@$(GO) test -race -ldflags=$(LDFLAGS_UNIT) ./controllers/ -ginkgo.focus="concurrent reconciliation"
+
 test-integration:
 	@echo "=== $(PROJECT_NAME) === [ test-integration ]: running integration tests..."
 	@mkdir -p $(COVERAGE_DIR)
@@ -51,4 +55,4 @@ cover-report:
 cover-view: cover-report
 	@$(GO) tool cover -html=$(COVERAGE_DIR)/coverage.out
 
-.PHONY: test test-only test-unit test-integration cover-report cover-view
+.PHONY: test test-only test-unit test-race test-integration cover-report cover-view
@@ -16,19 +16,21 @@ limitations under the License.
 package controllers
 
 import (
-	"context"
-	"errors"
+	"fmt"
 	"reflect"
 	"strconv"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	kErr "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	newrelic "github.com/newrelic/go-agent/v3/newrelic"
 
@@ -39,13 +41,14 @@ import (
 // AlertsAPMConditionReconciler reconciles a AlertsAPMCondition object
 type AlertsAPMConditionReconciler struct {
 	client.Client
-	Log             logr.Logger
-	Scheme          *runtime.Scheme
-	AlertClientFunc func(string, string) (interfaces.NewRelicAlertsClient, error)
-	apiKey          string
-	Alerts          interfaces.NewRelicAlertsClient
-	NewRelicAgent   newrelic.Application
-	txn             *newrelic.Transaction
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	AlertClientFunc         func(string, string) (interfaces.NewRelicAlertsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+	ResyncInterval          time.Duration
+	CorrectDrift            bool
 }
 
 // +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=alertsapmconditions,verbs=get;list;watch;create;update;patch;delete
@@ -53,15 +56,14 @@ type AlertsAPMConditionReconciler struct {
 
 // nolint:gocyclo
 func (r *AlertsAPMConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
-	r.txn = r.NewRelicAgent.StartTransaction("Reconcile/Alerts/apmCondition")
-	defer r.txn.End()
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Alerts/apmCondition")
+	defer rc.txn.End()
 
-	ctx := context.Background()
 	_ = r.Log.WithValues("alertsapmcondition", req.NamespacedName)
 
 	r.Log.Info("Starting reconcile action")
 	var condition nralertsv1.AlertsAPMCondition
-	err := r.Client.Get(ctx, req.NamespacedName, &condition)
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &condition)
 	if err != nil {
 		if kErr.IsNotFound(err) {
 			r.Log.Info("Expected error 'not found' after condition deleted", "error", err)
@@ -71,21 +73,19 @@ func (r *AlertsAPMConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result,
 		return ctrl.Result{}, err
 	}
 
-	r.apiKey, err = r.getAPIKeyOrSecret(condition)
+	err = resolveCredentials(rc, r.Client, &condition)
 	if err != nil {
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &condition, failureReason(err, nralertsv1.ReasonCredentialsError), err)
 		return ctrl.Result{}, err
 	}
-
-	if r.apiKey == "" {
-		return ctrl.Result{}, errors.New("api key is blank")
-	}
 	//initial alertsClient
-	alertsClient, errAlertsClient := r.AlertClientFunc(r.apiKey, condition.Spec.Region)
+	alertsClient, errAlertsClient := r.AlertClientFunc(rc.apiKey, rc.region)
 	if errAlertsClient != nil {
 		r.Log.Error(errAlertsClient, "Error thrown")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &condition, nralertsv1.ReasonCredentialsError, errAlertsClient)
 		return ctrl.Result{}, errAlertsClient
 	}
-	r.Alerts = alertsClient
+	rc.alerts = interfaces.NewTracedAlertsClient(alertsClient, rc.txn, rc.accountID, rc.region)
 
 	deleteFinalizer := "alertsapmconditions.finalizers.nr.k8s.newrelic.com"
 
@@ -101,19 +101,19 @@ func (r *AlertsAPMConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result,
 			if condition.Status.ConditionID == 0 {
 				r.Log.Info("No Condition ID set, just removing finalizer")
 				condition.Finalizers = removeString(condition.Finalizers, deleteFinalizer)
-				if err := r.Client.Update(ctx, &condition); err != nil {
+				if err := r.Client.Update(rc.ctx, &condition); err != nil {
 					r.Log.Error(err, "Failed to update condition after deleting New Relic Alert condition")
 					return ctrl.Result{}, err
 				}
 			} else {
 				// our finalizer is present, so lets handle any external dependency
-				if err := r.deleteNewRelicAlertCondition(condition); err != nil {
+				if err := r.deleteNewRelicAlertCondition(rc, condition); err != nil {
 					// if fail to delete the external dependency here, return with error
 					// so that it can be retried
 					r.Log.Error(err, "Failed to delete API Condition",
 						"conditionId", condition.Status.ConditionID,
 						"region", condition.Spec.Region,
-						"Api Key", interfaces.PartialAPIKey(r.apiKey),
+						"Api Key", interfaces.PartialAPIKey(rc.apiKey),
 					)
 					if err.Error() == "resource not found" {
 						r.Log.Info("New Relic API returned resource not found, deleting condition resource")
@@ -121,15 +121,18 @@ func (r *AlertsAPMConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result,
 						r.Log.Error(err, "Failed to delete API Condition",
 							"conditionId", condition.Status.ConditionID,
 							"region", condition.Spec.Region,
-							"Api Key", interfaces.PartialAPIKey(r.apiKey),
+							"Api Key", interfaces.PartialAPIKey(rc.apiKey),
 						)
+						updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &condition, nralertsv1.ReasonDeleteFailed, err)
 						return ctrl.Result{}, err
 					}
 				}
+				r.Recorder.Eventf(&condition, v1.EventTypeNormal, eventReasonDeleted, "Deleted New Relic condition %d", condition.Status.ConditionID)
+
 				// remove our finalizer from the list and update it.
 				r.Log.Info("New Relic Alert condition deleted, Removing finalizer")
 				condition.Finalizers = removeString(condition.Finalizers, deleteFinalizer)
-				if err := r.Client.Update(ctx, &condition); err != nil {
+				if err := r.Client.Update(rc.ctx, &condition); err != nil {
 					r.Log.Error(err, "Failed to update condition after deleting New Relic Alert condition")
 					return ctrl.Result{}, err
 				}
@@ -142,16 +145,31 @@ func (r *AlertsAPMConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result,
 		return ctrl.Result{}, nil
 	}
 
-	if reflect.DeepEqual(&condition.Spec, condition.Status.AppliedSpec) {
-		return ctrl.Result{}, nil
+	if reflect.DeepEqual(condition.Spec.Redacted(), condition.Status.AppliedSpec) {
+		drifted, err := r.checkForAPMConditionDrift(rc, &condition)
+		if err != nil {
+			r.Log.Error(err, "failed to resync condition with New Relic", "name", req.NamespacedName)
+			recordFailure(r.Recorder, &condition, eventReasonResyncFailed, err)
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
+
+		if !drifted {
+			if err := updateReadyConditionsIfNeeded(rc.ctx, r.Client, &condition); err != nil {
+				r.Log.Error(err, "tried updating condition status", "name", req.NamespacedName)
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
 	}
 
 	r.Log.Info("Reconciling", "condition", condition.Name)
 
 	//check if condition has condition id
-	r.checkForExistingCondition(&condition)
+	r.checkForExistingCondition(rc, &condition)
 
-	r.writeNewRelicAlertCondition(ctx, req, alertsClient, condition)
+	if err := r.writeNewRelicAlertCondition(rc, req, condition); err != nil {
+		return ctrl.Result{}, err
+	}
 
 	return ctrl.Result{}, nil
 }
@@ -161,11 +179,13 @@ func (r *AlertsAPMConditionReconciler) SetupWithManager(mgr ctrl.Manager) error
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&nralertsv1.AlertsAPMCondition{}).
-		Complete(r)
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nralertsv1.AlertsAPMConditionList{} }, true)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nralertsv1.AlertsAPMCondition{}, r))
 }
 
-func (r *AlertsAPMConditionReconciler) checkForExistingCondition(condition *nralertsv1.AlertsAPMCondition) {
-	defer r.txn.StartSegment("checkForExistingCondition").End()
+func (r *AlertsAPMConditionReconciler) checkForExistingCondition(rc *requestContext, condition *nralertsv1.AlertsAPMCondition) {
+	defer rc.txn.StartSegment("checkForExistingCondition").End()
 	if condition.Status.ConditionID == 0 {
 		r.Log.Info("Checking for existing condition", "conditionName", condition.Name)
 
@@ -176,18 +196,20 @@ func (r *AlertsAPMConditionReconciler) checkForExistingCondition(condition *nral
 			return
 		}
 
-		existingConditions, err := r.Alerts.ListConditions(existingPolicyIDInt)
+		existingConditions, err := rc.alerts.ListConditions(existingPolicyIDInt)
 		if err != nil {
 			r.Log.Error(err, "failed to get list of NRQL conditions from New Relic API",
 				"conditionId", condition.Status.ConditionID,
 				"region", condition.Spec.Region,
-				"Api Key", interfaces.PartialAPIKey(r.apiKey),
+				"Api Key", interfaces.PartialAPIKey(rc.apiKey),
 			)
+			recordFailure(r.Recorder, condition, eventReasonLookupFailed, err)
 		} else {
 			for _, existingCondition := range existingConditions {
 				if existingCondition.Name == condition.Spec.Name {
 					r.Log.Info("Matched on existing condition, updating ConditionId", "conditionId", existingCondition.ID)
 					condition.Status.ConditionID = existingCondition.ID
+					r.Recorder.Eventf(condition, v1.EventTypeNormal, eventReasonAdopted, "Adopted existing New Relic condition %d", existingCondition.ID)
 					break
 				}
 			}
@@ -195,60 +217,74 @@ func (r *AlertsAPMConditionReconciler) checkForExistingCondition(condition *nral
 	}
 }
 
-func (r *AlertsAPMConditionReconciler) writeNewRelicAlertCondition(ctx context.Context, req ctrl.Request, alertsClient interfaces.NewRelicAlertsClient, condition nralertsv1.AlertsAPMCondition) {
+func (r *AlertsAPMConditionReconciler) writeNewRelicAlertCondition(rc *requestContext, req ctrl.Request, condition nralertsv1.AlertsAPMCondition) error {
 	APICondition := condition.Spec.APICondition()
 
-	if condition.Status.ConditionID != 0 && !reflect.DeepEqual(&condition.Spec, condition.Status.AppliedSpec) {
+	if condition.Status.ConditionID != 0 && !reflect.DeepEqual(condition.Spec.Redacted(), condition.Status.AppliedSpec) {
 		r.Log.Info("updating condition", "ConditionName", condition.Name, "API fields", APICondition)
 		APICondition.ID = condition.Status.ConditionID
-		updatedCondition, err := alertsClient.UpdateCondition(APICondition)
+		updatedCondition, err := rc.alerts.UpdateCondition(APICondition)
 		if err != nil {
 			r.Log.Error(err, "failed to update condition")
+			recordFailure(r.Recorder, &condition, nralertsv1.ReasonUpdateFailed, err)
+			setFailedConditions(&condition, nralertsv1.ReasonUpdateFailed, err)
 		} else {
-			condition.Status.AppliedSpec = &condition.Spec
+			condition.Status.AppliedSpec = condition.Spec.Redacted()
 			condition.Status.ConditionID = updatedCondition.ID
+			r.Recorder.Eventf(&condition, v1.EventTypeNormal, eventReasonUpdated, "Updated New Relic condition %d", updatedCondition.ID)
+			setReadyConditions(&condition)
 		}
 
-		err = r.Client.Update(ctx, &condition)
-		if err != nil {
-			r.Log.Error(err, "tried updating condition status", "name", req.NamespacedName)
+		if updateErr := updateWithStatus(rc.ctx, r.Client, &condition); updateErr != nil {
+			r.Log.Error(updateErr, "tried updating condition status", "name", req.NamespacedName)
+			return updateErr
 		}
+
+		return err
+	}
+
+	r.Log.Info("Creating condition", "ConditionName", condition.Name, "API fields", APICondition)
+	existingPolicyIDInt, err := strconv.Atoi(condition.Spec.ExistingPolicyID)
+	if err != nil {
+		r.Log.Error(err, "failed to read existing policy ID", "existingPolicyID", condition.Spec.ExistingPolicyID)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &condition, nralertsv1.ReasonCreateFailed, err)
+		return err
+	}
+
+	createdCondition, err := rc.alerts.CreateCondition(existingPolicyIDInt, APICondition)
+	if err != nil {
+		r.Log.Error(err, "failed to create condition",
+			"conditionId", condition.Status.ConditionID,
+			"region", condition.Spec.Region,
+			"Api Key", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		recordFailure(r.Recorder, &condition, nralertsv1.ReasonCreateFailed, err)
+		setFailedConditions(&condition, nralertsv1.ReasonCreateFailed, err)
 	} else {
-		r.Log.Info("Creating condition", "ConditionName", condition.Name, "API fields", APICondition)
-		existingPolicyIDInt, err := strconv.Atoi(condition.Spec.ExistingPolicyID)
-		if err != nil {
-			r.Log.Error(err, "failed to read existing policy ID", "existingPolicyID", condition.Spec.ExistingPolicyID)
-		} else {
-			createdCondition, err := alertsClient.CreateCondition(existingPolicyIDInt, APICondition)
-			if err != nil {
-				r.Log.Error(err, "failed to create condition",
-					"conditionId", condition.Status.ConditionID,
-					"region", condition.Spec.Region,
-					"Api Key", interfaces.PartialAPIKey(r.apiKey),
-				)
-			} else {
-				condition.Status.AppliedSpec = &condition.Spec
-				condition.Status.ConditionID = createdCondition.ID
-			}
+		condition.Status.AppliedSpec = condition.Spec.Redacted()
+		condition.Status.ConditionID = createdCondition.ID
+		r.Recorder.Eventf(&condition, v1.EventTypeNormal, eventReasonCreated, "Created New Relic condition %d", createdCondition.ID)
+		setReadyConditions(&condition)
+	}
 
-			err = r.Client.Update(ctx, &condition)
-			if err != nil {
-				r.Log.Error(err, "tried updating condition status", "name", req.NamespacedName)
-			}
-		}
+	if updateErr := updateWithStatus(rc.ctx, r.Client, &condition); updateErr != nil {
+		r.Log.Error(updateErr, "tried updating condition status", "name", req.NamespacedName)
+		return updateErr
 	}
+
+	return err
 }
 
-func (r *AlertsAPMConditionReconciler) deleteNewRelicAlertCondition(condition nralertsv1.AlertsAPMCondition) error {
-	defer r.txn.StartSegment("deleteNewRelicAlertCondition").End()
+func (r *AlertsAPMConditionReconciler) deleteNewRelicAlertCondition(rc *requestContext, condition nralertsv1.AlertsAPMCondition) error {
+	defer rc.txn.StartSegment("deleteNewRelicAlertCondition").End()
 	r.Log.Info("Deleting condition", "conditionName", condition.Spec.Name)
 
-	_, err := r.Alerts.DeleteCondition(condition.Status.ConditionID)
+	_, err := rc.alerts.DeleteCondition(condition.Status.ConditionID)
 	if err != nil {
 		r.Log.Error(err, "Error deleting condition",
 			"conditionId", condition.Status.ConditionID,
 			"region", condition.Spec.Region,
-			"Api Key", interfaces.PartialAPIKey(r.apiKey),
+			"Api Key", interfaces.PartialAPIKey(rc.apiKey),
 		)
 
 		return err
@@ -257,24 +293,58 @@ func (r *AlertsAPMConditionReconciler) deleteNewRelicAlertCondition(condition nr
 	return nil
 }
 
-func (r *AlertsAPMConditionReconciler) getAPIKeyOrSecret(condition nralertsv1.AlertsAPMCondition) (string, error) {
-	defer r.txn.StartSegment("getAPIKeyOrSecret").End()
-	if condition.Spec.APIKey != "" {
-		return condition.Spec.APIKey, nil
+// checkForAPMConditionDrift compares the APM condition in New Relic with the spec when a resync interval is
+// configured and records the result in the Drifted condition. It returns true when the condition drifted
+// and has been prepared to be written again.
+func (r *AlertsAPMConditionReconciler) checkForAPMConditionDrift(rc *requestContext, condition *nralertsv1.AlertsAPMCondition) (bool, error) {
+	if r.ResyncInterval == 0 || condition.Status.ConditionID == 0 {
+		return false, nil
 	}
 
-	if condition.Spec.APIKeySecret != (nralertsv1.NewRelicAPIKeySecret{}) {
-		var apiKeySecret v1.Secret
+	defer rc.txn.StartSegment("checkForAPMConditionDrift").End()
 
-		key := types.NamespacedName{Namespace: condition.Spec.APIKeySecret.Namespace, Name: condition.Spec.APIKeySecret.Name}
+	policyID, err := strconv.Atoi(condition.Spec.ExistingPolicyID)
+	if err != nil {
+		return false, err
+	}
+
+	remoteConditions, err := rc.alerts.ListConditions(policyID)
+	if err != nil && !isNotFound(err) {
+		r.Log.Error(err, "failed to get list of APM conditions from New Relic API",
+			"policyId", policyID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		return false, err
+	}
+
+	differences := []string{fmt.Sprintf("condition %d not found in New Relic", condition.Status.ConditionID)}
+	found := false
 
-		if getErr := r.Client.Get(context.Background(), key, &apiKeySecret); getErr != nil {
-			r.Log.Error(getErr, "Error retrieving secret", "secret", apiKeySecret)
-			return "", getErr
+	for _, remoteCondition := range remoteConditions {
+		if remoteCondition.ID == condition.Status.ConditionID {
+			differences = condition.Spec.Diff(*remoteCondition)
+			found = true
+			break
 		}
+	}
+
+	if len(differences) == 0 || !r.CorrectDrift {
+		if setDriftedCondition(r.Recorder, condition, differences) {
+			return false, updateWithStatus(rc.ctx, r.Client, condition)
+		}
+		return false, nil
+	}
+
+	r.Log.Info("correcting drift of condition", "conditionId", condition.Status.ConditionID, "differences", differences)
+	setDriftedCondition(r.Recorder, condition, differences)
 
-		return string(apiKeySecret.Data[condition.Spec.APIKeySecret.KeyName]), nil
+	if !found {
+		condition.Status.ConditionID = 0
 	}
 
-	return "", nil
+	// forget the applied spec so the condition is written again
+	condition.Status.AppliedSpec = &nralertsv1.AlertsAPMConditionSpec{}
+
+	return true, nil
 }
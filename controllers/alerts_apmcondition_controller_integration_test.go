@@ -5,6 +5,7 @@ package controllers
 import (
 	"context"
 	"errors"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -12,6 +13,7 @@ import (
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -77,6 +79,7 @@ var _ = Describe("ApmCondition reconciliation", func() {
 		r = &AlertsAPMConditionReconciler{
 			Client:          k8sClient,
 			Log:             logf.Log,
+			Recorder:        record.NewFakeRecorder(100),
 			AlertClientFunc: fakeAlertFunc,
 			NewRelicAgent:   newrelicAgent,
 		}
@@ -172,7 +175,25 @@ var _ = Describe("ApmCondition reconciliation", func() {
 					var endStateCondition nrv1.AlertsAPMCondition
 					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
 					Expect(err).To(BeNil())
-					Expect(endStateCondition.Status.AppliedSpec).To(Equal(&condition.Spec))
+					Expect(endStateCondition.Status.AppliedSpec).To(Equal(condition.Spec.Redacted()))
+				})
+
+				It("returns the error when the condition can't be created", func() {
+					alertsClient.CreateConditionReturns(nil, errors.New("invalid metric"))
+
+					err := k8sClient.Create(ctx, condition)
+					Expect(err).ToNot(HaveOccurred())
+
+					// call reconcile
+					_, err = r.Reconcile(request)
+					Expect(err).To(MatchError("invalid metric"))
+
+					var endStateCondition nrv1.AlertsAPMCondition
+					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
+					Expect(err).To(BeNil())
+					failed := nrv1.FindCondition(endStateCondition.Status.Conditions, nrv1.ConditionError)
+					Expect(failed).ToNot(BeNil())
+					Expect(failed.Reason).To(Equal(nrv1.ReasonCreateFailed))
 				})
 			})
 		})
@@ -236,7 +257,7 @@ var _ = Describe("ApmCondition reconciliation", func() {
 					var endStateCondition nrv1.AlertsAPMCondition
 					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
 					Expect(err).To(BeNil())
-					Expect(endStateCondition.Status.AppliedSpec).To(Equal(&condition.Spec))
+					Expect(endStateCondition.Status.AppliedSpec).To(Equal(condition.Spec.Redacted()))
 				})
 			})
 		})
@@ -324,7 +345,7 @@ var _ = Describe("ApmCondition reconciliation", func() {
 					var endStateCondition nrv1.AlertsAPMCondition
 					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
 					Expect(err).To(BeNil())
-					Expect(endStateCondition.Status.AppliedSpec).To(Equal(&condition.Spec))
+					Expect(endStateCondition.Status.AppliedSpec).To(Equal(condition.Spec.Redacted()))
 				})
 			})
 		})
@@ -390,7 +411,7 @@ var _ = Describe("ApmCondition reconciliation", func() {
 					var endStateCondition nrv1.AlertsAPMCondition
 					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
 					Expect(err).To(BeNil())
-					Expect(endStateCondition.Status.AppliedSpec).To(Equal(&condition.Spec))
+					Expect(endStateCondition.Status.AppliedSpec).To(Equal(condition.Spec.Redacted()))
 					Expect(endStateCondition.Status.AppliedSpec.Metric).To(Equal("database"))
 				})
 			})
@@ -408,6 +429,42 @@ var _ = Describe("ApmCondition reconciliation", func() {
 					Expect(alertsClient.UpdateConditionCallCount()).To(Equal(0))
 				})
 			})
+
+			Context("when the condition was changed in New Relic", func() {
+				BeforeEach(func() {
+					r.ResyncInterval = time.Minute
+
+					alertsClient.ListConditionsStub = func(int) ([]*alerts.Condition, error) {
+						remote := condition.Spec.APICondition()
+						remote.ID = 111
+						remote.Metric = "error_percentage"
+						return []*alerts.Condition{&remote}, nil
+					}
+				})
+
+				It("reports the drift without an API call", func() {
+					result, err := r.Reconcile(request)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result.RequeueAfter).To(Equal(time.Minute))
+					Expect(alertsClient.UpdateConditionCallCount()).To(Equal(0))
+
+					var endStateCondition nrv1.AlertsAPMCondition
+					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
+					Expect(err).To(BeNil())
+					drifted := nrv1.FindCondition(endStateCondition.Status.Conditions, nrv1.ConditionDrifted)
+					Expect(drifted).ToNot(BeNil())
+					Expect(drifted.Message).To(ContainSubstring(`metric is "error_percentage"`))
+				})
+
+				It("updates the condition when correcting drift", func() {
+					r.CorrectDrift = true
+
+					_, err := r.Reconcile(request)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(alertsClient.CreateConditionCallCount()).To(Equal(1))
+					Expect(alertsClient.UpdateConditionCallCount()).To(Equal(1))
+				})
+			})
 		})
 
 		AfterEach(func() {
@@ -469,7 +526,7 @@ var _ = Describe("ApmCondition reconciliation", func() {
 			Context("with a condition with no condition ID", func() {
 				BeforeEach(func() {
 					condition.Status.ConditionID = 0
-					err := k8sClient.Update(ctx, condition)
+					err := k8sClient.Status().Update(ctx, condition)
 					Expect(err).ToNot(HaveOccurred())
 
 				})
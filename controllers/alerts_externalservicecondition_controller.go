@@ -0,0 +1,294 @@
+package controllers
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/externalservice"
+)
+
+const alertsExternalServiceConditionDeleteFinalizer = "alertsexternalserviceconditions.finalizers.nr.k8s.newrelic.com"
+
+// AlertsExternalServiceConditionReconciler reconciles a AlertsExternalServiceCondition object
+type AlertsExternalServiceConditionReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	AlertClientFunc         func(string, string) (interfaces.NewRelicAlertsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+	ResyncInterval          time.Duration
+	CorrectDrift            bool
+}
+
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=alertsexternalserviceconditions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=alertsexternalserviceconditions/status,verbs=get;update;patch
+
+// Reconcile is responsible for reconciling the spec and state of the AlertsExternalServiceCondition
+func (r *AlertsExternalServiceConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Alerts/externalServiceCondition")
+	defer rc.txn.End()
+
+	var condition nrv1.AlertsExternalServiceCondition
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &condition)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			r.Log.Info("AlertsExternalServiceCondition 'not found' after being deleted. This is expected and no cause for alarm", "error", err)
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET AlertsExternalServiceCondition", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	err = resolveCredentials(rc, r.Client, &condition)
+	if err != nil {
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &condition, failureReason(err, nrv1.ReasonCredentialsError), err)
+		return ctrl.Result{}, err
+	}
+
+	alertsClient, errAlertsClient := r.AlertClientFunc(rc.apiKey, rc.region)
+	if errAlertsClient != nil {
+		r.Log.Error(errAlertsClient, "Failed to create AlertsClient")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &condition, nrv1.ReasonCredentialsError, errAlertsClient)
+		return ctrl.Result{}, errAlertsClient
+	}
+	rc.alerts = interfaces.NewTracedAlertsClient(alertsClient, rc.txn, rc.accountID, rc.region)
+
+	// examine DeletionTimestamp to determine if object is under deletion
+	if condition.DeletionTimestamp.IsZero() {
+		if !containsString(condition.Finalizers, alertsExternalServiceConditionDeleteFinalizer) {
+			condition.Finalizers = append(condition.Finalizers, alertsExternalServiceConditionDeleteFinalizer)
+		}
+	} else {
+		return ctrl.Result{}, r.deleteExternalServiceCondition(rc, &condition)
+	}
+
+	if reflect.DeepEqual(condition.Spec.Redacted(), condition.Status.AppliedSpec) {
+		drifted, err := r.checkForExternalServiceConditionDrift(rc, &condition)
+		if err != nil {
+			r.Log.Error(err, "failed to resync condition with New Relic", "name", req.NamespacedName)
+			recordFailure(r.Recorder, &condition, eventReasonResyncFailed, err)
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
+
+		if !drifted {
+			if err := updateReadyConditionsIfNeeded(rc.ctx, r.Client, &condition); err != nil {
+				r.Log.Error(err, "tried updating condition status", "name", req.NamespacedName)
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
+	}
+
+	r.Log.Info("Reconciling", "condition", condition.Name)
+
+	r.checkForExistingExternalServiceCondition(rc, &condition)
+
+	return ctrl.Result{}, r.writeExternalServiceCondition(rc, &condition)
+}
+
+//SetupWithManager - Sets up Controller for AlertsExternalServiceCondition
+func (r *AlertsExternalServiceConditionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nrv1.AlertsExternalServiceCondition{}).
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nrv1.AlertsExternalServiceConditionList{} }, true)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nrv1.AlertsExternalServiceCondition{}, r))
+}
+
+// checkForExistingExternalServiceCondition adopts an external service condition of the policy with the same name
+// when the AlertsExternalServiceCondition has not created one yet
+func (r *AlertsExternalServiceConditionReconciler) checkForExistingExternalServiceCondition(rc *requestContext, condition *nrv1.AlertsExternalServiceCondition) {
+	if condition.Status.ConditionID != 0 {
+		return
+	}
+
+	defer rc.txn.StartSegment("checkForExistingExternalServiceCondition").End()
+
+	r.Log.Info("Checking for existing external service condition", "conditionName", condition.Spec.Name)
+
+	policyID, err := strconv.Atoi(condition.Spec.ExistingPolicyID)
+	if err != nil {
+		r.Log.Error(err, "failed to read existing policy ID", "existingPolicyID", condition.Spec.ExistingPolicyID)
+		return
+	}
+
+	existingConditions, err := rc.alerts.ListExternalServiceConditions(policyID)
+	if err != nil {
+		r.Log.Error(err, "failed to get list of external service conditions from New Relic API",
+			"policyId", policyID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		recordFailure(r.Recorder, condition, eventReasonLookupFailed, err)
+		return
+	}
+
+	for _, existingCondition := range existingConditions {
+		if existingCondition.Name == condition.Spec.Name {
+			r.Log.Info("Matched on existing external service condition, updating ConditionId", "conditionId", existingCondition.ID)
+			condition.Status.ConditionID = existingCondition.ID
+			r.Recorder.Eventf(condition, v1.EventTypeNormal, eventReasonAdopted, "Adopted existing New Relic external service condition %d", existingCondition.ID)
+			return
+		}
+	}
+}
+
+// writeExternalServiceCondition creates or updates the external service condition and records the result on the
+// status of the AlertsExternalServiceCondition
+func (r *AlertsExternalServiceConditionReconciler) writeExternalServiceCondition(rc *requestContext, condition *nrv1.AlertsExternalServiceCondition) error {
+	defer rc.txn.StartSegment("writeExternalServiceCondition").End()
+
+	reason := nrv1.ReasonCreateFailed
+	eventReason := eventReasonCreated
+
+	apiCondition := condition.Spec.APICondition()
+
+	var written *externalservice.Condition
+	var err error
+
+	if condition.Status.ConditionID != 0 {
+		r.Log.Info("updating external service condition", "conditionName", condition.Spec.Name, "conditionId", condition.Status.ConditionID)
+		reason = nrv1.ReasonUpdateFailed
+		eventReason = eventReasonUpdated
+
+		apiCondition.ID = condition.Status.ConditionID
+		written, err = rc.alerts.UpdateExternalServiceCondition(apiCondition)
+	} else {
+		r.Log.Info("creating external service condition", "conditionName", condition.Spec.Name, "policyId", condition.Spec.ExistingPolicyID)
+		policyID, _ := strconv.Atoi(condition.Spec.ExistingPolicyID)
+		written, err = rc.alerts.CreateExternalServiceCondition(policyID, apiCondition)
+	}
+
+	if err != nil {
+		r.Log.Error(err, "failed to write external service condition",
+			"conditionId", condition.Status.ConditionID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, condition, reason, err)
+		return err
+	}
+
+	condition.Status.ConditionID = written.ID
+	condition.Status.AppliedSpec = condition.Spec.Redacted()
+	r.Recorder.Eventf(condition, v1.EventTypeNormal, eventReason, "%s New Relic external service condition %d", eventReason, written.ID)
+	setReadyConditions(condition)
+
+	if err := updateWithStatus(rc.ctx, r.Client, condition); err != nil {
+		r.Log.Error(err, "tried updating condition status", "name", condition.Name)
+		return err
+	}
+
+	return nil
+}
+
+// deleteExternalServiceCondition deletes the external service condition from New Relic and removes the finalizer
+// once it is gone
+func (r *AlertsExternalServiceConditionReconciler) deleteExternalServiceCondition(rc *requestContext, condition *nrv1.AlertsExternalServiceCondition) error {
+	if !containsString(condition.Finalizers, alertsExternalServiceConditionDeleteFinalizer) {
+		return nil
+	}
+
+	defer rc.txn.StartSegment("deleteExternalServiceCondition").End()
+
+	if condition.Status.ConditionID != 0 {
+		r.Log.Info("Deleting external service condition", "conditionName", condition.Spec.Name, "conditionId", condition.Status.ConditionID)
+
+		_, err := rc.alerts.DeleteExternalServiceCondition(condition.Status.ConditionID)
+		if err != nil && !isNotFound(err) {
+			r.Log.Error(err, "Failed to delete external service condition",
+				"conditionId", condition.Status.ConditionID,
+				"region", rc.region,
+				"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+			)
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, condition, nrv1.ReasonDeleteFailed, err)
+			return err
+		}
+
+		r.Recorder.Eventf(condition, v1.EventTypeNormal, eventReasonDeleted, "Deleted New Relic external service condition %d", condition.Status.ConditionID)
+	}
+
+	// remove our finalizer from the list and update it.
+	condition.Finalizers = removeString(condition.Finalizers, alertsExternalServiceConditionDeleteFinalizer)
+	if err := r.Client.Update(rc.ctx, condition); err != nil {
+		r.Log.Error(err, "Failed to update condition after deleting New Relic external service condition")
+		return err
+	}
+
+	return nil
+}
+
+// checkForExternalServiceConditionDrift compares the external service condition in New Relic with the spec when a resync interval is
+// configured and records the result in the Drifted condition. It returns true when the condition drifted
+// and has been prepared to be written again.
+func (r *AlertsExternalServiceConditionReconciler) checkForExternalServiceConditionDrift(rc *requestContext, condition *nrv1.AlertsExternalServiceCondition) (bool, error) {
+	if r.ResyncInterval == 0 || condition.Status.ConditionID == 0 {
+		return false, nil
+	}
+
+	defer rc.txn.StartSegment("checkForExternalServiceConditionDrift").End()
+
+	policyID, err := strconv.Atoi(condition.Spec.ExistingPolicyID)
+	if err != nil {
+		return false, err
+	}
+
+	remoteConditions, err := rc.alerts.ListExternalServiceConditions(policyID)
+	if err != nil && !isNotFound(err) {
+		r.Log.Error(err, "failed to get list of external service conditions from New Relic API",
+			"policyId", policyID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		return false, err
+	}
+
+	differences := []string{fmt.Sprintf("condition %d not found in New Relic", condition.Status.ConditionID)}
+	found := false
+
+	for _, remoteCondition := range remoteConditions {
+		if remoteCondition.ID == condition.Status.ConditionID {
+			differences = condition.Spec.Diff(*remoteCondition)
+			found = true
+			break
+		}
+	}
+
+	if len(differences) == 0 || !r.CorrectDrift {
+		if setDriftedCondition(r.Recorder, condition, differences) {
+			return false, updateWithStatus(rc.ctx, r.Client, condition)
+		}
+		return false, nil
+	}
+
+	r.Log.Info("correcting drift of condition", "conditionId", condition.Status.ConditionID, "differences", differences)
+	setDriftedCondition(r.Recorder, condition, differences)
+
+	if !found {
+		condition.Status.ConditionID = 0
+	}
+
+	// forget the applied spec so the condition is written again
+	condition.Status.AppliedSpec = &nrv1.AlertsExternalServiceConditionSpec{}
+
+	return true, nil
+}
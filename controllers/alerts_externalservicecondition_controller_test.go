@@ -0,0 +1,219 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/externalservice"
+)
+
+var _ = Describe("AlertsExternalServiceCondition reconciliation", func() {
+	var (
+		ctx            context.Context
+		r              *AlertsExternalServiceConditionReconciler
+		condition      *nrv1.AlertsExternalServiceCondition
+		namespacedName types.NamespacedName
+		alertsClient   *interfacesfakes.FakeNewRelicAlertsClient
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		alertsClient = &interfacesfakes.FakeNewRelicAlertsClient{}
+		alertsClient.CreateExternalServiceConditionReturns(&externalservice.Condition{ID: 42}, nil)
+		alertsClient.UpdateExternalServiceConditionReturns(&externalservice.Condition{ID: 42}, nil)
+
+		r = &AlertsExternalServiceConditionReconciler{
+			Client:   k8sClient,
+			Log:      logf.Log,
+			Recorder: record.NewFakeRecorder(100),
+			AlertClientFunc: func(string, string) (interfaces.NewRelicAlertsClient, error) {
+				return alertsClient, nil
+			},
+			NewRelicAgent: newrelic.Application{},
+		}
+
+		condition = &nrv1.AlertsExternalServiceCondition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "slow-payments",
+				Namespace: "default",
+			},
+			Spec: nrv1.AlertsExternalServiceConditionSpec{
+				AlertsGenericConditionSpec: nrv1.AlertsGenericConditionSpec{
+					Name:             "Slow payment provider",
+					Type:             externalservice.ConditionTypeAPM,
+					Enabled:          true,
+					ExistingPolicyID: "123",
+					APIKey:           "api-key",
+					Region:           "US",
+					APMTerms: []nrv1.AlertConditionTerm{
+						{
+							Duration:     "5",
+							Operator:     "above",
+							Priority:     "critical",
+							Threshold:    "1.5",
+							TimeFunction: "all",
+						},
+					},
+				},
+				AlertsExternalServiceSpecificSpec: nrv1.AlertsExternalServiceSpecificSpec{
+					ExternalServiceURL: "api.payments.example.com",
+				},
+				Metric:   "response_time_average",
+				Entities: []string{"5950260"},
+			},
+			Status: nrv1.AlertsExternalServiceConditionStatus{
+				AppliedSpec: &nrv1.AlertsExternalServiceConditionSpec{},
+			},
+		}
+		namespacedName = types.NamespacedName{Namespace: "default", Name: "slow-payments"}
+
+		Expect(k8sClient.Create(ctx, condition)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		var current nrv1.AlertsExternalServiceCondition
+		if err := k8sClient.Get(ctx, namespacedName, &current); err == nil {
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	Context("when creating an external service condition", func() {
+		It("creates the condition in the policy", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.CreateExternalServiceConditionCallCount()).To(Equal(1))
+			policyID, created := alertsClient.CreateExternalServiceConditionArgsForCall(0)
+			Expect(policyID).To(Equal(123))
+			Expect(created.Type).To(Equal("apm_external_service"))
+			Expect(created.ExternalServiceURL).To(Equal("api.payments.example.com"))
+			Expect(created.Metric).To(Equal("response_time_average"))
+			Expect(created.Terms[0].Threshold).To(Equal(1.5))
+
+			var updated nrv1.AlertsExternalServiceCondition
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.ConditionID).To(Equal(42))
+			Expect(updated.Finalizers).To(ContainElement(alertsExternalServiceConditionDeleteFinalizer))
+			Expect(nrv1.IsConditionTrue(updated.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+		})
+
+		It("adopts an existing condition with the same name", func() {
+			alertsClient.ListExternalServiceConditionsReturns([]*externalservice.Condition{
+				{ID: 7, Name: "Other"},
+				{ID: 42, Name: "Slow payment provider"},
+			}, nil)
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.ListExternalServiceConditionsArgsForCall(0)).To(Equal(123))
+			Expect(alertsClient.CreateExternalServiceConditionCallCount()).To(Equal(0))
+			Expect(alertsClient.UpdateExternalServiceConditionCallCount()).To(Equal(1))
+			Expect(alertsClient.UpdateExternalServiceConditionArgsForCall(0).ID).To(Equal(42))
+		})
+
+		It("records a failure to create the condition", func() {
+			alertsClient.CreateExternalServiceConditionReturns(nil, errors.New("invalid entity"))
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(MatchError("invalid entity"))
+
+			var updated nrv1.AlertsExternalServiceCondition
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.ConditionID).To(BeZero())
+			failed := nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionError)
+			Expect(failed).ToNot(BeNil())
+			Expect(failed.Reason).To(Equal(nrv1.ReasonCreateFailed))
+		})
+	})
+
+	Context("when a resync interval is configured", func() {
+		var remote externalservice.Condition
+
+		BeforeEach(func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			r.ResyncInterval = time.Minute
+
+			remote = condition.Spec.APICondition()
+			remote.ID = 42
+		})
+
+		It("requeues a condition matching New Relic without reporting drift", func() {
+			alertsClient.ListExternalServiceConditionsReturns([]*externalservice.Condition{&remote}, nil)
+
+			result, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Minute))
+			Expect(alertsClient.UpdateExternalServiceConditionCallCount()).To(Equal(0))
+
+			var current nrv1.AlertsExternalServiceCondition
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(nrv1.IsConditionTrue(current.Status.Conditions, nrv1.ConditionDrifted)).To(BeFalse())
+		})
+
+		It("reports a condition changed in New Relic without an update", func() {
+			remote.ExternalServiceURL = "changed.example.com"
+			alertsClient.ListExternalServiceConditionsReturns([]*externalservice.Condition{&remote}, nil)
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(alertsClient.UpdateExternalServiceConditionCallCount()).To(Equal(0))
+
+			var current nrv1.AlertsExternalServiceCondition
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			drifted := nrv1.FindCondition(current.Status.Conditions, nrv1.ConditionDrifted)
+			Expect(drifted).ToNot(BeNil())
+			Expect(drifted.Message).To(ContainSubstring("external_service_url is \"changed.example.com\""))
+		})
+
+		It("updates the condition when correcting drift", func() {
+			r.CorrectDrift = true
+			remote.Terms = nil
+			alertsClient.ListExternalServiceConditionsReturns([]*externalservice.Condition{&remote}, nil)
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.UpdateExternalServiceConditionCallCount()).To(Equal(1))
+			Expect(alertsClient.UpdateExternalServiceConditionArgsForCall(0).Terms).To(HaveLen(1))
+		})
+	})
+
+	Context("when deleting an external service condition", func() {
+		BeforeEach(func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deletes the condition from New Relic and removes the finalizer", func() {
+			var current nrv1.AlertsExternalServiceCondition
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.DeleteExternalServiceConditionCallCount()).To(Equal(1))
+			Expect(alertsClient.DeleteExternalServiceConditionArgsForCall(0)).To(Equal(42))
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).ToNot(Succeed())
+		})
+	})
+})
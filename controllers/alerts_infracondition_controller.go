@@ -0,0 +1,293 @@
+package controllers
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	v1 "k8s.io/api/core/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+)
+
+const alertsInfraConditionDeleteFinalizer = "alertsinfraconditions.finalizers.nr.k8s.newrelic.com"
+
+// AlertsInfraConditionReconciler reconciles a AlertsInfraCondition object
+type AlertsInfraConditionReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	AlertClientFunc         func(string, string) (interfaces.NewRelicAlertsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+	ResyncInterval          time.Duration
+	CorrectDrift            bool
+}
+
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=alertsinfraconditions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=alertsinfraconditions/status,verbs=get;update;patch
+
+// Reconcile is responsible for reconciling the spec and state of the AlertsInfraCondition
+func (r *AlertsInfraConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Alerts/infraCondition")
+	defer rc.txn.End()
+
+	var condition nrv1.AlertsInfraCondition
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &condition)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			r.Log.Info("AlertsInfraCondition 'not found' after being deleted. This is expected and no cause for alarm", "error", err)
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET AlertsInfraCondition", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	err = resolveCredentials(rc, r.Client, &condition)
+	if err != nil {
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &condition, failureReason(err, nrv1.ReasonCredentialsError), err)
+		return ctrl.Result{}, err
+	}
+
+	alertsClient, errAlertsClient := r.AlertClientFunc(rc.apiKey, rc.region)
+	if errAlertsClient != nil {
+		r.Log.Error(errAlertsClient, "Failed to create AlertsClient")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &condition, nrv1.ReasonCredentialsError, errAlertsClient)
+		return ctrl.Result{}, errAlertsClient
+	}
+	rc.alerts = interfaces.NewTracedAlertsClient(alertsClient, rc.txn, rc.accountID, rc.region)
+
+	// examine DeletionTimestamp to determine if object is under deletion
+	if condition.DeletionTimestamp.IsZero() {
+		if !containsString(condition.Finalizers, alertsInfraConditionDeleteFinalizer) {
+			condition.Finalizers = append(condition.Finalizers, alertsInfraConditionDeleteFinalizer)
+		}
+	} else {
+		return ctrl.Result{}, r.deleteInfraCondition(rc, &condition)
+	}
+
+	if reflect.DeepEqual(condition.Spec.Redacted(), condition.Status.AppliedSpec) {
+		drifted, err := r.checkForInfraConditionDrift(rc, &condition)
+		if err != nil {
+			r.Log.Error(err, "failed to resync condition with New Relic", "name", req.NamespacedName)
+			recordFailure(r.Recorder, &condition, eventReasonResyncFailed, err)
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
+
+		if !drifted {
+			if err := updateReadyConditionsIfNeeded(rc.ctx, r.Client, &condition); err != nil {
+				r.Log.Error(err, "tried updating condition status", "name", req.NamespacedName)
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
+	}
+
+	r.Log.Info("Reconciling", "condition", condition.Name)
+
+	r.checkForExistingInfraCondition(rc, &condition)
+
+	return ctrl.Result{}, r.writeInfraCondition(rc, &condition)
+}
+
+//SetupWithManager - Sets up Controller for AlertsInfraCondition
+func (r *AlertsInfraConditionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nrv1.AlertsInfraCondition{}).
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nrv1.AlertsInfraConditionList{} }, true)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nrv1.AlertsInfraCondition{}, r))
+}
+
+// checkForExistingInfraCondition adopts an infrastructure condition of the policy with the same name
+// when the AlertsInfraCondition has not created one yet
+func (r *AlertsInfraConditionReconciler) checkForExistingInfraCondition(rc *requestContext, condition *nrv1.AlertsInfraCondition) {
+	if condition.Status.ConditionID != 0 {
+		return
+	}
+
+	defer rc.txn.StartSegment("checkForExistingInfraCondition").End()
+
+	r.Log.Info("Checking for existing infra condition", "conditionName", condition.Spec.Name)
+
+	policyID, err := strconv.Atoi(condition.Spec.ExistingPolicyID)
+	if err != nil {
+		r.Log.Error(err, "failed to read existing policy ID", "existingPolicyID", condition.Spec.ExistingPolicyID)
+		return
+	}
+
+	existingConditions, err := rc.alerts.ListInfrastructureConditions(policyID)
+	if err != nil {
+		r.Log.Error(err, "failed to get list of infrastructure conditions from New Relic API",
+			"policyId", policyID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		recordFailure(r.Recorder, condition, eventReasonLookupFailed, err)
+		return
+	}
+
+	for _, existingCondition := range existingConditions {
+		if existingCondition.Name == condition.Spec.Name {
+			r.Log.Info("Matched on existing infra condition, updating ConditionId", "conditionId", existingCondition.ID)
+			condition.Status.ConditionID = existingCondition.ID
+			r.Recorder.Eventf(condition, v1.EventTypeNormal, eventReasonAdopted, "Adopted existing New Relic infrastructure condition %d", existingCondition.ID)
+			return
+		}
+	}
+}
+
+// writeInfraCondition creates or updates the infrastructure condition and records the result on the
+// status of the AlertsInfraCondition
+func (r *AlertsInfraConditionReconciler) writeInfraCondition(rc *requestContext, condition *nrv1.AlertsInfraCondition) error {
+	defer rc.txn.StartSegment("writeInfraCondition").End()
+
+	reason := nrv1.ReasonCreateFailed
+	eventReason := eventReasonCreated
+
+	apiCondition := condition.Spec.APICondition()
+
+	var written *alerts.InfrastructureCondition
+	var err error
+
+	if condition.Status.ConditionID != 0 {
+		r.Log.Info("updating infra condition", "conditionName", condition.Spec.Name, "conditionId", condition.Status.ConditionID)
+		reason = nrv1.ReasonUpdateFailed
+		eventReason = eventReasonUpdated
+
+		apiCondition.ID = condition.Status.ConditionID
+		written, err = rc.alerts.UpdateInfrastructureCondition(apiCondition)
+	} else {
+		r.Log.Info("creating infra condition", "conditionName", condition.Spec.Name, "policyId", condition.Spec.ExistingPolicyID)
+		written, err = rc.alerts.CreateInfrastructureCondition(apiCondition)
+	}
+
+	if err != nil {
+		r.Log.Error(err, "failed to write infra condition",
+			"conditionId", condition.Status.ConditionID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, condition, reason, err)
+		return err
+	}
+
+	condition.Status.ConditionID = written.ID
+	condition.Status.AppliedSpec = condition.Spec.Redacted()
+	r.Recorder.Eventf(condition, v1.EventTypeNormal, eventReason, "%s New Relic infrastructure condition %d", eventReason, written.ID)
+	setReadyConditions(condition)
+
+	if err := updateWithStatus(rc.ctx, r.Client, condition); err != nil {
+		r.Log.Error(err, "tried updating condition status", "name", condition.Name)
+		return err
+	}
+
+	return nil
+}
+
+// deleteInfraCondition deletes the infrastructure condition from New Relic and removes the finalizer
+// once it is gone
+func (r *AlertsInfraConditionReconciler) deleteInfraCondition(rc *requestContext, condition *nrv1.AlertsInfraCondition) error {
+	if !containsString(condition.Finalizers, alertsInfraConditionDeleteFinalizer) {
+		return nil
+	}
+
+	defer rc.txn.StartSegment("deleteInfraCondition").End()
+
+	if condition.Status.ConditionID != 0 {
+		r.Log.Info("Deleting infra condition", "conditionName", condition.Spec.Name, "conditionId", condition.Status.ConditionID)
+
+		err := rc.alerts.DeleteInfrastructureCondition(condition.Status.ConditionID)
+		if err != nil && !isNotFound(err) {
+			r.Log.Error(err, "Failed to delete infra condition",
+				"conditionId", condition.Status.ConditionID,
+				"region", rc.region,
+				"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+			)
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, condition, nrv1.ReasonDeleteFailed, err)
+			return err
+		}
+
+		r.Recorder.Eventf(condition, v1.EventTypeNormal, eventReasonDeleted, "Deleted New Relic infrastructure condition %d", condition.Status.ConditionID)
+	}
+
+	// remove our finalizer from the list and update it.
+	condition.Finalizers = removeString(condition.Finalizers, alertsInfraConditionDeleteFinalizer)
+	if err := r.Client.Update(rc.ctx, condition); err != nil {
+		r.Log.Error(err, "Failed to update condition after deleting New Relic infrastructure condition")
+		return err
+	}
+
+	return nil
+}
+
+// checkForInfraConditionDrift compares the infrastructure condition in New Relic with the spec when a resync interval is
+// configured and records the result in the Drifted condition. It returns true when the condition drifted
+// and has been prepared to be written again.
+func (r *AlertsInfraConditionReconciler) checkForInfraConditionDrift(rc *requestContext, condition *nrv1.AlertsInfraCondition) (bool, error) {
+	if r.ResyncInterval == 0 || condition.Status.ConditionID == 0 {
+		return false, nil
+	}
+
+	defer rc.txn.StartSegment("checkForInfraConditionDrift").End()
+
+	policyID, err := strconv.Atoi(condition.Spec.ExistingPolicyID)
+	if err != nil {
+		return false, err
+	}
+
+	remoteConditions, err := rc.alerts.ListInfrastructureConditions(policyID)
+	if err != nil && !isNotFound(err) {
+		r.Log.Error(err, "failed to get list of infrastructure conditions from New Relic API",
+			"policyId", policyID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		return false, err
+	}
+
+	differences := []string{fmt.Sprintf("condition %d not found in New Relic", condition.Status.ConditionID)}
+	found := false
+
+	for _, remoteCondition := range remoteConditions {
+		if remoteCondition.ID == condition.Status.ConditionID {
+			differences = condition.Spec.Diff(remoteCondition)
+			found = true
+			break
+		}
+	}
+
+	if len(differences) == 0 || !r.CorrectDrift {
+		if setDriftedCondition(r.Recorder, condition, differences) {
+			return false, updateWithStatus(rc.ctx, r.Client, condition)
+		}
+		return false, nil
+	}
+
+	r.Log.Info("correcting drift of condition", "conditionId", condition.Status.ConditionID, "differences", differences)
+	setDriftedCondition(r.Recorder, condition, differences)
+
+	if !found {
+		condition.Status.ConditionID = 0
+	}
+
+	// forget the applied spec so the condition is written again
+	condition.Status.AppliedSpec = &nrv1.AlertsInfraConditionSpec{}
+
+	return true, nil
+}
@@ -0,0 +1,271 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+)
+
+var _ = Describe("AlertsInfraCondition reconciliation", func() {
+	var (
+		ctx            context.Context
+		r              *AlertsInfraConditionReconciler
+		condition      *nrv1.AlertsInfraCondition
+		namespacedName types.NamespacedName
+		alertsClient   *interfacesfakes.FakeNewRelicAlertsClient
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		alertsClient = &interfacesfakes.FakeNewRelicAlertsClient{}
+		alertsClient.CreateInfrastructureConditionReturns(&alerts.InfrastructureCondition{ID: 42}, nil)
+		alertsClient.UpdateInfrastructureConditionReturns(&alerts.InfrastructureCondition{ID: 42}, nil)
+
+		r = &AlertsInfraConditionReconciler{
+			Client:   k8sClient,
+			Log:      logf.Log,
+			Recorder: record.NewFakeRecorder(100),
+			AlertClientFunc: func(string, string) (interfaces.NewRelicAlertsClient, error) {
+				return alertsClient, nil
+			},
+			NewRelicAgent: newrelic.Application{},
+		}
+
+		condition = &nrv1.AlertsInfraCondition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "high-cpu",
+				Namespace: "default",
+			},
+			Spec: nrv1.AlertsInfraConditionSpec{
+				AlertsGenericConditionSpec: nrv1.AlertsGenericConditionSpec{
+					Name:             "High CPU",
+					Type:             nrv1.InfraConditionTypeMetric,
+					Enabled:          true,
+					ExistingPolicyID: "123",
+					APIKey:           "api-key",
+					Region:           "US",
+				},
+				AlertsInfraSpecificSpec: nrv1.AlertsInfraSpecificSpec{
+					Comparison:  "above",
+					EventType:   "SystemSample",
+					SelectValue: "cpuPercent",
+					CriticalThreshold: &nrv1.AlertsInfraConditionThreshold{
+						DurationMinutes: 5,
+						TimeFunction:    "all",
+						Value:           "90",
+					},
+				},
+			},
+			Status: nrv1.AlertsInfraConditionStatus{
+				AppliedSpec: &nrv1.AlertsInfraConditionSpec{},
+			},
+		}
+		namespacedName = types.NamespacedName{Namespace: "default", Name: "high-cpu"}
+
+		Expect(k8sClient.Create(ctx, condition)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		var current nrv1.AlertsInfraCondition
+		if err := k8sClient.Get(ctx, namespacedName, &current); err == nil {
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	Context("when creating an infrastructure condition", func() {
+		It("creates the condition in the policy", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.CreateInfrastructureConditionCallCount()).To(Equal(1))
+			created := alertsClient.CreateInfrastructureConditionArgsForCall(0)
+			Expect(created.PolicyID).To(Equal(123))
+			Expect(created.Type).To(Equal("infra_metric"))
+			Expect(created.Event).To(Equal("SystemSample"))
+			Expect(created.Select).To(Equal("cpuPercent"))
+			Expect(created.Critical.Duration).To(Equal(5))
+			Expect(*created.Critical.Value).To(Equal(float64(90)))
+
+			var updated nrv1.AlertsInfraCondition
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.ConditionID).To(Equal(42))
+			Expect(updated.Finalizers).To(ContainElement(alertsInfraConditionDeleteFinalizer))
+			Expect(nrv1.IsConditionTrue(updated.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+		})
+
+		It("adopts an existing condition with the same name", func() {
+			alertsClient.ListInfrastructureConditionsReturns([]alerts.InfrastructureCondition{
+				{ID: 7, Name: "Other"},
+				{ID: 42, Name: "High CPU"},
+			}, nil)
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.ListInfrastructureConditionsArgsForCall(0)).To(Equal(123))
+			Expect(alertsClient.CreateInfrastructureConditionCallCount()).To(Equal(0))
+			Expect(alertsClient.UpdateInfrastructureConditionCallCount()).To(Equal(1))
+			Expect(alertsClient.UpdateInfrastructureConditionArgsForCall(0).ID).To(Equal(42))
+		})
+
+		It("records a failure to create the condition", func() {
+			alertsClient.CreateInfrastructureConditionReturns(nil, errors.New("invalid event type"))
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(MatchError("invalid event type"))
+
+			var updated nrv1.AlertsInfraCondition
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.ConditionID).To(BeZero())
+			failed := nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionError)
+			Expect(failed).ToNot(BeNil())
+			Expect(failed.Reason).To(Equal(nrv1.ReasonCreateFailed))
+		})
+	})
+
+	Context("when the condition already exists", func() {
+		BeforeEach(func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("updates the condition", func() {
+			var current nrv1.AlertsInfraCondition
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			current.Spec.CriticalThreshold.Value = "95"
+			Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.UpdateInfrastructureConditionCallCount()).To(Equal(1))
+			updatedCondition := alertsClient.UpdateInfrastructureConditionArgsForCall(0)
+			Expect(updatedCondition.ID).To(Equal(42))
+			Expect(*updatedCondition.Critical.Value).To(Equal(float64(95)))
+		})
+
+		It("does not call New Relic when nothing changed", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.CreateInfrastructureConditionCallCount()).To(Equal(1))
+			Expect(alertsClient.UpdateInfrastructureConditionCallCount()).To(Equal(0))
+		})
+
+		Context("when a resync interval is configured", func() {
+			var remote alerts.InfrastructureCondition
+
+			BeforeEach(func() {
+				r.ResyncInterval = time.Minute
+
+				remote = condition.Spec.APICondition()
+				remote.ID = 42
+			})
+
+			It("requeues a condition matching New Relic without reporting drift", func() {
+				alertsClient.ListInfrastructureConditionsReturns([]alerts.InfrastructureCondition{remote}, nil)
+
+				result, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(time.Minute))
+
+				Expect(alertsClient.ListInfrastructureConditionsArgsForCall(0)).To(Equal(123))
+				Expect(alertsClient.UpdateInfrastructureConditionCallCount()).To(Equal(0))
+
+				var current nrv1.AlertsInfraCondition
+				Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+				Expect(nrv1.IsConditionTrue(current.Status.Conditions, nrv1.ConditionDrifted)).To(BeFalse())
+			})
+
+			It("reports a condition changed in New Relic without an update", func() {
+				remote.Critical.Duration = 10
+				alertsClient.ListInfrastructureConditionsReturns([]alerts.InfrastructureCondition{remote}, nil)
+
+				_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(alertsClient.UpdateInfrastructureConditionCallCount()).To(Equal(0))
+
+				var current nrv1.AlertsInfraCondition
+				Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+				drifted := nrv1.FindCondition(current.Status.Conditions, nrv1.ConditionDrifted)
+				Expect(drifted).ToNot(BeNil())
+				Expect(drifted.Message).To(ContainSubstring("critical_threshold differs"))
+			})
+
+			It("updates the condition when correcting drift", func() {
+				r.CorrectDrift = true
+				remote.Enabled = false
+				alertsClient.ListInfrastructureConditionsReturns([]alerts.InfrastructureCondition{remote}, nil)
+
+				_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(alertsClient.UpdateInfrastructureConditionCallCount()).To(Equal(1))
+				Expect(alertsClient.UpdateInfrastructureConditionArgsForCall(0).Enabled).To(BeTrue())
+			})
+
+			It("creates the condition again when it was deleted in New Relic", func() {
+				r.CorrectDrift = true
+
+				_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(alertsClient.CreateInfrastructureConditionCallCount()).To(Equal(2))
+				Expect(alertsClient.UpdateInfrastructureConditionCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when deleting an infrastructure condition", func() {
+		BeforeEach(func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deletes the condition from New Relic and removes the finalizer", func() {
+			var current nrv1.AlertsInfraCondition
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.DeleteInfrastructureConditionCallCount()).To(Equal(1))
+			Expect(alertsClient.DeleteInfrastructureConditionArgsForCall(0)).To(Equal(42))
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).ToNot(Succeed())
+		})
+
+		It("keeps the finalizer when New Relic fails to delete the condition", func() {
+			alertsClient.DeleteInfrastructureConditionReturns(errors.New("server error"))
+
+			var current nrv1.AlertsInfraCondition
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(HaveOccurred())
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(current.Finalizers).To(ContainElement(alertsInfraConditionDeleteFinalizer))
+
+			// let the AfterEach clean up
+			alertsClient.DeleteInfrastructureConditionReturns(nil)
+		})
+	})
+})
@@ -16,13 +16,13 @@ limitations under the License.
 package controllers
 
 import (
-	"context"
-	"errors"
+	"fmt"
 	"reflect"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	kErr "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 
 	newrelic "github.com/newrelic/go-agent/v3/newrelic"
 	"github.com/newrelic/newrelic-client-go/pkg/alerts"
@@ -33,6 +33,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
 )
@@ -44,13 +46,14 @@ const (
 // AlertsNrqlConditionReconciler reconciles a AlertsNrqlCondition object
 type AlertsNrqlConditionReconciler struct {
 	client.Client
-	Alerts          interfaces.NewRelicAlertsClient
-	Log             logr.Logger
-	Scheme          *runtime.Scheme
-	AlertClientFunc func(string, string) (interfaces.NewRelicAlertsClient, error)
-	apiKey          string
-	NewRelicAgent   newrelic.Application
-	txn             *newrelic.Transaction
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	AlertClientFunc         func(string, string) (interfaces.NewRelicAlertsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+	ResyncInterval          time.Duration
+	CorrectDrift            bool
 }
 
 // +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=alertsnrqlconditions,verbs=get;list;watch;create;update;patch;delete
@@ -58,15 +61,14 @@ type AlertsNrqlConditionReconciler struct {
 
 // Reconcile is responsible for reconciling the spec and state of the AlertsNrqlCondition.
 func (r *AlertsNrqlConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) { //nolint: gocyclo
-	ctx := context.Background()
 	_ = r.Log.WithValues("alertsnrqlcondition", req.NamespacedName)
-	r.txn = r.NewRelicAgent.StartTransaction("Reconcile/Alerts/NrqlCondition")
-	defer r.txn.End()
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Alerts/NrqlCondition")
+	defer rc.txn.End()
 
 	r.Log.Info("starting reconcile action")
 	var condition nrv1.AlertsNrqlCondition
 
-	err := r.Client.Get(ctx, req.NamespacedName, &condition)
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &condition)
 	if err != nil {
 		if kErr.IsNotFound(err) {
 			r.Log.Info("Expected error 'not found' after condition deleted", "error", err)
@@ -76,21 +78,19 @@ func (r *AlertsNrqlConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result
 		return ctrl.Result{}, err
 	}
 
-	r.apiKey, err = r.getAPIKeyOrSecret(condition)
+	err = resolveCredentials(rc, r.Client, &condition)
 	if err != nil {
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &condition, failureReason(err, nrv1.ReasonCredentialsError), err)
 		return ctrl.Result{}, err
 	}
 
-	if r.apiKey == "" {
-		return ctrl.Result{}, errors.New("api key is blank")
-	}
-
-	alertsClient, errAlertsClient := r.AlertClientFunc(r.apiKey, condition.Spec.Region)
+	alertsClient, errAlertsClient := r.AlertClientFunc(rc.apiKey, rc.region)
 	if errAlertsClient != nil {
 		r.Log.Error(errAlertsClient, "Error thrown")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &condition, nrv1.ReasonCredentialsError, errAlertsClient)
 		return ctrl.Result{}, errAlertsClient
 	}
-	r.Alerts = alertsClient
+	rc.alerts = interfaces.NewTracedAlertsClient(alertsClient, rc.txn, rc.accountID, rc.region)
 
 	// examine DeletionTimestamp to determine if object is under deletion
 	if condition.DeletionTimestamp.IsZero() {
@@ -104,19 +104,19 @@ func (r *AlertsNrqlConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result
 			if condition.Status.ConditionID == "" {
 				r.Log.Info("No Condition ID set, just removing finalizer")
 				condition.Finalizers = removeString(condition.Finalizers, alertsNrqlConditionDeleteFinalizer)
-				if err := r.Client.Update(ctx, &condition); err != nil {
+				if err := r.Client.Update(rc.ctx, &condition); err != nil {
 					r.Log.Error(err, "Failed to update condition after deleting New Relic Alert condition")
 					return ctrl.Result{}, err
 				}
 			} else {
 				// our finalizer is present, so lets handle any external dependency
-				if err := r.deleteNewRelicAlertCondition(condition); err != nil {
+				if err := r.deleteNewRelicAlertCondition(rc, condition); err != nil {
 					// if fail to delete the external dependency here, return with error
 					// so that it can be retried
 					r.Log.Error(err, "Failed to delete API Condition",
 						"conditionId", condition.Status.ConditionID,
 						"region", condition.Spec.Region,
-						"apiKey", interfaces.PartialAPIKey(r.apiKey),
+						"apiKey", interfaces.PartialAPIKey(rc.apiKey),
 					)
 					if err.Error() == "resource not found" {
 						r.Log.Info("New Relic API returned resource not found, deleting condition resource")
@@ -124,15 +124,18 @@ func (r *AlertsNrqlConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result
 						r.Log.Error(err, "Failed to delete API Condition",
 							"conditionId", condition.Status.ConditionID,
 							"region", condition.Spec.Region,
-							"apiKey", interfaces.PartialAPIKey(r.apiKey),
+							"apiKey", interfaces.PartialAPIKey(rc.apiKey),
 						)
+						updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &condition, nrv1.ReasonDeleteFailed, err)
 						return ctrl.Result{}, err
 					}
 				}
+				r.Recorder.Eventf(&condition, v1.EventTypeNormal, eventReasonDeleted, "Deleted New Relic condition %s", condition.Status.ConditionID)
+
 				// remove our finalizer from the list and update it.
 				r.Log.Info("New Relic Alert condition deleted, Removing finalizer")
 				condition.Finalizers = removeString(condition.Finalizers, alertsNrqlConditionDeleteFinalizer)
-				if err := r.Client.Update(ctx, &condition); err != nil {
+				if err := r.Client.Update(rc.ctx, &condition); err != nil {
 					r.Log.Error(err, "Failed to update condition after deleting New Relic Alert condition")
 					return ctrl.Result{}, err
 				}
@@ -145,40 +148,57 @@ func (r *AlertsNrqlConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result
 		return ctrl.Result{}, nil
 	}
 
-	if reflect.DeepEqual(&condition.Spec, condition.Status.AppliedSpec) {
-		return ctrl.Result{}, nil
+	if reflect.DeepEqual(condition.Spec.Redacted(), condition.Status.AppliedSpec) {
+		drifted, err := r.checkForNrqlConditionDrift(rc, &condition)
+		if err != nil {
+			r.Log.Error(err, "failed to resync condition with New Relic", "name", req.NamespacedName)
+			recordFailure(r.Recorder, &condition, eventReasonResyncFailed, err)
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
+
+		if !drifted {
+			if err := updateReadyConditionsIfNeeded(rc.ctx, r.Client, &condition); err != nil {
+				r.Log.Error(err, "tried updating condition status", "name", req.NamespacedName)
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
 	}
 
 	r.Log.Info("Reconciling", "condition", condition.Name)
 
 	//check if condition has condition id
-	r.checkForExistingCondition(&condition)
+	r.checkForExistingCondition(rc, &condition)
 
-	r.writeNewRelicAlertCondition(ctx, req, alertsClient, condition)
+	if err := r.writeNewRelicAlertCondition(rc, req, condition); err != nil {
+		return ctrl.Result{}, err
+	}
 
 	return ctrl.Result{}, nil
 }
 
-func (r *AlertsNrqlConditionReconciler) checkForExistingCondition(condition *nrv1.AlertsNrqlCondition) {
-	defer r.txn.StartSegment("checkForExistingCondition").End()
+func (r *AlertsNrqlConditionReconciler) checkForExistingCondition(rc *requestContext, condition *nrv1.AlertsNrqlCondition) {
+	defer rc.txn.StartSegment("checkForExistingCondition").End()
 	if condition.Status.ConditionID == "" {
 		r.Log.Info("Checking for existing condition", "conditionName", condition.Name)
 		//if no conditionId, get list of conditions and compare name
 		searchParams := alerts.NrqlConditionsSearchCriteria{
 			PolicyID: condition.Spec.ExistingPolicyID,
 		}
-		existingConditions, err := r.Alerts.SearchNrqlConditionsQuery(condition.Spec.AccountID, searchParams)
+		existingConditions, err := rc.alerts.SearchNrqlConditionsQuery(rc.accountID, searchParams)
 		if err != nil {
 			r.Log.Error(err, "failed to get list of NRQL conditions from New Relic API",
 				"conditionId", condition.Status.ConditionID,
 				"region", condition.Spec.Region,
-				"apiKey", interfaces.PartialAPIKey(r.apiKey),
+				"apiKey", interfaces.PartialAPIKey(rc.apiKey),
 			)
+			recordFailure(r.Recorder, condition, eventReasonLookupFailed, err)
 		} else {
 			for _, existingCondition := range existingConditions {
 				if existingCondition.Name == condition.Spec.Name {
 					r.Log.Info("Matched on existing condition, updating ConditionId", "conditionId", existingCondition.ID)
 					condition.Status.ConditionID = existingCondition.ID
+					r.Recorder.Eventf(condition, v1.EventTypeNormal, eventReasonAdopted, "Adopted existing New Relic condition %s", existingCondition.ID)
 					break
 				}
 			}
@@ -186,99 +206,193 @@ func (r *AlertsNrqlConditionReconciler) checkForExistingCondition(condition *nrv
 	}
 }
 
+// checkForNrqlConditionDrift compares the condition in New Relic with the spec when a resync interval is
+// configured and records the result in the Drifted condition. It returns true when the condition drifted
+// and has been prepared to be written again.
+func (r *AlertsNrqlConditionReconciler) checkForNrqlConditionDrift(rc *requestContext, condition *nrv1.AlertsNrqlCondition) (bool, error) {
+	if r.ResyncInterval == 0 || condition.Status.ConditionID == "" {
+		return false, nil
+	}
+
+	defer rc.txn.StartSegment("checkForNrqlConditionDrift").End()
+
+	remoteCondition, err := rc.alerts.GetNrqlConditionQuery(rc.accountID, condition.Status.ConditionID)
+	if err != nil && !isNotFound(err) {
+		r.Log.Error(err, "failed to get NRQL condition from New Relic API",
+			"conditionId", condition.Status.ConditionID,
+			"region", condition.Spec.Region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		return false, err
+	}
+
+	var differences []string
+	if err != nil || remoteCondition == nil {
+		differences = []string{fmt.Sprintf("condition %s not found in New Relic", condition.Status.ConditionID)}
+		remoteCondition = nil
+	} else {
+		differences = condition.Spec.Diff(*remoteCondition)
+
+		if condition.Spec.ManagesStreamingSettings(condition.Status.AppliedSpec) {
+			remoteSignal, err := rc.alerts.GetNrqlConditionSignal(rc.accountID, condition.Status.ConditionID)
+			if err != nil {
+				r.Log.Error(err, "failed to get NRQL condition signal from New Relic API",
+					"conditionId", condition.Status.ConditionID,
+					"region", condition.Spec.Region,
+					"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+				)
+				return false, err
+			}
+
+			differences = append(differences, condition.Spec.DiffSignal(condition.Status.AppliedSpec, remoteSignal)...)
+		}
+	}
+
+	if len(differences) == 0 || !r.CorrectDrift {
+		if setDriftedCondition(r.Recorder, condition, differences) {
+			return false, updateWithStatus(rc.ctx, r.Client, condition)
+		}
+		return false, nil
+	}
+
+	r.Log.Info("correcting drift of condition", "conditionId", condition.Status.ConditionID, "differences", differences)
+	setDriftedCondition(r.Recorder, condition, differences)
+
+	if remoteCondition == nil {
+		condition.Status.ConditionID = ""
+	}
+
+	// forget the applied spec so the condition is written again
+	condition.Status.AppliedSpec = &nrv1.AlertsNrqlConditionSpec{}
+
+	return true, nil
+}
+
 func (r *AlertsNrqlConditionReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.AlertClientFunc = interfaces.InitializeAlertsClient
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&nrv1.AlertsNrqlCondition{}).
-		Complete(r)
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nrv1.AlertsNrqlConditionList{} }, true)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nrv1.AlertsNrqlCondition{}, r))
 }
 
-func (r *AlertsNrqlConditionReconciler) writeNewRelicAlertCondition(ctx context.Context, req ctrl.Request, alertsClient interfaces.NewRelicAlertsClient, condition nrv1.AlertsNrqlCondition) {
+func (r *AlertsNrqlConditionReconciler) writeNewRelicAlertCondition(rc *requestContext, req ctrl.Request, condition nrv1.AlertsNrqlCondition) error {
 	updateInput := condition.Spec.ToNrqlConditionInput()
 
-	if condition.Status.ConditionID != "" && !reflect.DeepEqual(&condition.Spec, condition.Status.AppliedSpec) {
+	if condition.Status.ConditionID != "" && !reflect.DeepEqual(condition.Spec.Redacted(), condition.Status.AppliedSpec) {
 		r.Log.Info("updating condition", "ConditionName", condition.Name, "API fields", updateInput)
 		var updatedCondition *alerts.NrqlAlertCondition
 		var err error
 
-		if condition.Spec.BaselineDirection != nil {
-			updatedCondition, err = alertsClient.UpdateNrqlConditionBaselineMutation(condition.Spec.AccountID, condition.Status.ConditionID, updateInput)
-		} else {
-			updatedCondition, err = alertsClient.UpdateNrqlConditionStaticMutation(condition.Spec.AccountID, condition.Status.ConditionID, updateInput)
+		switch condition.Spec.GetConditionType() {
+		case alerts.NrqlConditionTypes.Baseline:
+			updatedCondition, err = rc.alerts.UpdateNrqlConditionBaselineMutation(rc.accountID, condition.Status.ConditionID, updateInput)
+		case alerts.NrqlConditionTypes.Outlier:
+			updatedCondition, err = rc.alerts.UpdateNrqlConditionOutlierMutation(rc.accountID, condition.Status.ConditionID, updateInput)
+		default:
+			updatedCondition, err = rc.alerts.UpdateNrqlConditionStaticMutation(rc.accountID, condition.Status.ConditionID, updateInput)
+		}
+
+		if err == nil {
+			err = r.writeNrqlConditionSignal(rc, condition, updatedCondition.ID)
 		}
 
 		if err != nil {
 			r.Log.Error(err, "failed to update condition")
+			recordFailure(r.Recorder, &condition, nrv1.ReasonUpdateFailed, err)
+			setFailedConditions(&condition, nrv1.ReasonUpdateFailed, err)
 		} else {
-			condition.Status.AppliedSpec = &condition.Spec
+			condition.Status.AppliedSpec = condition.Spec.Redacted()
 			condition.Status.ConditionID = updatedCondition.ID
+			r.Recorder.Eventf(&condition, v1.EventTypeNormal, eventReasonUpdated, "Updated New Relic condition %s", updatedCondition.ID)
+			setReadyConditions(&condition)
 		}
 
-		err = r.Client.Update(ctx, &condition)
-		if err != nil {
-			r.Log.Error(err, "tried updating condition status", "name", req.NamespacedName)
+		if updateErr := updateWithStatus(rc.ctx, r.Client, &condition); updateErr != nil {
+			r.Log.Error(updateErr, "tried updating condition status", "name", req.NamespacedName)
+			return updateErr
 		}
-	} else {
-		r.Log.Info("Creating condition", "ConditionName", condition.Name, "API fields", updateInput)
-		var createdCondition *alerts.NrqlAlertCondition
-		var err error
 
-		if condition.Spec.BaselineDirection != nil {
-			createdCondition, err = alertsClient.CreateNrqlConditionBaselineMutation(condition.Spec.AccountID, condition.Spec.ExistingPolicyID, updateInput)
-		} else {
-			createdCondition, err = alertsClient.CreateNrqlConditionStaticMutation(condition.Spec.AccountID, condition.Spec.ExistingPolicyID, updateInput)
-		}
+		return err
+	}
 
-		if err != nil {
-			r.Log.Error(err, "failed to create condition",
-				"conditionId", condition.Status.ConditionID,
-				"region", condition.Spec.Region,
-				"apiKey", interfaces.PartialAPIKey(r.apiKey),
-			)
-		} else {
-			condition.Status.AppliedSpec = &condition.Spec
-			condition.Status.ConditionID = createdCondition.ID
-		}
+	r.Log.Info("Creating condition", "ConditionName", condition.Name, "API fields", updateInput)
+	var createdCondition *alerts.NrqlAlertCondition
+	var err error
+
+	switch condition.Spec.GetConditionType() {
+	case alerts.NrqlConditionTypes.Baseline:
+		createdCondition, err = rc.alerts.CreateNrqlConditionBaselineMutation(rc.accountID, condition.Spec.ExistingPolicyID, updateInput)
+	case alerts.NrqlConditionTypes.Outlier:
+		createdCondition, err = rc.alerts.CreateNrqlConditionOutlierMutation(rc.accountID, condition.Spec.ExistingPolicyID, updateInput)
+	default:
+		createdCondition, err = rc.alerts.CreateNrqlConditionStaticMutation(rc.accountID, condition.Spec.ExistingPolicyID, updateInput)
+	}
 
-		err = r.Client.Update(ctx, &condition)
-		if err != nil {
-			r.Log.Error(err, "tried updating condition status", "name", req.NamespacedName)
-		}
+	if err == nil {
+		// keep the new condition even if its signal can't be written, the next reconcile updates it
+		condition.Status.ConditionID = createdCondition.ID
+		err = r.writeNrqlConditionSignal(rc, condition, createdCondition.ID)
 	}
-}
 
-func (r *AlertsNrqlConditionReconciler) deleteNewRelicAlertCondition(condition nrv1.AlertsNrqlCondition) error {
-	defer r.txn.StartSegment("deleteNewRelicAlertCondition").End()
-	r.Log.Info("Deleting condition", "conditionName", condition.Spec.Name)
-	_, err := r.Alerts.DeleteConditionMutation(condition.Spec.AccountID, condition.Status.ConditionID)
 	if err != nil {
-		r.Log.Error(err, "Error deleting condition",
+		r.Log.Error(err, "failed to create condition",
 			"conditionId", condition.Status.ConditionID,
 			"region", condition.Spec.Region,
-			"apiKey", interfaces.PartialAPIKey(r.apiKey),
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
 		)
-		return err
+		recordFailure(r.Recorder, &condition, nrv1.ReasonCreateFailed, err)
+		setFailedConditions(&condition, nrv1.ReasonCreateFailed, err)
+	} else {
+		condition.Status.AppliedSpec = condition.Spec.Redacted()
+		condition.Status.ConditionID = createdCondition.ID
+		r.Recorder.Eventf(&condition, v1.EventTypeNormal, eventReasonCreated, "Created New Relic condition %s", createdCondition.ID)
+		setReadyConditions(&condition)
 	}
 
-	return nil
+	if updateErr := updateWithStatus(rc.ctx, r.Client, &condition); updateErr != nil {
+		r.Log.Error(updateErr, "tried updating condition status", "name", req.NamespacedName)
+		return updateErr
+	}
+
+	return err
 }
 
-func (r *AlertsNrqlConditionReconciler) getAPIKeyOrSecret(condition nrv1.AlertsNrqlCondition) (string, error) {
-	defer r.txn.StartSegment("getAPIKeyOrSecret").End()
-	if condition.Spec.APIKey != "" {
-		return condition.Spec.APIKey, nil
+// writeNrqlConditionSignal writes the streaming settings of the signal, newrelic-client-go can't send
+// them with the rest of the condition. Settings removed from the spec since it was applied are reset.
+func (r *AlertsNrqlConditionReconciler) writeNrqlConditionSignal(rc *requestContext, condition nrv1.AlertsNrqlCondition, conditionID string) error {
+	if !condition.Spec.ManagesStreamingSettings(condition.Status.AppliedSpec) {
+		return nil
 	}
 
-	if condition.Spec.APIKeySecret != (nrv1.NewRelicAPIKeySecret{}) {
-		key := types.NamespacedName{Namespace: condition.Spec.APIKeySecret.Namespace, Name: condition.Spec.APIKeySecret.Name}
-		var apiKeySecret v1.Secret
-		if getErr := r.Client.Get(context.Background(), key, &apiKeySecret); getErr != nil {
-			r.Log.Error(getErr, "Error retrieving secret", "secret", apiKeySecret)
-			return "", getErr
-		}
-		return string(apiKeySecret.Data[condition.Spec.APIKeySecret.KeyName]), nil
+	defer rc.txn.StartSegment("writeNrqlConditionSignal").End()
+
+	_, err := rc.alerts.UpdateNrqlConditionSignal(rc.accountID, condition.Spec.GetConditionType(), conditionID, condition.Spec.ToSignalInput())
+	if err != nil {
+		r.Log.Error(err, "failed to write condition signal",
+			"conditionId", conditionID,
+			"region", condition.Spec.Region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
 	}
 
-	return "", nil
+	return err
+}
+
+func (r *AlertsNrqlConditionReconciler) deleteNewRelicAlertCondition(rc *requestContext, condition nrv1.AlertsNrqlCondition) error {
+	defer rc.txn.StartSegment("deleteNewRelicAlertCondition").End()
+	r.Log.Info("Deleting condition", "conditionName", condition.Spec.Name)
+	_, err := rc.alerts.DeleteConditionMutation(rc.accountID, condition.Status.ConditionID)
+	if err != nil {
+		r.Log.Error(err, "Error deleting condition",
+			"conditionId", condition.Status.ConditionID,
+			"region", condition.Spec.Region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		return err
+	}
+
+	return nil
 }
@@ -6,15 +6,18 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	newrelic "github.com/newrelic/go-agent/v3/newrelic"
 	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -22,6 +25,7 @@ import (
 	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
 	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
 	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/nrqlsignal"
 	"github.com/newrelic/newrelic-kubernetes-operator/internal/testutil"
 )
 
@@ -75,6 +79,20 @@ var _ = Describe("AlertsNrqlCondition reconciliation", func() {
 			return condition, nil
 		}
 
+		mockAlertsClient.CreateNrqlConditionOutlierMutationStub = func(accountID int, policyID string, a alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+			condition := &alerts.NrqlAlertCondition{
+				ID: "111",
+			}
+			return condition, nil
+		}
+
+		mockAlertsClient.UpdateNrqlConditionOutlierMutationStub = func(accountID int, conditionID string, a alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+			condition := &alerts.NrqlAlertCondition{
+				ID: "112",
+			}
+			return condition, nil
+		}
+
 		mockAlertsClient.SearchNrqlConditionsQueryStub = func(accountID int, searchCriteria alerts.NrqlConditionsSearchCriteria) ([]*alerts.NrqlAlertCondition, error) {
 			var a []*alerts.NrqlAlertCondition
 			condition := &alerts.NrqlAlertCondition{
@@ -115,6 +133,7 @@ var _ = Describe("AlertsNrqlCondition reconciliation", func() {
 		r = &AlertsNrqlConditionReconciler{
 			Client:          k8sClient,
 			Log:             logf.Log,
+			Recorder:        record.NewFakeRecorder(100),
 			AlertClientFunc: mockAlertsClientFunc,
 			NewRelicAgent:   newrelicAgent,
 		}
@@ -143,7 +162,7 @@ var _ = Describe("AlertsNrqlCondition reconciliation", func() {
 					var endStateCondition nrv1.AlertsNrqlCondition
 					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
 					Expect(err).To(BeNil())
-					Expect(endStateCondition.Status.AppliedSpec).To(Equal(&condition.Spec))
+					Expect(endStateCondition.Status.AppliedSpec).To(Equal(condition.Spec.Redacted()))
 				})
 			})
 		})
@@ -209,7 +228,7 @@ var _ = Describe("AlertsNrqlCondition reconciliation", func() {
 					var endStateCondition nrv1.AlertsNrqlCondition
 					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
 					Expect(err).To(BeNil())
-					Expect(endStateCondition.Status.AppliedSpec).To(Equal(&condition.Spec))
+					Expect(endStateCondition.Status.AppliedSpec).To(Equal(condition.Spec.Redacted()))
 				})
 			})
 		})
@@ -288,7 +307,7 @@ var _ = Describe("AlertsNrqlCondition reconciliation", func() {
 					var endStateCondition nrv1.AlertsNrqlCondition
 					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
 					Expect(err).To(BeNil())
-					Expect(endStateCondition.Status.AppliedSpec).To(Equal(&condition.Spec))
+					Expect(endStateCondition.Status.AppliedSpec).To(Equal(condition.Spec.Redacted()))
 				})
 			})
 		})
@@ -346,7 +365,7 @@ var _ = Describe("AlertsNrqlCondition reconciliation", func() {
 					var endStateCondition nrv1.AlertsNrqlCondition
 					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
 					Expect(err).To(BeNil())
-					Expect(endStateCondition.Status.AppliedSpec).To(Equal(&condition.Spec))
+					Expect(endStateCondition.Status.AppliedSpec).To(Equal(condition.Spec.Redacted()))
 				})
 			})
 
@@ -361,6 +380,37 @@ var _ = Describe("AlertsNrqlCondition reconciliation", func() {
 					Expect(mockAlertsClient.UpdateNrqlConditionStaticMutationCallCount()).To(Equal(0))
 				})
 			})
+
+			Context("when the condition was deleted in New Relic", func() {
+				BeforeEach(func() {
+					r.ResyncInterval = time.Minute
+
+					mockAlertsClient.GetNrqlConditionQueryStub = func(int, string) (*alerts.NrqlAlertCondition, error) {
+						return nil, nrErrors.NewNotFound("")
+					}
+				})
+
+				It("reports the drift without an API call", func() {
+					result, err := r.Reconcile(request)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result.RequeueAfter).To(Equal(time.Minute))
+					Expect(mockAlertsClient.CreateNrqlConditionStaticMutationCallCount()).To(Equal(1))
+
+					var endStateCondition nrv1.AlertsNrqlCondition
+					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
+					Expect(err).To(BeNil())
+					Expect(nrv1.IsConditionTrue(endStateCondition.Status.Conditions, nrv1.ConditionDrifted)).To(BeTrue())
+				})
+
+				It("creates the condition again when correcting drift", func() {
+					r.CorrectDrift = true
+
+					_, err := r.Reconcile(request)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(mockAlertsClient.CreateNrqlConditionStaticMutationCallCount()).To(Equal(2))
+					Expect(mockAlertsClient.UpdateNrqlConditionStaticMutationCallCount()).To(Equal(0))
+				})
+			})
 		})
 
 		Context("and given a new baseline condition", func() {
@@ -388,11 +438,124 @@ var _ = Describe("AlertsNrqlCondition reconciliation", func() {
 					var endStateCondition nrv1.AlertsNrqlCondition
 					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
 					Expect(err).To(BeNil())
-					Expect(endStateCondition.Status.AppliedSpec).To(Equal(&condition.Spec))
+					Expect(endStateCondition.Status.AppliedSpec).To(Equal(condition.Spec.Redacted()))
 				})
 			})
 		})
 
+		Context("and given a new outlier condition", func() {
+			BeforeEach(func() {
+				condition.Spec.ConditionType = alerts.NrqlConditionTypes.Outlier
+				condition.Spec.ExpectedGroups = 3
+				condition.Spec.IgnoreOverlap = true
+			})
+
+			It("should create the condition with the outlier mutation", func() {
+				err := k8sClient.Create(ctx, condition)
+				Expect(err).To(BeNil())
+
+				_, err = r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(mockAlertsClient.CreateNrqlConditionOutlierMutationCallCount()).To(Equal(1))
+				Expect(mockAlertsClient.CreateNrqlConditionStaticMutationCallCount()).To(Equal(0))
+
+				_, _, conditionInput := mockAlertsClient.CreateNrqlConditionOutlierMutationArgsForCall(0)
+				Expect(*conditionInput.ExpectedGroups).To(Equal(3))
+				Expect(*conditionInput.OpenViolationOnGroupOverlap).To(BeFalse())
+			})
+
+			It("should update the condition with the outlier mutation", func() {
+				err := k8sClient.Create(ctx, condition)
+				Expect(err).To(BeNil())
+
+				_, err = r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+
+				var current nrv1.AlertsNrqlCondition
+				err = k8sClient.Get(ctx, namespacedName, &current)
+				Expect(err).ToNot(HaveOccurred())
+				current.Spec.ExpectedGroups = 4
+				err = k8sClient.Update(ctx, &current)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(mockAlertsClient.UpdateNrqlConditionOutlierMutationCallCount()).To(Equal(1))
+				Expect(mockAlertsClient.UpdateNrqlConditionStaticMutationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("and given a new condition with streaming signal settings", func() {
+			BeforeEach(func() {
+				aggregationWindow, aggregationTimer := 60, 120
+				aggregationMethod := nrqlsignal.AggregationMethodEventTimer
+				condition.Spec.Signal = &nrv1.AlertsNrqlConditionSignal{
+					AggregationWindow: &aggregationWindow,
+					AggregationMethod: &aggregationMethod,
+					AggregationTimer:  &aggregationTimer,
+				}
+			})
+
+			It("should write the signal of the created condition", func() {
+				err := k8sClient.Create(ctx, condition)
+				Expect(err).To(BeNil())
+
+				_, err = r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(mockAlertsClient.CreateNrqlConditionStaticMutationCallCount()).To(Equal(1))
+				Expect(mockAlertsClient.UpdateNrqlConditionSignalCallCount()).To(Equal(1))
+
+				_, conditionType, conditionID, signal := mockAlertsClient.UpdateNrqlConditionSignalArgsForCall(0)
+				Expect(conditionType).To(Equal(alerts.NrqlConditionTypes.Static))
+				Expect(conditionID).To(Equal("111"))
+				Expect(*signal.AggregationMethod).To(Equal(nrqlsignal.AggregationMethodEventTimer))
+				Expect(*signal.AggregationTimer).To(Equal(120))
+			})
+
+			It("resets the streaming settings once they are removed from the spec", func() {
+				err := k8sClient.Create(ctx, condition)
+				Expect(err).To(BeNil())
+
+				_, err = r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(mockAlertsClient.UpdateNrqlConditionSignalCallCount()).To(Equal(1))
+
+				var current nrv1.AlertsNrqlCondition
+				err = k8sClient.Get(ctx, namespacedName, &current)
+				Expect(err).ToNot(HaveOccurred())
+				aggregationWindow := 60
+				current.Spec.Signal = &nrv1.AlertsNrqlConditionSignal{AggregationWindow: &aggregationWindow}
+				err = k8sClient.Update(ctx, &current)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(mockAlertsClient.UpdateNrqlConditionStaticMutationCallCount()).To(Equal(1))
+				Expect(mockAlertsClient.UpdateNrqlConditionSignalCallCount()).To(Equal(2))
+
+				_, _, _, signal := mockAlertsClient.UpdateNrqlConditionSignalArgsForCall(1)
+				Expect(*signal.AggregationMethod).To(Equal(nrqlsignal.AggregationMethodEventFlow))
+				Expect(signal.AggregationDelay).To(BeNil())
+				Expect(signal.AggregationTimer).To(BeNil())
+				Expect(*signal.AggregationWindow).To(Equal(60))
+			})
+
+			It("keeps the created condition when the signal can't be written", func() {
+				mockAlertsClient.UpdateNrqlConditionSignalReturns(nil, errors.New("invalid aggregationTimer"))
+
+				err := k8sClient.Create(ctx, condition)
+				Expect(err).To(BeNil())
+
+				_, err = r.Reconcile(request)
+				Expect(err).To(MatchError("invalid aggregationTimer"))
+
+				var endStateCondition nrv1.AlertsNrqlCondition
+				err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
+				Expect(err).To(BeNil())
+				Expect(endStateCondition.Status.ConditionID).To(Equal("111"))
+				Expect(endStateCondition.Status.AppliedSpec).ToNot(Equal(&condition.Spec))
+			})
+		})
 		AfterEach(func() {
 			// Delete the condition
 			err := k8sClient.Delete(ctx, condition)
@@ -445,7 +608,7 @@ var _ = Describe("AlertsNrqlCondition reconciliation", func() {
 			Context("with a condition with no condition ID", func() {
 				BeforeEach(func() {
 					condition.Status.ConditionID = "0"
-					err := k8sClient.Update(ctx, condition)
+					err := k8sClient.Status().Update(ctx, condition)
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -17,21 +17,26 @@ package controllers
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"reflect"
 	"strconv"
+	"time"
 
 	newrelic "github.com/newrelic/go-agent/v3/newrelic"
 	"github.com/newrelic/newrelic-client-go/pkg/alerts"
 	v1 "k8s.io/api/core/v1"
 	kErr "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/go-logr/logr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
 	customErrors "github.com/newrelic/newrelic-kubernetes-operator/errors"
@@ -47,27 +52,26 @@ var trueVar = true
 // AlertsPolicyReconciler reconciles a AlertsPolicy object
 type AlertsPolicyReconciler struct {
 	client.Client
-	Log             logr.Logger
-	Scheme          *runtime.Scheme
-	AlertClientFunc func(string, string) (interfaces.NewRelicAlertsClient, error)
-	apiKey          string
-	Alerts          interfaces.NewRelicAlertsClient
-	ctx             context.Context
-	NewRelicAgent   newrelic.Application
-	txn             *newrelic.Transaction
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	AlertClientFunc         func(string, string) (interfaces.NewRelicAlertsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+	ResyncInterval          time.Duration
+	CorrectDrift            bool
 }
 
 // +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=alertspolicies,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=alertspolicies/status,verbs=get;update;patch
 
 func (r *AlertsPolicyReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
-	r.ctx = context.Background()
 	_ = r.Log.WithValues("policy", req.NamespacedName)
-	r.txn = r.NewRelicAgent.StartTransaction("Reconcile/Alerts/AlertsPolicy")
-	defer r.txn.End()
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Alerts/AlertsPolicy")
+	defer rc.txn.End()
 
 	var policy nrv1.AlertsPolicy
-	err := r.Client.Get(r.ctx, req.NamespacedName, &policy)
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &policy)
 	if err != nil {
 		if kErr.IsNotFound(err) {
 			r.Log.Info("AlertsPolicy 'not found' after being deleted. This is expected and no cause for alarm", "error", err)
@@ -76,26 +80,31 @@ func (r *AlertsPolicyReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error
 		r.Log.Error(err, "Failed to GET policy", "name", req.NamespacedName.String())
 		return ctrl.Result{}, err
 	}
+
 	r.Log.Info("Starting reconcile action")
-	r.Log.Info("policy", "policy.Spec.Condition", policy.Spec.Conditions, "policy.status.applied.conditions", policy.Status.AppliedSpec.Conditions)
 
-	r.apiKey, err = r.getAPIKeyOrSecret(policy)
+	err = resolveCredentials(rc, r.Client, &policy)
 	if err != nil {
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &policy, failureReason(err, nrv1.ReasonCredentialsError), err)
 		return ctrl.Result{}, err
 	}
 
-	if r.apiKey == "" {
-		return ctrl.Result{}, errors.New("api key is blank")
-	}
-
 	//initial alertsClient
-	alertsClient, errAlertsClient := r.AlertClientFunc(r.apiKey, policy.Spec.Region)
+	alertsClient, errAlertsClient := r.AlertClientFunc(rc.apiKey, rc.region)
 	if errAlertsClient != nil {
 		r.Log.Error(errAlertsClient, "Failed to create AlertsClient")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &policy, nrv1.ReasonCredentialsError, errAlertsClient)
 		return ctrl.Result{}, errAlertsClient
 	}
 
-	r.Alerts = alertsClient
+	rc.alerts = interfaces.NewTracedAlertsClient(alertsClient, rc.txn, rc.accountID, rc.region)
+
+	// the status is not part of the create request, a new policy has no applied spec yet
+	if policy.Status.AppliedSpec == nil {
+		policy.Status.AppliedSpec = &nrv1.AlertsPolicySpec{}
+	}
+
+	r.Log.Info("policy", "policy.Spec.Condition", policy.Spec.Conditions, "policy.status.applied.conditions", policy.Status.AppliedSpec.Conditions)
 
 	//examine DeletionTimestamp to determine if object is under deletion
 	if policy.DeletionTimestamp.IsZero() {
@@ -103,27 +112,49 @@ func (r *AlertsPolicyReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error
 			policy.Finalizers = append(policy.Finalizers, alertsPolicyDeleteFinalizer)
 		}
 	} else {
-		return r.deleteAlertsPolicy(r.ctx, &policy, alertsPolicyDeleteFinalizer)
+		return r.deleteAlertsPolicy(rc, &policy, alertsPolicyDeleteFinalizer)
 	}
 
-	if policy.Spec.Equals(*policy.Status.AppliedSpec) {
-		return ctrl.Result{}, nil
+	channelIDs, err := r.channelRefIDs(rc, &policy)
+	if err != nil {
+		r.Log.Error(err, "failed to resolve channels of policy", "name", req.NamespacedName.String())
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &policy, nrv1.ReasonAlertsChannelNotFound, err)
+		return ctrl.Result{}, err
+	}
+
+	if policy.Spec.Redacted().Equals(*policy.Status.AppliedSpec) && reflect.DeepEqual(channelIDs, policy.Status.ChannelIDs) {
+		drifted, err := r.checkForAlertsPolicyDrift(rc, &policy)
+		if err != nil {
+			r.Log.Error(err, "failed to resync policy with New Relic", "name", policy.Name)
+			recordFailure(r.Recorder, &policy, eventReasonResyncFailed, err)
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
+
+		if !drifted {
+			if err := updateReadyConditionsIfNeeded(rc.ctx, r.Client, &policy); err != nil {
+				r.Log.Error(err, "failed to update policy status", "name", policy.Name)
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
 	}
 
 	r.Log.Info("Reconciling", "policy", policy.Name)
 
-	r.checkForExistingAlertsPolicy(&policy)
+	r.checkForExistingAlertsPolicy(rc, &policy)
 
 	if policy.Status.PolicyID != "" {
-		err := r.updateAlertsPolicy(&policy)
+		err := r.updateAlertsPolicy(rc, &policy, channelIDs)
 		if err != nil {
 			r.Log.Error(err, "error updating policy")
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &policy, nrv1.ReasonUpdateFailed, err)
 			return ctrl.Result{}, err
 		}
 	} else {
-		err := r.createAlertsPolicy(&policy)
+		err := r.createAlertsPolicy(rc, &policy, channelIDs)
 		if err != nil {
 			r.Log.Error(err, "Error creating policy")
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &policy, nrv1.ReasonCreateFailed, err)
 			return ctrl.Result{}, err
 		}
 	}
@@ -131,27 +162,108 @@ func (r *AlertsPolicyReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error
 	return ctrl.Result{}, nil
 }
 
-func (r *AlertsPolicyReconciler) createAlertsPolicy(policy *nrv1.AlertsPolicy) error {
-	defer r.txn.StartSegment("createAlertsPolicy").End()
+// checkForAlertsPolicyDrift compares the policy in New Relic with the spec when a resync interval is
+// configured and records the result in the Drifted condition. It returns true when the policy drifted
+// and has been prepared to be applied again.
+func (r *AlertsPolicyReconciler) checkForAlertsPolicyDrift(rc *requestContext, policy *nrv1.AlertsPolicy) (bool, error) {
+	if r.ResyncInterval == 0 || policy.Status.PolicyID == "" {
+		return false, nil
+	}
+
+	defer rc.txn.StartSegment("checkForAlertsPolicyDrift").End()
+
+	remotePolicy, err := rc.alerts.QueryPolicy(rc.accountID, policy.Status.PolicyID)
+	if err != nil && !isNotFound(err) {
+		r.Log.Error(err, "failed to get policy from New Relic API",
+			"policyId", policy.Status.PolicyID,
+			"region", policy.Spec.Region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		return false, err
+	}
+
+	var differences []string
+	if err != nil || remotePolicy == nil {
+		differences = []string{fmt.Sprintf("policy %s not found in New Relic", policy.Status.PolicyID)}
+		remotePolicy = nil
+	} else {
+		differences = policy.Spec.Diff(*remotePolicy)
+	}
+
+	if len(differences) == 0 || !r.CorrectDrift {
+		if setDriftedCondition(r.Recorder, policy, differences) {
+			return false, updateWithStatus(rc.ctx, r.Client, policy)
+		}
+		return false, nil
+	}
+
+	r.Log.Info("correcting drift of policy", "policyId", policy.Status.PolicyID, "differences", differences)
+	setDriftedCondition(r.Recorder, policy, differences)
+
+	if remotePolicy == nil {
+		return true, r.resetMissingAlertsPolicy(rc, policy)
+	}
+
+	// record what New Relic holds as applied so the update writes the spec again
+	appliedSpec := *policy.Status.AppliedSpec
+	appliedSpec.Name = remotePolicy.Name
+	appliedSpec.IncidentPreference = string(remotePolicy.IncidentPreference)
+	policy.Status.AppliedSpec = &appliedSpec
+
+	return true, nil
+}
+
+// resetMissingAlertsPolicy prepares a policy that was deleted in New Relic to be created again.
+// The conditions went with the policy, so their resources are replaced as well.
+func (r *AlertsPolicyReconciler) resetMissingAlertsPolicy(rc *requestContext, policy *nrv1.AlertsPolicy) error {
+	defer rc.txn.StartSegment("resetMissingAlertsPolicy").End()
+
+	collectedErrors := new(customErrors.ErrorCollector)
+	for _, condition := range policy.Status.AppliedSpec.Conditions {
+		err := r.deleteCondition(rc, &condition)
+		if err != nil {
+			r.Log.Error(err, "error deleting condition resource")
+			collectedErrors.Collect(err)
+		}
+	}
+
+	if len(*collectedErrors) > 0 {
+		return collectedErrors
+	}
+
+	for i := range policy.Spec.Conditions {
+		policy.Spec.Conditions[i].Name = ""
+		policy.Spec.Conditions[i].Namespace = ""
+	}
+
+	policy.Status.PolicyID = ""
+	policy.Status.AppliedSpec.Conditions = nil
+
+	return nil
+}
+
+func (r *AlertsPolicyReconciler) createAlertsPolicy(rc *requestContext, policy *nrv1.AlertsPolicy, channelIDs []int) error {
+	defer rc.txn.StartSegment("createAlertsPolicy").End()
 	p := alerts.AlertsPolicyInput{}
 	p.IncidentPreference = alerts.AlertsIncidentPreference(policy.Spec.IncidentPreference)
 	p.Name = policy.Spec.Name
 
 	r.Log.Info("Creating policy", "PolicyName", p.Name)
-	createResult, err := r.Alerts.CreatePolicyMutation(policy.Spec.AccountID, p)
+	createResult, err := rc.alerts.CreatePolicyMutation(rc.accountID, p)
 	if err != nil {
 		r.Log.Error(err, "failed to create policy via New Relic API",
 			"policyId", policy.Status.PolicyID,
 			"region", policy.Spec.Region,
-			"apiKey", interfaces.PartialAPIKey(r.apiKey),
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
 		)
 
 		return err
 	}
 
 	policy.Status.PolicyID = createResult.ID
+	r.Recorder.Eventf(policy, v1.EventTypeNormal, eventReasonCreated, "Created New Relic policy %s", createResult.ID)
 
-	err = r.createConditions(policy)
+	err = r.createConditions(rc, policy)
 	if err != nil {
 		r.Log.Error(err, "error creating or updating conditions")
 
@@ -159,16 +271,18 @@ func (r *AlertsPolicyReconciler) createAlertsPolicy(policy *nrv1.AlertsPolicy) e
 	}
 	r.Log.Info("policy after condition creation", "policyCondition", policy.Spec.Conditions, "pointer", &policy)
 
-	err = r.createAlertsChannels(policy)
+	err = r.createAlertsChannels(rc, policy, mergeChannelIDs(policy.Spec.ChannelIDs, channelIDs))
 	if err != nil {
 		r.Log.Error(err, "error updating alert channels")
 
 		return err
 	}
 
-	policy.Status.AppliedSpec = &policy.Spec
+	policy.Status.AppliedSpec = policy.Spec.Redacted()
+	policy.Status.ChannelIDs = channelIDs
+	setReadyConditions(policy)
 
-	err = r.Client.Update(r.ctx, policy)
+	err = updateWithStatus(rc.ctx, r.Client, policy)
 	if err != nil {
 		r.Log.Error(err, "tried updating policy status", "name", policy.Name)
 		return err
@@ -177,8 +291,8 @@ func (r *AlertsPolicyReconciler) createAlertsPolicy(policy *nrv1.AlertsPolicy) e
 	return nil
 }
 
-func (r *AlertsPolicyReconciler) createConditions(policy *nrv1.AlertsPolicy) error {
-	defer r.txn.StartSegment("createConditions").End()
+func (r *AlertsPolicyReconciler) createConditions(rc *requestContext, policy *nrv1.AlertsPolicy) error {
+	defer rc.txn.StartSegment("createConditions").End()
 	r.Log.Info("creating conditions for policy")
 
 	collectedErrors := new(customErrors.ErrorCollector)
@@ -187,9 +301,15 @@ func (r *AlertsPolicyReconciler) createConditions(policy *nrv1.AlertsPolicy) err
 		var err error
 		switch nrv1.GetAlertsConditionType(condition) {
 		case "AlertsAPMCondition":
-			err = r.createApmCondition(policy, &condition)
+			err = r.createApmCondition(rc, policy, &condition)
 		case "AlertsNrqlCondition":
-			err = r.createNrqlCondition(policy, &condition)
+			err = r.createNrqlCondition(rc, policy, &condition)
+		case "AlertsInfraCondition":
+			err = r.createInfraCondition(rc, policy, &condition)
+		case "AlertsExternalServiceCondition":
+			err = r.createExternalServiceCondition(rc, policy, &condition)
+		case "AlertsSyntheticsCondition":
+			err = r.createSyntheticsCondition(rc, policy, &condition)
 		}
 
 		if err != nil {
@@ -213,8 +333,8 @@ type processedAlertsConditions struct {
 	condition nrv1.AlertsPolicyCondition
 }
 
-func (r *AlertsPolicyReconciler) createOrUpdateCondition(policy *nrv1.AlertsPolicy, condition *nrv1.AlertsPolicyCondition) (*nrv1.AlertsPolicyCondition, error) {
-	defer r.txn.StartSegment("createOrUpdateCondition").End()
+func (r *AlertsPolicyReconciler) createOrUpdateCondition(rc *requestContext, policy *nrv1.AlertsPolicy, condition *nrv1.AlertsPolicyCondition) (*nrv1.AlertsPolicyCondition, error) {
+	defer rc.txn.StartSegment("createOrUpdateCondition").End()
 	//loop through the policies, creating/updating as needed
 	r.Log.Info("Checking on condition", "resourceName", condition.Name, "conditionName", condition.Spec.Name)
 	//first we check to see if the name is set
@@ -236,9 +356,15 @@ func (r *AlertsPolicyReconciler) createOrUpdateCondition(policy *nrv1.AlertsPoli
 			var err error
 			switch nrv1.GetAlertsConditionType(*condition) {
 			case "AlertsAPMCondition":
-				err = r.createApmCondition(policy, condition)
+				err = r.createApmCondition(rc, policy, condition)
 			case "AlertsNrqlCondition":
-				err = r.createNrqlCondition(policy, condition)
+				err = r.createNrqlCondition(rc, policy, condition)
+			case "AlertsInfraCondition":
+				err = r.createInfraCondition(rc, policy, condition)
+			case "AlertsExternalServiceCondition":
+				err = r.createExternalServiceCondition(rc, policy, condition)
+			case "AlertsSyntheticsCondition":
+				err = r.createSyntheticsCondition(rc, policy, condition)
 			}
 			return condition, err
 		}
@@ -247,17 +373,23 @@ func (r *AlertsPolicyReconciler) createOrUpdateCondition(policy *nrv1.AlertsPoli
 	var err error
 	switch nrv1.GetAlertsConditionType(*condition) {
 	case "AlertsAPMCondition":
-		err = r.updateApmCondition(policy, condition)
+		err = r.updateApmCondition(rc, policy, condition)
 	case "AlertsNrqlCondition":
-		err = r.updateNrqlCondition(policy, condition)
+		err = r.updateNrqlCondition(rc, policy, condition)
+	case "AlertsInfraCondition":
+		err = r.updateInfraCondition(rc, policy, condition)
+	case "AlertsExternalServiceCondition":
+		err = r.updateExternalServiceCondition(rc, policy, condition)
+	case "AlertsSyntheticsCondition":
+		err = r.updateSyntheticsCondition(rc, policy, condition)
 	}
 
 	return condition, err
 }
 
-func (r *AlertsPolicyReconciler) updateNrqlCondition(policy *nrv1.AlertsPolicy, condition *nrv1.AlertsPolicyCondition) error {
-	defer r.txn.StartSegment("updateNrqlCondition").End()
-	nrqlCondition := r.getAlertsNrqlConditionFromAlertsPolicyCondition(condition)
+func (r *AlertsPolicyReconciler) updateNrqlCondition(rc *requestContext, policy *nrv1.AlertsPolicy, condition *nrv1.AlertsPolicyCondition) error {
+	defer rc.txn.StartSegment("updateNrqlCondition").End()
+	nrqlCondition := r.getAlertsNrqlConditionFromAlertsPolicyCondition(rc, condition)
 
 	r.Log.Info("Found nrql condition to update", "retrievedCondition", nrqlCondition)
 
@@ -277,18 +409,19 @@ func (r *AlertsPolicyReconciler) updateNrqlCondition(policy *nrv1.AlertsPolicy,
 	//Set inherited values
 	nrqlCondition.Spec.Region = policy.Spec.Region
 	nrqlCondition.Spec.ExistingPolicyID = policy.Status.PolicyID
-	nrqlCondition.Spec.APIKey = policy.Spec.APIKey
+	nrqlCondition.Spec.APIKey = ""
 	nrqlCondition.Spec.APIKeySecret = policy.Spec.APIKeySecret
+	nrqlCondition.Spec.AccountRef = policy.Spec.AccountRef
 	nrqlCondition.Spec.AccountID = policy.Spec.AccountID
 
-	err := r.Client.Update(r.ctx, &nrqlCondition)
+	err := r.Client.Update(rc.ctx, &nrqlCondition)
 
 	return err
 }
 
-func (r *AlertsPolicyReconciler) updateApmCondition(policy *nrv1.AlertsPolicy, condition *nrv1.AlertsPolicyCondition) error {
-	defer r.txn.StartSegment("updateApmCondition").End()
-	apmCondition := r.getApmConditionFromAlertsPolicyCondition(condition)
+func (r *AlertsPolicyReconciler) updateApmCondition(rc *requestContext, policy *nrv1.AlertsPolicy, condition *nrv1.AlertsPolicyCondition) error {
+	defer rc.txn.StartSegment("updateApmCondition").End()
+	apmCondition := r.getApmConditionFromAlertsPolicyCondition(rc, condition)
 
 	r.Log.Info("Found apm condition to update", "retrievedCondition", apmCondition)
 
@@ -307,22 +440,119 @@ func (r *AlertsPolicyReconciler) updateApmCondition(policy *nrv1.AlertsPolicy, c
 	apmCondition.Spec = condition.ReturnApmConditionSpec()
 	//Set inherited values
 	apmCondition.Spec.Region = policy.Spec.Region
-	apmCondition.Spec.APIKey = policy.Spec.APIKey
+	apmCondition.Spec.APIKey = ""
 	apmCondition.Spec.APIKeySecret = policy.Spec.APIKeySecret
+	apmCondition.Spec.AccountRef = policy.Spec.AccountRef
 	apmCondition.Spec.AccountID = policy.Spec.AccountID
 
 	apmCondition.Spec.ExistingPolicyID = policy.Status.PolicyID
 
 	r.Log.Info("updating existing condition", "alertsAPMCondition", apmCondition)
 
-	err := r.Client.Update(r.ctx, &apmCondition)
+	err := r.Client.Update(rc.ctx, &apmCondition)
+
+	return err
+}
+
+func (r *AlertsPolicyReconciler) updateInfraCondition(rc *requestContext, policy *nrv1.AlertsPolicy, condition *nrv1.AlertsPolicyCondition) error {
+	defer rc.txn.StartSegment("updateInfraCondition").End()
+	infraCondition := r.getInfraConditionFromAlertsPolicyCondition(rc, condition)
+
+	r.Log.Info("Found infra condition to update", "retrievedCondition", infraCondition)
+
+	//Now check to confirm the InfraCondition matches our PolicyCondition
+	retrievedPolicyCondition := nrv1.AlertsPolicyCondition{}
+	retrievedPolicyCondition.GenerateSpecFromInfraConditionSpec(infraCondition.Spec)
+	r.Log.Info("conditions", "retrieved", retrievedPolicyCondition, "condition", condition)
+
+	if retrievedPolicyCondition.SpecHash() == condition.SpecHash() {
+		r.Log.Info("existing InfraCondition matches going to next")
+		return nil
+	}
+
+	r.Log.Info("updating existing condition", "policyRegion", policy.Spec.Region, "policyId", policy.Status.PolicyID)
+
+	infraCondition.Spec = condition.ReturnInfraConditionSpec()
+	//Set inherited values
+	infraCondition.Spec.Region = policy.Spec.Region
+	infraCondition.Spec.ExistingPolicyID = policy.Status.PolicyID
+	infraCondition.Spec.APIKey = ""
+	infraCondition.Spec.APIKeySecret = policy.Spec.APIKeySecret
+	infraCondition.Spec.AccountRef = policy.Spec.AccountRef
+	infraCondition.Spec.AccountID = policy.Spec.AccountID
+
+	err := r.Client.Update(rc.ctx, &infraCondition)
+
+	return err
+}
+
+func (r *AlertsPolicyReconciler) updateExternalServiceCondition(rc *requestContext, policy *nrv1.AlertsPolicy, condition *nrv1.AlertsPolicyCondition) error {
+	defer rc.txn.StartSegment("updateExternalServiceCondition").End()
+	externalServiceCondition := r.getExternalServiceConditionFromAlertsPolicyCondition(rc, condition)
+
+	r.Log.Info("Found external service condition to update", "retrievedCondition", externalServiceCondition)
+
+	//Now check to confirm the ExternalServiceCondition matches our PolicyCondition
+	retrievedPolicyCondition := nrv1.AlertsPolicyCondition{}
+	retrievedPolicyCondition.GenerateSpecFromExternalServiceConditionSpec(externalServiceCondition.Spec)
+	r.Log.Info("conditions", "retrieved", retrievedPolicyCondition, "condition", condition)
+
+	if retrievedPolicyCondition.SpecHash() == condition.SpecHash() {
+		r.Log.Info("existing ExternalServiceCondition matches going to next")
+		return nil
+	}
+
+	r.Log.Info("updating existing condition", "policyRegion", policy.Spec.Region, "policyId", policy.Status.PolicyID)
+
+	externalServiceCondition.Spec = condition.ReturnExternalServiceConditionSpec()
+	//Set inherited values
+	externalServiceCondition.Spec.Region = policy.Spec.Region
+	externalServiceCondition.Spec.ExistingPolicyID = policy.Status.PolicyID
+	externalServiceCondition.Spec.APIKey = ""
+	externalServiceCondition.Spec.APIKeySecret = policy.Spec.APIKeySecret
+	externalServiceCondition.Spec.AccountRef = policy.Spec.AccountRef
+	externalServiceCondition.Spec.AccountID = policy.Spec.AccountID
+
+	err := r.Client.Update(rc.ctx, &externalServiceCondition)
+
+	return err
+}
+
+func (r *AlertsPolicyReconciler) updateSyntheticsCondition(rc *requestContext, policy *nrv1.AlertsPolicy, condition *nrv1.AlertsPolicyCondition) error {
+	defer rc.txn.StartSegment("updateSyntheticsCondition").End()
+	syntheticsCondition := r.getSyntheticsConditionFromAlertsPolicyCondition(rc, condition)
+
+	r.Log.Info("Found synthetics condition to update", "retrievedCondition", syntheticsCondition)
+
+	//Now check to confirm the SyntheticsCondition matches our PolicyCondition
+	retrievedPolicyCondition := nrv1.AlertsPolicyCondition{}
+	retrievedPolicyCondition.GenerateSpecFromSyntheticsConditionSpec(syntheticsCondition.Spec)
+	r.Log.Info("conditions", "retrieved", retrievedPolicyCondition, "condition", condition)
+
+	if retrievedPolicyCondition.SpecHash() == condition.SpecHash() {
+		r.Log.Info("existing SyntheticsCondition matches going to next")
+		return nil
+	}
+
+	r.Log.Info("updating existing condition", "policyRegion", policy.Spec.Region, "policyId", policy.Status.PolicyID)
+
+	syntheticsCondition.Spec = condition.ReturnSyntheticsConditionSpec()
+	//Set inherited values
+	syntheticsCondition.Spec.Region = policy.Spec.Region
+	syntheticsCondition.Spec.ExistingPolicyID = policy.Status.PolicyID
+	syntheticsCondition.Spec.APIKey = ""
+	syntheticsCondition.Spec.APIKeySecret = policy.Spec.APIKeySecret
+	syntheticsCondition.Spec.AccountRef = policy.Spec.AccountRef
+	syntheticsCondition.Spec.AccountID = policy.Spec.AccountID
+
+	err := r.Client.Update(rc.ctx, &syntheticsCondition)
 
 	return err
 }
 
-func (r *AlertsPolicyReconciler) createOrUpdateConditions(policy *nrv1.AlertsPolicy) error {
-	defer r.txn.StartSegment("createOrUpdateConditions").End()
-	if reflect.DeepEqual(policy.Spec.Conditions, policy.Status.AppliedSpec.Conditions) {
+func (r *AlertsPolicyReconciler) createOrUpdateConditions(rc *requestContext, policy *nrv1.AlertsPolicy) error {
+	defer rc.txn.StartSegment("createOrUpdateConditions").End()
+	if reflect.DeepEqual(policy.Spec.Redacted().Conditions, policy.Status.AppliedSpec.Conditions) {
 		return nil
 	}
 
@@ -338,7 +568,7 @@ func (r *AlertsPolicyReconciler) createOrUpdateConditions(policy *nrv1.AlertsPol
 	collectedErrors := new(customErrors.ErrorCollector)
 
 	for i, condition := range policy.Spec.Conditions {
-		condition, err := r.createOrUpdateCondition(policy, &condition)
+		condition, err := r.createOrUpdateCondition(rc, policy, &condition)
 		if err != nil {
 			r.Log.Error(err, "error creating condition")
 			collectedErrors.Collect(err)
@@ -361,7 +591,7 @@ func (r *AlertsPolicyReconciler) createOrUpdateConditions(policy *nrv1.AlertsPol
 		r.Log.Info("checking "+processedCondition.condition.Name, "bool is", processedCondition.processed)
 		if !processedCondition.processed {
 			r.Log.Info("Need to delete", "ppliedConditionName", conditionName)
-			err := r.deleteCondition(&processedCondition.condition)
+			err := r.deleteCondition(rc, &processedCondition.condition)
 			if err != nil {
 				r.Log.Error(err, "error deleting condition resource")
 				collectedErrors.Collect(err)
@@ -389,8 +619,8 @@ func asOwner(p *nrv1.AlertsPolicy) metav1.OwnerReference {
 	}
 }
 
-func (r *AlertsPolicyReconciler) createNrqlCondition(policy *nrv1.AlertsPolicy, condition *nrv1.AlertsPolicyCondition) error {
-	defer r.txn.StartSegment("createNrqlCondition").End()
+func (r *AlertsPolicyReconciler) createNrqlCondition(rc *requestContext, policy *nrv1.AlertsPolicy, condition *nrv1.AlertsPolicyCondition) error {
+	defer rc.txn.StartSegment("createNrqlCondition").End()
 	var alertsNrqlCondition nrv1.AlertsNrqlCondition
 	alertsNrqlCondition.GenerateName = policy.Name + "-condition-"
 	alertsNrqlCondition.Namespace = policy.Namespace
@@ -398,15 +628,16 @@ func (r *AlertsPolicyReconciler) createNrqlCondition(policy *nrv1.AlertsPolicy,
 	alertsNrqlCondition.Spec = condition.ReturnNrqlConditionSpec()
 	alertsNrqlCondition.Spec.Region = policy.Spec.Region
 	alertsNrqlCondition.Spec.ExistingPolicyID = policy.Status.PolicyID
-	alertsNrqlCondition.Spec.APIKey = policy.Spec.APIKey
+	alertsNrqlCondition.Spec.APIKey = ""
 	alertsNrqlCondition.Spec.APIKeySecret = policy.Spec.APIKeySecret
+	alertsNrqlCondition.Spec.AccountRef = policy.Spec.AccountRef
 	alertsNrqlCondition.Spec.AccountID = policy.Spec.AccountID
 	alertsNrqlCondition.Status.AppliedSpec = &nrv1.AlertsNrqlConditionSpec{}
 	alertsNrqlCondition.OwnerReferences = append(alertsNrqlCondition.OwnerReferences, asOwner(policy))
 
 	r.Log.Info("creating condition", "condition", condition.Name, "conditionName", condition.Spec.Name, "alertsNrqlCondition", alertsNrqlCondition)
 
-	errCondition := r.Create(r.ctx, &alertsNrqlCondition)
+	errCondition := r.Create(rc.ctx, &alertsNrqlCondition)
 	if errCondition != nil {
 		r.Log.Error(errCondition, "error creating condition")
 		return errCondition
@@ -414,14 +645,15 @@ func (r *AlertsPolicyReconciler) createNrqlCondition(policy *nrv1.AlertsPolicy,
 
 	condition.Name = alertsNrqlCondition.Name
 	condition.Namespace = alertsNrqlCondition.Namespace
+	r.Recorder.Eventf(policy, v1.EventTypeNormal, eventReasonCreated, "Created AlertsNrqlCondition %s for condition %s", alertsNrqlCondition.Name, condition.Spec.Name)
 
 	r.Log.Info("created condition", "condition", condition.Name, "conditionName", condition.Spec.Name, "alertsNrqlCondition", alertsNrqlCondition)
 
 	return nil
 }
 
-func (r *AlertsPolicyReconciler) createApmCondition(policy *nrv1.AlertsPolicy, condition *nrv1.AlertsPolicyCondition) error {
-	defer r.txn.StartSegment("createApmCondition").End()
+func (r *AlertsPolicyReconciler) createApmCondition(rc *requestContext, policy *nrv1.AlertsPolicy, condition *nrv1.AlertsPolicyCondition) error {
+	defer rc.txn.StartSegment("createApmCondition").End()
 	var apmCondition nrv1.AlertsAPMCondition
 	apmCondition.GenerateName = policy.Name + "-condition-"
 	apmCondition.Namespace = policy.Namespace
@@ -429,8 +661,9 @@ func (r *AlertsPolicyReconciler) createApmCondition(policy *nrv1.AlertsPolicy, c
 
 	apmCondition.Spec = condition.ReturnApmConditionSpec()
 	apmCondition.Spec.Region = policy.Spec.Region
-	apmCondition.Spec.APIKey = policy.Spec.APIKey
+	apmCondition.Spec.APIKey = ""
 	apmCondition.Spec.APIKeySecret = policy.Spec.APIKeySecret
+	apmCondition.Spec.AccountRef = policy.Spec.AccountRef
 	apmCondition.Spec.AccountID = policy.Spec.AccountID
 	apmCondition.Status.AppliedSpec = &nrv1.AlertsAPMConditionSpec{}
 
@@ -438,7 +671,7 @@ func (r *AlertsPolicyReconciler) createApmCondition(policy *nrv1.AlertsPolicy, c
 	apmCondition.OwnerReferences = append(apmCondition.OwnerReferences, asOwner(policy))
 
 	r.Log.Info("creating apm condition", "condition", condition.Name, "conditionName", condition.Spec.Name, "alertsAPMCondition", apmCondition)
-	errCondition := r.Create(r.ctx, &apmCondition)
+	errCondition := r.Create(rc.ctx, &apmCondition)
 	if errCondition != nil {
 		r.Log.Error(errCondition, "error creating condition")
 		return errCondition
@@ -446,29 +679,135 @@ func (r *AlertsPolicyReconciler) createApmCondition(policy *nrv1.AlertsPolicy, c
 
 	condition.Name = apmCondition.Name
 	condition.Namespace = apmCondition.Namespace
+	r.Recorder.Eventf(policy, v1.EventTypeNormal, eventReasonCreated, "Created AlertsAPMCondition %s for condition %s", apmCondition.Name, condition.Spec.Name)
 
 	r.Log.Info("created apm condition", "condition", condition.Name, "conditionName", condition.Spec.Name, "alertsAPMCondition", apmCondition, "actualCondition", condition.Spec)
 
 	return nil
 }
 
-func (r *AlertsPolicyReconciler) deleteCondition(condition *nrv1.AlertsPolicyCondition) error {
-	defer r.txn.StartSegment("deleteCondition").End()
+func (r *AlertsPolicyReconciler) createInfraCondition(rc *requestContext, policy *nrv1.AlertsPolicy, condition *nrv1.AlertsPolicyCondition) error {
+	defer rc.txn.StartSegment("createInfraCondition").End()
+	var infraCondition nrv1.AlertsInfraCondition
+	infraCondition.GenerateName = policy.Name + "-condition-"
+	infraCondition.Namespace = policy.Namespace
+	infraCondition.Labels = policy.Labels
+	infraCondition.Spec = condition.ReturnInfraConditionSpec()
+	infraCondition.Spec.Region = policy.Spec.Region
+	infraCondition.Spec.ExistingPolicyID = policy.Status.PolicyID
+	infraCondition.Spec.APIKey = ""
+	infraCondition.Spec.APIKeySecret = policy.Spec.APIKeySecret
+	infraCondition.Spec.AccountRef = policy.Spec.AccountRef
+	infraCondition.Spec.AccountID = policy.Spec.AccountID
+	infraCondition.Status.AppliedSpec = &nrv1.AlertsInfraConditionSpec{}
+	infraCondition.OwnerReferences = append(infraCondition.OwnerReferences, asOwner(policy))
+
+	r.Log.Info("creating infra condition", "condition", condition.Name, "conditionName", condition.Spec.Name, "alertsInfraCondition", infraCondition)
+	errCondition := r.Create(rc.ctx, &infraCondition)
+	if errCondition != nil {
+		r.Log.Error(errCondition, "error creating condition")
+		return errCondition
+	}
+
+	condition.Name = infraCondition.Name
+	condition.Namespace = infraCondition.Namespace
+	r.Recorder.Eventf(policy, v1.EventTypeNormal, eventReasonCreated, "Created AlertsInfraCondition %s for condition %s", infraCondition.Name, condition.Spec.Name)
+
+	r.Log.Info("created infra condition", "condition", condition.Name, "conditionName", condition.Spec.Name, "alertsInfraCondition", infraCondition)
+
+	return nil
+}
+
+func (r *AlertsPolicyReconciler) createExternalServiceCondition(rc *requestContext, policy *nrv1.AlertsPolicy, condition *nrv1.AlertsPolicyCondition) error {
+	defer rc.txn.StartSegment("createExternalServiceCondition").End()
+	var externalServiceCondition nrv1.AlertsExternalServiceCondition
+	externalServiceCondition.GenerateName = policy.Name + "-condition-"
+	externalServiceCondition.Namespace = policy.Namespace
+	externalServiceCondition.Labels = policy.Labels
+	externalServiceCondition.Spec = condition.ReturnExternalServiceConditionSpec()
+	externalServiceCondition.Spec.Region = policy.Spec.Region
+	externalServiceCondition.Spec.ExistingPolicyID = policy.Status.PolicyID
+	externalServiceCondition.Spec.APIKey = ""
+	externalServiceCondition.Spec.APIKeySecret = policy.Spec.APIKeySecret
+	externalServiceCondition.Spec.AccountRef = policy.Spec.AccountRef
+	externalServiceCondition.Spec.AccountID = policy.Spec.AccountID
+	externalServiceCondition.Status.AppliedSpec = &nrv1.AlertsExternalServiceConditionSpec{}
+	externalServiceCondition.OwnerReferences = append(externalServiceCondition.OwnerReferences, asOwner(policy))
+
+	r.Log.Info("creating external service condition", "condition", condition.Name, "conditionName", condition.Spec.Name, "alertsExternalServiceCondition", externalServiceCondition)
+	errCondition := r.Create(rc.ctx, &externalServiceCondition)
+	if errCondition != nil {
+		r.Log.Error(errCondition, "error creating condition")
+		return errCondition
+	}
+
+	condition.Name = externalServiceCondition.Name
+	condition.Namespace = externalServiceCondition.Namespace
+	r.Recorder.Eventf(policy, v1.EventTypeNormal, eventReasonCreated, "Created AlertsExternalServiceCondition %s for condition %s", externalServiceCondition.Name, condition.Spec.Name)
+
+	r.Log.Info("created external service condition", "condition", condition.Name, "conditionName", condition.Spec.Name, "alertsExternalServiceCondition", externalServiceCondition)
+
+	return nil
+}
+
+func (r *AlertsPolicyReconciler) createSyntheticsCondition(rc *requestContext, policy *nrv1.AlertsPolicy, condition *nrv1.AlertsPolicyCondition) error {
+	defer rc.txn.StartSegment("createSyntheticsCondition").End()
+	var syntheticsCondition nrv1.AlertsSyntheticsCondition
+	syntheticsCondition.GenerateName = policy.Name + "-condition-"
+	syntheticsCondition.Namespace = policy.Namespace
+	syntheticsCondition.Labels = policy.Labels
+	syntheticsCondition.Spec = condition.ReturnSyntheticsConditionSpec()
+	syntheticsCondition.Spec.Region = policy.Spec.Region
+	syntheticsCondition.Spec.ExistingPolicyID = policy.Status.PolicyID
+	syntheticsCondition.Spec.APIKey = ""
+	syntheticsCondition.Spec.APIKeySecret = policy.Spec.APIKeySecret
+	syntheticsCondition.Spec.AccountRef = policy.Spec.AccountRef
+	syntheticsCondition.Spec.AccountID = policy.Spec.AccountID
+	syntheticsCondition.Status.AppliedSpec = &nrv1.AlertsSyntheticsConditionSpec{}
+	syntheticsCondition.OwnerReferences = append(syntheticsCondition.OwnerReferences, asOwner(policy))
+
+	r.Log.Info("creating synthetics condition", "condition", condition.Name, "conditionName", condition.Spec.Name, "alertsSyntheticsCondition", syntheticsCondition)
+	errCondition := r.Create(rc.ctx, &syntheticsCondition)
+	if errCondition != nil {
+		r.Log.Error(errCondition, "error creating condition")
+		return errCondition
+	}
+
+	condition.Name = syntheticsCondition.Name
+	condition.Namespace = syntheticsCondition.Namespace
+	r.Recorder.Eventf(policy, v1.EventTypeNormal, eventReasonCreated, "Created AlertsSyntheticsCondition %s for condition %s", syntheticsCondition.Name, condition.Spec.Name)
+
+	r.Log.Info("created synthetics condition", "condition", condition.Name, "conditionName", condition.Spec.Name, "alertsSyntheticsCondition", syntheticsCondition)
+
+	return nil
+}
+
+func (r *AlertsPolicyReconciler) deleteCondition(rc *requestContext, condition *nrv1.AlertsPolicyCondition) error {
+	defer rc.txn.StartSegment("deleteCondition").End()
 	r.Log.Info("Deleting condition", "condition", condition.Name, "conditionName", condition.Spec.Name)
 
 	var retrievedCondition runtime.Object
 	switch nrv1.GetAlertsConditionType(*condition) {
 	case "AlertsAPMCondition":
-		returnedCondition := r.getApmConditionFromAlertsPolicyCondition(condition)
+		returnedCondition := r.getApmConditionFromAlertsPolicyCondition(rc, condition)
 		retrievedCondition = &returnedCondition
 	case "AlertsNrqlCondition":
-		returnedCondition := r.getAlertsNrqlConditionFromAlertsPolicyCondition(condition)
+		returnedCondition := r.getAlertsNrqlConditionFromAlertsPolicyCondition(rc, condition)
+		retrievedCondition = &returnedCondition
+	case "AlertsInfraCondition":
+		returnedCondition := r.getInfraConditionFromAlertsPolicyCondition(rc, condition)
+		retrievedCondition = &returnedCondition
+	case "AlertsExternalServiceCondition":
+		returnedCondition := r.getExternalServiceConditionFromAlertsPolicyCondition(rc, condition)
+		retrievedCondition = &returnedCondition
+	case "AlertsSyntheticsCondition":
+		returnedCondition := r.getSyntheticsConditionFromAlertsPolicyCondition(rc, condition)
 		retrievedCondition = &returnedCondition
 	}
 
 	r.Log.Info("retrieved condition for deletion", "retrievedCondition", retrievedCondition)
 
-	err := r.Delete(r.ctx, retrievedCondition)
+	err := r.Delete(rc.ctx, retrievedCondition)
 	if err != nil {
 		r.Log.Error(err, "error deleting condition resource")
 		return err
@@ -477,30 +816,63 @@ func (r *AlertsPolicyReconciler) deleteCondition(condition *nrv1.AlertsPolicyCon
 	return nil
 }
 
-func (r *AlertsPolicyReconciler) getAlertsNrqlConditionFromAlertsPolicyCondition(condition *nrv1.AlertsPolicyCondition) (nrqlCondition nrv1.AlertsNrqlCondition) {
-	defer r.txn.StartSegment("getAlertsNrqlConditionFromAlertsPolicyCondition").End()
+func (r *AlertsPolicyReconciler) getAlertsNrqlConditionFromAlertsPolicyCondition(rc *requestContext, condition *nrv1.AlertsPolicyCondition) (nrqlCondition nrv1.AlertsNrqlCondition) {
+	defer rc.txn.StartSegment("getAlertsNrqlConditionFromAlertsPolicyCondition").End()
 	r.Log.Info("condition before retrieval", "condition", condition)
 
 	//throw away the error since empty conditions are expected
-	_ = r.Client.Get(r.ctx, condition.GetNamespace(), &nrqlCondition)
+	_ = r.Client.Get(rc.ctx, condition.GetNamespace(), &nrqlCondition)
 	r.Log.Info("retrieved condition", "alertsNrqlCondition", nrqlCondition, "namespace", condition.GetNamespace())
 
 	return
 }
 
-func (r *AlertsPolicyReconciler) getApmConditionFromAlertsPolicyCondition(condition *nrv1.AlertsPolicyCondition) (apmCondition nrv1.AlertsAPMCondition) {
-	defer r.txn.StartSegment("getApmConditionFromAlertsPolicyCondition").End()
+func (r *AlertsPolicyReconciler) getApmConditionFromAlertsPolicyCondition(rc *requestContext, condition *nrv1.AlertsPolicyCondition) (apmCondition nrv1.AlertsAPMCondition) {
+	defer rc.txn.StartSegment("getApmConditionFromAlertsPolicyCondition").End()
 	r.Log.Info("apm condition before retrieval", "condition", condition)
 
 	//throw away the error since empty conditions are expected
-	_ = r.Client.Get(r.ctx, condition.GetNamespace(), &apmCondition)
+	_ = r.Client.Get(rc.ctx, condition.GetNamespace(), &apmCondition)
 	r.Log.Info("retrieved condition", "alertsAPMCondition", apmCondition, "namespace", condition.GetNamespace())
 
 	return
 }
 
-func (r *AlertsPolicyReconciler) updateAlertsPolicy(policy *nrv1.AlertsPolicy) error {
-	defer r.txn.StartSegment("updateAlertsPolicy").End()
+func (r *AlertsPolicyReconciler) getInfraConditionFromAlertsPolicyCondition(rc *requestContext, condition *nrv1.AlertsPolicyCondition) (infraCondition nrv1.AlertsInfraCondition) {
+	defer rc.txn.StartSegment("getInfraConditionFromAlertsPolicyCondition").End()
+	r.Log.Info("infra condition before retrieval", "condition", condition)
+
+	//throw away the error since empty conditions are expected
+	_ = r.Client.Get(rc.ctx, condition.GetNamespace(), &infraCondition)
+	r.Log.Info("retrieved condition", "alertsInfraCondition", infraCondition, "namespace", condition.GetNamespace())
+
+	return
+}
+
+func (r *AlertsPolicyReconciler) getExternalServiceConditionFromAlertsPolicyCondition(rc *requestContext, condition *nrv1.AlertsPolicyCondition) (externalServiceCondition nrv1.AlertsExternalServiceCondition) {
+	defer rc.txn.StartSegment("getExternalServiceConditionFromAlertsPolicyCondition").End()
+	r.Log.Info("external service condition before retrieval", "condition", condition)
+
+	//throw away the error since empty conditions are expected
+	_ = r.Client.Get(rc.ctx, condition.GetNamespace(), &externalServiceCondition)
+	r.Log.Info("retrieved condition", "alertsExternalServiceCondition", externalServiceCondition, "namespace", condition.GetNamespace())
+
+	return
+}
+
+func (r *AlertsPolicyReconciler) getSyntheticsConditionFromAlertsPolicyCondition(rc *requestContext, condition *nrv1.AlertsPolicyCondition) (syntheticsCondition nrv1.AlertsSyntheticsCondition) {
+	defer rc.txn.StartSegment("getSyntheticsConditionFromAlertsPolicyCondition").End()
+	r.Log.Info("synthetics condition before retrieval", "condition", condition)
+
+	//throw away the error since empty conditions are expected
+	_ = r.Client.Get(rc.ctx, condition.GetNamespace(), &syntheticsCondition)
+	r.Log.Info("retrieved condition", "alertsSyntheticsCondition", syntheticsCondition, "namespace", condition.GetNamespace())
+
+	return
+}
+
+func (r *AlertsPolicyReconciler) updateAlertsPolicy(rc *requestContext, policy *nrv1.AlertsPolicy, channelIDs []int) error {
+	defer rc.txn.StartSegment("updateAlertsPolicy").End()
 	r.Log.Info("updating policy", "PolicyName", policy.Name)
 
 	//only update policy if policy fields have changed
@@ -513,37 +885,43 @@ func (r *AlertsPolicyReconciler) updateAlertsPolicy(policy *nrv1.AlertsPolicy) e
 			"Alert AlertsPolicy Name", updateInput.Name,
 			"incident preference ", policy.Status.AppliedSpec.IncidentPreference,
 		)
-		updateResult, err = r.Alerts.UpdatePolicyMutation(policy.Spec.AccountID, policy.Status.PolicyID, updateInput)
+		updateResult, err = rc.alerts.UpdatePolicyMutation(rc.accountID, policy.Status.PolicyID, updateInput)
 		if err != nil {
 			r.Log.Error(err, "failed to update policy via New Relic API",
 				"policyId", policy.Status.PolicyID,
 				"region", policy.Spec.Region,
-				"apiKey", interfaces.PartialAPIKey(r.apiKey),
+				"apiKey", interfaces.PartialAPIKey(rc.apiKey),
 			)
 			return err
 		}
 		policy.Status.PolicyID = updateResult.ID
+		r.Recorder.Eventf(policy, v1.EventTypeNormal, eventReasonUpdated, "Updated New Relic policy %s", updateResult.ID)
 	}
 
-	err = r.createOrUpdateConditions(policy)
+	err = r.createOrUpdateConditions(rc, policy)
 	if err != nil {
 		r.Log.Error(err, "error creating or updating conditions")
 		return err
 	}
 	r.Log.Info("policySpec before update", "policy.Spec", policy.Spec)
 
-	if len(policy.Spec.ChannelIDs) > 0 {
+	desiredChannels := mergeChannelIDs(policy.Spec.ChannelIDs, channelIDs)
+	appliedChannels := mergeChannelIDs(policy.Status.AppliedSpec.ChannelIDs, policy.Status.ChannelIDs)
+
+	if len(desiredChannels) > 0 || len(appliedChannels) > 0 {
 		r.Log.Info("May need to udpate policy Channels")
-		err = r.updateAlertsChannels(policy)
+		err = r.updateAlertsChannels(rc, policy, desiredChannels, appliedChannels)
 		if err != nil {
 			r.Log.Error(err, "error creating or updating conditions")
 			return err
 		}
 	}
 
-	policy.Status.AppliedSpec = &policy.Spec
+	policy.Status.AppliedSpec = policy.Spec.Redacted()
+	policy.Status.ChannelIDs = channelIDs
+	setReadyConditions(policy)
 
-	err = r.Client.Update(r.ctx, policy)
+	err = updateWithStatus(rc.ctx, r.Client, policy)
 	if err != nil {
 		r.Log.Error(err, "failed to update policy status", "name", policy.Name)
 		return err
@@ -552,8 +930,8 @@ func (r *AlertsPolicyReconciler) updateAlertsPolicy(policy *nrv1.AlertsPolicy) e
 	return nil
 }
 
-func (r *AlertsPolicyReconciler) deleteAlertsPolicy(ctx context.Context, policy *nrv1.AlertsPolicy, deleteFinalizer string) (ctrl.Result, error) {
-	defer r.txn.StartSegment("deleteAlertsPolicy").End()
+func (r *AlertsPolicyReconciler) deleteAlertsPolicy(rc *requestContext, policy *nrv1.AlertsPolicy, deleteFinalizer string) (ctrl.Result, error) {
+	defer rc.txn.StartSegment("deleteAlertsPolicy").End()
 	// The object is being deleted
 	if containsString(policy.Finalizers, deleteFinalizer) {
 		// catch invalid state
@@ -564,7 +942,7 @@ func (r *AlertsPolicyReconciler) deleteAlertsPolicy(ctx context.Context, policy
 			// our finalizer is present, so lets handle any external dependency
 			collectedErrors := new(customErrors.ErrorCollector)
 			for _, condition := range policy.Status.AppliedSpec.Conditions {
-				err := r.deleteCondition(&condition)
+				err := r.deleteCondition(rc, &condition)
 				if err != nil {
 					r.Log.Error(err, "error deleting condition resources")
 					collectedErrors.Collect(err)
@@ -572,24 +950,28 @@ func (r *AlertsPolicyReconciler) deleteAlertsPolicy(ctx context.Context, policy
 			}
 			if len(*collectedErrors) > 0 {
 				r.Log.Info("errors deleting condition resources", "collectedErrors", collectedErrors)
+				updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, policy, nrv1.ReasonDeleteFailed, collectedErrors)
 				return ctrl.Result{}, collectedErrors
 			}
 
-			if err := r.deleteNewRelicAlertPolicy(policy); err != nil {
+			if err := r.deleteNewRelicAlertPolicy(rc, policy); err != nil {
 				// if fail to delete the external dependency here, return with error
 				// so that it can be retried
 				r.Log.Error(err, "Failed to delete Alert AlertsPolicy via New Relic API",
 					"policyId", policy.Status.PolicyID,
 					"region", policy.Spec.Region,
-					"apiKey", interfaces.PartialAPIKey(r.apiKey),
+					"apiKey", interfaces.PartialAPIKey(rc.apiKey),
 				)
+				updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, policy, nrv1.ReasonDeleteFailed, err)
 				return ctrl.Result{}, err
 			}
 
+			r.Recorder.Eventf(policy, v1.EventTypeNormal, eventReasonDeleted, "Deleted New Relic policy %s", policy.Status.PolicyID)
+
 			// remove our finalizer from the list and update it.
 			r.Log.Info("removing finalizer")
 			policy.Finalizers = removeString(policy.Finalizers, deleteFinalizer)
-			if err := r.Client.Update(ctx, policy); err != nil {
+			if err := r.Client.Update(rc.ctx, policy); err != nil {
 				r.Log.Error(err, "Failed to update k8s records for this policy after successfully deleting the policy via New Relic Alert API")
 				return ctrl.Result{}, err
 			}
@@ -605,11 +987,19 @@ func (r *AlertsPolicyReconciler) deleteAlertsPolicy(ctx context.Context, policy
 func (r *AlertsPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&nrv1.AlertsPolicy{}).
-		Complete(r)
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nrv1.AlertsPolicyList{} }, true)).
+		Watches(&source.Kind{Type: &nrv1.AlertsChannel{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(func(channel handler.MapObject) []reconcile.Request {
+				channelKey := alertsChannelIndexKey(channel.Meta.GetNamespace(), channel.Meta.GetName())
+				return listRequests(context.Background(), r.Client, r.Log, &nrv1.AlertsPolicyList{}, client.MatchingFields{alertsChannelIndexField: channelKey})
+			}),
+		}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nrv1.AlertsPolicy{}, r))
 }
 
-func (r *AlertsPolicyReconciler) checkForExistingAlertsPolicy(policy *nrv1.AlertsPolicy) {
-	defer r.txn.StartSegment("checkForExistingAlertsPolicy").End()
+func (r *AlertsPolicyReconciler) checkForExistingAlertsPolicy(rc *requestContext, policy *nrv1.AlertsPolicy) {
+	defer rc.txn.StartSegment("checkForExistingAlertsPolicy").End()
 	if policy.Status.PolicyID != "" {
 		return
 	}
@@ -618,19 +1008,21 @@ func (r *AlertsPolicyReconciler) checkForExistingAlertsPolicy(policy *nrv1.Alert
 
 	//if no policyId, get list of policies and compare name
 	searchParams := alerts.AlertsPoliciesSearchCriteriaInput{}
-	existingPolicies, err := r.Alerts.QueryPolicySearch(policy.Spec.AccountID, searchParams)
+	existingPolicies, err := rc.alerts.QueryPolicySearch(rc.accountID, searchParams)
 
 	if err != nil {
 		r.Log.Error(err, "failed to get list of policies from New Relic API",
 			"policyId", policy.Status.PolicyID,
 			"region", policy.Spec.Region,
-			"apiKey", interfaces.PartialAPIKey(r.apiKey),
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
 		)
+		recordFailure(r.Recorder, policy, eventReasonLookupFailed, err)
 	} else {
 		for _, existingAlertsPolicy := range existingPolicies {
 			if existingAlertsPolicy.Name == policy.Spec.Name {
 				r.Log.Info("matched on existing policy, updating PolicyId", "policyId", existingAlertsPolicy.ID)
 				policy.Status.PolicyID = existingAlertsPolicy.ID
+				r.Recorder.Eventf(policy, v1.EventTypeNormal, eventReasonAdopted, "Adopted existing New Relic policy %s", existingAlertsPolicy.ID)
 
 				break
 			}
@@ -638,16 +1030,16 @@ func (r *AlertsPolicyReconciler) checkForExistingAlertsPolicy(policy *nrv1.Alert
 	}
 }
 
-func (r *AlertsPolicyReconciler) deleteNewRelicAlertPolicy(policy *nrv1.AlertsPolicy) error {
-	defer r.txn.StartSegment("deleteNewRelicAlertPolicy").End()
+func (r *AlertsPolicyReconciler) deleteNewRelicAlertPolicy(rc *requestContext, policy *nrv1.AlertsPolicy) error {
+	defer rc.txn.StartSegment("deleteNewRelicAlertPolicy").End()
 	r.Log.Info("Deleting policy", "policyName", policy.Spec.Name)
 
-	_, err := r.Alerts.DeletePolicyMutation(policy.Spec.AccountID, policy.Status.PolicyID)
+	_, err := rc.alerts.DeletePolicyMutation(rc.accountID, policy.Status.PolicyID)
 	if err != nil {
 		r.Log.Error(err, "error deleting policy via New Relic API",
 			"policyId", policy.Status.PolicyID,
 			"region", policy.Spec.Region,
-			"apiKey", interfaces.PartialAPIKey(r.apiKey),
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
 		)
 
 		return err
@@ -656,16 +1048,41 @@ func (r *AlertsPolicyReconciler) deleteNewRelicAlertPolicy(policy *nrv1.AlertsPo
 	return nil
 }
 
-func (r *AlertsPolicyReconciler) createAlertsChannels(policy *nrv1.AlertsPolicy) error {
-	if len(policy.Spec.ChannelIDs) > 0 {
-		r.Log.Info("creating channels to policy", "channelIds", policy.Spec.ChannelIDs, "policyId", policy.Status.PolicyID)
+// channelRefIDs returns the IDs New Relic assigned to the AlertsChannels referenced by the policy
+func (r *AlertsPolicyReconciler) channelRefIDs(rc *requestContext, policy *nrv1.AlertsPolicy) ([]int, error) {
+	var ids []int
+
+	for _, ref := range policy.Spec.ChannelRefs {
+		var channel nrv1.AlertsChannel
+
+		key := ref.GetNamespacedName(policy.Namespace)
+		if err := r.Client.Get(rc.ctx, key, &channel); err != nil {
+			if kErr.IsNotFound(err) {
+				return nil, fmt.Errorf("AlertsChannel %s not found", key)
+			}
+			return nil, err
+		}
+
+		if channel.Status.ChannelID == 0 {
+			return nil, fmt.Errorf("AlertsChannel %s has not been created in New Relic yet", key)
+		}
+
+		ids = append(ids, channel.Status.ChannelID)
+	}
+
+	return ids, nil
+}
+
+func (r *AlertsPolicyReconciler) createAlertsChannels(rc *requestContext, policy *nrv1.AlertsPolicy, channelIDs []int) error {
+	if len(channelIDs) > 0 {
+		r.Log.Info("creating channels to policy", "channelIds", channelIDs, "policyId", policy.Status.PolicyID)
 		policyID, errInt := strconv.Atoi(policy.Status.PolicyID)
 		if errInt != nil {
 			r.Log.Error(errInt, "Failed to parse policyID as an int")
 			return errInt
 		}
 
-		alertsChannels, err := r.Alerts.UpdatePolicyChannels(policyID, policy.Spec.ChannelIDs)
+		alertsChannels, err := rc.alerts.UpdatePolicyChannels(policyID, channelIDs)
 		if err != nil {
 			r.Log.Error(err, "error creating channels")
 			return err
@@ -678,27 +1095,31 @@ func (r *AlertsPolicyReconciler) createAlertsChannels(policy *nrv1.AlertsPolicy)
 	return nil
 }
 
-func (r *AlertsPolicyReconciler) updateAlertsChannels(policy *nrv1.AlertsPolicy) error {
+func (r *AlertsPolicyReconciler) updateAlertsChannels(rc *requestContext, policy *nrv1.AlertsPolicy, desiredChannels []int, appliedChannels []int) error {
 	policyID, errInt := strconv.Atoi(policy.Status.PolicyID)
 	if errInt != nil {
 		r.Log.Error(errInt, "Failed to parse policyID as an int")
 		return errInt
 	}
-	r.Log.Info("updating channels to policy", "channelIds", policy.Spec.ChannelIDs, "policyId", policy.Status.PolicyID)
+	r.Log.Info("updating channels to policy", "channelIds", desiredChannels, "policyId", policy.Status.PolicyID)
 
-	channelsToAdd := diffIntSlice(policy.Spec.ChannelIDs, policy.Status.AppliedSpec.ChannelIDs)
-	channelsToRemove := diffIntSlice(policy.Status.AppliedSpec.ChannelIDs, policy.Spec.ChannelIDs)
+	channelsToAdd := diffIntSlice(desiredChannels, appliedChannels)
+	channelsToRemove := diffIntSlice(appliedChannels, desiredChannels)
 	r.Log.Info("channel differences found", "channelsToAdd", channelsToAdd, "channelsToRemove", channelsToRemove)
 
 	for _, channel := range channelsToRemove {
-		deleteChannel, err := r.Alerts.DeletePolicyChannel(policyID, channel)
+		deleteChannel, err := rc.alerts.DeletePolicyChannel(policyID, channel)
 		if err != nil {
 			r.Log.Error(err, "error removing channels", "deleteChannel", deleteChannel)
 			return err
 		}
 	}
 
-	alertsChannel, err := r.Alerts.UpdatePolicyChannels(policyID, channelsToAdd)
+	if len(channelsToAdd) == 0 {
+		return nil
+	}
+
+	alertsChannel, err := rc.alerts.UpdatePolicyChannels(policyID, channelsToAdd)
 	if err != nil {
 		r.Log.Error(err, "error updating channels")
 		return err
@@ -708,6 +1129,13 @@ func (r *AlertsPolicyReconciler) updateAlertsChannels(policy *nrv1.AlertsPolicy)
 	return nil
 }
 
+// mergeChannelIDs returns the channel IDs of the spec followed by the IDs of referenced channels that are not part of it
+func mergeChannelIDs(ids []int, refIDs []int) []int {
+	merged := append([]int{}, ids...)
+
+	return append(merged, diffIntSlice(refIDs, ids)...)
+}
+
 //diffIntSlice - compares two slices of ints and outputs the values from the first slice that are not contained in the second
 func diffIntSlice(first, second []int) []int {
 	diff := []int{}
@@ -727,24 +1155,3 @@ func diffIntSlice(first, second []int) []int {
 
 	return diff
 }
-
-func (r *AlertsPolicyReconciler) getAPIKeyOrSecret(policy nrv1.AlertsPolicy) (string, error) {
-	defer r.txn.StartSegment("getAPIKeyOrSecret").End()
-	if policy.Spec.APIKey != "" {
-		return policy.Spec.APIKey, nil
-	}
-
-	if policy.Spec.APIKeySecret != (nrv1.NewRelicAPIKeySecret{}) {
-		key := types.NamespacedName{Namespace: policy.Spec.APIKeySecret.Namespace, Name: policy.Spec.APIKeySecret.Name}
-		var apiKeySecret v1.Secret
-		getErr := r.Client.Get(context.Background(), key, &apiKeySecret)
-		if getErr != nil {
-			r.Log.Error(getErr, "Failed to retrieve secret", "secret", apiKeySecret)
-			return "", getErr
-		}
-
-		return string(apiKeySecret.Data[policy.Spec.APIKeySecret.KeyName]), nil
-	}
-
-	return "", nil
-}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -33,6 +34,7 @@ func TestIntegrationAlertsPolicyController(t *testing.T) {
 	reconciler := &AlertsPolicyReconciler{
 		Client:          k8sClient,
 		Log:             logf.Log,
+		Recorder:        record.NewFakeRecorder(100),
 		AlertClientFunc: interfaces.InitializeAlertsClient,
 	}
 
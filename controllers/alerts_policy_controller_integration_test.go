@@ -3,6 +3,7 @@ package controllers
 import (
 	"context"
 	"errors"
+	"time"
 
 	newrelic "github.com/newrelic/go-agent/v3/newrelic"
 	"github.com/newrelic/newrelic-client-go/pkg/alerts"
@@ -14,9 +15,12 @@ import (
 	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
 	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
 	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/redact"
 
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -33,6 +37,7 @@ var _ = Describe("alertspolicy reconciliation", func() {
 		fakeAlertFunc             func(string, string) (interfaces.NewRelicAlertsClient, error)
 		deletedConditionNamespace types.NamespacedName
 		mockAlertsClient          interfacesfakes.FakeNewRelicAlertsClient
+		recorder                  *record.FakeRecorder
 	)
 
 	BeforeEach(func() {
@@ -74,6 +79,7 @@ var _ = Describe("alertspolicy reconciliation", func() {
 		r = &AlertsPolicyReconciler{
 			Client:          k8sClient,
 			Log:             logf.Log,
+			Recorder:        record.NewFakeRecorder(100),
 			AlertClientFunc: fakeAlertFunc,
 			NewRelicAgent:   newRelicAgent,
 		}
@@ -107,9 +113,12 @@ var _ = Describe("alertspolicy reconciliation", func() {
 				}, nil
 			}
 
+			recorder = record.NewFakeRecorder(100)
+
 			r = &AlertsPolicyReconciler{
 				Client:          k8sClient,
 				Log:             logf.Log,
+				Recorder:        recorder,
 				AlertClientFunc: fakeAlertFunc,
 				NewRelicAgent:   newrelic.Application{},
 			}
@@ -203,6 +212,17 @@ var _ = Describe("alertspolicy reconciliation", func() {
 
 			})
 
+			It("records a Created event for the policy", func() {
+				err := k8sClient.Create(ctx, alertspolicy)
+				Expect(err).ToNot(HaveOccurred())
+
+				// call reconcile
+				_, err = r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(recorder.Events).To(Receive(Equal("Normal Created Created New Relic policy 333")))
+			})
+
 			It("creates the NRQL condition with attributes from the AlertsPolicy", func() {
 				err := k8sClient.Create(ctx, alertspolicy)
 				Expect(err).ToNot(HaveOccurred())
@@ -268,7 +288,55 @@ var _ = Describe("alertspolicy reconciliation", func() {
 
 				Expect(endStateCondition.Spec.ExistingPolicyID).To(Equal("333"))
 				Expect(endStateCondition.Spec.Region).To(Equal(alertspolicy.Spec.Region))
-				Expect(endStateCondition.Spec.APIKey).To(Equal(alertspolicy.Spec.APIKey))
+				Expect(endStateCondition.Spec.APIKey).To(BeEmpty())
+				Expect(endStateCondition.Spec.APIKeySecret).To(Equal(expectedAPIKeySecret(namespacedName.Namespace, "112233")))
+			})
+
+			It("moves the inline api_key of the policy to a secret owned by the policy", func() {
+				err := k8sClient.Create(ctx, alertspolicy)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+
+				var endStateAlertsPolicy nrv1.AlertsPolicy
+				err = k8sClient.Get(ctx, namespacedName, &endStateAlertsPolicy)
+				Expect(err).To(BeNil())
+				Expect(endStateAlertsPolicy.Spec.APIKey).To(BeEmpty())
+				Expect(endStateAlertsPolicy.Spec.APIKeySecret).To(Equal(expectedAPIKeySecret(namespacedName.Namespace, "112233")))
+
+				var secret v1.Secret
+				secretName := types.NamespacedName{Namespace: namespacedName.Namespace, Name: endStateAlertsPolicy.Spec.APIKeySecret.Name}
+				err = k8sClient.Get(ctx, secretName, &secret)
+				Expect(err).To(BeNil())
+				Expect(string(secret.Data[nrv1.APIKeySecretKeyName])).To(Equal("112233"))
+				Expect(secret.Labels).To(HaveKeyWithValue(nrv1.ManagedByLabel, nrv1.ManagedBy))
+				// the secret is shared with the policies of the other tests using the same key
+				Expect(secret.OwnerReferences).To(ContainElement(WithTransform(ownerUID, Equal(endStateAlertsPolicy.UID))))
+			})
+
+			It("adopts the secret the webhook wrote before the policy was created", func() {
+				// the webhook moves the key before the policy has a UID
+				moved, err := nrv1.MoveAPIKeysToSecrets(ctx, k8sClient, alertspolicy)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(moved).To(BeTrue())
+
+				err = k8sClient.Create(ctx, alertspolicy)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+
+				var endStateAlertsPolicy nrv1.AlertsPolicy
+				err = k8sClient.Get(ctx, namespacedName, &endStateAlertsPolicy)
+				Expect(err).To(BeNil())
+				Expect(endStateAlertsPolicy.Spec.APIKey).To(BeEmpty())
+
+				var secret v1.Secret
+				secretName := types.NamespacedName{Namespace: namespacedName.Namespace, Name: endStateAlertsPolicy.Spec.APIKeySecret.Name}
+				err = k8sClient.Get(ctx, secretName, &secret)
+				Expect(err).To(BeNil())
+				Expect(secret.OwnerReferences).To(ContainElement(WithTransform(ownerUID, Equal(endStateAlertsPolicy.UID))))
 			})
 
 			It("adds expected alertsChannels", func() {
@@ -309,6 +377,22 @@ var _ = Describe("alertspolicy reconciliation", func() {
 				Expect(getErr).ToNot(HaveOccurred())
 				Expect(endStateAlertsPolicy.Status.PolicyID).To(Equal(""))
 			})
+
+			It("should record a warning event and the Error condition", func() {
+				createErr := k8sClient.Create(ctx, alertspolicy)
+				Expect(createErr).ToNot(HaveOccurred())
+
+				// call reconcile
+				_, reconcileErr := r.Reconcile(request)
+				Expect(reconcileErr).To(HaveOccurred())
+
+				Expect(recorder.Events).To(Receive(Equal("Warning CreateFailed any Error Goes Here")))
+
+				var endStateAlertsPolicy nrv1.AlertsPolicy
+				getErr := k8sClient.Get(ctx, namespacedName, &endStateAlertsPolicy)
+				Expect(getErr).ToNot(HaveOccurred())
+				Expect(nrv1.IsConditionTrue(endStateAlertsPolicy.Status.Conditions, nrv1.ConditionError)).To(BeTrue())
+			})
 		})
 
 		Context("when creating a valid alertspolicy with apm conditions", func() {
@@ -803,7 +887,8 @@ var _ = Describe("alertspolicy reconciliation", func() {
 				Expect(endStateCondition.Spec.Nrql.Query).To(Equal("SELECT count(*) FROM MyEvent"))
 				Expect(endStateCondition.Name).To(Equal(originalConditionName))
 				Expect(endStateCondition.Spec.Region).To(Equal("us"))
-				Expect(endStateCondition.Spec.APIKey).To(Equal("112233"))
+				Expect(endStateCondition.Spec.APIKey).To(BeEmpty())
+				Expect(endStateCondition.Spec.APIKeySecret).To(Equal(expectedAPIKeySecret(endStateAlertsPolicy.Namespace, "112233")))
 			})
 		})
 
@@ -865,7 +950,74 @@ var _ = Describe("alertspolicy reconciliation", func() {
 				Expect(err).To(BeNil())
 				Expect(endStateCondition.Spec.Name).To(Equal("second alert condition"))
 				Expect(endStateCondition.Spec.Region).To(Equal("us"))
-				Expect(endStateCondition.Spec.APIKey).To(Equal("112233"))
+				Expect(endStateCondition.Spec.APIKey).To(BeEmpty())
+				Expect(endStateCondition.Spec.APIKeySecret).To(Equal(expectedAPIKeySecret(endStateAlertsPolicy.Namespace, "112233")))
+			})
+		})
+
+		Context("and resyncing with New Relic", func() {
+			BeforeEach(func() {
+				r.ResyncInterval = time.Minute
+
+				mockAlertsClient.QueryPolicyStub = func(int, string) (*alerts.AlertsPolicy, error) {
+					return &alerts.AlertsPolicy{
+						ID:                 "333",
+						Name:               "test alertspolicy",
+						IncidentPreference: alerts.AlertsIncidentPreferenceTypes.PER_POLICY,
+					}, nil
+				}
+			})
+
+			It("should requeue after the resync interval", func() {
+				result, err := r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(time.Minute))
+				Expect(mockAlertsClient.QueryPolicyCallCount()).To(Equal(1))
+			})
+
+			It("should report the policy as in sync", func() {
+				_, err := r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+
+				var endStateAlertsPolicy nrv1.AlertsPolicy
+				err = k8sClient.Get(ctx, namespacedName, &endStateAlertsPolicy)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nrv1.FindCondition(endStateAlertsPolicy.Status.Conditions, nrv1.ConditionDrifted).Reason).To(Equal(nrv1.ReasonInSync))
+			})
+
+			Context("when the policy was changed in New Relic", func() {
+				BeforeEach(func() {
+					mockAlertsClient.QueryPolicyStub = func(int, string) (*alerts.AlertsPolicy, error) {
+						return &alerts.AlertsPolicy{
+							ID:                 "333",
+							Name:               "test alertspolicy",
+							IncidentPreference: alerts.AlertsIncidentPreferenceTypes.PER_CONDITION,
+						}, nil
+					}
+				})
+
+				It("should report the drift without updating the policy", func() {
+					_, err := r.Reconcile(request)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(mockAlertsClient.UpdatePolicyMutationCallCount()).To(Equal(0))
+
+					var endStateAlertsPolicy nrv1.AlertsPolicy
+					err = k8sClient.Get(ctx, namespacedName, &endStateAlertsPolicy)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(nrv1.IsConditionTrue(endStateAlertsPolicy.Status.Conditions, nrv1.ConditionDrifted)).To(BeTrue())
+					Expect(nrv1.FindCondition(endStateAlertsPolicy.Status.Conditions, nrv1.ConditionDrifted).Message).To(ContainSubstring("incidentPreference"))
+				})
+
+				It("should re-apply the policy when correcting drift", func() {
+					r.CorrectDrift = true
+
+					_, err := r.Reconcile(request)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(mockAlertsClient.UpdatePolicyMutationCallCount()).To(Equal(1))
+
+					_, _, updateInput := mockAlertsClient.UpdatePolicyMutationArgsForCall(0)
+					Expect(updateInput.IncidentPreference).To(Equal(alerts.AlertsIncidentPreferenceTypes.PER_POLICY))
+				})
 			})
 		})
 
@@ -1125,7 +1277,8 @@ var _ = Describe("alertspolicy reconciliation", func() {
 				Expect(err).To(BeNil())
 				Expect(endStateCondition.Name).To(Equal(originalConditionName))
 				Expect(endStateCondition.Spec.Region).To(Equal("us"))
-				Expect(endStateCondition.Spec.APIKey).To(Equal("112233"))
+				Expect(endStateCondition.Spec.APIKey).To(BeEmpty())
+				Expect(endStateCondition.Spec.APIKeySecret).To(Equal(expectedAPIKeySecret(endStateAlertsPolicy.Namespace, "112233")))
 			})
 		})
 
@@ -1184,7 +1337,8 @@ var _ = Describe("alertspolicy reconciliation", func() {
 				Expect(err).To(BeNil())
 				Expect(endStateCondition.Spec.Name).To(Equal("Second APM Condition"))
 				Expect(endStateCondition.Spec.Region).To(Equal("us"))
-				Expect(endStateCondition.Spec.APIKey).To(Equal("112233"))
+				Expect(endStateCondition.Spec.APIKey).To(BeEmpty())
+				Expect(endStateCondition.Spec.APIKeySecret).To(Equal(expectedAPIKeySecret(endStateAlertsPolicy.Namespace, "112233")))
 			})
 		})
 
@@ -1426,6 +1580,148 @@ var _ = Describe("alertspolicy reconciliation", func() {
 		})
 	})
 
+	Context("When referencing AlertsChannels by name", func() {
+		var channel *nrv1.AlertsChannel
+
+		BeforeEach(func() {
+			recorder = record.NewFakeRecorder(100)
+			r.Recorder = recorder
+
+			channel = &nrv1.AlertsChannel{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "policy-channel",
+					Namespace: "default",
+				},
+				Spec: nrv1.AlertsChannelSpec{
+					Name:   "policy channel",
+					APIKey: "112233",
+					Type:   "email",
+				},
+				Status: nrv1.AlertsChannelStatus{
+					AppliedSpec:      &nrv1.AlertsChannelSpec{},
+					AppliedPolicyIDs: []int{},
+				},
+			}
+
+			alertspolicy = &nrv1.AlertsPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-alertspolicy",
+					Namespace: "default",
+				},
+				Spec: nrv1.AlertsPolicySpec{
+					Name:               "test alertspolicy",
+					APIKey:             "112233",
+					IncidentPreference: "PER_POLICY",
+					Region:             "us",
+					ChannelIDs:         []int{1},
+					ChannelRefs: []nrv1.AlertsChannelReference{
+						{Name: "policy-channel", Namespace: "default"},
+					},
+				},
+				Status: nrv1.AlertsPolicyStatus{
+					AppliedSpec: &nrv1.AlertsPolicySpec{},
+				},
+			}
+
+			err := k8sClient.Create(ctx, alertspolicy)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			err := k8sClient.Delete(ctx, alertspolicy)
+			Expect(err).ToNot(HaveOccurred())
+
+			// Need to call reconcile to delete finalizer
+			_, err = r.Reconcile(request)
+			Expect(err).ToNot(HaveOccurred())
+
+			_ = k8sClient.Delete(ctx, channel)
+		})
+
+		Context("and the channel does not exist", func() {
+			It("should report the missing channel", func() {
+				_, err := r.Reconcile(request)
+				Expect(err).To(MatchError("AlertsChannel default/policy-channel not found"))
+				Expect(mockAlertsClient.CreatePolicyMutationCallCount()).To(Equal(0))
+
+				var endStateAlertsPolicy nrv1.AlertsPolicy
+				err = k8sClient.Get(ctx, namespacedName, &endStateAlertsPolicy)
+				Expect(err).ToNot(HaveOccurred())
+				errorCondition := nrv1.FindCondition(endStateAlertsPolicy.Status.Conditions, nrv1.ConditionError)
+				Expect(errorCondition).ToNot(BeNil())
+				Expect(errorCondition.Reason).To(Equal(nrv1.ReasonAlertsChannelNotFound))
+			})
+		})
+
+		Context("and the channel has not been created in New Relic yet", func() {
+			BeforeEach(func() {
+				err := k8sClient.Create(ctx, channel)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should wait for the channel", func() {
+				_, err := r.Reconcile(request)
+				Expect(err).To(MatchError("AlertsChannel default/policy-channel has not been created in New Relic yet"))
+				Expect(mockAlertsClient.CreatePolicyMutationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("and the channel exists in New Relic", func() {
+			BeforeEach(func() {
+				channel.Status.ChannelID = 42
+				err := createWithStatus(ctx, channel)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should add the channel to the policy", func() {
+				Expect(mockAlertsClient.UpdatePolicyChannelsCallCount()).To(Equal(1))
+				policyID, alertsChannels := mockAlertsClient.UpdatePolicyChannelsArgsForCall(0)
+				Expect(policyID).To(Equal(333))
+				Expect(alertsChannels).To(Equal([]int{1, 42}))
+
+				var endStateAlertsPolicy nrv1.AlertsPolicy
+				err := k8sClient.Get(ctx, namespacedName, &endStateAlertsPolicy)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(endStateAlertsPolicy.Status.ChannelIDs).To(Equal([]int{42}))
+				Expect(nrv1.IsConditionTrue(endStateAlertsPolicy.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+			})
+
+			It("should not update the channels again", func() {
+				_, err := r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(mockAlertsClient.UpdatePolicyChannelsCallCount()).To(Equal(1))
+				Expect(mockAlertsClient.DeletePolicyChannelCallCount()).To(Equal(0))
+			})
+
+			It("should replace the channel when it is recreated", func() {
+				err := k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "policy-channel"}, channel)
+				Expect(err).ToNot(HaveOccurred())
+				channel.Status.ChannelID = 43
+				err = k8sClient.Status().Update(ctx, channel)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(mockAlertsClient.DeletePolicyChannelCallCount()).To(Equal(1))
+				_, deletedChannel := mockAlertsClient.DeletePolicyChannelArgsForCall(0)
+				Expect(deletedChannel).To(Equal(42))
+
+				Expect(mockAlertsClient.UpdatePolicyChannelsCallCount()).To(Equal(2))
+				_, alertsChannels := mockAlertsClient.UpdatePolicyChannelsArgsForCall(1)
+				Expect(alertsChannels).To(Equal([]int{43}))
+
+				var endStateAlertsPolicy nrv1.AlertsPolicy
+				err = k8sClient.Get(ctx, namespacedName, &endStateAlertsPolicy)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(endStateAlertsPolicy.Status.ChannelIDs).To(Equal([]int{43}))
+			})
+		})
+	})
+
 	Describe("diffIntSlice", func() {
 		var (
 			in      []int
@@ -1469,4 +1765,28 @@ var _ = Describe("alertspolicy reconciliation", func() {
 			})
 		})
 	})
+
+	Describe("mergeChannelIDs", func() {
+		It("appends the referenced channels missing from the spec", func() {
+			Expect(mergeChannelIDs([]int{1, 2}, []int{2, 3})).To(Equal([]int{1, 2, 3}))
+		})
+
+		It("returns an empty slice without channels", func() {
+			Expect(mergeChannelIDs(nil, nil)).To(BeEmpty())
+		})
+	})
 })
+
+// ownerUID returns the UID of the owner referenced by ref
+func ownerUID(ref metav1.OwnerReference) types.UID {
+	return ref.UID
+}
+
+// expectedAPIKeySecret returns the reference to the secret an inline apiKey in namespace is written to
+func expectedAPIKeySecret(namespace string, apiKey string) nrv1.NewRelicAPIKeySecret {
+	return nrv1.NewRelicAPIKeySecret{
+		Name:      "newrelic-api-key-" + redact.Fingerprint(apiKey),
+		Namespace: namespace,
+		KeyName:   nrv1.APIKeySecretKeyName,
+	}
+}
@@ -0,0 +1,393 @@
+package controllers
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	v1 "k8s.io/api/core/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+)
+
+const alertsSyntheticsConditionDeleteFinalizer = "alertssyntheticsconditions.finalizers.nr.k8s.newrelic.com"
+
+// AlertsSyntheticsConditionReconciler reconciles a AlertsSyntheticsCondition object
+type AlertsSyntheticsConditionReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	AlertClientFunc         func(string, string) (interfaces.NewRelicAlertsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+	ResyncInterval          time.Duration
+	CorrectDrift            bool
+}
+
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=alertssyntheticsconditions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=alertssyntheticsconditions/status,verbs=get;update;patch
+
+// Reconcile is responsible for reconciling the spec and state of the AlertsSyntheticsCondition
+func (r *AlertsSyntheticsConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Alerts/syntheticsCondition")
+	defer rc.txn.End()
+
+	var condition nrv1.AlertsSyntheticsCondition
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &condition)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			r.Log.Info("AlertsSyntheticsCondition 'not found' after being deleted. This is expected and no cause for alarm", "error", err)
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET AlertsSyntheticsCondition", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	err = resolveCredentials(rc, r.Client, &condition)
+	if err != nil {
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &condition, failureReason(err, nrv1.ReasonCredentialsError), err)
+		return ctrl.Result{}, err
+	}
+
+	alertsClient, errAlertsClient := r.AlertClientFunc(rc.apiKey, rc.region)
+	if errAlertsClient != nil {
+		r.Log.Error(errAlertsClient, "Failed to create AlertsClient")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &condition, nrv1.ReasonCredentialsError, errAlertsClient)
+		return ctrl.Result{}, errAlertsClient
+	}
+	rc.alerts = interfaces.NewTracedAlertsClient(alertsClient, rc.txn, rc.accountID, rc.region)
+
+	// examine DeletionTimestamp to determine if object is under deletion
+	if condition.DeletionTimestamp.IsZero() {
+		if !containsString(condition.Finalizers, alertsSyntheticsConditionDeleteFinalizer) {
+			condition.Finalizers = append(condition.Finalizers, alertsSyntheticsConditionDeleteFinalizer)
+		}
+	} else {
+		return ctrl.Result{}, r.deleteSyntheticsCondition(rc, &condition)
+	}
+
+	if reflect.DeepEqual(condition.Spec.Redacted(), condition.Status.AppliedSpec) {
+		drifted, err := r.checkForSyntheticsConditionDrift(rc, &condition)
+		if err != nil {
+			r.Log.Error(err, "failed to resync condition with New Relic", "name", req.NamespacedName)
+			recordFailure(r.Recorder, &condition, eventReasonResyncFailed, err)
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
+
+		if !drifted {
+			if err := updateReadyConditionsIfNeeded(rc.ctx, r.Client, &condition); err != nil {
+				r.Log.Error(err, "tried updating condition status", "name", req.NamespacedName)
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
+	}
+
+	r.Log.Info("Reconciling", "condition", condition.Name)
+
+	r.checkForExistingSyntheticsCondition(rc, &condition)
+
+	return ctrl.Result{}, r.writeSyntheticsCondition(rc, &condition)
+}
+
+//SetupWithManager - Sets up Controller for AlertsSyntheticsCondition
+func (r *AlertsSyntheticsConditionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nrv1.AlertsSyntheticsCondition{}).
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nrv1.AlertsSyntheticsConditionList{} }, true)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nrv1.AlertsSyntheticsCondition{}, r))
+}
+
+// checkForExistingSyntheticsCondition adopts a synthetics condition of the policy with the same name
+// when the AlertsSyntheticsCondition has not created one yet
+func (r *AlertsSyntheticsConditionReconciler) checkForExistingSyntheticsCondition(rc *requestContext, condition *nrv1.AlertsSyntheticsCondition) {
+	if condition.Status.ConditionID != 0 {
+		return
+	}
+
+	defer rc.txn.StartSegment("checkForExistingSyntheticsCondition").End()
+
+	r.Log.Info("Checking for existing synthetics condition", "conditionName", condition.Spec.Name)
+
+	policyID, err := strconv.Atoi(condition.Spec.ExistingPolicyID)
+	if err != nil {
+		r.Log.Error(err, "failed to read existing policy ID", "existingPolicyID", condition.Spec.ExistingPolicyID)
+		return
+	}
+
+	existingConditions, err := listSyntheticsConditionIDs(rc.alerts, condition.Spec.IsMultiLocation(), policyID)
+	if err != nil {
+		r.Log.Error(err, "failed to get list of synthetics conditions from New Relic API",
+			"policyId", policyID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		recordFailure(r.Recorder, condition, eventReasonLookupFailed, err)
+		return
+	}
+
+	if conditionID, ok := existingConditions[condition.Spec.Name]; ok {
+		r.Log.Info("Matched on existing synthetics condition, updating ConditionId", "conditionId", conditionID)
+		condition.Status.ConditionID = conditionID
+		r.Recorder.Eventf(condition, v1.EventTypeNormal, eventReasonAdopted, "Adopted existing New Relic synthetics condition %d", conditionID)
+	}
+}
+
+// listSyntheticsConditionIDs returns the IDs of the synthetics or the synthetics_multi_location
+// conditions of the policy by name
+func listSyntheticsConditionIDs(alertsClient interfaces.NewRelicAlertsClient, multiLocation bool, policyID int) (map[string]int, error) {
+	conditionIDs := map[string]int{}
+
+	if multiLocation {
+		conditions, err := alertsClient.ListMultiLocationSyntheticsConditions(policyID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range conditions {
+			conditionIDs[c.Name] = c.ID
+		}
+
+		return conditionIDs, nil
+	}
+
+	conditions, err := alertsClient.ListSyntheticsConditions(policyID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range conditions {
+		conditionIDs[c.Name] = c.ID
+	}
+
+	return conditionIDs, nil
+}
+
+// diffAPISyntheticsCondition lists the fields of the synthetics or synthetics_multi_location condition with
+// the given ID that no longer match spec, found is false when the policy has no such condition
+func diffAPISyntheticsCondition(alertsClient interfaces.NewRelicAlertsClient, spec nrv1.AlertsSyntheticsConditionSpec, policyID int, conditionID int) ([]string, bool, error) {
+	if spec.IsMultiLocation() {
+		conditions, err := alertsClient.ListMultiLocationSyntheticsConditions(policyID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		for _, c := range conditions {
+			if c.ID == conditionID {
+				return spec.DiffMultiLocationSyntheticsCondition(*c), true, nil
+			}
+		}
+
+		return nil, false, nil
+	}
+
+	conditions, err := alertsClient.ListSyntheticsConditions(policyID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, c := range conditions {
+		if c.ID == conditionID {
+			return spec.DiffSyntheticsCondition(*c), true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// writeSyntheticsCondition creates or updates the synthetics condition and records the result on the
+// status of the AlertsSyntheticsCondition
+func (r *AlertsSyntheticsConditionReconciler) writeSyntheticsCondition(rc *requestContext, condition *nrv1.AlertsSyntheticsCondition) error {
+	defer rc.txn.StartSegment("writeSyntheticsCondition").End()
+
+	reason := nrv1.ReasonCreateFailed
+	eventReason := eventReasonCreated
+
+	if condition.Status.ConditionID != 0 {
+		r.Log.Info("updating synthetics condition", "conditionName", condition.Spec.Name, "conditionId", condition.Status.ConditionID)
+		reason = nrv1.ReasonUpdateFailed
+		eventReason = eventReasonUpdated
+	} else {
+		r.Log.Info("creating synthetics condition", "conditionName", condition.Spec.Name, "policyId", condition.Spec.ExistingPolicyID)
+	}
+
+	writtenID, err := writeAPISyntheticsCondition(rc.alerts, condition.Spec, condition.Status.ConditionID)
+
+	if err != nil {
+		r.Log.Error(err, "failed to write synthetics condition",
+			"conditionId", condition.Status.ConditionID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, condition, reason, err)
+		return err
+	}
+
+	condition.Status.ConditionID = writtenID
+	condition.Status.AppliedSpec = condition.Spec.Redacted()
+	r.Recorder.Eventf(condition, v1.EventTypeNormal, eventReason, "%s New Relic synthetics condition %d", eventReason, writtenID)
+	setReadyConditions(condition)
+
+	if err := updateWithStatus(rc.ctx, r.Client, condition); err != nil {
+		r.Log.Error(err, "tried updating condition status", "name", condition.Name)
+		return err
+	}
+
+	return nil
+}
+
+// deleteSyntheticsCondition deletes the synthetics condition from New Relic and removes the finalizer
+// once it is gone
+func (r *AlertsSyntheticsConditionReconciler) deleteSyntheticsCondition(rc *requestContext, condition *nrv1.AlertsSyntheticsCondition) error {
+	if !containsString(condition.Finalizers, alertsSyntheticsConditionDeleteFinalizer) {
+		return nil
+	}
+
+	defer rc.txn.StartSegment("deleteSyntheticsCondition").End()
+
+	if condition.Status.ConditionID != 0 {
+		r.Log.Info("Deleting synthetics condition", "conditionName", condition.Spec.Name, "conditionId", condition.Status.ConditionID)
+
+		err := deleteAPISyntheticsCondition(rc.alerts, condition.Spec.IsMultiLocation(), condition.Status.ConditionID)
+		if err != nil && !isNotFound(err) {
+			r.Log.Error(err, "Failed to delete synthetics condition",
+				"conditionId", condition.Status.ConditionID,
+				"region", rc.region,
+				"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+			)
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, condition, nrv1.ReasonDeleteFailed, err)
+			return err
+		}
+
+		r.Recorder.Eventf(condition, v1.EventTypeNormal, eventReasonDeleted, "Deleted New Relic synthetics condition %d", condition.Status.ConditionID)
+	}
+
+	// remove our finalizer from the list and update it.
+	condition.Finalizers = removeString(condition.Finalizers, alertsSyntheticsConditionDeleteFinalizer)
+	if err := r.Client.Update(rc.ctx, condition); err != nil {
+		r.Log.Error(err, "Failed to update condition after deleting New Relic synthetics condition")
+		return err
+	}
+
+	return nil
+}
+
+// writeAPISyntheticsCondition creates the condition described by spec when conditionID is 0 and updates
+// it otherwise, single and multi-location conditions are written through different APIs
+func writeAPISyntheticsCondition(alertsClient interfaces.NewRelicAlertsClient, spec nrv1.AlertsSyntheticsConditionSpec, conditionID int) (int, error) {
+	policyID, _ := strconv.Atoi(spec.ExistingPolicyID)
+
+	if spec.IsMultiLocation() {
+		apiCondition := spec.APIMultiLocationSyntheticsCondition()
+
+		var written *alerts.MultiLocationSyntheticsCondition
+		var err error
+
+		if conditionID != 0 {
+			apiCondition.ID = conditionID
+			written, err = alertsClient.UpdateMultiLocationSyntheticsCondition(apiCondition)
+		} else {
+			written, err = alertsClient.CreateMultiLocationSyntheticsCondition(apiCondition, policyID)
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		return written.ID, nil
+	}
+
+	apiCondition := spec.APISyntheticsCondition()
+
+	var written *alerts.SyntheticsCondition
+	var err error
+
+	if conditionID != 0 {
+		apiCondition.ID = conditionID
+		written, err = alertsClient.UpdateSyntheticsCondition(apiCondition)
+	} else {
+		written, err = alertsClient.CreateSyntheticsCondition(policyID, apiCondition)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return written.ID, nil
+}
+
+// deleteAPISyntheticsCondition deletes a synthetics or a synthetics_multi_location condition
+func deleteAPISyntheticsCondition(alertsClient interfaces.NewRelicAlertsClient, multiLocation bool, conditionID int) error {
+	if multiLocation {
+		_, err := alertsClient.DeleteMultiLocationSyntheticsCondition(conditionID)
+		return err
+	}
+
+	_, err := alertsClient.DeleteSyntheticsCondition(conditionID)
+	return err
+}
+
+// checkForSyntheticsConditionDrift compares the synthetics condition in New Relic with the spec when a resync interval is
+// configured and records the result in the Drifted condition. It returns true when the condition drifted
+// and has been prepared to be written again.
+func (r *AlertsSyntheticsConditionReconciler) checkForSyntheticsConditionDrift(rc *requestContext, condition *nrv1.AlertsSyntheticsCondition) (bool, error) {
+	if r.ResyncInterval == 0 || condition.Status.ConditionID == 0 {
+		return false, nil
+	}
+
+	defer rc.txn.StartSegment("checkForSyntheticsConditionDrift").End()
+
+	policyID, err := strconv.Atoi(condition.Spec.ExistingPolicyID)
+	if err != nil {
+		return false, err
+	}
+
+	differences, found, err := diffAPISyntheticsCondition(rc.alerts, condition.Spec, policyID, condition.Status.ConditionID)
+	if err != nil && !isNotFound(err) {
+		r.Log.Error(err, "failed to get list of synthetics conditions from New Relic API",
+			"policyId", policyID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		return false, err
+	}
+
+	if !found {
+		differences = []string{fmt.Sprintf("condition %d not found in New Relic", condition.Status.ConditionID)}
+	}
+
+	if len(differences) == 0 || !r.CorrectDrift {
+		if setDriftedCondition(r.Recorder, condition, differences) {
+			return false, updateWithStatus(rc.ctx, r.Client, condition)
+		}
+		return false, nil
+	}
+
+	r.Log.Info("correcting drift of condition", "conditionId", condition.Status.ConditionID, "differences", differences)
+	setDriftedCondition(r.Recorder, condition, differences)
+
+	if !found {
+		condition.Status.ConditionID = 0
+	}
+
+	// forget the applied spec so the condition is written again
+	condition.Status.AppliedSpec = &nrv1.AlertsSyntheticsConditionSpec{}
+
+	return true, nil
+}
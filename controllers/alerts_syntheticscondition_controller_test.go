@@ -0,0 +1,248 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+)
+
+var _ = Describe("AlertsSyntheticsCondition reconciliation", func() {
+	var (
+		ctx            context.Context
+		r              *AlertsSyntheticsConditionReconciler
+		condition      *nrv1.AlertsSyntheticsCondition
+		namespacedName types.NamespacedName
+		alertsClient   *interfacesfakes.FakeNewRelicAlertsClient
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		alertsClient = &interfacesfakes.FakeNewRelicAlertsClient{}
+		alertsClient.CreateSyntheticsConditionReturns(&alerts.SyntheticsCondition{ID: 42}, nil)
+		alertsClient.UpdateSyntheticsConditionReturns(&alerts.SyntheticsCondition{ID: 42}, nil)
+		alertsClient.CreateMultiLocationSyntheticsConditionReturns(&alerts.MultiLocationSyntheticsCondition{ID: 43}, nil)
+		alertsClient.UpdateMultiLocationSyntheticsConditionReturns(&alerts.MultiLocationSyntheticsCondition{ID: 43}, nil)
+
+		r = &AlertsSyntheticsConditionReconciler{
+			Client:   k8sClient,
+			Log:      logf.Log,
+			Recorder: record.NewFakeRecorder(100),
+			AlertClientFunc: func(string, string) (interfaces.NewRelicAlertsClient, error) {
+				return alertsClient, nil
+			},
+			NewRelicAgent: newrelic.Application{},
+		}
+
+		condition = &nrv1.AlertsSyntheticsCondition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "home-page",
+				Namespace: "default",
+			},
+			Spec: nrv1.AlertsSyntheticsConditionSpec{
+				AlertsGenericConditionSpec: nrv1.AlertsGenericConditionSpec{
+					Name:             "Home page monitor failed",
+					Type:             nrv1.SyntheticsConditionTypeSingle,
+					Enabled:          true,
+					ExistingPolicyID: "123",
+					APIKey:           "api-key",
+					Region:           "US",
+				},
+				AlertsSyntheticsSpecificSpec: nrv1.AlertsSyntheticsSpecificSpec{
+					MonitorID: "6a1c2f0e-4b7d-4d3c-9a2e-1f7b6c5d4e3a",
+				},
+			},
+			Status: nrv1.AlertsSyntheticsConditionStatus{
+				AppliedSpec: &nrv1.AlertsSyntheticsConditionSpec{},
+			},
+		}
+		namespacedName = types.NamespacedName{Namespace: "default", Name: "home-page"}
+	})
+
+	JustBeforeEach(func() {
+		Expect(k8sClient.Create(ctx, condition)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		var current nrv1.AlertsSyntheticsCondition
+		if err := k8sClient.Get(ctx, namespacedName, &current); err == nil {
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	Context("when creating a synthetics condition", func() {
+		It("creates the condition for the monitor", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.CreateSyntheticsConditionCallCount()).To(Equal(1))
+			Expect(alertsClient.CreateMultiLocationSyntheticsConditionCallCount()).To(Equal(0))
+			policyID, created := alertsClient.CreateSyntheticsConditionArgsForCall(0)
+			Expect(policyID).To(Equal(123))
+			Expect(created.MonitorID).To(Equal("6a1c2f0e-4b7d-4d3c-9a2e-1f7b6c5d4e3a"))
+
+			var updated nrv1.AlertsSyntheticsCondition
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.ConditionID).To(Equal(42))
+			Expect(updated.Finalizers).To(ContainElement(alertsSyntheticsConditionDeleteFinalizer))
+			Expect(nrv1.IsConditionTrue(updated.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+		})
+
+		It("adopts an existing condition with the same name", func() {
+			alertsClient.ListSyntheticsConditionsReturns([]*alerts.SyntheticsCondition{
+				{ID: 42, Name: "Home page monitor failed"},
+			}, nil)
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.CreateSyntheticsConditionCallCount()).To(Equal(0))
+			Expect(alertsClient.UpdateSyntheticsConditionCallCount()).To(Equal(1))
+			Expect(alertsClient.UpdateSyntheticsConditionArgsForCall(0).ID).To(Equal(42))
+		})
+
+		It("deletes the condition from New Relic", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			var current nrv1.AlertsSyntheticsCondition
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.DeleteSyntheticsConditionCallCount()).To(Equal(1))
+			Expect(alertsClient.DeleteSyntheticsConditionArgsForCall(0)).To(Equal(42))
+			Expect(alertsClient.DeleteMultiLocationSyntheticsConditionCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a resync interval is configured", func() {
+		BeforeEach(func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			r.ResyncInterval = time.Minute
+		})
+
+		It("requeues a condition matching New Relic without reporting drift", func() {
+			remote := condition.Spec.APISyntheticsCondition()
+			remote.ID = 42
+			alertsClient.ListSyntheticsConditionsReturns([]*alerts.SyntheticsCondition{&remote}, nil)
+
+			result, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Minute))
+			Expect(alertsClient.UpdateSyntheticsConditionCallCount()).To(Equal(0))
+
+			var current nrv1.AlertsSyntheticsCondition
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(nrv1.IsConditionTrue(current.Status.Conditions, nrv1.ConditionDrifted)).To(BeFalse())
+		})
+
+		It("reports a condition deleted in New Relic", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(alertsClient.CreateSyntheticsConditionCallCount()).To(Equal(1))
+
+			var current nrv1.AlertsSyntheticsCondition
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			drifted := nrv1.FindCondition(current.Status.Conditions, nrv1.ConditionDrifted)
+			Expect(drifted).ToNot(BeNil())
+			Expect(drifted.Message).To(ContainSubstring("condition 42 not found in New Relic"))
+		})
+
+		It("updates the condition when correcting drift", func() {
+			r.CorrectDrift = true
+			remote := condition.Spec.APISyntheticsCondition()
+			remote.ID = 42
+			remote.MonitorID = "another-monitor"
+			alertsClient.ListSyntheticsConditionsReturns([]*alerts.SyntheticsCondition{&remote}, nil)
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.UpdateSyntheticsConditionCallCount()).To(Equal(1))
+			Expect(alertsClient.UpdateSyntheticsConditionArgsForCall(0).MonitorID).To(Equal("6a1c2f0e-4b7d-4d3c-9a2e-1f7b6c5d4e3a"))
+		})
+	})
+
+	Context("when creating a synthetics_multi_location condition", func() {
+		BeforeEach(func() {
+			condition.Spec.Type = nrv1.SyntheticsConditionTypeMultiLocation
+			condition.Spec.MonitorID = ""
+			condition.Spec.Entities = []string{"6a1c2f0e-4b7d-4d3c-9a2e-1f7b6c5d4e3a"}
+			condition.Spec.ViolationTimeLimitSeconds = 3600
+			condition.Spec.APMTerms = []nrv1.AlertConditionTerm{{Priority: "critical", Threshold: "2"}}
+		})
+
+		It("creates the condition with the multi-location API", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.CreateSyntheticsConditionCallCount()).To(Equal(0))
+			Expect(alertsClient.CreateMultiLocationSyntheticsConditionCallCount()).To(Equal(1))
+			created, policyID := alertsClient.CreateMultiLocationSyntheticsConditionArgsForCall(0)
+			Expect(policyID).To(Equal(123))
+			Expect(created.ViolationTimeLimitSeconds).To(Equal(3600))
+			Expect(created.Terms).To(Equal([]alerts.MultiLocationSyntheticsConditionTerm{{Priority: "critical", Threshold: 2}}))
+
+			var updated nrv1.AlertsSyntheticsCondition
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.ConditionID).To(Equal(43))
+		})
+
+		It("compares the condition with the multi-location API", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			r.ResyncInterval = time.Minute
+			remote := condition.Spec.APIMultiLocationSyntheticsCondition()
+			remote.ID = 43
+			remote.Terms[0].Threshold = 3
+			alertsClient.ListMultiLocationSyntheticsConditionsReturns([]*alerts.MultiLocationSyntheticsCondition{&remote}, nil)
+
+			_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(alertsClient.ListSyntheticsConditionsCallCount()).To(Equal(0))
+
+			var current nrv1.AlertsSyntheticsCondition
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			drifted := nrv1.FindCondition(current.Status.Conditions, nrv1.ConditionDrifted)
+			Expect(drifted).ToNot(BeNil())
+			Expect(drifted.Message).To(ContainSubstring("terms differ"))
+		})
+
+		It("deletes the condition with the multi-location API", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			var current nrv1.AlertsSyntheticsCondition
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.DeleteMultiLocationSyntheticsConditionCallCount()).To(Equal(1))
+			Expect(alertsClient.DeleteMultiLocationSyntheticsConditionArgsForCall(0)).To(Equal(43))
+			Expect(alertsClient.DeleteSyntheticsConditionCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -16,39 +16,44 @@ limitations under the License.
 package controllers
 
 import (
-	"context"
 	"errors"
+	"fmt"
 	"reflect"
 	"sort"
 	"strconv"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	kErr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/newrelic/go-agent/v3/newrelic"
 	"github.com/newrelic/newrelic-client-go/pkg/alerts"
 
 	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	customErrors "github.com/newrelic/newrelic-kubernetes-operator/errors"
 	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
 )
 
 // AlertsChannelReconciler reconciles a AlertsChannel object
 type AlertsChannelReconciler struct {
 	client.Client
-	Log             logr.Logger
-	Scheme          *runtime.Scheme
-	AlertClientFunc func(string, string) (interfaces.NewRelicAlertsClient, error)
-	apiKey          string
-	Alerts          interfaces.NewRelicAlertsClient
-	ctx             context.Context
-	NewRelicAgent   newrelic.Application
-	txn             *newrelic.Transaction
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	AlertClientFunc         func(string, string) (interfaces.NewRelicAlertsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+	ResyncInterval          time.Duration
+	CorrectDrift            bool
 }
 
 // +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=alertschannels,verbs=get;list;watch;create;update;patch;delete
@@ -58,13 +63,12 @@ type AlertsChannelReconciler struct {
 func (r *AlertsChannelReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	var alertsChannel nrv1.AlertsChannel
 
-	r.ctx = context.Background()
 	r.Log.WithValues("alertsChannel", req.NamespacedName)
 
-	r.txn = r.NewRelicAgent.StartTransaction("Reconcile/Alerts/AlertsPolicy")
-	defer r.txn.End()
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Alerts/AlertsChannel")
+	defer rc.txn.End()
 
-	err := r.Client.Get(r.ctx, req.NamespacedName, &alertsChannel)
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &alertsChannel)
 	if err != nil {
 		if kErr.IsNotFound(err) {
 			r.Log.Info("AlertsChannel 'not found' after being deleted. This is expected and no cause for alarm", "error", err)
@@ -76,23 +80,21 @@ func (r *AlertsChannelReconciler) Reconcile(req ctrl.Request) (ctrl.Result, erro
 
 	r.Log.Info("alertsChannel", "alertsChannel.Spec", alertsChannel.Spec, "alertsChannel.status.applied", alertsChannel.Status.AppliedSpec)
 
-	r.apiKey, err = r.getAPIKeyOrSecret(alertsChannel)
+	err = resolveCredentials(rc, r.Client, &alertsChannel)
 	if err != nil {
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &alertsChannel, failureReason(err, nrv1.ReasonCredentialsError), err)
 		return ctrl.Result{}, err
 	}
 
-	if r.apiKey == "" {
-		return ctrl.Result{}, errors.New("api key is blank")
-	}
-
 	//initial alertsClient
-	alertsClient, errAlertsClient := r.AlertClientFunc(r.apiKey, alertsChannel.Spec.Region)
+	alertsClient, errAlertsClient := r.AlertClientFunc(rc.apiKey, rc.region)
 
 	if errAlertsClient != nil {
 		r.Log.Error(errAlertsClient, "Failed to create AlertsClient")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &alertsChannel, nrv1.ReasonCredentialsError, errAlertsClient)
 		return ctrl.Result{}, errAlertsClient
 	}
-	r.Alerts = alertsClient
+	rc.alerts = interfaces.NewTracedAlertsClient(alertsClient, rc.txn, rc.accountID, rc.region)
 
 	deleteFinalizer := "alertschannels.finalizers.nr.k8s.newrelic.com"
 
@@ -102,7 +104,7 @@ func (r *AlertsChannelReconciler) Reconcile(req ctrl.Request) (ctrl.Result, erro
 			alertsChannel.Finalizers = append(alertsChannel.Finalizers, deleteFinalizer)
 		}
 	} else {
-		err := r.deleteAlertsChannel(&alertsChannel, deleteFinalizer)
+		err := r.deleteAlertsChannel(rc, &alertsChannel, deleteFinalizer)
 		if err != nil {
 			r.Log.Error(err, "error deleting channel", "name", alertsChannel.Name)
 			return ctrl.Result{}, err
@@ -110,24 +112,39 @@ func (r *AlertsChannelReconciler) Reconcile(req ctrl.Request) (ctrl.Result, erro
 		return ctrl.Result{}, nil
 	}
 
-	if reflect.DeepEqual(&alertsChannel.Spec, alertsChannel.Status.AppliedSpec) {
-		return ctrl.Result{}, nil
+	if reflect.DeepEqual(alertsChannel.Spec.Redacted(), alertsChannel.Status.AppliedSpec) {
+		drifted, err := r.checkForAlertsChannelDrift(rc, &alertsChannel)
+		if err != nil {
+			r.Log.Error(err, "failed to resync channel with New Relic", "name", alertsChannel.Name)
+			recordFailure(r.Recorder, &alertsChannel, eventReasonResyncFailed, err)
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
+
+		if !drifted {
+			if err := updateReadyConditionsIfNeeded(rc.ctx, r.Client, &alertsChannel); err != nil {
+				r.Log.Error(err, "Error updating channel status", "name", alertsChannel.Name)
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
 	}
 
 	r.Log.Info("Reconciling", "alertsChannel", alertsChannel.Name)
 
-	r.checkForExistingAlertsChannel(&alertsChannel)
+	r.checkForExistingAlertsChannel(rc, &alertsChannel)
 
 	if alertsChannel.Status.ChannelID != 0 {
-		err := r.updateAlertsChannel(&alertsChannel)
+		err := r.updateAlertsChannel(rc, &alertsChannel)
 		if err != nil {
 			r.Log.Error(err, "error updating alertsChannel")
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &alertsChannel, failureReason(err, nrv1.ReasonUpdateFailed), err)
 			return ctrl.Result{}, err
 		}
 	} else {
-		err := r.createAlertsChannel(&alertsChannel)
+		err := r.createAlertsChannel(rc, &alertsChannel)
 		if err != nil {
 			r.Log.Error(err, "Error creating alertsChannel")
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &alertsChannel, failureReason(err, nrv1.ReasonCreateFailed), err)
 			return ctrl.Result{}, err
 		}
 	}
@@ -139,40 +156,22 @@ func (r *AlertsChannelReconciler) Reconcile(req ctrl.Request) (ctrl.Result, erro
 func (r *AlertsChannelReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&nrv1.AlertsChannel{}).
-		Complete(r)
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nrv1.AlertsChannelList{} }, true)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nrv1.AlertsChannel{}, r))
 }
 
-func (r *AlertsChannelReconciler) getAPIKeyOrSecret(alertschannel nrv1.AlertsChannel) (string, error) {
-	defer r.txn.StartSegment("getAPIKeyOrSecret").End()
-	if alertschannel.Spec.APIKey != "" {
-		return alertschannel.Spec.APIKey, nil
-	}
-
-	if alertschannel.Spec.APIKeySecret != (nrv1.NewRelicAPIKeySecret{}) {
-		var apiKeySecret v1.Secret
-
-		key := types.NamespacedName{Namespace: alertschannel.Spec.APIKeySecret.Namespace, Name: alertschannel.Spec.APIKeySecret.Name}
-
-		getErr := r.Client.Get(context.Background(), key, &apiKeySecret)
-		if getErr != nil {
-			r.Log.Error(getErr, "Failed to retrieve secret", "secret", apiKeySecret)
-			return "", getErr
-		}
-
-		return string(apiKeySecret.Data[alertschannel.Spec.APIKeySecret.KeyName]), nil
-	}
-
-	return "", nil
-}
-
-func (r *AlertsChannelReconciler) deleteAlertsChannel(alertsChannel *nrv1.AlertsChannel, deleteFinalizer string) (err error) {
-	defer r.txn.StartSegment("deleteAlertsChannel").End()
+func (r *AlertsChannelReconciler) deleteAlertsChannel(rc *requestContext, alertsChannel *nrv1.AlertsChannel, deleteFinalizer string) (err error) {
+	defer rc.txn.StartSegment("deleteAlertsChannel").End()
 	r.Log.Info("Deleting AlertsChannel", "name", alertsChannel.Name, "ChannelName", alertsChannel.Spec.Name)
 
 	if alertsChannel.Status.ChannelID != 0 {
-		_, err = r.Alerts.DeleteChannel(alertsChannel.Status.ChannelID)
+		_, err = rc.alerts.DeleteChannel(alertsChannel.Status.ChannelID)
 		if err != nil {
 			r.Log.Error(err, "error deleting AlertsChannel", "name", alertsChannel.Name, "ChannelName", alertsChannel.Spec.Name)
+			recordFailure(r.Recorder, alertsChannel, nrv1.ReasonDeleteFailed, err)
+		} else {
+			r.Recorder.Eventf(alertsChannel, v1.EventTypeNormal, eventReasonDeleted, "Deleted New Relic channel %d", alertsChannel.Status.ChannelID)
 		}
 
 	}
@@ -180,7 +179,7 @@ func (r *AlertsChannelReconciler) deleteAlertsChannel(alertsChannel *nrv1.Alerts
 	// Now remove finalizer
 	alertsChannel.Finalizers = removeString(alertsChannel.Finalizers, deleteFinalizer)
 
-	err = r.Client.Update(r.ctx, alertsChannel)
+	err = r.Client.Update(rc.ctx, alertsChannel)
 	if err != nil {
 		r.Log.Error(err, "tried updating condition status", "name", alertsChannel.Name, "Namespace", alertsChannel.Namespace)
 		return err
@@ -189,26 +188,28 @@ func (r *AlertsChannelReconciler) deleteAlertsChannel(alertsChannel *nrv1.Alerts
 	return nil
 }
 
-func (r *AlertsChannelReconciler) createAlertsChannel(alertsChannel *nrv1.AlertsChannel) error {
-	defer r.txn.StartSegment("createAlertsChannel").End()
+func (r *AlertsChannelReconciler) createAlertsChannel(rc *requestContext, alertsChannel *nrv1.AlertsChannel) error {
+	defer rc.txn.StartSegment("createAlertsChannel").End()
 	r.Log.Info("Creating AlertsChannel", "name", alertsChannel.Name, "ChannelName", alertsChannel.Spec.Name)
 	APIChannel, err := alertsChannel.Spec.APIChannel(r.Client)
 	if err != nil {
 		return err
 	}
 
-	r.Log.Info("API Payload before calling NR API", "APIChannel", APIChannel)
+	// the configuration holds the values read from secrets, so only the channel itself is logged
+	r.Log.Info("API Payload before calling NR API", "name", APIChannel.Name, "type", APIChannel.Type)
 
-	createdChannel, err := r.Alerts.CreateChannel(APIChannel)
+	createdChannel, err := rc.alerts.CreateChannel(APIChannel)
 	if err != nil {
 		r.Log.Error(err, "Error creating AlertsChannel"+alertsChannel.Name)
 		return err
 	}
 
 	alertsChannel.Status.ChannelID = createdChannel.ID
+	r.Recorder.Eventf(alertsChannel, v1.EventTypeNormal, eventReasonCreated, "Created New Relic channel %d", createdChannel.ID)
 
 	// Now create the links to policies
-	allPolicyIDs, err := r.getAllPolicyIDs(&alertsChannel.Spec)
+	allPolicyIDs, err := r.getAllPolicyIDs(rc, &alertsChannel.Spec)
 
 	if err != nil {
 		r.Log.Error(err, "Error getting list of policyIds")
@@ -216,16 +217,18 @@ func (r *AlertsChannelReconciler) createAlertsChannel(alertsChannel *nrv1.Alerts
 	}
 
 	for _, policyID := range allPolicyIDs {
-		policyChannels, errUpdatePolicies := r.Alerts.UpdatePolicyChannels(policyID, []int{createdChannel.ID})
+		policyChannels, errUpdatePolicies := rc.alerts.UpdatePolicyChannels(policyID, []int{createdChannel.ID})
 		if errUpdatePolicies != nil {
 			r.Log.Error(errUpdatePolicies, "error updating policyAlertsChannels", "policyID", policyID, "conditionID", createdChannel.ID, "policyChannels", policyChannels)
+			recordFailure(r.Recorder, alertsChannel, nrv1.ReasonUpdateFailed, errUpdatePolicies)
 		} else {
 			alertsChannel.Status.AppliedPolicyIDs = append(alertsChannel.Status.AppliedPolicyIDs, policyID)
 		}
 	}
 
-	alertsChannel.Status.AppliedSpec = &alertsChannel.Spec
-	errClientUpdate := r.Client.Update(r.ctx, alertsChannel)
+	alertsChannel.Status.AppliedSpec = alertsChannel.Spec.Redacted()
+	setReadyConditions(alertsChannel)
+	errClientUpdate := updateWithStatus(rc.ctx, r.Client, alertsChannel)
 
 	if errClientUpdate != nil {
 		r.Log.Error(errClientUpdate, "Error updating channel status", "name", alertsChannel.Name, "Namespace", alertsChannel.Namespace)
@@ -235,19 +238,19 @@ func (r *AlertsChannelReconciler) createAlertsChannel(alertsChannel *nrv1.Alerts
 	return nil
 }
 
-func (r *AlertsChannelReconciler) updateAlertsChannel(alertsChannel *nrv1.AlertsChannel) error {
-	defer r.txn.StartSegment("updateAlertsChannel").End()
+func (r *AlertsChannelReconciler) updateAlertsChannel(rc *requestContext, alertsChannel *nrv1.AlertsChannel) error {
+	defer rc.txn.StartSegment("updateAlertsChannel").End()
 	r.Log.Info("Updating AlertsChannel", "name", alertsChannel.Name, "ChannelName", alertsChannel.Spec.Name)
 
 	//Check to see if update is needed
-	AppliedPolicyIDs, AppliedErr := r.getAllPolicyIDs(alertsChannel.Status.AppliedSpec)
+	AppliedPolicyIDs, AppliedErr := r.getAllPolicyIDs(rc, alertsChannel.Status.AppliedSpec)
 
 	if AppliedErr != nil {
 		r.Log.Error(AppliedErr, "Error getting list of AppliedPolicyIds")
 		return AppliedErr
 	}
 
-	IncomingPolicyIDs, incomingErr := r.getAllPolicyIDs(&alertsChannel.Spec)
+	IncomingPolicyIDs, incomingErr := r.getAllPolicyIDs(rc, &alertsChannel.Spec)
 
 	if incomingErr != nil {
 		r.Log.Error(incomingErr, "Error getting list of AppliedPolicyIds")
@@ -260,6 +263,7 @@ func (r *AlertsChannelReconciler) updateAlertsChannel(alertsChannel *nrv1.Alerts
 	)
 
 	processedPolicyIDs := make(map[int]bool)
+	collectedErrors := new(customErrors.ErrorCollector)
 
 	for _, incomingPolicyID := range IncomingPolicyIDs {
 		processedPolicyIDs[incomingPolicyID] = false
@@ -270,13 +274,14 @@ func (r *AlertsChannelReconciler) updateAlertsChannel(alertsChannel *nrv1.Alerts
 			processedPolicyIDs[appliedPolicyID] = true
 		} else {
 			r.Log.Info("Need to delete link to", "policyId", appliedPolicyID)
-			PolicyChannels, err := r.Alerts.DeletePolicyChannel(appliedPolicyID, alertsChannel.Status.ChannelID)
+			PolicyChannels, err := rc.alerts.DeletePolicyChannel(appliedPolicyID, alertsChannel.Status.ChannelID)
 			if err != nil {
 				r.Log.Error(err, "error updating policyAlertsChannels",
 					"policyID", appliedPolicyID,
 					"conditionID", alertsChannel.Status.ChannelID,
 					"PolicyChannels", PolicyChannels,
 				)
+				collectedErrors.Collect(fmt.Errorf("failed to unlink policy %d: %w", appliedPolicyID, err))
 			}
 		}
 	}
@@ -286,12 +291,11 @@ func (r *AlertsChannelReconciler) updateAlertsChannel(alertsChannel *nrv1.Alerts
 
 	for policyID, processed := range processedPolicyIDs {
 		r.Log.Info("processing ", "policyID", policyID, ":processed", processed)
-		alertsChannel.Status.AppliedPolicyIDs = append(alertsChannel.Status.AppliedPolicyIDs, policyID)
 
 		if !processed {
 			r.Log.Info("need to add ", "policyID", policyID)
 
-			policyChannels, err := r.Alerts.UpdatePolicyChannels(policyID, []int{alertsChannel.Status.ChannelID})
+			policyChannels, err := rc.alerts.UpdatePolicyChannels(policyID, []int{alertsChannel.Status.ChannelID})
 			if err != nil {
 				r.Log.Error(err, "error updating policyAlertsChannels",
 					"policyID", policyID,
@@ -299,14 +303,26 @@ func (r *AlertsChannelReconciler) updateAlertsChannel(alertsChannel *nrv1.Alerts
 					"policyChannels", policyChannels,
 				)
 				r.Log.Info("policyChannels", "", policyChannels)
+				collectedErrors.Collect(fmt.Errorf("failed to link policy %d: %w", policyID, err))
+				continue
 			}
 		}
+
+		alertsChannel.Status.AppliedPolicyIDs = append(alertsChannel.Status.AppliedPolicyIDs, policyID)
 	}
 
+	// the applied spec is kept so the failed links are retried, the caller records the failure
+	if len(*collectedErrors) > 0 {
+		return collectedErrors
+	}
+
+	r.Recorder.Eventf(alertsChannel, v1.EventTypeNormal, eventReasonUpdated, "Updated policies linked to New Relic channel %d", alertsChannel.Status.ChannelID)
+
 	// Now update the AppliedSpec and the k8s object
-	alertsChannel.Status.AppliedSpec = &alertsChannel.Spec
+	alertsChannel.Status.AppliedSpec = alertsChannel.Spec.Redacted()
+	setReadyConditions(alertsChannel)
 
-	err := r.Client.Update(r.ctx, alertsChannel)
+	err := updateWithStatus(rc.ctx, r.Client, alertsChannel)
 	if err != nil {
 		r.Log.Error(err, "Tried updating channel status", "name", alertsChannel.Name, "Namespace", alertsChannel.Namespace)
 		return err
@@ -315,13 +331,14 @@ func (r *AlertsChannelReconciler) updateAlertsChannel(alertsChannel *nrv1.Alerts
 	return nil
 }
 
-func (r *AlertsChannelReconciler) checkForExistingAlertsChannel(alertsChannel *nrv1.AlertsChannel) {
-	defer r.txn.StartSegment("checkForExistingAlertsChannel").End()
+func (r *AlertsChannelReconciler) checkForExistingAlertsChannel(rc *requestContext, alertsChannel *nrv1.AlertsChannel) {
+	defer rc.txn.StartSegment("checkForExistingAlertsChannel").End()
 	r.Log.Info("Checking for existing Channels matching name: " + alertsChannel.Spec.Name)
-	retrievedChannels, err := r.Alerts.ListChannels()
+	retrievedChannels, err := rc.alerts.ListChannels()
 
 	if err != nil {
 		r.Log.Error(err, "error retrieving list of Channels")
+		recordFailure(r.Recorder, alertsChannel, eventReasonLookupFailed, err)
 		return
 	}
 
@@ -339,23 +356,145 @@ func (r *AlertsChannelReconciler) checkForExistingAlertsChannel(alertsChannel *n
 			if reflect.DeepEqual(&APIChannel, channel) {
 				r.Log.Info("Found matching Alerts Channel name from the New Relic API", "ID", channel.ID)
 				alertsChannel.Status.ChannelID = channelID
+				r.Recorder.Eventf(alertsChannel, v1.EventTypeNormal, eventReasonAdopted, "Adopted existing New Relic channel %d", channelID)
 
-				alertsChannel.Status.AppliedSpec = &alertsChannel.Spec
+				alertsChannel.Status.AppliedSpec = alertsChannel.Spec.Redacted()
 			}
 
 			r.Log.Info("Found non matching channel so need to delete and create channel")
 
-			_, err = r.Alerts.DeleteChannel(channelID)
+			_, err = rc.alerts.DeleteChannel(channelID)
 			if err != nil {
 				r.Log.Error(err, "Error deleting non-matching AlertsChannel via New Relic API")
+				recordFailure(r.Recorder, alertsChannel, nrv1.ReasonDeleteFailed, err)
 				continue
 			}
+
+			r.Recorder.Eventf(alertsChannel, v1.EventTypeNormal, eventReasonDeleted, "Deleted non-matching New Relic channel %d", channelID)
+		}
+	}
+}
+
+// checkForAlertsChannelDrift compares the channel in New Relic with the spec when a resync interval is
+// configured and records the result in the Drifted condition. It returns true when the channel drifted
+// and has been prepared to be applied again.
+func (r *AlertsChannelReconciler) checkForAlertsChannelDrift(rc *requestContext, alertsChannel *nrv1.AlertsChannel) (bool, error) {
+	if r.ResyncInterval == 0 || alertsChannel.Status.ChannelID == 0 {
+		return false, nil
+	}
+
+	defer rc.txn.StartSegment("checkForAlertsChannelDrift").End()
+
+	retrievedChannels, err := rc.alerts.ListChannels()
+	if err != nil {
+		r.Log.Error(err, "error retrieving list of Channels")
+		return false, err
+	}
+
+	var remoteChannel *alerts.Channel
+	for _, channel := range retrievedChannels {
+		if channel.ID == alertsChannel.Status.ChannelID {
+			remoteChannel = channel
+			break
+		}
+	}
+
+	var differences []string
+	if remoteChannel == nil {
+		differences = []string{fmt.Sprintf("channel %d not found in New Relic", alertsChannel.Status.ChannelID)}
+	} else {
+		APIChannel, err := alertsChannel.Spec.APIChannel(r.Client)
+		if err != nil {
+			r.Log.Error(err, "Error parsing Alerts Channel configuration")
+			return false, err
 		}
+
+		differences = diffAlertsChannel(APIChannel, *remoteChannel, alertsChannel.Status.AppliedPolicyIDs)
 	}
+
+	if len(differences) == 0 || !r.CorrectDrift {
+		if setDriftedCondition(r.Recorder, alertsChannel, differences) {
+			return false, updateWithStatus(rc.ctx, r.Client, alertsChannel)
+		}
+		return false, nil
+	}
+
+	r.Log.Info("correcting drift of channel", "channelId", alertsChannel.Status.ChannelID, "differences", differences)
+	setDriftedCondition(r.Recorder, alertsChannel, differences)
+
+	// channels can't be updated through the API, so a drifted channel is replaced and linked again
+	if remoteChannel != nil {
+		_, err = rc.alerts.DeleteChannel(remoteChannel.ID)
+		if err != nil {
+			r.Log.Error(err, "Error deleting drifted AlertsChannel via New Relic API")
+			return false, err
+		}
+
+		r.Recorder.Eventf(alertsChannel, v1.EventTypeNormal, eventReasonDeleted, "Deleted drifted New Relic channel %d", remoteChannel.ID)
+	}
+
+	alertsChannel.Status.ChannelID = 0
+	alertsChannel.Status.AppliedPolicyIDs = []int{}
+	alertsChannel.Status.AppliedSpec = &nrv1.AlertsChannelSpec{}
+
+	return true, nil
+}
+
+// diffAlertsChannel lists the differences between the desired channel and the channel in New Relic,
+// including policies the channel is no longer linked to
+func diffAlertsChannel(desired alerts.Channel, remote alerts.Channel, appliedPolicyIDs []int) []string {
+	differences := []string{}
+
+	if desired.Name != remote.Name {
+		differences = append(differences, fmt.Sprintf("name is %q, expected %q", remote.Name, desired.Name))
+	}
+
+	if desired.Type != remote.Type {
+		differences = append(differences, fmt.Sprintf("type is %q, expected %q", remote.Type, desired.Type))
+	}
+
+	differences = append(differences, diffChannelConfiguration(desired.Configuration, remote.Configuration)...)
+
+	missingPolicyIDs := diffIntSlice(appliedPolicyIDs, remote.Links.PolicyIDs)
+	if len(missingPolicyIDs) > 0 {
+		differences = append(differences, fmt.Sprintf("not linked to policies %v", missingPolicyIDs))
+	}
+
+	return differences
+}
+
+// diffChannelConfiguration compares the configuration fields New Relic returns for a channel.
+// Credentials like the auth token, api key, service key, route key, url and basic auth are not returned,
+// and headers and payload come back as loosely typed maps, so those are left out.
+func diffChannelConfiguration(desired alerts.ChannelConfiguration, remote alerts.ChannelConfiguration) []string {
+	differences := []string{}
+
+	fields := []struct {
+		name            string
+		desired, remote string
+	}{
+		{"recipients", desired.Recipients, remote.Recipients},
+		{"include_json_attachment", desired.IncludeJSONAttachment, remote.IncludeJSONAttachment},
+		{"teams", desired.Teams, remote.Teams},
+		{"tags", desired.Tags, remote.Tags},
+		{"channel", desired.Channel, remote.Channel},
+		{"base_url", desired.BaseURL, remote.BaseURL},
+		{"payload_type", desired.PayloadType, remote.PayloadType},
+		{"region", desired.Region, remote.Region},
+		{"user_id", desired.UserID, remote.UserID},
+	}
+
+	for _, field := range fields {
+		if field.desired != field.remote {
+			differences = append(differences, fmt.Sprintf("configuration %s is %q, expected %q", field.name, field.remote, field.desired))
+		}
+	}
+
+	return differences
 }
 
-func (r *AlertsChannelReconciler) getAllPolicyIDs(alertsChannelSpec *nrv1.AlertsChannelSpec) (policyIDs []int, err error) {
-	defer r.txn.StartSegment("getAllPolicyIDs").End()
+func (r *AlertsChannelReconciler) getAllPolicyIDs(rc *requestContext, alertsChannelSpec *nrv1.AlertsChannelSpec) (policyIDs []int, err error) {
+	defer rc.txn.StartSegment("getAllPolicyIDs").End()
 	var retrievedPolicies []alerts.Policy
 	policyIDMap := make(map[int]bool)
 
@@ -369,7 +508,7 @@ func (r *AlertsChannelReconciler) getAllPolicyIDs(alertsChannelSpec *nrv1.Alerts
 				Name: policyName,
 			}
 
-			retrievedPolicies, err = r.Alerts.ListPolicies(alertParams)
+			retrievedPolicies, err = rc.alerts.ListPolicies(alertParams)
 			if err != nil {
 				r.Log.Error(err, "Error getting list of policies")
 				return
@@ -397,7 +536,7 @@ func (r *AlertsChannelReconciler) getAllPolicyIDs(alertsChannelSpec *nrv1.Alerts
 
 			var k8sPolicy nrv1.AlertsPolicy
 
-			err = r.Client.Get(context.Background(), key, &k8sPolicy)
+			err = r.Client.Get(rc.ctx, key, &k8sPolicy)
 			if err != nil {
 				r.Log.Error(err, "Failed to retrieve policy", "k8sPolicy", key)
 				return
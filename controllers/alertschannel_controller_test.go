@@ -2,12 +2,15 @@ package controllers
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -30,6 +33,7 @@ var _ = Describe("AlertsChannel reconciliation", func() {
 		// secret         *v1.Secret
 		fakeAlertFunc func(string, string) (interfaces.NewRelicAlertsClient, error)
 		testPolicy    nrv1.AlertsPolicy
+		recorder      *record.FakeRecorder
 	)
 
 	BeforeEach(func() {
@@ -66,10 +70,12 @@ var _ = Describe("AlertsChannel reconciliation", func() {
 			return alertsClient, nil
 		}
 		newRelicAgent := newrelic.Application{}
+		recorder = record.NewFakeRecorder(100)
 
 		r = &AlertsChannelReconciler{
 			Client:          k8sClient,
 			Log:             logf.Log,
+			Recorder:        recorder,
 			AlertClientFunc: fakeAlertFunc,
 			NewRelicAgent:   newRelicAgent,
 		}
@@ -132,7 +138,7 @@ var _ = Describe("AlertsChannel reconciliation", func() {
 				PolicyID:    "665544",
 			},
 		}
-		err = ignoreAlreadyExists(k8sClient.Create(ctx, &testPolicy))
+		err = ignoreAlreadyExists(createWithStatus(ctx, &testPolicy))
 		Expect(err).ToNot(HaveOccurred())
 	})
 
@@ -163,7 +169,20 @@ var _ = Describe("AlertsChannel reconciliation", func() {
 					var endStateAlertsChannel nrv1.AlertsChannel
 					err = k8sClient.Get(ctx, namespacedName, &endStateAlertsChannel)
 					Expect(err).To(BeNil())
-					Expect(endStateAlertsChannel.Status.AppliedSpec).To(Equal(&alertsChannel.Spec))
+					Expect(endStateAlertsChannel.Status.AppliedSpec).To(Equal(alertsChannel.Spec.Redacted()))
+				})
+
+				It("records a Created event on the kubernetes object", func() {
+					Expect(recorder.Events).To(Receive(Equal("Normal Created Created New Relic channel 543")))
+				})
+
+				It("marks the kubernetes object as Ready", func() {
+					var endStateAlertsChannel nrv1.AlertsChannel
+					err = k8sClient.Get(ctx, namespacedName, &endStateAlertsChannel)
+					Expect(err).To(BeNil())
+					Expect(nrv1.IsConditionTrue(endStateAlertsChannel.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+					Expect(nrv1.IsConditionTrue(endStateAlertsChannel.Status.Conditions, nrv1.ConditionSynced)).To(BeTrue())
+					Expect(nrv1.IsConditionTrue(endStateAlertsChannel.Status.Conditions, nrv1.ConditionError)).To(BeFalse())
 				})
 
 				It("adds a policy by policy name to the alertsChannel", func() {
@@ -249,7 +268,7 @@ var _ = Describe("AlertsChannel reconciliation", func() {
 					Expect(err).ToNot(HaveOccurred())
 					existingPolicyID = testPolicy.Status.PolicyID
 					testPolicy.Status.PolicyID = ""
-					err = k8sClient.Update(ctx, &testPolicy)
+					err = k8sClient.Status().Update(ctx, &testPolicy)
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -261,13 +280,42 @@ var _ = Describe("AlertsChannel reconciliation", func() {
 					Expect(err.Error()).To(ContainSubstring("Retrieved policy " + testPolicy.Name + " but ID was blank"))
 				})
 
+				It("Should record the failure in the Error condition", func() {
+					err := k8sClient.Create(ctx, alertsChannel)
+					Expect(err).ToNot(HaveOccurred())
+					_, err = r.Reconcile(request)
+					Expect(err).To(HaveOccurred())
+
+					var endStateAlertsChannel nrv1.AlertsChannel
+					err = k8sClient.Get(ctx, namespacedName, &endStateAlertsChannel)
+					Expect(err).To(BeNil())
+					Expect(nrv1.IsConditionTrue(endStateAlertsChannel.Status.Conditions, nrv1.ConditionReady)).To(BeFalse())
+
+					errorCondition := nrv1.FindCondition(endStateAlertsChannel.Status.Conditions, nrv1.ConditionError)
+					Expect(errorCondition).ToNot(BeNil())
+					Expect(errorCondition.Status).To(Equal(v1.ConditionTrue))
+					Expect(errorCondition.Reason).To(Equal(nrv1.ReasonCreateFailed))
+					Expect(errorCondition.Message).To(ContainSubstring("but ID was blank"))
+				})
+
+				It("Should record a warning event", func() {
+					err := k8sClient.Create(ctx, alertsChannel)
+					Expect(err).ToNot(HaveOccurred())
+					_, err = r.Reconcile(request)
+					Expect(err).To(HaveOccurred())
+
+					// the channel itself is created before linking the policy fails
+					Expect(recorder.Events).To(Receive(HavePrefix("Normal " + eventReasonCreated)))
+					Expect(recorder.Events).To(Receive(HavePrefix("Warning " + nrv1.ReasonCreateFailed)))
+				})
+
 				AfterEach(func() {
 					key := types.NamespacedName{Name: "my-policy",
 						Namespace: "default"}
 					err := k8sClient.Get(ctx, key, &testPolicy)
 					Expect(err).ToNot(HaveOccurred())
 					testPolicy.Status.PolicyID = existingPolicyID
-					err = k8sClient.Update(ctx, &testPolicy)
+					err = k8sClient.Status().Update(ctx, &testPolicy)
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
@@ -324,7 +372,7 @@ var _ = Describe("AlertsChannel reconciliation", func() {
 					var endStateAlertsChannel nrv1.AlertsChannel
 					err = k8sClient.Get(ctx, namespacedName, &endStateAlertsChannel)
 					Expect(err).To(BeNil())
-					Expect(endStateAlertsChannel.Status.AppliedSpec).To(Equal(&alertsChannel.Spec))
+					Expect(endStateAlertsChannel.Status.AppliedSpec).To(Equal(alertsChannel.Spec.Redacted()))
 				})
 			})
 
@@ -384,7 +432,7 @@ var _ = Describe("AlertsChannel reconciliation", func() {
 					var endStateAlertsChannel nrv1.AlertsChannel
 					err = k8sClient.Get(ctx, namespacedName, &endStateAlertsChannel)
 					Expect(err).To(BeNil())
-					Expect(endStateAlertsChannel.Status.AppliedSpec).To(Equal(&alertsChannel.Spec))
+					Expect(endStateAlertsChannel.Status.AppliedSpec).To(Equal(alertsChannel.Spec.Redacted()))
 				})
 			})
 
@@ -451,7 +499,7 @@ var _ = Describe("AlertsChannel reconciliation", func() {
 					var endStateAlertsChannel nrv1.AlertsChannel
 					err = k8sClient.Get(ctx, namespacedName, &endStateAlertsChannel)
 					Expect(err).To(BeNil())
-					Expect(endStateAlertsChannel.Status.AppliedSpec).To(Equal(&alertsChannel.Spec))
+					Expect(endStateAlertsChannel.Status.AppliedSpec).To(Equal(alertsChannel.Spec.Redacted()))
 				})
 			})
 		})
@@ -501,6 +549,45 @@ var _ = Describe("AlertsChannel reconciliation", func() {
 			})
 		})
 
+		Context("and the alertsChannel was deleted in New Relic", func() {
+			BeforeEach(func() {
+				r.ResyncInterval = time.Minute
+			})
+
+			It("Should report the drift", func() {
+				_, err := r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(alertsClient.CreateChannelCallCount()).To(Equal(1))
+
+				var endStateAlertsChannel nrv1.AlertsChannel
+				err = k8sClient.Get(ctx, namespacedName, &endStateAlertsChannel)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nrv1.IsConditionTrue(endStateAlertsChannel.Status.Conditions, nrv1.ConditionDrifted)).To(BeTrue())
+				Expect(nrv1.FindCondition(endStateAlertsChannel.Status.Conditions, nrv1.ConditionDrifted).Message).To(Equal("channel 543 not found in New Relic"))
+			})
+
+			It("Should create the alertsChannel again when correcting drift", func() {
+				r.CorrectDrift = true
+
+				_, err := r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(alertsClient.CreateChannelCallCount()).To(Equal(2))
+
+				var endStateAlertsChannel nrv1.AlertsChannel
+				err = k8sClient.Get(ctx, namespacedName, &endStateAlertsChannel)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(endStateAlertsChannel.Status.ChannelID).To(Equal(543))
+				Expect(endStateAlertsChannel.Status.AppliedSpec).To(Equal(endStateAlertsChannel.Spec.Redacted()))
+			})
+
+			AfterEach(func() {
+				err := k8sClient.Delete(ctx, alertsChannel)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
 		Context("and updating that alertsChannel", func() {
 			BeforeEach(func() {
 				//Get the object again after creation
@@ -552,6 +639,49 @@ var _ = Describe("AlertsChannel reconciliation", func() {
 				})
 			})
 
+			Context("When linking a new policy fails", func() {
+				BeforeEach(func() {
+					alertsClient.UpdatePolicyChannelsReturns(nil, errors.New("policy not found"))
+
+					alertsChannel.Spec.Links.PolicyIDs = append(alertsChannel.Spec.Links.PolicyIDs, 4)
+					err := k8sClient.Update(ctx, alertsChannel)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("Should fail the reconcile loop", func() {
+					_, err := r.Reconcile(request)
+					Expect(err).To(MatchError(ContainSubstring("failed to link policy 4: policy not found")))
+				})
+
+				It("Should not record the policies as updated", func() {
+					_, err := r.Reconcile(request)
+					Expect(err).To(HaveOccurred())
+
+					events := []string{}
+					for len(recorder.Events) > 0 {
+						events = append(events, <-recorder.Events)
+					}
+					Expect(events).ToNot(ContainElement(HavePrefix("Normal " + eventReasonUpdated)))
+					Expect(events).To(ContainElement(HavePrefix("Warning " + nrv1.ReasonUpdateFailed)))
+				})
+
+				It("Should keep the applied spec so the link is retried", func() {
+					_, err := r.Reconcile(request)
+					Expect(err).To(HaveOccurred())
+
+					var endStateAlertsChannel nrv1.AlertsChannel
+					err = k8sClient.Get(ctx, namespacedName, &endStateAlertsChannel)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(endStateAlertsChannel.Status.AppliedSpec.Links.PolicyIDs).ToNot(ContainElement(4))
+					Expect(endStateAlertsChannel.Status.AppliedPolicyIDs).ToNot(ContainElement(4))
+					Expect(nrv1.IsConditionTrue(endStateAlertsChannel.Status.Conditions, nrv1.ConditionReady)).To(BeFalse())
+
+					errorCondition := nrv1.FindCondition(endStateAlertsChannel.Status.Conditions, nrv1.ConditionError)
+					Expect(errorCondition).ToNot(BeNil())
+					Expect(errorCondition.Reason).To(Equal(nrv1.ReasonUpdateFailed))
+				})
+			})
+
 			AfterEach(func() {
 				err := k8sClient.Delete(ctx, alertsChannel)
 				Expect(err).ToNot(HaveOccurred())
@@ -561,3 +691,60 @@ var _ = Describe("AlertsChannel reconciliation", func() {
 		})
 	})
 })
+
+var _ = Describe("diffAlertsChannel", func() {
+	var desired, remote alerts.Channel
+
+	BeforeEach(func() {
+		desired = alerts.Channel{
+			Name: "my-webhook",
+			Type: "webhook",
+			Configuration: alerts.ChannelConfiguration{
+				BaseURL:      "https://example.com/hook",
+				PayloadType:  "application/json",
+				AuthUsername: "user",
+				AuthPassword: "password",
+				Headers:      map[string]interface{}{"X-Token": "secret"},
+				Payload:      map[string]interface{}{"account_id": "12345"},
+			},
+		}
+
+		// New Relic leaves out credentials and returns headers and payload as generic maps
+		remote = alerts.Channel{
+			ID:   42,
+			Name: "my-webhook",
+			Type: "webhook",
+			Configuration: alerts.ChannelConfiguration{
+				BaseURL:     "https://example.com/hook",
+				PayloadType: "application/json",
+				Payload:     map[string]interface{}{"account_id": float64(12345)},
+			},
+			Links: alerts.ChannelLinks{PolicyIDs: []int{1, 2}},
+		}
+	})
+
+	It("finds no differences in a channel as returned by New Relic", func() {
+		Expect(diffAlertsChannel(desired, remote, []int{1, 2})).To(BeEmpty())
+	})
+
+	It("ignores the credentials New Relic does not return", func() {
+		desired.Type = "pagerduty"
+		desired.Configuration = alerts.ChannelConfiguration{ServiceKey: "service-key"}
+		remote.Type = "pagerduty"
+		remote.Configuration = alerts.ChannelConfiguration{}
+
+		Expect(diffAlertsChannel(desired, remote, []int{1, 2})).To(BeEmpty())
+	})
+
+	It("lists the differences", func() {
+		remote.Name = "renamed"
+		remote.Configuration.BaseURL = "https://example.com/other"
+		remote.Links.PolicyIDs = []int{1}
+
+		Expect(diffAlertsChannel(desired, remote, []int{1, 2})).To(ConsistOf(
+			`name is "renamed", expected "my-webhook"`,
+			`configuration base_url is "https://example.com/other", expected "https://example.com/hook"`,
+			"not linked to policies [2]",
+		))
+	})
+})
@@ -0,0 +1,295 @@
+package controllers
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+)
+
+const alertsMutingRuleDeleteFinalizer = "alertsmutingrules.finalizers.nr.k8s.newrelic.com"
+
+// AlertsMutingRuleReconciler reconciles a AlertsMutingRule object
+type AlertsMutingRuleReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	AlertClientFunc         func(string, string) (interfaces.NewRelicAlertsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+	ResyncInterval          time.Duration
+	CorrectDrift            bool
+}
+
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=alertsmutingrules,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=alertsmutingrules/status,verbs=get;update;patch
+
+// Reconcile is responsible for reconciling the spec and state of the AlertsMutingRule. Rules are
+// requeued whenever their schedule starts or ends so the Active status follows it.
+func (r *AlertsMutingRuleReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Alerts/MutingRule")
+	defer rc.txn.End()
+
+	var rule nrv1.AlertsMutingRule
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &rule)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			r.Log.Info("AlertsMutingRule 'not found' after being deleted. This is expected and no cause for alarm", "error", err)
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET AlertsMutingRule", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	err = resolveCredentials(rc, r.Client, &rule)
+	if err != nil {
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &rule, failureReason(err, nrv1.ReasonCredentialsError), err)
+		return ctrl.Result{}, err
+	}
+
+	alertsClient, errAlertsClient := r.AlertClientFunc(rc.apiKey, rc.region)
+	if errAlertsClient != nil {
+		r.Log.Error(errAlertsClient, "Failed to create AlertsClient")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &rule, nrv1.ReasonCredentialsError, errAlertsClient)
+		return ctrl.Result{}, errAlertsClient
+	}
+	rc.alerts = interfaces.NewTracedAlertsClient(alertsClient, rc.txn, rc.accountID, rc.region)
+
+	// examine DeletionTimestamp to determine if object is under deletion
+	if rule.DeletionTimestamp.IsZero() {
+		if !containsString(rule.Finalizers, alertsMutingRuleDeleteFinalizer) {
+			rule.Finalizers = append(rule.Finalizers, alertsMutingRuleDeleteFinalizer)
+		}
+	} else {
+		return ctrl.Result{}, r.deleteMutingRule(rc, &rule)
+	}
+
+	if reflect.DeepEqual(rule.Spec.Redacted(), rule.Status.AppliedSpec) {
+		drifted, err := r.checkForMutingRuleDrift(rc, &rule)
+		if err != nil {
+			r.Log.Error(err, "failed to resync muting rule with New Relic", "name", req.NamespacedName)
+			recordFailure(r.Recorder, &rule, eventReasonResyncFailed, err)
+			return ctrl.Result{RequeueAfter: r.requeueAfter(0)}, nil
+		}
+
+		if !drifted {
+			activeChanged, untilChange := r.setActive(&rule)
+
+			if activeChanged || !nrv1.IsConditionTrue(rule.Status.Conditions, nrv1.ConditionReady) {
+				setReadyConditions(&rule)
+
+				if err := updateWithStatus(rc.ctx, r.Client, &rule); err != nil {
+					r.Log.Error(err, "tried updating muting rule status", "name", req.NamespacedName)
+					return ctrl.Result{}, err
+				}
+			}
+
+			return ctrl.Result{RequeueAfter: r.requeueAfter(untilChange)}, nil
+		}
+	}
+
+	r.Log.Info("Reconciling", "mutingRule", rule.Name)
+
+	untilChange, err := r.writeMutingRule(rc, &rule)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: r.requeueAfter(untilChange)}, nil
+}
+
+//SetupWithManager - Sets up Controller for AlertsMutingRule
+func (r *AlertsMutingRuleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nrv1.AlertsMutingRule{}).
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nrv1.AlertsMutingRuleList{} }, true)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nrv1.AlertsMutingRule{}, r))
+}
+
+// requeueAfter returns the delay until the rule has to be looked at again, which is the earlier of
+// the next change of its schedule and the resync interval. Zero means it is not requeued.
+func (r *AlertsMutingRuleReconciler) requeueAfter(untilChange time.Duration) time.Duration {
+	if untilChange == 0 || (r.ResyncInterval != 0 && r.ResyncInterval < untilChange) {
+		return r.ResyncInterval
+	}
+
+	return untilChange
+}
+
+// setActive records on the status whether the rule mutes violations right now. It returns whether
+// the status changed and how long until the schedule changes it again, zero if it does not.
+func (r *AlertsMutingRuleReconciler) setActive(rule *nrv1.AlertsMutingRule) (bool, time.Duration) {
+	now := time.Now()
+
+	active, next, err := rule.Spec.ActiveAt(now)
+	if err != nil {
+		r.Log.Error(err, "failed to evaluate schedule of muting rule", "name", rule.Name)
+	}
+
+	changed := rule.Status.Active != active
+	rule.Status.Active = active
+
+	if next.IsZero() {
+		return changed, 0
+	}
+
+	return changed, next.Sub(now)
+}
+
+// checkForMutingRuleDrift compares the muting rule in New Relic with the spec when a resync interval is
+// configured and records the result in the Drifted condition. It returns true when the rule drifted
+// and has been prepared to be written again.
+func (r *AlertsMutingRuleReconciler) checkForMutingRuleDrift(rc *requestContext, rule *nrv1.AlertsMutingRule) (bool, error) {
+	if r.ResyncInterval == 0 || rule.Status.MutingRuleID == 0 {
+		return false, nil
+	}
+
+	defer rc.txn.StartSegment("checkForMutingRuleDrift").End()
+
+	remoteRule, err := rc.alerts.GetMutingRule(rc.accountID, rule.Status.MutingRuleID)
+	if err != nil && !isNotFound(err) {
+		r.Log.Error(err, "failed to get muting rule from New Relic API",
+			"mutingRuleId", rule.Status.MutingRuleID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		return false, err
+	}
+
+	var differences []string
+	if err != nil || remoteRule == nil {
+		differences = []string{fmt.Sprintf("muting rule %d not found in New Relic", rule.Status.MutingRuleID)}
+		remoteRule = nil
+	} else {
+		differences = rule.Spec.Diff(*remoteRule)
+	}
+
+	if len(differences) == 0 || !r.CorrectDrift {
+		if setDriftedCondition(r.Recorder, rule, differences) {
+			return false, updateWithStatus(rc.ctx, r.Client, rule)
+		}
+		return false, nil
+	}
+
+	r.Log.Info("correcting drift of muting rule", "mutingRuleId", rule.Status.MutingRuleID, "differences", differences)
+	setDriftedCondition(r.Recorder, rule, differences)
+
+	if remoteRule == nil {
+		rule.Status.MutingRuleID = 0
+	}
+
+	// forget the applied spec so the rule is written again
+	rule.Status.AppliedSpec = &nrv1.AlertsMutingRuleSpec{}
+
+	return true, nil
+}
+
+// writeMutingRule creates or updates the muting rule through NerdGraph and records the result on the
+// status of the AlertsMutingRule. It returns how long until the schedule of the rule changes its state.
+func (r *AlertsMutingRuleReconciler) writeMutingRule(rc *requestContext, rule *nrv1.AlertsMutingRule) (time.Duration, error) {
+	defer rc.txn.StartSegment("writeMutingRule").End()
+
+	reason := nrv1.ReasonCreateFailed
+	eventReason := eventReasonCreated
+
+	var err error
+
+	if rule.Status.MutingRuleID != 0 {
+		r.Log.Info("updating muting rule", "mutingRuleName", rule.Spec.Name, "mutingRuleId", rule.Status.MutingRuleID)
+		reason = nrv1.ReasonUpdateFailed
+		eventReason = eventReasonUpdated
+
+		input, inputErr := rule.Spec.ToMutingRuleUpdateInput()
+		err = inputErr
+		if err == nil {
+			_, err = rc.alerts.UpdateMutingRule(rc.accountID, rule.Status.MutingRuleID, input)
+		}
+	} else {
+		r.Log.Info("creating muting rule", "mutingRuleName", rule.Spec.Name, "accountId", rc.accountID)
+
+		input, inputErr := rule.Spec.ToMutingRuleCreateInput()
+		err = inputErr
+		if err == nil {
+			created, createErr := rc.alerts.CreateMutingRule(rc.accountID, input)
+			err = createErr
+			if err == nil {
+				rule.Status.MutingRuleID = created.ID
+			}
+		}
+	}
+
+	if err != nil {
+		r.Log.Error(err, "failed to write muting rule",
+			"mutingRuleId", rule.Status.MutingRuleID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, rule, reason, err)
+		return 0, err
+	}
+
+	_, untilChange := r.setActive(rule)
+
+	rule.Status.AppliedSpec = rule.Spec.Redacted()
+	r.Recorder.Eventf(rule, v1.EventTypeNormal, eventReason, "%s New Relic muting rule %d", eventReason, rule.Status.MutingRuleID)
+	setReadyConditions(rule)
+
+	if err := updateWithStatus(rc.ctx, r.Client, rule); err != nil {
+		r.Log.Error(err, "tried updating muting rule status", "name", rule.Name)
+		return 0, err
+	}
+
+	return untilChange, nil
+}
+
+// deleteMutingRule deletes the muting rule from New Relic and removes the finalizer once it is gone
+func (r *AlertsMutingRuleReconciler) deleteMutingRule(rc *requestContext, rule *nrv1.AlertsMutingRule) error {
+	if !containsString(rule.Finalizers, alertsMutingRuleDeleteFinalizer) {
+		return nil
+	}
+
+	defer rc.txn.StartSegment("deleteMutingRule").End()
+
+	if rule.Status.MutingRuleID != 0 {
+		r.Log.Info("Deleting muting rule", "mutingRuleName", rule.Spec.Name, "mutingRuleId", rule.Status.MutingRuleID)
+
+		err := rc.alerts.DeleteMutingRule(rc.accountID, rule.Status.MutingRuleID)
+		if err != nil && !isNotFound(err) {
+			r.Log.Error(err, "Failed to delete muting rule",
+				"mutingRuleId", rule.Status.MutingRuleID,
+				"region", rc.region,
+				"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+			)
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, rule, nrv1.ReasonDeleteFailed, err)
+			return err
+		}
+
+		r.Recorder.Eventf(rule, v1.EventTypeNormal, eventReasonDeleted, "Deleted New Relic muting rule %d", rule.Status.MutingRuleID)
+	}
+
+	// remove our finalizer from the list and update it.
+	rule.Finalizers = removeString(rule.Finalizers, alertsMutingRuleDeleteFinalizer)
+	if err := r.Client.Update(rc.ctx, rule); err != nil {
+		r.Log.Error(err, "Failed to update muting rule after deleting New Relic muting rule")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,234 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+)
+
+var _ = Describe("AlertsMutingRule reconciliation", func() {
+	var (
+		ctx            context.Context
+		r              *AlertsMutingRuleReconciler
+		rule           *nrv1.AlertsMutingRule
+		namespacedName types.NamespacedName
+		alertsClient   *interfacesfakes.FakeNewRelicAlertsClient
+		now            time.Time
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Now().UTC()
+		alertsClient = &interfacesfakes.FakeNewRelicAlertsClient{}
+		alertsClient.CreateMutingRuleReturns(&alerts.MutingRule{ID: 42}, nil)
+		alertsClient.UpdateMutingRuleReturns(&alerts.MutingRule{ID: 42}, nil)
+
+		r = &AlertsMutingRuleReconciler{
+			Client:   k8sClient,
+			Log:      logf.Log,
+			Recorder: record.NewFakeRecorder(100),
+			AlertClientFunc: func(string, string) (interfaces.NewRelicAlertsClient, error) {
+				return alertsClient, nil
+			},
+			NewRelicAgent: newrelic.Application{},
+		}
+
+		rule = &nrv1.AlertsMutingRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "maintenance",
+				Namespace: "default",
+			},
+			Spec: nrv1.AlertsMutingRuleSpec{
+				Name:    "maintenance",
+				Enabled: true,
+				Condition: nrv1.AlertsMutingRuleConditionGroup{
+					Operator: "AND",
+					Conditions: []nrv1.AlertsMutingRuleCondition{
+						{Attribute: "policyName", Operator: "EQUALS", Values: []string{"shop"}},
+					},
+				},
+				Schedule: &nrv1.AlertsMutingRuleSchedule{
+					StartTime: now.Add(-time.Hour).Format(nrv1.MutingRuleTimeLayout),
+					EndTime:   now.Add(time.Hour).Format(nrv1.MutingRuleTimeLayout),
+					TimeZone:  "UTC",
+				},
+				APIKey:    "api-key",
+				Region:    "US",
+				AccountID: 1,
+			},
+			Status: nrv1.AlertsMutingRuleStatus{
+				AppliedSpec: &nrv1.AlertsMutingRuleSpec{},
+			},
+		}
+		namespacedName = types.NamespacedName{Namespace: "default", Name: "maintenance"}
+
+		Expect(k8sClient.Create(ctx, rule)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		var current nrv1.AlertsMutingRule
+		if err := k8sClient.Get(ctx, namespacedName, &current); err == nil {
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	Context("when creating a muting rule", func() {
+		It("creates the rule and reports that it is active", func() {
+			result, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.CreateMutingRuleCallCount()).To(Equal(1))
+			accountID, input := alertsClient.CreateMutingRuleArgsForCall(0)
+			Expect(accountID).To(Equal(1))
+			Expect(input.Name).To(Equal("maintenance"))
+			Expect(input.Schedule.TimeZone).To(Equal("UTC"))
+
+			var updated nrv1.AlertsMutingRule
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.MutingRuleID).To(Equal(42))
+			Expect(updated.Status.Active).To(BeTrue())
+			Expect(updated.Finalizers).To(ContainElement(alertsMutingRuleDeleteFinalizer))
+			Expect(nrv1.IsConditionTrue(updated.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+
+			// requeued when the schedule ends
+			Expect(result.RequeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+		})
+
+		It("reports a rule whose schedule has not started as inactive", func() {
+			var current nrv1.AlertsMutingRule
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			current.Spec.Schedule.StartTime = now.Add(2 * time.Hour).Format(nrv1.MutingRuleTimeLayout)
+			current.Spec.Schedule.EndTime = now.Add(3 * time.Hour).Format(nrv1.MutingRuleTimeLayout)
+			Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+
+			result, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			var updated nrv1.AlertsMutingRule
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.Active).To(BeFalse())
+			Expect(result.RequeueAfter).To(BeNumerically("~", 2*time.Hour, time.Minute))
+		})
+
+		It("records a failure to create the rule", func() {
+			alertsClient.CreateMutingRuleReturns(nil, errors.New("invalid condition"))
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(MatchError("invalid condition"))
+
+			var updated nrv1.AlertsMutingRule
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.MutingRuleID).To(BeZero())
+			failed := nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionError)
+			Expect(failed).ToNot(BeNil())
+			Expect(failed.Reason).To(Equal(nrv1.ReasonCreateFailed))
+		})
+	})
+
+	Context("when the rule already exists", func() {
+		BeforeEach(func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("updates the rule", func() {
+			var current nrv1.AlertsMutingRule
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			current.Spec.Enabled = false
+			Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.UpdateMutingRuleCallCount()).To(Equal(1))
+			accountID, ruleID, input := alertsClient.UpdateMutingRuleArgsForCall(0)
+			Expect(accountID).To(Equal(1))
+			Expect(ruleID).To(Equal(42))
+			Expect(input.Enabled).To(BeFalse())
+
+			var updated nrv1.AlertsMutingRule
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.Active).To(BeFalse())
+		})
+
+		It("does not call New Relic when nothing changed", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.CreateMutingRuleCallCount()).To(Equal(1))
+			Expect(alertsClient.UpdateMutingRuleCallCount()).To(Equal(0))
+			Expect(alertsClient.GetMutingRuleCallCount()).To(Equal(0))
+		})
+
+		It("reports drift when a resync interval is set", func() {
+			r.ResyncInterval = time.Minute
+			alertsClient.GetMutingRuleReturns(&alerts.MutingRule{ID: 42, Name: "maintenance", Enabled: false}, nil)
+
+			result, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Minute))
+			Expect(alertsClient.UpdateMutingRuleCallCount()).To(Equal(0))
+
+			var updated nrv1.AlertsMutingRule
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			drifted := nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionDrifted)
+			Expect(drifted).ToNot(BeNil())
+			Expect(drifted.Message).To(ContainSubstring("enabled is false, expected true"))
+		})
+	})
+
+	Context("when deleting a muting rule", func() {
+		BeforeEach(func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deletes the rule from New Relic and removes the finalizer", func() {
+			var current nrv1.AlertsMutingRule
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(alertsClient.DeleteMutingRuleCallCount()).To(Equal(1))
+			accountID, ruleID := alertsClient.DeleteMutingRuleArgsForCall(0)
+			Expect(accountID).To(Equal(1))
+			Expect(ruleID).To(Equal(42))
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).ToNot(Succeed())
+		})
+
+		It("keeps the finalizer when New Relic fails to delete the rule", func() {
+			alertsClient.DeleteMutingRuleReturns(errors.New("server error"))
+
+			var current nrv1.AlertsMutingRule
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(HaveOccurred())
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(current.Finalizers).To(ContainElement(alertsMutingRuleDeleteFinalizer))
+
+			// let the AfterEach clean up
+			alertsClient.DeleteMutingRuleReturns(nil)
+		})
+	})
+})
@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	customErrors "github.com/newrelic/newrelic-kubernetes-operator/errors"
+)
+
+// Labels identifying the object a generated burn rate AlertsNrqlCondition belongs to.
+const (
+	burnRateSourceKindLabel = "nr.k8s.newrelic.com/burn-rate-source-kind"
+	burnRateSourceNameLabel = "nr.k8s.newrelic.com/burn-rate-source-name"
+)
+
+// burnRateOwner is a resource generating burn rate AlertsNrqlConditions
+type burnRateOwner interface {
+	runtime.Object
+	accountObject
+}
+
+// burnRateConditionName returns the name of the AlertsNrqlCondition generated by owner for burnRate
+func burnRateConditionName(owner metav1.Object, burnRate string) string {
+	name := monitorNameInvalidChars.ReplaceAllString(strings.ToLower(owner.GetName()+"-"+burnRate), "-")
+
+	return strings.Trim(name, ".-")
+}
+
+// syncBurnRateConditions makes the AlertsNrqlConditions generated by owner match specs, which are keyed
+// by the name of their burn rate. The conditions are added to the New Relic policy policyID with the
+// account settings of owner. They are owned by owner, so they are garbage collected together with it.
+// Conditions of burn rates that are no longer present are deleted.
+func syncBurnRateConditions(ctx context.Context, k8sClient client.Client, scheme *runtime.Scheme, owner burnRateOwner, kind string, policyID string, specs map[string]nrv1.AlertsNrqlConditionSpec) error {
+	collectedErrors := new(customErrors.ErrorCollector)
+
+	desired := map[string]bool{}
+	account := owner.GetAccountSettings()
+	if account.APIKey != "" {
+		// only the secret reference is passed on, resolveCredentials moves the key before the conditions are synced
+		return fmt.Errorf("api_key of %s %s has not been moved to a secret yet", kind, owner.GetName())
+	}
+
+	for burnRate, spec := range specs {
+		condition := &nrv1.AlertsNrqlCondition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      burnRateConditionName(owner, burnRate),
+				Namespace: owner.GetNamespace(),
+			},
+		}
+		desired[condition.Name] = true
+
+		_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, condition, func() error {
+			if condition.Labels == nil {
+				condition.Labels = map[string]string{}
+			}
+			condition.Labels[burnRateSourceKindLabel] = kind
+			condition.Labels[burnRateSourceNameLabel] = owner.GetName()
+
+			// only the generated fields are set, the webhook defaults the rest
+			condition.Spec.Name = spec.Name
+			condition.Spec.Enabled = spec.Enabled
+			condition.Spec.ConditionType = spec.ConditionType
+			condition.Spec.Nrql = spec.Nrql
+			condition.Spec.Signal = spec.Signal
+			condition.Spec.Terms = spec.Terms
+			condition.Spec.ExistingPolicyID = policyID
+			condition.Spec.APIKey = ""
+			condition.Spec.APIKeySecret = account.APIKeySecret
+			condition.Spec.AccountRef = account.AccountRef
+			condition.Spec.Region = account.Region
+			condition.Spec.AccountID = account.AccountID
+
+			return controllerutil.SetControllerReference(owner, condition, scheme)
+		})
+		if err != nil {
+			collectedErrors.Collect(fmt.Errorf("failed to write AlertsNrqlCondition %s: %w", condition.Name, err))
+		}
+	}
+
+	var existing nrv1.AlertsNrqlConditionList
+
+	err := k8sClient.List(ctx, &existing,
+		client.InNamespace(owner.GetNamespace()),
+		client.MatchingLabels{burnRateSourceKindLabel: kind, burnRateSourceNameLabel: owner.GetName()},
+	)
+	if err != nil {
+		collectedErrors.Collect(err)
+	}
+
+	for i := range existing.Items {
+		condition := &existing.Items[i]
+		if desired[condition.Name] || !metav1.IsControlledBy(condition, owner) {
+			continue
+		}
+
+		if err := k8sClient.Delete(ctx, condition); client.IgnoreNotFound(err) != nil {
+			collectedErrors.Collect(fmt.Errorf("failed to delete AlertsNrqlCondition %s: %w", condition.Name, err))
+		}
+	}
+
+	if len(*collectedErrors) > 0 {
+		return collectedErrors
+	}
+
+	return nil
+}
+
+// alertsPolicyIDByRef returns the ID New Relic assigned to the AlertsPolicy name in namespace
+func alertsPolicyIDByRef(ctx context.Context, k8sClient client.Client, namespace string, name string) (string, error) {
+	var policy nrv1.AlertsPolicy
+
+	key := client.ObjectKey{Namespace: namespace, Name: name}
+	if err := k8sClient.Get(ctx, key, &policy); err != nil {
+		if kErr.IsNotFound(err) {
+			return "", fmt.Errorf("AlertsPolicy %s not found", key)
+		}
+		return "", err
+	}
+
+	if policy.Status.PolicyID == "" {
+		return "", fmt.Errorf("AlertsPolicy %s has not been created in New Relic yet", key)
+	}
+
+	return policy.Status.PolicyID, nil
+}
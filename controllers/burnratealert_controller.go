@@ -0,0 +1,138 @@
+package controllers
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+)
+
+const burnRateAlertKind = "BurnRateAlert"
+
+// BurnRateAlertReconciler reconciles a BurnRateAlert object. It does not call New Relic itself, the
+// generated AlertsNrqlConditions are reconciled by their own controller.
+type BurnRateAlertReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=burnratealerts,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=burnratealerts/status,verbs=get;update;patch
+
+// Reconcile is responsible for reconciling the spec and state of the BurnRateAlert.
+func (r *BurnRateAlertReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Alerts/BurnRateAlert")
+	defer rc.txn.End()
+
+	var alert nrv1.BurnRateAlert
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &alert)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			r.Log.Info("BurnRateAlert 'not found' after being deleted. This is expected and no cause for alarm", "error", err)
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET BurnRateAlert", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	// the generated conditions are garbage collected together with the BurnRateAlert
+	if !alert.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	// the generated conditions reference the secret holding the api key, never the raw key
+	if err := moveAPIKeyToSecret(rc, r.Client, &alert, alert.Spec.APIKeySecret); err != nil {
+		r.Log.Error(err, "failed to move api_key of burn rate alert", "name", req.NamespacedName)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &alert, failureReason(err, nrv1.ReasonCreateFailed), err)
+		return ctrl.Result{}, err
+	}
+
+	policyID, err := alertsPolicyIDByRef(rc.ctx, r.Client, alert.Namespace, alert.Spec.AlertsPolicyRef)
+	if err != nil {
+		r.Log.Error(err, "failed to resolve policy of burn rate alert", "name", req.NamespacedName)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &alert, nrv1.ReasonAlertsPolicyNotFound, err)
+		return ctrl.Result{}, err
+	}
+
+	if err := r.syncConditions(rc, &alert, policyID); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+//SetupWithManager - Sets up Controller for BurnRateAlert
+func (r *BurnRateAlertReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nrv1.BurnRateAlert{}).
+		Owns(&nrv1.AlertsNrqlCondition{}).
+		Watches(&source.Kind{Type: &nrv1.AlertsPolicy{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(func(policy handler.MapObject) []reconcile.Request {
+				policyKey := alertsPolicyIndexKey(policy.Meta.GetNamespace(), policy.Meta.GetName())
+				return listRequests(context.Background(), r.Client, r.Log, &nrv1.BurnRateAlertList{}, client.MatchingFields{alertsPolicyIndexField: policyKey})
+			}),
+		}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nrv1.BurnRateAlert{}, r))
+}
+
+// syncConditions makes the AlertsNrqlConditions of the burn rate alert match its spec and records the
+// result on its status. The conditions are synced on every reconcile, so changes made to them directly
+// are overwritten.
+func (r *BurnRateAlertReconciler) syncConditions(rc *requestContext, alert *nrv1.BurnRateAlert, policyID string) error {
+	defer rc.txn.StartSegment("syncConditions").End()
+
+	reason := nrv1.ReasonCreateFailed
+	eventReason := eventReasonCreated
+
+	if alert.Status.AlertsPolicyID != "" {
+		reason = nrv1.ReasonUpdateFailed
+		eventReason = eventReasonUpdated
+	}
+
+	err := syncBurnRateConditions(rc.ctx, r.Client, r.Scheme, alert, burnRateAlertKind, policyID, alert.Spec.BurnRateConditionSpecs())
+	if err != nil {
+		r.Log.Error(err, "failed to write burn rate conditions", "name", alert.Name, "policyId", policyID)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, alert, reason, err)
+		return err
+	}
+
+	if reflect.DeepEqual(alert.Spec.Redacted(), alert.Status.AppliedSpec) && alert.Status.AlertsPolicyID == policyID {
+		if err := updateReadyConditionsIfNeeded(rc.ctx, r.Client, alert); err != nil {
+			r.Log.Error(err, "tried updating burn rate alert status", "name", alert.Name)
+			return err
+		}
+		return nil
+	}
+
+	alert.Status.AlertsPolicyID = policyID
+	alert.Status.AppliedSpec = alert.Spec.Redacted()
+	r.Recorder.Eventf(alert, v1.EventTypeNormal, eventReason, "%s burn rate conditions in New Relic policy %s", eventReason, policyID)
+	setReadyConditions(alert)
+
+	if err := updateWithStatus(rc.ctx, r.Client, alert); err != nil {
+		r.Log.Error(err, "tried updating burn rate alert status", "name", alert.Name)
+		return err
+	}
+
+	return nil
+}
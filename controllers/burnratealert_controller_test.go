@@ -0,0 +1,162 @@
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+)
+
+var _ = Describe("BurnRateAlert reconciliation", func() {
+	var (
+		ctx            context.Context
+		r              *BurnRateAlertReconciler
+		alert          *nrv1.BurnRateAlert
+		policy         *nrv1.AlertsPolicy
+		namespacedName types.NamespacedName
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		r = &BurnRateAlertReconciler{
+			Client:        k8sClient,
+			Log:           logf.Log,
+			Scheme:        scheme.Scheme,
+			Recorder:      record.NewFakeRecorder(100),
+			NewRelicAgent: newrelic.Application{},
+		}
+
+		policy = &nrv1.AlertsPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "burn-rate-policy",
+				Namespace: "default",
+			},
+			Spec: nrv1.AlertsPolicySpec{
+				Name:   "burn rate policy",
+				APIKey: "api-key",
+				Region: "US",
+			},
+			Status: nrv1.AlertsPolicyStatus{
+				PolicyID: "42",
+			},
+		}
+		Expect(createWithStatus(ctx, policy)).To(Succeed())
+
+		alert = &nrv1.BurnRateAlert{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "checkout-errors",
+				Namespace: "default",
+			},
+			Spec: nrv1.BurnRateAlertSpec{
+				Name:            "checkout errors",
+				AlertsPolicyRef: "burn-rate-policy",
+				Objective:       nrv1.BurnRateObjective{Target: "99.9", TimeWindowDays: 30},
+				Events: nrv1.BurnRateAlertEvents{
+					ValidEvents: nrv1.ServiceLevelEventsQuery{From: "Transaction"},
+					GoodEvents:  nrv1.ServiceLevelEventsQuery{From: "Transaction", Where: "error IS NULL"},
+				},
+				APIKey:    "api-key",
+				Region:    "US",
+				AccountID: 1,
+			},
+			Status: nrv1.BurnRateAlertStatus{
+				AppliedSpec: &nrv1.BurnRateAlertSpec{},
+			},
+		}
+		namespacedName = types.NamespacedName{Namespace: "default", Name: "checkout-errors"}
+		Expect(k8sClient.Create(ctx, alert)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, alert)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, policy)).To(Succeed())
+
+		// the test environment does not garbage collect the generated conditions
+		Expect(k8sClient.DeleteAllOf(ctx, &nrv1.AlertsNrqlCondition{},
+			client.InNamespace("default"),
+			client.MatchingLabels{burnRateSourceKindLabel: burnRateAlertKind},
+		)).To(Succeed())
+	})
+
+	It("generates a fast-burn and a slow-burn condition in the policy", func() {
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		var conditions nrv1.AlertsNrqlConditionList
+		Expect(k8sClient.List(ctx, &conditions, client.MatchingLabels{burnRateSourceNameLabel: "checkout-errors"})).To(Succeed())
+		Expect(conditions.Items).To(HaveLen(2))
+
+		var fastBurn nrv1.AlertsNrqlCondition
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "checkout-errors-fast-burn"}, &fastBurn)).To(Succeed())
+		Expect(fastBurn.Spec.ExistingPolicyID).To(Equal("42"))
+		Expect(fastBurn.Spec.Terms[0].Threshold).To(Equal("1.44"))
+		Expect(fastBurn.Spec.Nrql.Query).To(ContainSubstring("error IS NULL"))
+
+		var updated nrv1.BurnRateAlert
+		Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+		Expect(metav1.IsControlledBy(&fastBurn, &updated)).To(BeTrue())
+		Expect(updated.Spec.APIKey).To(BeEmpty())
+		Expect(fastBurn.Spec.APIKey).To(BeEmpty())
+		Expect(fastBurn.Spec.APIKeySecret).To(Equal(updated.Spec.APIKeySecret))
+		Expect(updated.Status.AlertsPolicyID).To(Equal("42"))
+		Expect(nrv1.IsConditionTrue(updated.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+	})
+
+	It("updates the conditions when the objective changes", func() {
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		var current nrv1.BurnRateAlert
+		Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+		current.Spec.Objective.Target = "99"
+		Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+
+		_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		var fastBurn nrv1.AlertsNrqlCondition
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "checkout-errors-fast-burn"}, &fastBurn)).To(Succeed())
+		Expect(fastBurn.Spec.Terms[0].Threshold).To(Equal("14.4"))
+	})
+
+	It("overwrites changes made to a generated condition", func() {
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		var fastBurn nrv1.AlertsNrqlCondition
+		key := types.NamespacedName{Namespace: "default", Name: "checkout-errors-fast-burn"}
+		Expect(k8sClient.Get(ctx, key, &fastBurn)).To(Succeed())
+		fastBurn.Spec.Terms[0].Threshold = "50"
+		Expect(k8sClient.Update(ctx, &fastBurn)).To(Succeed())
+
+		_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, key, &fastBurn)).To(Succeed())
+		Expect(fastBurn.Spec.Terms[0].Threshold).To(Equal("1.44"))
+	})
+
+	It("records a policy that has not been created yet", func() {
+		policy.Status.PolicyID = ""
+		Expect(k8sClient.Status().Update(ctx, policy)).To(Succeed())
+
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).To(HaveOccurred())
+
+		var updated nrv1.BurnRateAlert
+		Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+		Expect(nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionError).Reason).To(Equal(nrv1.ReasonAlertsPolicyNotFound))
+	})
+})
@@ -0,0 +1,222 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+)
+
+// These tests reconcile many objects in parallel with a single reconciler, the way the
+// controller does with MaxConcurrentReconciles > 1. Run them with -race to catch shared state.
+var _ = Describe("concurrent reconciliation", func() {
+	const workers = 10
+
+	var (
+		ctx             context.Context
+		clientsByAPIKey map[string]*interfacesfakes.FakeNewRelicAlertsClient
+		mu              sync.Mutex
+		fakeAlertFunc   func(string, string) (interfaces.NewRelicAlertsClient, error)
+	)
+
+	// each API key gets its own fake client, so a request picking up the client of
+	// another request shows up as a wrong ID in the status of the object
+	newFakeClient := func(apiKey string) *interfacesfakes.FakeNewRelicAlertsClient {
+		fakeClient := &interfacesfakes.FakeNewRelicAlertsClient{}
+
+		fakeClient.CreatePolicyMutationStub = func(int, alerts.AlertsPolicyInput) (*alerts.AlertsPolicy, error) {
+			return &alerts.AlertsPolicy{ID: "policy-" + apiKey}, nil
+		}
+
+		fakeClient.CreateNrqlConditionStaticMutationStub = func(int, string, alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+			return &alerts.NrqlAlertCondition{ID: "condition-" + apiKey}, nil
+		}
+
+		return fakeClient
+	}
+
+	reconcileAll := func(reconcile func(ctrl.Request) (ctrl.Result, error), names []string) {
+		var wg sync.WaitGroup
+
+		errs := make(chan error, len(names))
+
+		for _, name := range names {
+			wg.Add(1)
+
+			go func(name string) {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				_, err := reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: name}})
+				errs <- err
+			}(name)
+		}
+
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			Expect(err).ToNot(HaveOccurred())
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		clientsByAPIKey = make(map[string]*interfacesfakes.FakeNewRelicAlertsClient)
+
+		fakeAlertFunc = func(apiKey string, region string) (interfaces.NewRelicAlertsClient, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if _, ok := clientsByAPIKey[apiKey]; !ok {
+				clientsByAPIKey[apiKey] = newFakeClient(apiKey)
+			}
+
+			return clientsByAPIKey[apiKey], nil
+		}
+	})
+
+	Context("with many AlertsPolicies", func() {
+		var (
+			r     *AlertsPolicyReconciler
+			names []string
+		)
+
+		BeforeEach(func() {
+			r = &AlertsPolicyReconciler{
+				Client:                  k8sClient,
+				Log:                     logf.Log,
+				Recorder:                record.NewFakeRecorder(1000),
+				AlertClientFunc:         fakeAlertFunc,
+				NewRelicAgent:           newrelic.Application{},
+				MaxConcurrentReconciles: workers,
+			}
+
+			names = []string{}
+
+			for i := 0; i < workers; i++ {
+				policy := &nrv1.AlertsPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("concurrent-policy-%d", i),
+						Namespace: "default",
+					},
+					Spec: nrv1.AlertsPolicySpec{
+						Name:               fmt.Sprintf("concurrent policy %d", i),
+						APIKey:             fmt.Sprintf("key-%d", i),
+						IncidentPreference: "PER_POLICY",
+						Region:             "us",
+					},
+					Status: nrv1.AlertsPolicyStatus{
+						AppliedSpec: &nrv1.AlertsPolicySpec{},
+					},
+				}
+
+				Expect(k8sClient.Create(ctx, policy)).To(Succeed())
+				names = append(names, policy.Name)
+			}
+		})
+
+		It("keeps the state of every request separate", func() {
+			reconcileAll(r.Reconcile, names)
+
+			for i, name := range names {
+				var policy nrv1.AlertsPolicy
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: name}, &policy)).To(Succeed())
+				Expect(policy.Status.PolicyID).To(Equal(fmt.Sprintf("policy-key-%d", i)))
+				Expect(nrv1.IsConditionTrue(policy.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+			}
+		})
+
+		AfterEach(func() {
+			for _, name := range names {
+				policy := &nrv1.AlertsPolicy{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+				Expect(k8sClient.Delete(ctx, policy)).To(Succeed())
+			}
+
+			reconcileAll(r.Reconcile, names)
+		})
+	})
+
+	Context("with many AlertsNrqlConditions", func() {
+		var (
+			r     *AlertsNrqlConditionReconciler
+			names []string
+		)
+
+		BeforeEach(func() {
+			r = &AlertsNrqlConditionReconciler{
+				Client:                  k8sClient,
+				Log:                     logf.Log,
+				Recorder:                record.NewFakeRecorder(1000),
+				AlertClientFunc:         fakeAlertFunc,
+				NewRelicAgent:           newrelic.Application{},
+				MaxConcurrentReconciles: workers,
+			}
+
+			names = []string{}
+
+			for i := 0; i < workers; i++ {
+				condition := &nrv1.AlertsNrqlCondition{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("concurrent-condition-%d", i),
+						Namespace: "default",
+					},
+					Spec: nrv1.AlertsNrqlConditionSpec{
+						AlertsGenericConditionSpec: nrv1.AlertsGenericConditionSpec{
+							Name:             fmt.Sprintf("concurrent condition %d", i),
+							Type:             "NRQL",
+							Enabled:          true,
+							ExistingPolicyID: "1234",
+							APIKey:           fmt.Sprintf("key-%d", i),
+							Region:           "us",
+						},
+						AlertsNrqlSpecificSpec: nrv1.AlertsNrqlSpecificSpec{
+							Nrql: alerts.NrqlConditionQuery{
+								Query: "SELECT 1 FROM MyEvents",
+							},
+						},
+					},
+					Status: nrv1.AlertsNrqlConditionStatus{
+						AppliedSpec: &nrv1.AlertsNrqlConditionSpec{},
+					},
+				}
+
+				Expect(k8sClient.Create(ctx, condition)).To(Succeed())
+				names = append(names, condition.Name)
+			}
+		})
+
+		It("keeps the state of every request separate", func() {
+			reconcileAll(r.Reconcile, names)
+
+			for i, name := range names {
+				var condition nrv1.AlertsNrqlCondition
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: name}, &condition)).To(Succeed())
+				Expect(condition.Status.ConditionID).To(Equal(fmt.Sprintf("condition-key-%d", i)))
+			}
+		})
+
+		AfterEach(func() {
+			for _, name := range names {
+				condition := &nrv1.AlertsNrqlCondition{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+				Expect(k8sClient.Delete(ctx, condition)).To(Succeed())
+			}
+
+			reconcileAll(r.Reconcile, names)
+		})
+	})
+})
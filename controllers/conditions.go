@@ -0,0 +1,90 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/metrics"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/redact"
+)
+
+// setReadyConditions marks the object as successfully reconciled against the New Relic API
+func setReadyConditions(obj nrv1.ConditionedObject) {
+	conditions := obj.GetConditions()
+	nrv1.SetReadyConditions(&conditions, obj.GetGeneration())
+	obj.SetConditions(conditions)
+	metrics.SetObjectInError(kindOf(obj), metricsKey(obj), false)
+}
+
+// setFailedConditions marks the object as failing to reconcile for the given reason
+func setFailedConditions(obj nrv1.ConditionedObject, reason string, err error) {
+	metrics.SetObjectInError(kindOf(obj), metricsKey(obj), true)
+
+	// the message of the conditions is part of the status, credentials of the spec must not end up in it
+	if err != nil {
+		err = errors.New(redact.Text(err.Error(), obj))
+	}
+
+	conditions := obj.GetConditions()
+
+	// Every status change triggers another reconciliation, so a failure that is already
+	// recorded is left untouched to avoid re-triggering reconciliation on each retry.
+	current := nrv1.FindCondition(conditions, nrv1.ConditionError)
+	if current != nil && current.Status == v1.ConditionTrue && current.Reason == reason && err != nil && current.Message == err.Error() {
+		return
+	}
+
+	nrv1.SetFailedConditions(&conditions, obj.GetGeneration(), reason, err)
+	obj.SetConditions(conditions)
+}
+
+// updateFailedConditions records the failure on the object, emits a warning event and persists it.
+// Errors from the update are only logged so the original failure remains the one returned by the reconciler.
+func updateFailedConditions(ctx context.Context, k8sClient client.Client, recorder record.EventRecorder, log logr.Logger, obj nrv1.ConditionedObject, reason string, err error) {
+	recordFailure(recorder, obj, reason, err)
+	setFailedConditions(obj, reason, err)
+
+	if updateErr := updateWithStatus(ctx, k8sClient, obj); updateErr != nil {
+		log.Error(updateErr, "failed to record error conditions", "name", obj.GetName(), "namespace", obj.GetNamespace())
+	}
+}
+
+// updateReadyConditionsIfNeeded marks an already reconciled object as ready when it
+// is not, e.g. after recovering from an error that did not require any API changes.
+func updateReadyConditionsIfNeeded(ctx context.Context, k8sClient client.Client, obj nrv1.ConditionedObject) error {
+	if nrv1.IsConditionTrue(obj.GetConditions(), nrv1.ConditionReady) {
+		return nil
+	}
+
+	setReadyConditions(obj)
+
+	return updateWithStatus(ctx, k8sClient, obj)
+}
+
+// updateWithStatus persists the metadata and spec of obj, e.g. an added finalizer, followed by its status.
+// The status is a subresource that Update ignores, and Update replaces obj with the stored object, so the
+// desired status is put back before it is written through the status writer.
+func updateWithStatus(ctx context.Context, k8sClient client.Client, obj runtime.Object) error {
+	desired := obj.DeepCopyObject()
+
+	if err := k8sClient.Update(ctx, obj); err != nil {
+		return err
+	}
+
+	copyStatus(obj, desired)
+
+	return k8sClient.Status().Update(ctx, obj)
+}
+
+// copyStatus sets the Status field of dst, a pointer to one of the nrv1 types, to the status of src.
+func copyStatus(dst runtime.Object, src runtime.Object) {
+	reflect.ValueOf(dst).Elem().FieldByName("Status").Set(reflect.ValueOf(src).Elem().FieldByName("Status"))
+}
@@ -0,0 +1,84 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+)
+
+// accountObject is implemented by every resource that selects the New Relic account it is
+// managed in through an account_ref or an inline api_key or api_key_secret.
+type accountObject interface {
+	metav1.Object
+	GetAccountSettings() nrv1.AccountSettings
+}
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=create;get;list;watch;update
+
+// resolveCredentials looks up the API key, region and account ID of the object and stores them on the request context.
+// An inline api_key the webhook didn't move to a secret is moved afterwards.
+func resolveCredentials(rc *requestContext, k8sClient client.Client, obj accountObject) error {
+	defer rc.txn.StartSegment("resolveCredentials").End()
+
+	credentials, err := nrv1.ResolveCredentials(rc.ctx, k8sClient, obj.GetNamespace(), obj.GetAccountSettings())
+	if err != nil {
+		return err
+	}
+
+	if credentials.APIKey == "" {
+		return errors.New("api key is blank")
+	}
+
+	rc.apiKey = credentials.APIKey
+	rc.region = credentials.Region
+	rc.accountID = credentials.AccountID
+
+	if apiKeyObj, ok := obj.(nrv1.APIKeyObject); ok {
+		return moveAPIKeyToSecret(rc, k8sClient, apiKeyObj, obj.GetAccountSettings().APIKeySecret)
+	}
+
+	return nil
+}
+
+// moveAPIKeyToSecret writes an inline api_key of obj to an operator managed secret owned by obj and updates
+// obj to reference the secret instead. The mutating webhook already does so on admission, this covers
+// resources admitted without it. The secrets the webhook wrote for a new resource are adopted by obj, so they
+// are garbage collected with it. Objects that are being deleted are left as they are.
+func moveAPIKeyToSecret(rc *requestContext, k8sClient client.Client, obj nrv1.APIKeyObject, apiKeySecret nrv1.NewRelicAPIKeySecret) error {
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return nil
+	}
+
+	defer rc.txn.StartSegment("moveAPIKeyToSecret").End()
+
+	moved, err := nrv1.MoveAPIKeysToSecrets(rc.ctx, k8sClient, obj)
+	if err != nil {
+		return fmt.Errorf("failed to move api_key to a secret: %w", err)
+	}
+
+	if moved {
+		return k8sClient.Update(rc.ctx, obj)
+	}
+
+	return nrv1.AdoptAPIKeySecrets(rc.ctx, k8sClient, obj, apiKeySecret)
+}
+
+// failureReason returns the condition reason for err. Missing secrets and secret keys get their own
+// reasons so they can be told apart from failures of the New Relic API, otherwise reason is returned.
+func failureReason(err error, reason string) string {
+	var secretNotFound *nrv1.SecretNotFoundError
+	var secretKeyNotFound *nrv1.SecretKeyNotFoundError
+
+	switch {
+	case errors.As(err, &secretNotFound):
+		return nrv1.ReasonSecretNotFound
+	case errors.As(err, &secretKeyNotFound):
+		return nrv1.ReasonSecretKeyNotFound
+	default:
+		return reason
+	}
+}
@@ -0,0 +1,307 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+)
+
+const (
+	dashboardDeleteFinalizer = "dashboards.finalizers.nr.k8s.newrelic.com"
+
+	// dashboardNotFoundError is the type of the error NerdGraph returns when deleting a dashboard that does not exist
+	dashboardNotFoundError = "DASHBOARD_NOT_FOUND"
+)
+
+// DashboardReconciler reconciles a Dashboard object
+type DashboardReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	DashboardsClientFunc    func(string, string) (interfaces.NewRelicDashboardsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+	ResyncInterval          time.Duration
+	CorrectDrift            bool
+}
+
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=dashboards,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=dashboards/status,verbs=get;update;patch
+
+// Reconcile is responsible for reconciling the spec and state of the Dashboard.
+func (r *DashboardReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Dashboards/Dashboard")
+	defer rc.txn.End()
+
+	var dashboard nrv1.Dashboard
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &dashboard)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			r.Log.Info("Dashboard 'not found' after being deleted. This is expected and no cause for alarm", "error", err)
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET Dashboard", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	err = resolveCredentials(rc, r.Client, &dashboard)
+	if err != nil {
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &dashboard, failureReason(err, nrv1.ReasonCredentialsError), err)
+		return ctrl.Result{}, err
+	}
+
+	dashboardsClient, errDashboardsClient := r.DashboardsClientFunc(rc.apiKey, rc.region)
+	if errDashboardsClient != nil {
+		r.Log.Error(errDashboardsClient, "Failed to create Dashboards Client")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &dashboard, nrv1.ReasonCredentialsError, errDashboardsClient)
+		return ctrl.Result{}, errDashboardsClient
+	}
+	rc.dashboards = dashboardsClient
+
+	// examine DeletionTimestamp to determine if object is under deletion
+	if dashboard.DeletionTimestamp.IsZero() {
+		if !containsString(dashboard.Finalizers, dashboardDeleteFinalizer) {
+			dashboard.Finalizers = append(dashboard.Finalizers, dashboardDeleteFinalizer)
+		}
+	} else {
+		return ctrl.Result{}, r.deleteDashboard(rc, &dashboard)
+	}
+
+	if reflect.DeepEqual(dashboard.Spec.Redacted(), dashboard.Status.AppliedSpec) {
+		drifted, err := r.checkForDashboardDrift(rc, &dashboard)
+		if err != nil {
+			r.Log.Error(err, "failed to resync dashboard with New Relic", "name", req.NamespacedName)
+			recordFailure(r.Recorder, &dashboard, eventReasonResyncFailed, err)
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
+
+		if !drifted {
+			if err := updateReadyConditionsIfNeeded(rc.ctx, r.Client, &dashboard); err != nil {
+				r.Log.Error(err, "tried updating dashboard status", "name", req.NamespacedName)
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
+	}
+
+	r.Log.Info("Reconciling", "dashboard", dashboard.Name)
+
+	if err := r.writeDashboard(rc, &dashboard); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+//SetupWithManager - Sets up Controller for Dashboard
+func (r *DashboardReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nrv1.Dashboard{}).
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nrv1.DashboardList{} }, true)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nrv1.Dashboard{}, r))
+}
+
+// checkForDashboardDrift compares the dashboard in New Relic with the spec when a resync interval is
+// configured and records the result in the Drifted condition. It returns true when the dashboard drifted
+// and has been prepared to be written again.
+func (r *DashboardReconciler) checkForDashboardDrift(rc *requestContext, dashboard *nrv1.Dashboard) (bool, error) {
+	if r.ResyncInterval == 0 || dashboard.Status.DashboardGUID == "" {
+		return false, nil
+	}
+
+	defer rc.txn.StartSegment("checkForDashboardDrift").End()
+
+	remoteDashboard, err := rc.dashboards.GetDashboardEntity(entities.EntityGUID(dashboard.Status.DashboardGUID))
+	if err != nil && !isNotFound(err) {
+		r.Log.Error(err, "failed to get dashboard from New Relic API",
+			"dashboardGuid", dashboard.Status.DashboardGUID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		return false, err
+	}
+
+	var differences []string
+	if err != nil || remoteDashboard == nil {
+		differences = []string{fmt.Sprintf("dashboard %s not found in New Relic", dashboard.Status.DashboardGUID)}
+		remoteDashboard = nil
+	} else {
+		differences = dashboard.Spec.Diff(*remoteDashboard, rc.accountID)
+	}
+
+	if len(differences) == 0 || !r.CorrectDrift {
+		if setDriftedCondition(r.Recorder, dashboard, differences) {
+			return false, updateWithStatus(rc.ctx, r.Client, dashboard)
+		}
+		return false, nil
+	}
+
+	r.Log.Info("correcting drift of dashboard", "dashboardGuid", dashboard.Status.DashboardGUID, "differences", differences)
+	setDriftedCondition(r.Recorder, dashboard, differences)
+
+	if remoteDashboard == nil {
+		dashboard.Status.DashboardGUID = ""
+		dashboard.Status.Permalink = ""
+	}
+
+	// forget the applied spec so the dashboard is written again
+	dashboard.Status.AppliedSpec = &nrv1.DashboardSpec{}
+
+	return true, nil
+}
+
+// writeDashboard creates or updates the dashboard through NerdGraph and records the result
+// on the status of the Dashboard
+func (r *DashboardReconciler) writeDashboard(rc *requestContext, dashboard *nrv1.Dashboard) error {
+	defer rc.txn.StartSegment("writeDashboard").End()
+
+	input := dashboard.Spec.ToDashboardInput(rc.accountID)
+
+	reason := nrv1.ReasonCreateFailed
+	eventReason := eventReasonCreated
+
+	var err error
+
+	if dashboard.Status.DashboardGUID != "" {
+		r.Log.Info("updating dashboard", "dashboardName", dashboard.Spec.Name, "dashboardGuid", dashboard.Status.DashboardGUID)
+		reason = nrv1.ReasonUpdateFailed
+		eventReason = eventReasonUpdated
+
+		result, updateErr := rc.dashboards.DashboardUpdate(input, entities.EntityGUID(dashboard.Status.DashboardGUID))
+		err = updateErr
+		if err == nil {
+			var messages []string
+			for _, resultErr := range result.Errors {
+				messages = append(messages, resultErr.Description)
+			}
+			err = dashboardError(messages)
+		}
+	} else {
+		r.Log.Info("creating dashboard", "dashboardName", dashboard.Spec.Name, "accountId", rc.accountID)
+
+		result, createErr := rc.dashboards.DashboardCreate(rc.accountID, input)
+		err = createErr
+		if err == nil {
+			var messages []string
+			for _, resultErr := range result.Errors {
+				messages = append(messages, resultErr.Description)
+			}
+
+			if err = dashboardError(messages); err == nil {
+				dashboard.Status.DashboardGUID = string(result.EntityResult.GUID)
+			}
+		}
+	}
+
+	if err != nil {
+		r.Log.Error(err, "failed to write dashboard",
+			"dashboardGuid", dashboard.Status.DashboardGUID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, dashboard, reason, err)
+		return err
+	}
+
+	if dashboard.Status.Permalink == "" {
+		r.setPermalink(rc, dashboard)
+	}
+
+	dashboard.Status.AppliedSpec = dashboard.Spec.Redacted()
+	r.Recorder.Eventf(dashboard, v1.EventTypeNormal, eventReason, "%s New Relic dashboard %s", eventReason, dashboard.Status.DashboardGUID)
+	setReadyConditions(dashboard)
+
+	if err := updateWithStatus(rc.ctx, r.Client, dashboard); err != nil {
+		r.Log.Error(err, "tried updating dashboard status", "name", dashboard.Name)
+		return err
+	}
+
+	return nil
+}
+
+// setPermalink reads the permalink of the dashboard, which the mutations do not return. A failure
+// is only logged, the permalink is read again on the next write.
+func (r *DashboardReconciler) setPermalink(rc *requestContext, dashboard *nrv1.Dashboard) {
+	remoteDashboard, err := rc.dashboards.GetDashboardEntity(entities.EntityGUID(dashboard.Status.DashboardGUID))
+	if err != nil || remoteDashboard == nil {
+		r.Log.Error(err, "failed to get permalink of dashboard", "dashboardGuid", dashboard.Status.DashboardGUID)
+		return
+	}
+
+	dashboard.Status.Permalink = remoteDashboard.Permalink
+}
+
+// deleteDashboard deletes the dashboard from New Relic and removes the finalizer once it is gone
+func (r *DashboardReconciler) deleteDashboard(rc *requestContext, dashboard *nrv1.Dashboard) error {
+	if !containsString(dashboard.Finalizers, dashboardDeleteFinalizer) {
+		return nil
+	}
+
+	defer rc.txn.StartSegment("deleteDashboard").End()
+
+	if dashboard.Status.DashboardGUID != "" {
+		r.Log.Info("Deleting dashboard", "dashboardName", dashboard.Spec.Name, "dashboardGuid", dashboard.Status.DashboardGUID)
+
+		result, err := rc.dashboards.DashboardDelete(entities.EntityGUID(dashboard.Status.DashboardGUID))
+		if err == nil {
+			var messages []string
+			for _, resultErr := range result.Errors {
+				if string(resultErr.Type) != dashboardNotFoundError {
+					messages = append(messages, resultErr.Description)
+				}
+			}
+			err = dashboardError(messages)
+		}
+
+		if err != nil && !isNotFound(err) {
+			r.Log.Error(err, "Failed to delete dashboard",
+				"dashboardGuid", dashboard.Status.DashboardGUID,
+				"region", rc.region,
+				"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+			)
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, dashboard, nrv1.ReasonDeleteFailed, err)
+			return err
+		}
+
+		r.Recorder.Eventf(dashboard, v1.EventTypeNormal, eventReasonDeleted, "Deleted New Relic dashboard %s", dashboard.Status.DashboardGUID)
+	}
+
+	// remove our finalizer from the list and update it.
+	dashboard.Finalizers = removeString(dashboard.Finalizers, dashboardDeleteFinalizer)
+	if err := r.Client.Update(rc.ctx, dashboard); err != nil {
+		r.Log.Error(err, "Failed to update dashboard after deleting New Relic dashboard")
+		return err
+	}
+
+	return nil
+}
+
+// dashboardError turns the errors NerdGraph reports in the result of a dashboard mutation into an error
+func dashboardError(messages []string) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(messages, ", "))
+}
@@ -0,0 +1,243 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/dashboards"
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+)
+
+var _ = Describe("Dashboard reconciliation", func() {
+	var (
+		ctx              context.Context
+		r                *DashboardReconciler
+		dashboard        *nrv1.Dashboard
+		namespacedName   types.NamespacedName
+		dashboardsClient *interfacesfakes.FakeNewRelicDashboardsClient
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dashboardsClient = &interfacesfakes.FakeNewRelicDashboardsClient{}
+		dashboardsClient.DashboardCreateReturns(&dashboards.DashboardCreateResult{
+			EntityResult: dashboards.DashboardEntityResult{GUID: "dashboard-guid"},
+		}, nil)
+		dashboardsClient.DashboardUpdateReturns(&dashboards.DashboardUpdateResult{}, nil)
+		dashboardsClient.DashboardDeleteReturns(&dashboards.DashboardDeleteResult{}, nil)
+		dashboardsClient.GetDashboardEntityReturns(&entities.DashboardEntity{
+			Permalink: "https://one.newrelic.com/redirect/entity/dashboard-guid",
+		}, nil)
+
+		r = &DashboardReconciler{
+			Client:   k8sClient,
+			Log:      logf.Log,
+			Recorder: record.NewFakeRecorder(100),
+			DashboardsClientFunc: func(string, string) (interfaces.NewRelicDashboardsClient, error) {
+				return dashboardsClient, nil
+			},
+			NewRelicAgent: newrelic.Application{},
+		}
+
+		dashboard = &nrv1.Dashboard{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-dashboard",
+				Namespace: "default",
+			},
+			Spec: nrv1.DashboardSpec{
+				Name:        "my dashboard",
+				Permissions: "PUBLIC_READ_WRITE",
+				Pages: []nrv1.DashboardPage{
+					{
+						Name: "Overview",
+						Widgets: []nrv1.DashboardWidget{
+							{
+								Title:         "Throughput",
+								Visualization: nrv1.DashboardVisualizationLine,
+								Queries:       []nrv1.DashboardWidgetQuery{{NRQL: "SELECT count(*) FROM Transaction TIMESERIES"}},
+							},
+						},
+					},
+				},
+				APIKey:    "api-key",
+				Region:    "US",
+				AccountID: 1,
+			},
+			Status: nrv1.DashboardStatus{
+				AppliedSpec: &nrv1.DashboardSpec{},
+			},
+		}
+		namespacedName = types.NamespacedName{Namespace: "default", Name: "my-dashboard"}
+
+		Expect(k8sClient.Create(ctx, dashboard)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		var current nrv1.Dashboard
+		if err := k8sClient.Get(ctx, namespacedName, &current); err == nil {
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	Context("when creating a dashboard", func() {
+		It("creates the dashboard through NerdGraph and records its GUID and permalink", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(dashboardsClient.DashboardCreateCallCount()).To(Equal(1))
+			accountID, input := dashboardsClient.DashboardCreateArgsForCall(0)
+			Expect(accountID).To(Equal(1))
+			Expect(input.Name).To(Equal("my dashboard"))
+			Expect(input.Pages[0].Widgets[0].Configuration.Line.NRQLQueries[0].AccountID).To(Equal(1))
+
+			var updated nrv1.Dashboard
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.DashboardGUID).To(Equal("dashboard-guid"))
+			Expect(updated.Status.Permalink).To(Equal("https://one.newrelic.com/redirect/entity/dashboard-guid"))
+			Expect(updated.Status.AppliedSpec).To(Equal(updated.Spec.Redacted()))
+			Expect(updated.Finalizers).To(ContainElement(dashboardDeleteFinalizer))
+			Expect(nrv1.IsConditionTrue(updated.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+		})
+
+		It("reports errors returned in the mutation result", func() {
+			dashboardsClient.DashboardCreateReturns(&dashboards.DashboardCreateResult{
+				Errors: []dashboards.DashboardCreateError{{Description: "invalid nrql"}},
+			}, nil)
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(MatchError("invalid nrql"))
+
+			var updated nrv1.Dashboard
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.DashboardGUID).To(BeEmpty())
+			failed := nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionError)
+			Expect(failed).ToNot(BeNil())
+			Expect(failed.Reason).To(Equal(nrv1.ReasonCreateFailed))
+			Expect(failed.Message).To(Equal("invalid nrql"))
+		})
+	})
+
+	Context("when updating a dashboard", func() {
+		BeforeEach(func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("updates the existing dashboard", func() {
+			var current nrv1.Dashboard
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			current.Spec.Description = "shop overview"
+			Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(dashboardsClient.DashboardCreateCallCount()).To(Equal(1))
+			Expect(dashboardsClient.DashboardUpdateCallCount()).To(Equal(1))
+			input, guid := dashboardsClient.DashboardUpdateArgsForCall(0)
+			Expect(guid).To(Equal(entities.EntityGUID("dashboard-guid")))
+			Expect(input.Description).To(Equal("shop overview"))
+			// the permalink is only read once
+			Expect(dashboardsClient.GetDashboardEntityCallCount()).To(Equal(1))
+		})
+
+		It("reports drift when a resync interval is set", func() {
+			r.ResyncInterval = time.Minute
+			dashboardsClient.GetDashboardEntityReturns(&entities.DashboardEntity{
+				Name:        "renamed in the UI",
+				Permissions: "PUBLIC_READ_WRITE",
+				Pages:       []entities.DashboardPage{},
+			}, nil)
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dashboardsClient.DashboardUpdateCallCount()).To(Equal(0))
+
+			var updated nrv1.Dashboard
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			drifted := nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionDrifted)
+			Expect(drifted).ToNot(BeNil())
+			Expect(drifted.Message).To(ContainSubstring(`name is "renamed in the UI", expected "my dashboard"`))
+			Expect(drifted.Message).To(ContainSubstring("dashboard has 0 pages, expected 1"))
+		})
+
+		It("rewrites the dashboard when drift is corrected", func() {
+			r.ResyncInterval = time.Minute
+			r.CorrectDrift = true
+			dashboardsClient.GetDashboardEntityReturns(&entities.DashboardEntity{
+				Name:        "renamed in the UI",
+				Permissions: "PUBLIC_READ_WRITE",
+			}, nil)
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dashboardsClient.DashboardUpdateCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when deleting a dashboard", func() {
+		BeforeEach(func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deletes the dashboard from New Relic and removes the finalizer", func() {
+			var current nrv1.Dashboard
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(dashboardsClient.DashboardDeleteCallCount()).To(Equal(1))
+			Expect(dashboardsClient.DashboardDeleteArgsForCall(0)).To(Equal(entities.EntityGUID("dashboard-guid")))
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).ToNot(Succeed())
+		})
+
+		It("ignores a dashboard that is already gone", func() {
+			dashboardsClient.DashboardDeleteReturns(&dashboards.DashboardDeleteResult{
+				Errors: []dashboards.DashboardDeleteError{{Type: dashboardNotFoundError, Description: "not found"}},
+			}, nil)
+
+			var current nrv1.Dashboard
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).ToNot(Succeed())
+		})
+
+		It("keeps the finalizer when New Relic fails to delete the dashboard", func() {
+			dashboardsClient.DashboardDeleteReturns(nil, errors.New("server error"))
+
+			var current nrv1.Dashboard
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(HaveOccurred())
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(current.Finalizers).To(ContainElement(dashboardDeleteFinalizer))
+
+			// let the AfterEach clean up
+			dashboardsClient.DashboardDeleteReturns(&dashboards.DashboardDeleteResult{}, nil)
+		})
+	})
+})
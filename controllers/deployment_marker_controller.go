@@ -0,0 +1,298 @@
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/apm"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+)
+
+// Annotations on Deployments that record a deployment marker in New Relic whenever the pod template changes.
+const (
+	// the APM application the marker is recorded for, either by name or by entity GUID
+	deploymentMarkerAppNameAnnotation    = "newrelic.com/deployment-marker-app-name"
+	deploymentMarkerEntityGUIDAnnotation = "newrelic.com/deployment-marker-entity-guid"
+
+	// credentials, either an account or an API key secret in the same namespace
+	deploymentMarkerAccountAnnotation         = "newrelic.com/deployment-marker-account"
+	deploymentMarkerClusterAccountAnnotation  = "newrelic.com/deployment-marker-cluster-account"
+	deploymentMarkerAPIKeySecretAnnotation    = "newrelic.com/deployment-marker-api-key-secret"
+	deploymentMarkerAPIKeySecretKeyAnnotation = "newrelic.com/deployment-marker-api-key-secret-key"
+	deploymentMarkerRegionAnnotation          = "newrelic.com/deployment-marker-region"
+
+	// deploymentMarkerRevisionAnnotation overrides the revision, which defaults to the image tag of the first container
+	deploymentMarkerRevisionAnnotation = "newrelic.com/deployment-marker-revision"
+	// deploymentMarkerChangedByAnnotation is the user the marker is recorded for
+	deploymentMarkerChangedByAnnotation = "newrelic.com/deployment-marker-changed-by"
+	// deploymentMarkerDescriptionAnnotation is the description of the marker
+	deploymentMarkerDescriptionAnnotation = "newrelic.com/deployment-marker-description"
+
+	// deploymentMarkerTemplateHashAnnotation is set by the operator to the hash of the pod template the last
+	// marker was recorded for
+	deploymentMarkerTemplateHashAnnotation = "nr.k8s.newrelic.com/deployment-marker-template-hash"
+)
+
+// DeploymentMarkerReconciler records New Relic deployment markers for rollouts of annotated Deployments
+type DeploymentMarkerReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Recorder                record.EventRecorder
+	APMClientFunc           func(string, string) (interfaces.NewRelicAPMClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+
+// Reconcile records a deployment marker for the APM application named by the annotations of a Deployment
+// each time its pod template changes
+func (r *DeploymentMarkerReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/APM/DeploymentMarker")
+	defer rc.txn.End()
+
+	var deployment appsv1.Deployment
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &deployment)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET Deployment", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	if !deployment.DeletionTimestamp.IsZero() || !deploymentMarkerEnabled(&deployment) {
+		return ctrl.Result{}, nil
+	}
+
+	templateHash, err := podTemplateHash(&deployment.Spec.Template)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	recordedHash, recorded := deployment.Annotations[deploymentMarkerTemplateHashAnnotation]
+	if recordedHash == templateHash {
+		return ctrl.Result{}, nil
+	}
+
+	// annotating an existing Deployment is not a release, only later changes of its template are
+	if recorded || deployment.Generation <= 1 {
+		if err := r.recordDeploymentMarker(rc, &deployment, templateHash); err != nil {
+			r.Log.Error(err, "failed to record deployment marker", "name", req.NamespacedName.String())
+			recordFailure(r.Recorder, &deployment, eventReasonDeploymentMarkerFailed, err)
+			return ctrl.Result{}, err
+		}
+	}
+
+	patch := client.MergeFrom(deployment.DeepCopy())
+	deployment.Annotations[deploymentMarkerTemplateHashAnnotation] = templateHash
+
+	if err := r.Client.Patch(rc.ctx, &deployment, patch); err != nil {
+		r.Log.Error(err, "failed to store pod template hash on Deployment", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager - Sets up Controller for deployment markers
+func (r *DeploymentMarkerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("deploymentmarker").
+		For(&appsv1.Deployment{}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &appsv1.Deployment{}, r))
+}
+
+// recordDeploymentMarker creates a deployment marker for the current pod template of deployment
+func (r *DeploymentMarkerReconciler) recordDeploymentMarker(rc *requestContext, deployment *appsv1.Deployment, templateHash string) error {
+	err := resolveCredentials(rc, r.Client, deploymentMarkerAccount{deployment})
+	if err != nil {
+		return err
+	}
+
+	apmClient, err := r.APMClientFunc(rc.apiKey, rc.region)
+	if err != nil {
+		r.Log.Error(err, "Failed to create APM Client")
+		return err
+	}
+	rc.apm = apmClient
+
+	applicationID, err := r.resolveApplicationID(rc, deployment)
+	if err != nil {
+		return err
+	}
+
+	marker := deploymentMarker(deployment, templateHash)
+
+	r.Log.Info("Recording deployment marker",
+		"name", deployment.Name,
+		"applicationId", applicationID,
+		"revision", marker.Revision,
+		"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+	)
+
+	created, err := rc.apm.CreateDeployment(applicationID, marker)
+	if err != nil {
+		return fmt.Errorf("failed to create deployment marker for application %d: %w", applicationID, err)
+	}
+
+	r.Recorder.Eventf(deployment, v1.EventTypeNormal, eventReasonCreated,
+		"Recorded New Relic deployment marker %d for revision %s of application %d", created.ID, marker.Revision, applicationID)
+
+	return nil
+}
+
+// resolveApplicationID returns the ID of the APM application named by the annotations of deployment
+func (r *DeploymentMarkerReconciler) resolveApplicationID(rc *requestContext, deployment *appsv1.Deployment) (int, error) {
+	if guid := deployment.Annotations[deploymentMarkerEntityGUIDAnnotation]; guid != "" {
+		return apmApplicationIDFromGUID(guid)
+	}
+
+	defer rc.txn.StartSegment("resolveApplicationID").End()
+
+	name := deployment.Annotations[deploymentMarkerAppNameAnnotation]
+
+	applications, err := rc.apm.ListApplications(&apm.ListApplicationsParams{Name: name})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list APM applications: %w", err)
+	}
+
+	// the name filter of the API matches partially
+	for _, application := range applications {
+		if application.Name == name {
+			return application.ID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("APM application %q not found", name)
+}
+
+// deploymentMarkerEnabled returns true when deployment names the APM application to record markers for
+func deploymentMarkerEnabled(deployment *appsv1.Deployment) bool {
+	return deployment.Annotations[deploymentMarkerAppNameAnnotation] != "" ||
+		deployment.Annotations[deploymentMarkerEntityGUIDAnnotation] != ""
+}
+
+// deploymentMarker returns the marker recorded for the current pod template of deployment
+func deploymentMarker(deployment *appsv1.Deployment, templateHash string) apm.Deployment {
+	annotations := deployment.Annotations
+	containers := deployment.Spec.Template.Spec.Containers
+
+	marker := apm.Deployment{
+		Revision:    annotations[deploymentMarkerRevisionAnnotation],
+		User:        annotations[deploymentMarkerChangedByAnnotation],
+		Description: annotations[deploymentMarkerDescriptionAnnotation],
+	}
+
+	if marker.Revision == "" && len(containers) > 0 {
+		marker.Revision = imageTag(containers[0].Image)
+	}
+
+	if marker.Revision == "" {
+		marker.Revision = templateHash
+	}
+
+	if marker.Description == "" {
+		marker.Description = fmt.Sprintf("Rollout of Deployment %s/%s", deployment.Namespace, deployment.Name)
+	}
+
+	images := make([]string, 0, len(containers))
+	for _, container := range containers {
+		images = append(images, container.Name+"="+container.Image)
+	}
+	marker.Changelog = strings.Join(images, ", ")
+
+	return marker
+}
+
+// imageTag returns the tag or digest of image, or an empty string when the image is untagged
+func imageTag(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		return image[i+1:]
+	}
+
+	// the registry may contain a port, the tag follows the last path segment
+	name := image[strings.LastIndex(image, "/")+1:]
+	if i := strings.Index(name, ":"); i >= 0 {
+		return name[i+1:]
+	}
+
+	return ""
+}
+
+// podTemplateHash returns a short hash identifying template
+func podTemplateHash(template *v1.PodTemplateSpec) (string, error) {
+	data, err := json.Marshal(template)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// apmApplicationIDFromGUID returns the application ID encoded in the entity GUID of an APM application,
+// which is the base64 encoding of "<account id>|APM|APPLICATION|<application id>"
+func apmApplicationIDFromGUID(guid string) (int, error) {
+	decoded, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(guid, "="))
+	if err != nil {
+		return 0, fmt.Errorf("%s is not an entity GUID: %w", deploymentMarkerEntityGUIDAnnotation, err)
+	}
+
+	parts := strings.Split(string(decoded), "|")
+	if len(parts) != 4 || parts[1] != "APM" || parts[2] != "APPLICATION" {
+		return 0, fmt.Errorf("%s must be the GUID of an APM application, got %q", deploymentMarkerEntityGUIDAnnotation, guid)
+	}
+
+	return strconv.Atoi(parts[3])
+}
+
+// deploymentMarkerAccount selects the account markers of a Deployment are recorded in from its annotations
+type deploymentMarkerAccount struct {
+	*appsv1.Deployment
+}
+
+// GetAccountSettings returns the account settings of the deployment markers
+func (d deploymentMarkerAccount) GetAccountSettings() nrv1.AccountSettings {
+	annotations := d.Annotations
+
+	settings := nrv1.AccountSettings{
+		Region: annotations[deploymentMarkerRegionAnnotation],
+	}
+
+	switch {
+	case annotations[deploymentMarkerAccountAnnotation] != "":
+		settings.AccountRef = nrv1.NewRelicAccountReference{Kind: nrv1.NewRelicAccountKind, Name: annotations[deploymentMarkerAccountAnnotation]}
+	case annotations[deploymentMarkerClusterAccountAnnotation] != "":
+		settings.AccountRef = nrv1.NewRelicAccountReference{Kind: nrv1.ClusterNewRelicAccountKind, Name: annotations[deploymentMarkerClusterAccountAnnotation]}
+	case annotations[deploymentMarkerAPIKeySecretAnnotation] != "":
+		// the secret is always read from the namespace of the Deployment
+		settings.APIKeySecret = nrv1.NewRelicAPIKeySecret{
+			Name:      annotations[deploymentMarkerAPIKeySecretAnnotation],
+			Namespace: d.Namespace,
+			KeyName:   annotations[deploymentMarkerAPIKeySecretKeyAnnotation],
+		}
+	}
+
+	return settings
+}
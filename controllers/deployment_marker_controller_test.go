@@ -0,0 +1,278 @@
+package controllers
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/apm"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+)
+
+var _ = Describe("Deployment marker reconciliation", func() {
+	var (
+		ctx            context.Context
+		r              *DeploymentMarkerReconciler
+		apmClient      *interfacesfakes.FakeNewRelicAPMClient
+		recorder       *record.FakeRecorder
+		deployment     *appsv1.Deployment
+		secret         *v1.Secret
+		namespacedName types.NamespacedName
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		apmClient = &interfacesfakes.FakeNewRelicAPMClient{}
+		apmClient.ListApplicationsReturns([]*apm.Application{
+			{ID: 7, Name: "checkout-worker"},
+			{ID: 8, Name: "checkout"},
+		}, nil)
+		apmClient.CreateDeploymentReturns(&apm.Deployment{ID: 100}, nil)
+		recorder = record.NewFakeRecorder(100)
+
+		r = &DeploymentMarkerReconciler{
+			Client:   k8sClient,
+			Log:      logf.Log,
+			Recorder: recorder,
+			APMClientFunc: func(string, string) (interfaces.NewRelicAPMClient, error) {
+				return apmClient, nil
+			},
+			NewRelicAgent: newrelic.Application{},
+		}
+
+		secret = &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "marker-api-key",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{"api-key": []byte("api-key")},
+		}
+		Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+
+		labels := map[string]string{"app": "checkout"}
+
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "checkout",
+				Namespace: "default",
+				Annotations: map[string]string{
+					deploymentMarkerAppNameAnnotation:         "checkout",
+					deploymentMarkerAPIKeySecretAnnotation:    "marker-api-key",
+					deploymentMarkerAPIKeySecretKeyAnnotation: "api-key",
+					deploymentMarkerRegionAnnotation:          "US",
+					deploymentMarkerChangedByAnnotation:       "jane@example.com",
+				},
+			},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: labels},
+				Template: v1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{
+							{Name: "checkout", Image: "registry.example.com:5000/shop/checkout:1.2.3"},
+							{Name: "proxy", Image: "envoyproxy/envoy:v1.16.0"},
+						},
+					},
+				},
+			},
+		}
+		namespacedName = types.NamespacedName{Namespace: "default", Name: "checkout"}
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, deployment)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+	})
+
+	updateImage := func(image string) {
+		var current appsv1.Deployment
+		Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+		current.Spec.Template.Spec.Containers[0].Image = image
+		Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+	}
+
+	Context("when a Deployment is created", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+		})
+
+		It("records a deployment marker for the application", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(apmClient.ListApplicationsArgsForCall(0).Name).To(Equal("checkout"))
+			Expect(apmClient.CreateDeploymentCallCount()).To(Equal(1))
+			applicationID, marker := apmClient.CreateDeploymentArgsForCall(0)
+			Expect(applicationID).To(Equal(8))
+			Expect(marker.Revision).To(Equal("1.2.3"))
+			Expect(marker.User).To(Equal("jane@example.com"))
+			Expect(marker.Description).To(Equal("Rollout of Deployment default/checkout"))
+			Expect(marker.Changelog).To(Equal("checkout=registry.example.com:5000/shop/checkout:1.2.3, proxy=envoyproxy/envoy:v1.16.0"))
+
+			var updated appsv1.Deployment
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Annotations).To(HaveKey(deploymentMarkerTemplateHashAnnotation))
+			Expect(recorder.Events).To(Receive(ContainSubstring("Recorded New Relic deployment marker 100")))
+		})
+
+		It("records a marker only once per pod template", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apmClient.CreateDeploymentCallCount()).To(Equal(1))
+
+			updateImage("registry.example.com:5000/shop/checkout:1.3.0")
+
+			_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apmClient.CreateDeploymentCallCount()).To(Equal(2))
+			_, marker := apmClient.CreateDeploymentArgsForCall(1)
+			Expect(marker.Revision).To(Equal("1.3.0"))
+		})
+
+		It("uses the revision annotation", func() {
+			var current appsv1.Deployment
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			current.Annotations[deploymentMarkerRevisionAnnotation] = "a1b2c3d"
+			Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, marker := apmClient.CreateDeploymentArgsForCall(0)
+			Expect(marker.Revision).To(Equal("a1b2c3d"))
+		})
+
+		It("retries when the marker cannot be recorded", func() {
+			apmClient.CreateDeploymentReturns(nil, errors.New("server error"))
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(MatchError(ContainSubstring("server error")))
+
+			var updated appsv1.Deployment
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Annotations).ToNot(HaveKey(deploymentMarkerTemplateHashAnnotation))
+			Expect(recorder.Events).To(Receive(ContainSubstring(eventReasonDeploymentMarkerFailed)))
+		})
+
+		It("reports an unknown application", func() {
+			apmClient.ListApplicationsReturns([]*apm.Application{{ID: 7, Name: "checkout-worker"}}, nil)
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(MatchError(`APM application "checkout" not found`))
+			Expect(apmClient.CreateDeploymentCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the application is given by its entity GUID", func() {
+		BeforeEach(func() {
+			delete(deployment.Annotations, deploymentMarkerAppNameAnnotation)
+			deployment.Annotations[deploymentMarkerEntityGUIDAnnotation] = base64.StdEncoding.EncodeToString([]byte("1|APM|APPLICATION|99"))
+			Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+		})
+
+		It("records the marker without looking up the application", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(apmClient.ListApplicationsCallCount()).To(Equal(0))
+			applicationID, _ := apmClient.CreateDeploymentArgsForCall(0)
+			Expect(applicationID).To(Equal(99))
+		})
+	})
+
+	Context("when an existing Deployment is annotated", func() {
+		BeforeEach(func() {
+			annotations := deployment.Annotations
+			deployment.Annotations = nil
+			Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+
+			updateImage("registry.example.com:5000/shop/checkout:1.2.4")
+
+			var current appsv1.Deployment
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			current.Annotations = annotations
+			Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+		})
+
+		It("only records markers for later changes of the pod template", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apmClient.CreateDeploymentCallCount()).To(Equal(0))
+
+			updateImage("registry.example.com:5000/shop/checkout:1.2.5")
+
+			_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apmClient.CreateDeploymentCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when a Deployment is not annotated", func() {
+		BeforeEach(func() {
+			deployment.Annotations = nil
+			Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+		})
+
+		It("does nothing", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apmClient.CreateDeploymentCallCount()).To(Equal(0))
+
+			var updated appsv1.Deployment
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Annotations).ToNot(HaveKey(deploymentMarkerTemplateHashAnnotation))
+		})
+	})
+})
+
+var _ = Describe("deployment marker helpers", func() {
+	Describe("imageTag", func() {
+		It("returns the tag of an image", func() {
+			Expect(imageTag("nginx:1.19")).To(Equal("1.19"))
+			Expect(imageTag("registry.example.com:5000/shop/checkout:1.2.3")).To(Equal("1.2.3"))
+		})
+
+		It("returns the digest of an image", func() {
+			Expect(imageTag("nginx@sha256:abc")).To(Equal("sha256:abc"))
+		})
+
+		It("does not mistake the port of a registry for a tag", func() {
+			Expect(imageTag("nginx")).To(BeEmpty())
+			Expect(imageTag("registry.example.com:5000/shop/checkout")).To(BeEmpty())
+		})
+	})
+
+	Describe("apmApplicationIDFromGUID", func() {
+		It("decodes the application ID", func() {
+			guid := base64.StdEncoding.EncodeToString([]byte("1|APM|APPLICATION|12345"))
+			Expect(apmApplicationIDFromGUID(guid)).To(Equal(12345))
+		})
+
+		It("accepts a GUID without padding", func() {
+			guid := base64.RawStdEncoding.EncodeToString([]byte("1|APM|APPLICATION|1234"))
+			Expect(apmApplicationIDFromGUID(guid)).To(Equal(1234))
+		})
+
+		It("rejects the GUID of another entity type", func() {
+			guid := base64.StdEncoding.EncodeToString([]byte("1|VIZ|DASHBOARD|12345"))
+			_, err := apmApplicationIDFromGUID(guid)
+			Expect(err).To(MatchError(ContainSubstring("must be the GUID of an APM application")))
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+)
+
+// DeploymentRolloutReconciler mutes alerts while a rollout of an annotated Deployment is progressing
+type DeploymentRolloutReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;patch
+
+// Reconcile mutes the conditions of the AlertsPolicy named by the newrelic.com/rollout-muting-alerts-policy
+// annotation of a Deployment until its rollout completes
+func (r *DeploymentRolloutReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Rollouts/Deployment")
+	defer rc.txn.End()
+
+	var deployment appsv1.Deployment
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &deployment)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			// the muting rule is garbage collected through its owner reference
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET Deployment", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	if !deployment.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	window, err := syncRolloutMutingRule(rc.ctx, r.Client, r.Scheme, &deployment, "Deployment", deploymentRolloutProgressing(&deployment))
+	if err != nil {
+		r.Log.Error(err, "failed to mute alerts for rollout of Deployment", "name", req.NamespacedName.String())
+		recordFailure(r.Recorder, &deployment, eventReasonRolloutMutingFailed, err)
+		return ctrl.Result{}, err
+	}
+
+	// the muting rule is deleted once the window ends
+	return ctrl.Result{RequeueAfter: window}, nil
+}
+
+// SetupWithManager - Sets up Controller for Deployment
+func (r *DeploymentRolloutReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&appsv1.Deployment{}).
+		Owns(&nrv1.AlertsMutingRule{}).
+		Watches(&source.Kind{Type: &nrv1.AlertsPolicy{}}, enqueueForRolloutPolicy(r.Client, r.Log, func() runtime.Object { return &appsv1.DeploymentList{} })).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &appsv1.Deployment{}, r))
+}
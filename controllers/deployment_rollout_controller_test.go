@@ -0,0 +1,417 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+)
+
+var _ = Describe("Deployment rollout reconciliation", func() {
+	var (
+		ctx            context.Context
+		r              *DeploymentRolloutReconciler
+		deployment     *appsv1.Deployment
+		policy         *nrv1.AlertsPolicy
+		namespacedName types.NamespacedName
+		ruleName       types.NamespacedName
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		r = &DeploymentRolloutReconciler{
+			Client:        k8sClient,
+			Log:           logf.Log,
+			Scheme:        scheme.Scheme,
+			Recorder:      record.NewFakeRecorder(100),
+			NewRelicAgent: newrelic.Application{},
+		}
+
+		policy = &nrv1.AlertsPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "shop-policy",
+				Namespace: "default",
+			},
+			Spec: nrv1.AlertsPolicySpec{
+				Name:       "shop policy",
+				AccountRef: nrv1.NewRelicAccountReference{Kind: nrv1.NewRelicAccountKind, Name: "my-account"},
+			},
+			Status: nrv1.AlertsPolicyStatus{
+				PolicyID: "42",
+			},
+		}
+		Expect(createWithStatus(ctx, policy)).To(Succeed())
+
+		labels := map[string]string{"app": "shop"}
+
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "shop",
+				Namespace: "default",
+				Annotations: map[string]string{
+					rolloutMutingAlertsPolicyAnnotation: "shop-policy",
+					rolloutMutingMaxDurationAnnotation:  "45m",
+				},
+			},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: labels},
+				Template: v1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{{Name: "shop", Image: "shop:1"}},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+
+		namespacedName = types.NamespacedName{Namespace: "default", Name: "shop"}
+		ruleName = types.NamespacedName{Namespace: "default", Name: "shop-deployment-rollout"}
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, deployment)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, policy)).To(Succeed())
+
+		// envtest does not run the garbage collector
+		var rule nrv1.AlertsMutingRule
+		if err := k8sClient.Get(ctx, ruleName, &rule); err == nil {
+			Expect(k8sClient.Delete(ctx, &rule)).To(Succeed())
+		}
+	})
+
+	completeRollout := func() {
+		var current appsv1.Deployment
+		Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+		current.Status = appsv1.DeploymentStatus{
+			ObservedGeneration: current.Generation,
+			Replicas:           1,
+			UpdatedReplicas:    1,
+			ReadyReplicas:      1,
+			AvailableReplicas:  1,
+		}
+		Expect(k8sClient.Status().Update(ctx, &current)).To(Succeed())
+	}
+
+	startRollout := func() {
+		var current appsv1.Deployment
+		Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+		current.Status = appsv1.DeploymentStatus{
+			ObservedGeneration: current.Generation,
+			Replicas:           2,
+			UpdatedReplicas:    1,
+			ReadyReplicas:      1,
+			AvailableReplicas:  1,
+		}
+		Expect(k8sClient.Status().Update(ctx, &current)).To(Succeed())
+	}
+
+	It("doesn't copy an inline api_key of the policy to the muting rule", func() {
+		var current nrv1.AlertsPolicy
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "shop-policy"}, &current)).To(Succeed())
+		current.Spec.APIKey = "raw-api-key"
+		Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+
+		startRollout()
+
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).To(MatchError("api_key of AlertsPolicy shop-policy has not been moved to a secret yet"))
+
+		var rule nrv1.AlertsMutingRule
+		Expect(k8sClient.Get(ctx, ruleName, &rule)).ToNot(Succeed())
+	})
+
+	It("mutes the conditions of the policy while the rollout is progressing", func() {
+		startRollout()
+
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		var rule nrv1.AlertsMutingRule
+		Expect(k8sClient.Get(ctx, ruleName, &rule)).To(Succeed())
+		Expect(rule.Spec.Enabled).To(BeTrue())
+		Expect(rule.Spec.Condition.Conditions).To(Equal([]nrv1.AlertsMutingRuleCondition{
+			{Attribute: "policyId", Operator: "EQUALS", Values: []string{"42"}},
+		}))
+		Expect(rule.Spec.AccountRef).To(Equal(policy.Spec.AccountRef))
+		Expect(rule.Labels).To(HaveKeyWithValue(rolloutSourceKindLabel, "Deployment"))
+		Expect(rule.Annotations).To(HaveKeyWithValue(rolloutGenerationAnnotation, "1"))
+		Expect(rule.OwnerReferences).To(HaveLen(1))
+		Expect(rule.OwnerReferences[0].Name).To(Equal("shop"))
+
+		start, err := time.Parse(nrv1.MutingRuleTimeLayout, rule.Spec.Schedule.StartTime)
+		Expect(err).ToNot(HaveOccurred())
+		end, err := time.Parse(nrv1.MutingRuleTimeLayout, rule.Spec.Schedule.EndTime)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(end.Sub(start)).To(Equal(45 * time.Minute))
+		Expect(rule.Spec.Schedule.TimeZone).To(Equal("UTC"))
+	})
+
+	It("keeps the window of a running rollout", func() {
+		startRollout()
+
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		var rule nrv1.AlertsMutingRule
+		Expect(k8sClient.Get(ctx, ruleName, &rule)).To(Succeed())
+		schedule := *rule.Spec.Schedule
+
+		time.Sleep(1100 * time.Millisecond)
+
+		_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, ruleName, &rule)).To(Succeed())
+		Expect(*rule.Spec.Schedule).To(Equal(schedule))
+	})
+
+	It("removes the muting rule once the rollout completed", func() {
+		startRollout()
+
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		completeRollout()
+
+		_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		var rule nrv1.AlertsMutingRule
+		Expect(k8sClient.Get(ctx, ruleName, &rule)).ToNot(Succeed())
+	})
+
+	It("requeues the deployment when the window ends", func() {
+		startRollout()
+
+		result, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically("~", 45*time.Minute, time.Minute))
+	})
+
+	It("removes the muting rule once the maximum duration expired", func() {
+		startRollout()
+
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		var rule nrv1.AlertsMutingRule
+		Expect(k8sClient.Get(ctx, ruleName, &rule)).To(Succeed())
+		rule.Spec.Schedule.EndTime = time.Now().UTC().Add(-time.Minute).Format(nrv1.MutingRuleTimeLayout)
+		Expect(k8sClient.Update(ctx, &rule)).To(Succeed())
+
+		result, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(k8sClient.Get(ctx, ruleName, &rule)).ToNot(Succeed())
+
+		var current appsv1.Deployment
+		Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+		Expect(current.Annotations).To(HaveKeyWithValue(rolloutMutingExpiredGenerationAnnotation, "1"))
+
+		// the stuck rollout isn't muted again
+		_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k8sClient.Get(ctx, ruleName, &rule)).ToNot(Succeed())
+	})
+
+	It("doesn't mute a scale-up", func() {
+		var current appsv1.Deployment
+		Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+		current.Status = appsv1.DeploymentStatus{
+			ObservedGeneration: current.Generation,
+			Replicas:           3,
+			UpdatedReplicas:    3,
+			AvailableReplicas:  1,
+		}
+		Expect(k8sClient.Status().Update(ctx, &current)).To(Succeed())
+
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		var rule nrv1.AlertsMutingRule
+		Expect(k8sClient.Get(ctx, ruleName, &rule)).ToNot(Succeed())
+	})
+
+	It("does nothing without the annotation", func() {
+		var current appsv1.Deployment
+		Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+		delete(current.Annotations, rolloutMutingAlertsPolicyAnnotation)
+		Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		var rule nrv1.AlertsMutingRule
+		Expect(k8sClient.Get(ctx, ruleName, &rule)).ToNot(Succeed())
+	})
+
+	It("waits for the policy to be created in New Relic", func() {
+		var current nrv1.AlertsPolicy
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "shop-policy"}, &current)).To(Succeed())
+		current.Status.PolicyID = ""
+		Expect(k8sClient.Status().Update(ctx, &current)).To(Succeed())
+
+		startRollout()
+
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).To(MatchError(ContainSubstring("has not been created in New Relic yet")))
+
+		var rule nrv1.AlertsMutingRule
+		Expect(k8sClient.Get(ctx, ruleName, &rule)).ToNot(Succeed())
+	})
+
+	It("rejects an invalid maximum duration", func() {
+		var current appsv1.Deployment
+		Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+		current.Annotations[rolloutMutingMaxDurationAnnotation] = "forever"
+		Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+
+		startRollout()
+
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).To(MatchError(ContainSubstring(`got "forever"`)))
+	})
+})
+
+var _ = Describe("rollout progress", func() {
+	replicas := func(n int32) *int32 { return &n }
+
+	Describe("deploymentRolloutProgressing", func() {
+		var deployment *appsv1.Deployment
+
+		BeforeEach(func() {
+			deployment = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: replicas(3)},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 2,
+					Replicas:           3,
+					UpdatedReplicas:    3,
+					AvailableReplicas:  3,
+				},
+			}
+		})
+
+		It("is complete when all replicas are updated and available", func() {
+			Expect(deploymentRolloutProgressing(deployment)).To(BeFalse())
+		})
+
+		It("waits for the new generation to be observed", func() {
+			deployment.Generation = 3
+			deployment.Status.Replicas = 4
+			Expect(deploymentRolloutProgressing(deployment)).To(BeFalse())
+		})
+
+		It("progresses while old replicas are running", func() {
+			deployment.Status.Replicas = 4
+			deployment.Status.UpdatedReplicas = 1
+			Expect(deploymentRolloutProgressing(deployment)).To(BeTrue())
+		})
+
+		It("is no rollout while a scale-up is starting replicas", func() {
+			deployment.Spec.Replicas = replicas(5)
+			deployment.Status.Replicas = 5
+			deployment.Status.UpdatedReplicas = 5
+			Expect(deploymentRolloutProgressing(deployment)).To(BeFalse())
+		})
+	})
+
+	Describe("statefulSetRolloutProgressing", func() {
+		var statefulSet *appsv1.StatefulSet
+
+		BeforeEach(func() {
+			statefulSet = &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas:       replicas(3),
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{Type: appsv1.RollingUpdateStatefulSetStrategyType},
+				},
+				Status: appsv1.StatefulSetStatus{
+					ObservedGeneration: 2,
+					ReadyReplicas:      3,
+					UpdatedReplicas:    3,
+					CurrentRevision:    "shop-2",
+					UpdateRevision:     "shop-2",
+				},
+			}
+		})
+
+		It("is complete when all replicas run the update revision", func() {
+			Expect(statefulSetRolloutProgressing(statefulSet)).To(BeFalse())
+		})
+
+		It("progresses until the update revision is current", func() {
+			statefulSet.Status.UpdateRevision = "shop-3"
+			Expect(statefulSetRolloutProgressing(statefulSet)).To(BeTrue())
+		})
+
+		It("only waits for the replicas above the partition", func() {
+			partition := int32(2)
+			statefulSet.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition}
+			statefulSet.Status.UpdateRevision = "shop-3"
+			statefulSet.Status.UpdatedReplicas = 1
+			Expect(statefulSetRolloutProgressing(statefulSet)).To(BeFalse())
+		})
+
+		It("is no rollout while a scale-up is starting replicas", func() {
+			statefulSet.Spec.Replicas = replicas(5)
+			statefulSet.Status.UpdatedReplicas = 5
+			Expect(statefulSetRolloutProgressing(statefulSet)).To(BeFalse())
+		})
+
+		It("never progresses with OnDelete updates", func() {
+			statefulSet.Spec.UpdateStrategy.Type = appsv1.OnDeleteStatefulSetStrategyType
+			statefulSet.Generation = 3
+			Expect(statefulSetRolloutProgressing(statefulSet)).To(BeFalse())
+		})
+	})
+
+	Describe("daemonSetRolloutProgressing", func() {
+		var daemonSet *appsv1.DaemonSet
+
+		BeforeEach(func() {
+			daemonSet = &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec: appsv1.DaemonSetSpec{
+					UpdateStrategy: appsv1.DaemonSetUpdateStrategy{Type: appsv1.RollingUpdateDaemonSetStrategyType},
+				},
+				Status: appsv1.DaemonSetStatus{
+					ObservedGeneration:     2,
+					DesiredNumberScheduled: 5,
+					UpdatedNumberScheduled: 5,
+					NumberAvailable:        5,
+				},
+			}
+		})
+
+		It("is complete when every node runs an available updated pod", func() {
+			Expect(daemonSetRolloutProgressing(daemonSet)).To(BeFalse())
+		})
+
+		It("progresses while nodes run the old pod", func() {
+			daemonSet.Status.UpdatedNumberScheduled = 4
+			Expect(daemonSetRolloutProgressing(daemonSet)).To(BeTrue())
+		})
+
+		It("is no rollout while a new node starts the pod", func() {
+			daemonSet.Status.DesiredNumberScheduled = 6
+			daemonSet.Status.UpdatedNumberScheduled = 6
+			Expect(daemonSetRolloutProgressing(daemonSet)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	"errors"
+
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/metrics"
+)
+
+// setDriftedCondition records the result of comparing the object against New Relic and reports
+// whether the Drifted condition changed. A warning event is emitted whenever new drift is found.
+func setDriftedCondition(recorder record.EventRecorder, obj nrv1.ConditionedObject, differences []string) bool {
+	conditions := obj.GetConditions()
+
+	var previousStatus v1.ConditionStatus
+	var previousMessage string
+	if previous := nrv1.FindCondition(conditions, nrv1.ConditionDrifted); previous != nil {
+		previousStatus = previous.Status
+		previousMessage = previous.Message
+	}
+
+	nrv1.SetDriftedCondition(&conditions, obj.GetGeneration(), differences)
+	current := nrv1.FindCondition(conditions, nrv1.ConditionDrifted)
+
+	// Every status change triggers another reconciliation, so an unchanged result is not
+	// written back to avoid re-triggering reconciliation on every resync.
+	if current.Status == previousStatus && current.Message == previousMessage {
+		return false
+	}
+
+	obj.SetConditions(conditions)
+
+	if current.Status == v1.ConditionTrue {
+		metrics.DriftDetections.WithLabelValues(kindOf(obj)).Inc()
+		recorder.Event(obj, v1.EventTypeWarning, nrv1.ReasonDriftDetected, current.Message)
+	}
+
+	return true
+}
+
+// isNotFound returns true if the New Relic API reported that the requested resource does not exist
+func isNotFound(err error) bool {
+	var notFound *nrErrors.NotFound
+
+	return errors.As(err, &notFound)
+}
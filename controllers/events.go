@@ -0,0 +1,44 @@
+package controllers
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/redact"
+)
+
+// Reasons for the Normal events emitted when a New Relic API call succeeds.
+// Warning events reuse the failure reasons of the status conditions.
+const (
+	eventReasonCreated = "Created"
+	eventReasonUpdated = "Updated"
+	eventReasonDeleted = "Deleted"
+	eventReasonAdopted = "Adopted"
+
+	// eventReasonLookupFailed is used when searching New Relic for an existing resource to adopt fails
+	eventReasonLookupFailed = "LookupFailed"
+
+	// eventReasonResyncFailed is used when comparing a resource against New Relic during a resync fails
+	eventReasonResyncFailed = "ResyncFailed"
+
+	// eventReasonSyntheticsFailed is used when the monitors derived from an annotated Ingress or Service cannot be written
+	eventReasonSyntheticsFailed = "SyntheticsFailed"
+
+	// eventReasonRolloutMutingFailed is used when the muting rule for a rollout of an annotated workload cannot be written
+	eventReasonRolloutMutingFailed = "RolloutMutingFailed"
+
+	// eventReasonDeploymentMarkerFailed is used when the deployment marker for a rollout of an annotated Deployment cannot be recorded
+	eventReasonDeploymentMarkerFailed = "DeploymentMarkerFailed"
+)
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// recordFailure emits a warning event describing a failed New Relic API interaction
+func recordFailure(recorder record.EventRecorder, obj runtime.Object, reason string, err error) {
+	if err == nil {
+		return
+	}
+
+	recorder.Event(obj, v1.EventTypeWarning, reason, redact.Text(err.Error(), obj))
+}
@@ -0,0 +1,56 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/redact"
+)
+
+const (
+	// FingerprintKeySecretName is the name of the secret holding the key of the credential fingerprints
+	FingerprintKeySecretName = "newrelic-kubernetes-operator-fingerprint-key"
+
+	fingerprintKeyName = "key"
+)
+
+// LoadFingerprintKey sets the key of the credential fingerprints to the key of the install, kept in a secret
+// in namespace. The secret is created with a random key on the first start. k8sClient must not be a cached
+// client, the key is loaded before the manager starts.
+func LoadFingerprintKey(ctx context.Context, k8sClient client.Client, namespace string) error {
+	secret := v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      FingerprintKeySecretName,
+			Namespace: namespace,
+			Labels:    map[string]string{nrv1.ManagedByLabel: nrv1.ManagedBy},
+		},
+		Type: v1.SecretTypeOpaque,
+		Data: map[string][]byte{fingerprintKeyName: redact.NewFingerprintKey()},
+	}
+
+	// another replica may create the secret first, its key wins
+	err := k8sClient.Create(ctx, &secret)
+	if kErr.IsAlreadyExists(err) {
+		err = k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: FingerprintKeySecretName}, &secret)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	key := secret.Data[fingerprintKeyName]
+	if len(key) < redact.FingerprintKeySize {
+		return fmt.Errorf("secret %s/%s holds no fingerprint key of %d bytes", namespace, FingerprintKeySecretName, redact.FingerprintKeySize)
+	}
+
+	redact.SetFingerprintKey(key)
+
+	return nil
+}
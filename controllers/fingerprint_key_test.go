@@ -0,0 +1,56 @@
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/redact"
+)
+
+var _ = Describe("LoadFingerprintKey", func() {
+	var (
+		ctx context.Context
+		key types.NamespacedName
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		key = types.NamespacedName{Namespace: "default", Name: FingerprintKeySecretName}
+	})
+
+	AfterEach(func() {
+		var secret v1.Secret
+		if err := k8sClient.Get(ctx, key, &secret); err == nil {
+			Expect(k8sClient.Delete(ctx, &secret)).To(Succeed())
+		}
+	})
+
+	It("keeps the key of the install across restarts", func() {
+		Expect(LoadFingerprintKey(ctx, k8sClient, "default")).To(Succeed())
+		fingerprint := redact.Fingerprint("api-key")
+
+		var secret v1.Secret
+		Expect(k8sClient.Get(ctx, key, &secret)).To(Succeed())
+		Expect(secret.Data["key"]).To(HaveLen(redact.FingerprintKeySize))
+
+		redact.SetFingerprintKey(redact.NewFingerprintKey())
+		Expect(redact.Fingerprint("api-key")).ToNot(Equal(fingerprint))
+
+		Expect(LoadFingerprintKey(ctx, k8sClient, "default")).To(Succeed())
+		Expect(redact.Fingerprint("api-key")).To(Equal(fingerprint))
+	})
+
+	It("rejects a secret without a key", func() {
+		secret := v1.Secret{}
+		secret.Name = key.Name
+		secret.Namespace = key.Namespace
+		secret.Data = map[string][]byte{"key": []byte("short")}
+		Expect(k8sClient.Create(ctx, &secret)).To(Succeed())
+
+		Expect(LoadFingerprintKey(ctx, k8sClient, "default")).To(MatchError(ContainSubstring("holds no fingerprint key")))
+	})
+})
@@ -0,0 +1,101 @@
+package controllers
+
+import (
+	"strings"
+
+	"github.com/go-logr/logr"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+)
+
+// IngressSyntheticsReconciler derives SyntheticsMonitors from annotated Ingress objects. Ingresses are read
+// through networking.k8s.io/v1beta1, the k8s.io/api version the operator is built against has no v1 Ingress.
+type IngressSyntheticsReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch
+
+// Reconcile creates a ping monitor for every host of an Ingress annotated with newrelic.com/synthetics: enabled
+func (r *IngressSyntheticsReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Synthetics/Ingress")
+	defer rc.txn.End()
+
+	var ingress networkingv1beta1.Ingress
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &ingress)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			// the monitors are garbage collected through their owner reference
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET Ingress", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	if !ingress.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	err = syncAnnotatedMonitors(rc.ctx, r.Client, r.Scheme, &ingress, "Ingress", ingressMonitorURIs(&ingress))
+	if err != nil {
+		r.Log.Error(err, "failed to write monitors for Ingress", "name", req.NamespacedName.String())
+		recordFailure(r.Recorder, &ingress, eventReasonSyntheticsFailed, err)
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager - Sets up Controller for Ingress
+func (r *IngressSyntheticsReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&networkingv1beta1.Ingress{}).
+		Owns(&nrv1.SyntheticsMonitor{}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &networkingv1beta1.Ingress{}, r))
+}
+
+// ingressMonitorURIs returns the URI to monitor for every host of the ingress, keyed by host.
+// Hosts listed in the TLS section are monitored over https, wildcard hosts are skipped.
+func ingressMonitorURIs(ingress *networkingv1beta1.Ingress) map[string]string {
+	tlsHosts := map[string]bool{}
+
+	for _, tls := range ingress.Spec.TLS {
+		for _, host := range tls.Hosts {
+			tlsHosts[host] = true
+		}
+	}
+
+	path := monitorPath(ingress)
+	uris := map[string]string{}
+
+	for _, rule := range ingress.Spec.Rules {
+		if rule.Host == "" || strings.Contains(rule.Host, "*") {
+			continue
+		}
+
+		scheme := "http"
+		if tlsHosts[rule.Host] {
+			scheme = "https"
+		}
+
+		uris[rule.Host] = scheme + "://" + rule.Host + path
+	}
+
+	return uris
+}
@@ -0,0 +1,187 @@
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+)
+
+var _ = Describe("Ingress synthetics reconciliation", func() {
+	var (
+		ctx            context.Context
+		r              *IngressSyntheticsReconciler
+		ingress        *networkingv1beta1.Ingress
+		namespacedName types.NamespacedName
+	)
+
+	listMonitors := func() []nrv1.SyntheticsMonitor {
+		var monitors nrv1.SyntheticsMonitorList
+		Expect(k8sClient.List(ctx, &monitors,
+			client.InNamespace("default"),
+			client.MatchingLabels{syntheticsSourceKindLabel: "Ingress", syntheticsSourceNameLabel: "shop"},
+		)).To(Succeed())
+
+		return monitors.Items
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		r = &IngressSyntheticsReconciler{
+			Client:        k8sClient,
+			Log:           logf.Log,
+			Scheme:        scheme.Scheme,
+			Recorder:      record.NewFakeRecorder(100),
+			NewRelicAgent: newrelic.Application{},
+		}
+
+		backend := &networkingv1beta1.IngressBackend{ServiceName: "shop", ServicePort: intstr.FromInt(80)}
+
+		ingress = &networkingv1beta1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "shop",
+				Namespace: "default",
+				Annotations: map[string]string{
+					syntheticsAnnotation:             syntheticsAnnotationEnabled,
+					syntheticsAccountAnnotation:      "my-account",
+					syntheticsAlertsPolicyAnnotation: "shop-policy",
+					syntheticsFrequencyAnnotation:    "5",
+					syntheticsLocationsAnnotation:    "AWS_US_EAST_1, AWS_EU_WEST_1",
+					syntheticsPathAnnotation:         "healthz",
+				},
+			},
+			Spec: networkingv1beta1.IngressSpec{
+				TLS: []networkingv1beta1.IngressTLS{
+					{Hosts: []string{"shop.example.com"}},
+				},
+				Rules: []networkingv1beta1.IngressRule{
+					{Host: "shop.example.com"},
+					{Host: "api.example.com"},
+				},
+				Backend: backend,
+			},
+		}
+		namespacedName = types.NamespacedName{Namespace: "default", Name: "shop"}
+
+		Expect(k8sClient.Create(ctx, ingress)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.DeleteAllOf(ctx, &nrv1.SyntheticsMonitor{}, client.InNamespace("default"),
+			client.MatchingLabels{syntheticsSourceKindLabel: "Ingress"})).To(Succeed())
+		Expect(k8sClient.Delete(ctx, ingress)).To(Succeed())
+	})
+
+	It("creates a ping monitor for every host", func() {
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		monitors := listMonitors()
+		Expect(monitors).To(HaveLen(2))
+
+		var shop nrv1.SyntheticsMonitor
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "shop-shop.example.com"}, &shop)).To(Succeed())
+		Expect(shop.Spec.Name).To(Equal("default/shop https://shop.example.com/healthz"))
+		Expect(shop.Spec.Type).To(Equal(synthetics.MonitorTypes.Ping))
+		Expect(shop.Spec.URI).To(Equal("https://shop.example.com/healthz"))
+		Expect(shop.Spec.Frequency).To(Equal(uint(5)))
+		Expect(shop.Spec.Locations).To(Equal([]string{"AWS_US_EAST_1", "AWS_EU_WEST_1"}))
+		Expect(shop.Spec.AccountRef).To(Equal(nrv1.NewRelicAccountReference{Kind: nrv1.NewRelicAccountKind, Name: "my-account"}))
+		Expect(shop.Spec.AlertsPolicyRef).To(Equal("shop-policy"))
+		Expect(metav1.IsControlledBy(&shop, ingress)).To(BeTrue())
+
+		var api nrv1.SyntheticsMonitor
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "shop-api.example.com"}, &api)).To(Succeed())
+		Expect(api.Spec.URI).To(Equal("http://api.example.com/healthz"))
+	})
+
+	It("deletes the monitor of a removed host", func() {
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, namespacedName, ingress)).To(Succeed())
+		ingress.Spec.Rules = ingress.Spec.Rules[:1]
+		Expect(k8sClient.Update(ctx, ingress)).To(Succeed())
+
+		_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		monitors := listMonitors()
+		Expect(monitors).To(HaveLen(1))
+		Expect(monitors[0].Name).To(Equal("shop-shop.example.com"))
+	})
+
+	It("deletes the monitors when the annotation is removed", func() {
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, namespacedName, ingress)).To(Succeed())
+		delete(ingress.Annotations, syntheticsAnnotation)
+		Expect(k8sClient.Update(ctx, ingress)).To(Succeed())
+
+		_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(listMonitors()).To(BeEmpty())
+	})
+
+	It("rejects an invalid frequency", func() {
+		Expect(k8sClient.Get(ctx, namespacedName, ingress)).To(Succeed())
+		ingress.Annotations[syntheticsFrequencyAnnotation] = "often"
+		Expect(k8sClient.Update(ctx, ingress)).To(Succeed())
+
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).To(MatchError(ContainSubstring(syntheticsFrequencyAnnotation)))
+		Expect(listMonitors()).To(BeEmpty())
+	})
+
+	It("creates the monitors in the annotated account of an API key secret", func() {
+		Expect(k8sClient.Get(ctx, namespacedName, ingress)).To(Succeed())
+		delete(ingress.Annotations, syntheticsAccountAnnotation)
+		ingress.Annotations[syntheticsAPIKeySecretAnnotation] = "nr-api-key"
+		ingress.Annotations[syntheticsAccountIDAnnotation] = "12345"
+		Expect(k8sClient.Update(ctx, ingress)).To(Succeed())
+
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		var shop nrv1.SyntheticsMonitor
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "shop-shop.example.com"}, &shop)).To(Succeed())
+		Expect(shop.Spec.AccountID).To(Equal(12345))
+		Expect(shop.Spec.APIKeySecret.Name).To(Equal("nr-api-key"))
+	})
+
+	It("rejects an invalid account ID", func() {
+		Expect(k8sClient.Get(ctx, namespacedName, ingress)).To(Succeed())
+		ingress.Annotations[syntheticsAccountIDAnnotation] = "my-account"
+		Expect(k8sClient.Update(ctx, ingress)).To(Succeed())
+
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).To(MatchError(ContainSubstring(syntheticsAccountIDAnnotation)))
+		Expect(listMonitors()).To(BeEmpty())
+	})
+
+	It("skips wildcard hosts", func() {
+		ingress.Spec.Rules = append(ingress.Spec.Rules, networkingv1beta1.IngressRule{Host: "*.example.com"})
+
+		Expect(ingressMonitorURIs(ingress)).To(Equal(map[string]string{
+			"shop.example.com": "https://shop.example.com/healthz",
+			"api.example.com":  "http://api.example.com/healthz",
+		}))
+	})
+})
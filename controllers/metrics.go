@@ -0,0 +1,57 @@
+package controllers
+
+import (
+	"context"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/metrics"
+)
+
+// instrumentedReconciler records the outcome of every reconcile request of the wrapped reconciler
+type instrumentedReconciler struct {
+	kind       string
+	obj        runtime.Object
+	reader     client.Reader
+	reconciler reconcile.Reconciler
+}
+
+// instrumentReconciler wraps the reconciler of the objects of the given type with reconcile metrics
+func instrumentReconciler(reader client.Reader, obj runtime.Object, r reconcile.Reconciler) reconcile.Reconciler {
+	return &instrumentedReconciler{kind: kindOf(obj), obj: obj, reader: reader, reconciler: r}
+}
+
+// Reconcile implements reconcile.Reconciler
+func (r *instrumentedReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	result, err := r.reconciler.Reconcile(req)
+	// a result with only RequeueAfter set is the periodic resync of a successfully reconciled object
+	metrics.ObserveReconcile(r.kind, result.Requeue, err)
+
+	// objects are marked as in error or recovered when their conditions are set, only the objects
+	// deleted while in error have to be cleared here
+	if err == nil {
+		getErr := r.reader.Get(context.Background(), req.NamespacedName, r.obj.DeepCopyObject())
+		if apierrors.IsNotFound(getErr) {
+			metrics.SetObjectInError(r.kind, req.NamespacedName.String(), false)
+		}
+	}
+
+	return result, err
+}
+
+// kindOf returns the name of the type of obj, which is its kind for all types of the operator
+func kindOf(obj runtime.Object) string {
+	return reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+}
+
+// metricsKey returns the key identifying obj in the objects in error metric
+func metricsKey(obj metav1.Object) string {
+	return types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}.String()
+}
@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/metrics"
+)
+
+var _ = Describe("reconcile metrics", func() {
+	var (
+		condition *nrv1.AlertsInfraCondition
+		request   ctrl.Request
+		failure   error
+		recovered bool
+		result    ctrl.Result
+		r         reconcile.Reconciler
+		ctx       = context.Background()
+	)
+
+	BeforeEach(func() {
+		condition = &nrv1.AlertsInfraCondition{}
+		condition.Namespace = "metrics"
+		condition.Name = "infra-condition"
+		request = ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "metrics", Name: "infra-condition"}}
+		failure = nil
+		recovered = true
+		result = ctrl.Result{}
+
+		r = instrumentReconciler(k8sClient, &nrv1.AlertsInfraCondition{}, reconcile.Func(func(ctrl.Request) (ctrl.Result, error) {
+			if failure != nil {
+				setFailedConditions(condition, nrv1.ReasonCreateFailed, failure)
+			} else if recovered {
+				setReadyConditions(condition)
+			}
+			return result, failure
+		}))
+	})
+
+	It("uses the name of the type as kind", func() {
+		Expect(kindOf(condition)).To(Equal("AlertsInfraCondition"))
+	})
+
+	It("counts the results of reconcile requests", func() {
+		errorCount := testutil.ToFloat64(metrics.Reconciles.WithLabelValues("AlertsInfraCondition", metrics.StatusError))
+		successCount := testutil.ToFloat64(metrics.Reconciles.WithLabelValues("AlertsInfraCondition", metrics.StatusSuccess))
+
+		failure = errors.New("create failed")
+		_, _ = r.Reconcile(request)
+		failure = nil
+		_, _ = r.Reconcile(request)
+
+		Expect(testutil.ToFloat64(metrics.Reconciles.WithLabelValues("AlertsInfraCondition", metrics.StatusError))).To(Equal(errorCount + 1))
+		Expect(testutil.ToFloat64(metrics.Reconciles.WithLabelValues("AlertsInfraCondition", metrics.StatusSuccess))).To(Equal(successCount + 1))
+	})
+
+	It("counts a resync of a reconciled object as success", func() {
+		requeueCount := testutil.ToFloat64(metrics.Reconciles.WithLabelValues("AlertsInfraCondition", metrics.ResultRequeue))
+		successCount := testutil.ToFloat64(metrics.Reconciles.WithLabelValues("AlertsInfraCondition", metrics.StatusSuccess))
+
+		result = ctrl.Result{RequeueAfter: time.Minute}
+		_, _ = r.Reconcile(request)
+		result = ctrl.Result{Requeue: true}
+		_, _ = r.Reconcile(request)
+
+		Expect(testutil.ToFloat64(metrics.Reconciles.WithLabelValues("AlertsInfraCondition", metrics.StatusSuccess))).To(Equal(successCount + 1))
+		Expect(testutil.ToFloat64(metrics.Reconciles.WithLabelValues("AlertsInfraCondition", metrics.ResultRequeue))).To(Equal(requeueCount + 1))
+	})
+
+	It("counts objects in error until they reconcile successfully", func() {
+		inError := metrics.ObjectsInError.WithLabelValues("AlertsInfraCondition")
+		before := testutil.ToFloat64(inError)
+
+		failure = errors.New("create failed")
+		_, _ = r.Reconcile(request)
+		_, _ = r.Reconcile(request)
+		Expect(testutil.ToFloat64(inError)).To(Equal(before + 1))
+
+		failure = nil
+		_, _ = r.Reconcile(request)
+		Expect(testutil.ToFloat64(inError)).To(Equal(before))
+	})
+
+	It("keeps counting an existing object in error when a reconcile doesn't recover it", func() {
+		inError := metrics.ObjectsInError.WithLabelValues("AlertsInfraCondition")
+		before := testutil.ToFloat64(inError)
+
+		condition.Spec.Enabled = true
+		Expect(k8sClient.Create(ctx, condition)).To(Succeed())
+		defer func() {
+			Expect(k8sClient.Delete(ctx, condition)).To(Succeed())
+		}()
+
+		failure = errors.New("create failed")
+		_, _ = r.Reconcile(request)
+
+		failure = nil
+		recovered = false
+		_, _ = r.Reconcile(request)
+		Expect(testutil.ToFloat64(inError)).To(Equal(before + 1))
+
+		setReadyConditions(condition)
+	})
+
+	It("stops counting objects in error once they are deleted", func() {
+		inError := metrics.ObjectsInError.WithLabelValues("AlertsInfraCondition")
+		before := testutil.ToFloat64(inError)
+
+		failure = errors.New("create failed")
+		_, _ = r.Reconcile(request)
+		Expect(testutil.ToFloat64(inError)).To(Equal(before + 1))
+
+		failure = nil
+		recovered = false
+		_, _ = r.Reconcile(request)
+		Expect(testutil.ToFloat64(inError)).To(Equal(before))
+	})
+
+	It("counts newly detected drift", func() {
+		drift := metrics.DriftDetections.WithLabelValues("AlertsInfraCondition")
+		before := testutil.ToFloat64(drift)
+		recorder := record.NewFakeRecorder(10)
+
+		setDriftedCondition(recorder, condition, []string{"threshold differs"})
+		setDriftedCondition(recorder, condition, []string{"threshold differs"})
+		Expect(testutil.ToFloat64(drift)).To(Equal(before + 1))
+
+		setDriftedCondition(recorder, condition, nil)
+		setDriftedCondition(recorder, condition, []string{"threshold differs"})
+		Expect(testutil.ToFloat64(drift)).To(Equal(before + 2))
+	})
+})
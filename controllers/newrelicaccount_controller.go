@@ -0,0 +1,214 @@
+package controllers
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+)
+
+// NewRelicAccountReconciler reconciles a NewRelicAccount object
+type NewRelicAccountReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	AlertClientFunc         func(string, string) (interfaces.NewRelicAlertsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+	ResyncInterval          time.Duration
+}
+
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=newrelicaccounts,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=newrelicaccounts/status,verbs=get;update;patch
+
+//Reconcile - checks that the API key of a NewRelicAccount authenticates against New Relic
+func (r *NewRelicAccountReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Accounts/NewRelicAccount")
+	defer rc.txn.End()
+
+	var account nrv1.NewRelicAccount
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &account)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			r.Log.Info("NewRelicAccount 'not found' after being deleted. This is expected and no cause for alarm", "error", err)
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET NewRelicAccount", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	// a namespaced account always reads its secret from its own namespace
+	spec := account.Spec
+	spec.APIKeySecret.Namespace = account.Namespace
+
+	err = checkAccount(rc, r.Client, r.Recorder, r.Log, r.AlertClientFunc, &account, spec)
+
+	return ctrl.Result{RequeueAfter: r.ResyncInterval}, err
+}
+
+//SetupWithManager - Sets up Controller for NewRelicAccount
+func (r *NewRelicAccountReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nrv1.NewRelicAccount{}).
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nrv1.NewRelicAccountList{} }, false)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nrv1.NewRelicAccount{}, r))
+}
+
+// ClusterNewRelicAccountReconciler reconciles a ClusterNewRelicAccount object
+type ClusterNewRelicAccountReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	AlertClientFunc         func(string, string) (interfaces.NewRelicAlertsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+	ResyncInterval          time.Duration
+}
+
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=clusternewrelicaccounts,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=clusternewrelicaccounts/status,verbs=get;update;patch
+
+//Reconcile - checks that the API key of a ClusterNewRelicAccount authenticates against New Relic
+func (r *ClusterNewRelicAccountReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Accounts/ClusterNewRelicAccount")
+	defer rc.txn.End()
+
+	var account nrv1.ClusterNewRelicAccount
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &account)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			r.Log.Info("ClusterNewRelicAccount 'not found' after being deleted. This is expected and no cause for alarm", "error", err)
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET ClusterNewRelicAccount", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	err = checkAccount(rc, r.Client, r.Recorder, r.Log, r.AlertClientFunc, &account, account.Spec)
+
+	return ctrl.Result{RequeueAfter: r.ResyncInterval}, err
+}
+
+//SetupWithManager - Sets up Controller for ClusterNewRelicAccount
+func (r *ClusterNewRelicAccountReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nrv1.ClusterNewRelicAccount{}).
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nrv1.ClusterNewRelicAccountList{} }, false)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nrv1.ClusterNewRelicAccount{}, r))
+}
+
+// checkAccount verifies the credentials of an account against the New Relic API and records the result
+// on its status conditions. The returned error is the authentication failure, if any, so it is retried.
+func checkAccount(
+	rc *requestContext,
+	k8sClient client.Client,
+	recorder record.EventRecorder,
+	log logr.Logger,
+	alertClientFunc func(string, string) (interfaces.NewRelicAlertsClient, error),
+	obj nrv1.ConditionedObject,
+	spec nrv1.NewRelicAccountSpec,
+) error {
+	authErr := authenticateAccount(rc, k8sClient, alertClientFunc, spec)
+	if authErr != nil {
+		log.Error(authErr, "account failed to authenticate",
+			"name", obj.GetName(),
+			"namespace", obj.GetNamespace(),
+			"accountID", spec.AccountID,
+			"region", spec.Region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+	}
+
+	conditions := obj.GetConditions()
+
+	var previousStatus v1.ConditionStatus
+	var previousReason, previousMessage string
+	if previous := nrv1.FindCondition(conditions, nrv1.ConditionAuthenticated); previous != nil {
+		previousStatus = previous.Status
+		previousReason = previous.Reason
+		previousMessage = previous.Message
+	}
+
+	reason := failureReason(authErr, nrv1.ReasonCredentialsError)
+	nrv1.SetAuthenticatedCondition(&conditions, obj.GetGeneration(), reason, authErr)
+	current := nrv1.FindCondition(conditions, nrv1.ConditionAuthenticated)
+
+	// Every status change triggers another reconciliation, so an unchanged result is not
+	// written back to avoid re-triggering reconciliation.
+	if current.Status == previousStatus && current.Reason == previousReason && current.Message == previousMessage {
+		return authErr
+	}
+
+	if authErr == nil {
+		nrv1.SetReadyConditions(&conditions, obj.GetGeneration())
+		recorder.Eventf(obj, v1.EventTypeNormal, nrv1.ReasonAuthenticated, "Authenticated against New Relic account %d", spec.AccountID)
+	} else {
+		nrv1.SetFailedConditions(&conditions, obj.GetGeneration(), reason, authErr)
+		recordFailure(recorder, obj, reason, authErr)
+	}
+
+	obj.SetConditions(conditions)
+
+	if err := updateWithStatus(rc.ctx, k8sClient, obj); err != nil {
+		log.Error(err, "failed to update account status", "name", obj.GetName(), "namespace", obj.GetNamespace())
+		return err
+	}
+
+	return authErr
+}
+
+// authenticateAccount reads the API key of the account and makes a read-only call with it
+func authenticateAccount(
+	rc *requestContext,
+	k8sClient client.Client,
+	alertClientFunc func(string, string) (interfaces.NewRelicAlertsClient, error),
+	spec nrv1.NewRelicAccountSpec,
+) error {
+	defer rc.txn.StartSegment("authenticateAccount").End()
+
+	credentials, err := nrv1.ResolveCredentials(rc.ctx, k8sClient, "", nrv1.AccountSettings{
+		APIKeySecret: spec.APIKeySecret,
+		Region:       spec.Region,
+		AccountID:    spec.AccountID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if credentials.APIKey == "" {
+		return errors.New("api key is blank")
+	}
+
+	rc.apiKey = credentials.APIKey
+	rc.region = credentials.Region
+	rc.accountID = credentials.AccountID
+
+	alertsClient, err := alertClientFunc(rc.apiKey, rc.region)
+	if err != nil {
+		return err
+	}
+
+	_, err = alertsClient.QueryPolicySearch(rc.accountID, alerts.AlertsPoliciesSearchCriteriaInput{})
+
+	return err
+}
@@ -0,0 +1,227 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+)
+
+var _ = Describe("NewRelicAccount reconciliation", func() {
+	var (
+		ctx           context.Context
+		secret        *v1.Secret
+		account       *nrv1.NewRelicAccount
+		fakeAlertFunc func(string, string) (interfaces.NewRelicAlertsClient, error)
+		usedAPIKey    string
+		usedRegion    string
+		recorder      *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		usedAPIKey = ""
+		usedRegion = ""
+
+		alertsClient = &interfacesfakes.FakeNewRelicAlertsClient{}
+		fakeAlertFunc = func(apiKey string, region string) (interfaces.NewRelicAlertsClient, error) {
+			usedAPIKey = apiKey
+			usedRegion = region
+			return alertsClient, nil
+		}
+		recorder = record.NewFakeRecorder(100)
+
+		secret = &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "account-api-key",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{
+				"api-key": []byte("account-key"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+
+		account = &nrv1.NewRelicAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-account",
+				Namespace: "default",
+			},
+			Spec: nrv1.NewRelicAccountSpec{
+				APIKeySecret: nrv1.NewRelicAPIKeySecret{Name: "account-api-key", KeyName: "api-key"},
+				Region:       "EU",
+				AccountID:    12345,
+			},
+		}
+		Expect(k8sClient.Create(ctx, account)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, account)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+	})
+
+	Context("when reconciling the account", func() {
+		var r *NewRelicAccountReconciler
+
+		BeforeEach(func() {
+			r = &NewRelicAccountReconciler{
+				Client:          k8sClient,
+				Log:             logf.Log,
+				Recorder:        recorder,
+				AlertClientFunc: fakeAlertFunc,
+				NewRelicAgent:   newrelic.Application{},
+			}
+		})
+
+		It("marks the account as authenticated when New Relic accepts the key", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "my-account"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(usedAPIKey).To(Equal("account-key"))
+			Expect(usedRegion).To(Equal("EU"))
+			Expect(alertsClient.QueryPolicySearchCallCount()).To(Equal(1))
+			accountID, _ := alertsClient.QueryPolicySearchArgsForCall(0)
+			Expect(accountID).To(Equal(12345))
+
+			var updated nrv1.NewRelicAccount
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "my-account"}, &updated)).To(Succeed())
+			Expect(nrv1.IsConditionTrue(updated.Status.Conditions, nrv1.ConditionAuthenticated)).To(BeTrue())
+			Expect(nrv1.IsConditionTrue(updated.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+		})
+
+		It("reports the failure when New Relic rejects the key", func() {
+			alertsClient.QueryPolicySearchReturns(nil, errors.New("401 unauthorized"))
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "my-account"}})
+			Expect(err).To(HaveOccurred())
+
+			var updated nrv1.NewRelicAccount
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "my-account"}, &updated)).To(Succeed())
+			authenticated := nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionAuthenticated)
+			Expect(authenticated).ToNot(BeNil())
+			Expect(authenticated.Status).To(Equal(v1.ConditionFalse))
+			Expect(authenticated.Message).To(Equal("401 unauthorized"))
+			Expect(recorder.Events).To(Receive(ContainSubstring(nrv1.ReasonCredentialsError)))
+		})
+
+		It("reports a missing key in the secret", func() {
+			secret.Data = map[string][]byte{"other-key": []byte("account-key")}
+			Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "my-account"}})
+			Expect(err).To(HaveOccurred())
+			Expect(alertsClient.QueryPolicySearchCallCount()).To(Equal(0))
+
+			var updated nrv1.NewRelicAccount
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "my-account"}, &updated)).To(Succeed())
+			authenticated := nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionAuthenticated)
+			Expect(authenticated).ToNot(BeNil())
+			Expect(authenticated.Reason).To(Equal(nrv1.ReasonSecretKeyNotFound))
+			Expect(authenticated.Message).To(Equal(`key "api-key" not found in secret default/account-api-key`))
+		})
+
+		It("reports a missing secret", func() {
+			Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "my-account"}})
+			Expect(err).To(HaveOccurred())
+
+			var updated nrv1.NewRelicAccount
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "my-account"}, &updated)).To(Succeed())
+			Expect(nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionAuthenticated).Reason).To(Equal(nrv1.ReasonSecretNotFound))
+			Expect(nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionError).Reason).To(Equal(nrv1.ReasonSecretNotFound))
+
+			// recreate the secret so it can be cleaned up
+			secret.ResourceVersion = ""
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+		})
+	})
+
+	Context("when reconciling an AlertsPolicy referencing the account", func() {
+		var (
+			r      *AlertsPolicyReconciler
+			policy *nrv1.AlertsPolicy
+		)
+
+		BeforeEach(func() {
+			alertsClient.CreatePolicyMutationStub = func(int, alerts.AlertsPolicyInput) (*alerts.AlertsPolicy, error) {
+				return &alerts.AlertsPolicy{ID: "333"}, nil
+			}
+
+			r = &AlertsPolicyReconciler{
+				Client:          k8sClient,
+				Log:             logf.Log,
+				Recorder:        recorder,
+				AlertClientFunc: fakeAlertFunc,
+				NewRelicAgent:   newrelic.Application{},
+			}
+
+			policy = &nrv1.AlertsPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "account-policy",
+					Namespace: "default",
+				},
+				Spec: nrv1.AlertsPolicySpec{
+					Name:               "account policy",
+					IncidentPreference: "PER_POLICY",
+					AccountRef:         nrv1.NewRelicAccountReference{Kind: nrv1.NewRelicAccountKind, Name: "my-account"},
+				},
+				Status: nrv1.AlertsPolicyStatus{
+					AppliedSpec: &nrv1.AlertsPolicySpec{},
+				},
+			}
+			Expect(k8sClient.Create(ctx, policy)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, policy)).To(Succeed())
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "account-policy"}})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("uses the credentials, region and account ID of the account", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "account-policy"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(usedAPIKey).To(Equal("account-key"))
+			Expect(usedRegion).To(Equal("EU"))
+			Expect(alertsClient.CreatePolicyMutationCallCount()).To(Equal(1))
+			accountID, _ := alertsClient.CreatePolicyMutationArgsForCall(0)
+			Expect(accountID).To(Equal(12345))
+		})
+
+		It("reports a missing account as a credentials error", func() {
+			var current nrv1.AlertsPolicy
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "account-policy"}, &current)).To(Succeed())
+			current.Spec.AccountRef.Name = "missing-account"
+			Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "account-policy"}})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("NewRelicAccount default/missing-account not found"))
+			Expect(alertsClient.CreatePolicyMutationCallCount()).To(Equal(0))
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "account-policy"}, &current)).To(Succeed())
+			Expect(nrv1.FindCondition(current.Status.Conditions, nrv1.ConditionError).Reason).To(Equal(nrv1.ReasonCredentialsError))
+
+			// point the policy back at the account so it can be cleaned up
+			current.Spec.AccountRef.Name = "my-account"
+			Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+		})
+	})
+})
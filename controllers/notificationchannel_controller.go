@@ -0,0 +1,238 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/notifications"
+)
+
+const notificationChannelDeleteFinalizer = "notificationchannels.finalizers.nr.k8s.newrelic.com"
+
+// NotificationChannelReconciler reconciles a NotificationChannel object
+type NotificationChannelReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	NotificationsClientFunc func(string, string) (interfaces.NewRelicNotificationsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=notificationchannels,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=notificationchannels/status,verbs=get;update;patch
+
+// Reconcile is responsible for reconciling the spec and state of the NotificationChannel.
+func (r *NotificationChannelReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Notifications/Channel")
+	defer rc.txn.End()
+
+	var channel nrv1.NotificationChannel
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &channel)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			r.Log.Info("NotificationChannel 'not found' after being deleted. This is expected and no cause for alarm", "error", err)
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET NotificationChannel", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	err = resolveCredentials(rc, r.Client, &channel)
+	if err != nil {
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &channel, failureReason(err, nrv1.ReasonCredentialsError), err)
+		return ctrl.Result{}, err
+	}
+
+	notificationsClient, errNotificationsClient := r.NotificationsClientFunc(rc.apiKey, rc.region)
+	if errNotificationsClient != nil {
+		r.Log.Error(errNotificationsClient, "Failed to create Notifications Client")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &channel, nrv1.ReasonCredentialsError, errNotificationsClient)
+		return ctrl.Result{}, errNotificationsClient
+	}
+	rc.notifications = notificationsClient
+
+	// examine DeletionTimestamp to determine if object is under deletion
+	if channel.DeletionTimestamp.IsZero() {
+		if !containsString(channel.Finalizers, notificationChannelDeleteFinalizer) {
+			channel.Finalizers = append(channel.Finalizers, notificationChannelDeleteFinalizer)
+		}
+	} else {
+		return ctrl.Result{}, r.deleteChannel(rc, &channel)
+	}
+
+	destinationID, err := r.destinationID(rc, &channel)
+	if err != nil {
+		r.Log.Error(err, "failed to resolve destination of notification channel", "name", req.NamespacedName)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &channel, nrv1.ReasonNotificationDestinationNotFound, err)
+		return ctrl.Result{}, err
+	}
+
+	if reflect.DeepEqual(channel.Spec.Redacted(), channel.Status.AppliedSpec) && destinationID == channel.Status.DestinationID {
+		if err := updateReadyConditionsIfNeeded(rc.ctx, r.Client, &channel); err != nil {
+			r.Log.Error(err, "tried updating notification channel status", "name", req.NamespacedName)
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	r.Log.Info("Reconciling", "notificationChannel", channel.Name)
+
+	if err := r.writeChannel(rc, &channel, destinationID); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+//SetupWithManager - Sets up Controller for NotificationChannel
+func (r *NotificationChannelReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nrv1.NotificationChannel{}).
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nrv1.NotificationChannelList{} }, true)).
+		Watches(&source.Kind{Type: &nrv1.NotificationDestination{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(func(destination handler.MapObject) []reconcile.Request {
+				destinationKey := notificationDestinationIndexKey(destination.Meta.GetNamespace(), destination.Meta.GetName())
+				return listRequests(context.Background(), r.Client, r.Log, &nrv1.NotificationChannelList{}, client.MatchingFields{notificationDestinationIndexField: destinationKey})
+			}),
+		}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nrv1.NotificationChannel{}, r))
+}
+
+// destinationID returns the ID New Relic assigned to the NotificationDestination referenced by the channel
+func (r *NotificationChannelReconciler) destinationID(rc *requestContext, channel *nrv1.NotificationChannel) (string, error) {
+	var destination nrv1.NotificationDestination
+
+	key := types.NamespacedName{Namespace: channel.Namespace, Name: channel.Spec.DestinationRef}
+	if err := r.Client.Get(rc.ctx, key, &destination); err != nil {
+		if kErr.IsNotFound(err) {
+			return "", fmt.Errorf("NotificationDestination %s not found", key)
+		}
+		return "", err
+	}
+
+	if destination.Status.DestinationID == "" {
+		return "", fmt.Errorf("NotificationDestination %s has not been created in New Relic yet", key)
+	}
+
+	return destination.Status.DestinationID, nil
+}
+
+// writeChannel creates or updates the channel through NerdGraph and records the result on the status
+// of the NotificationChannel. The destination of a channel cannot be changed, a channel whose destination
+// has been recreated is created again.
+func (r *NotificationChannelReconciler) writeChannel(rc *requestContext, channel *nrv1.NotificationChannel, destinationID string) error {
+	defer rc.txn.StartSegment("writeChannel").End()
+
+	if channel.Status.ChannelID != "" && channel.Status.DestinationID != destinationID {
+		r.Log.Info("recreating notification channel for new destination", "channelId", channel.Status.ChannelID, "destinationId", destinationID)
+
+		err := rc.notifications.DeleteChannel(rc.accountID, channel.Status.ChannelID)
+		if err != nil && !isNotFound(err) {
+			r.Log.Error(err, "failed to delete notification channel of previous destination", "channelId", channel.Status.ChannelID)
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, channel, nrv1.ReasonUpdateFailed, err)
+			return err
+		}
+
+		channel.Status.ChannelID = ""
+	}
+
+	input := channel.Spec.ChannelInput(destinationID)
+
+	reason := nrv1.ReasonCreateFailed
+	eventReason := eventReasonCreated
+
+	var written *notifications.Channel
+	var err error
+
+	if channel.Status.ChannelID != "" {
+		r.Log.Info("updating notification channel", "channelName", channel.Spec.Name, "channelId", channel.Status.ChannelID)
+		reason = nrv1.ReasonUpdateFailed
+		eventReason = eventReasonUpdated
+
+		written, err = rc.notifications.UpdateChannel(rc.accountID, channel.Status.ChannelID, input)
+	} else {
+		r.Log.Info("creating notification channel", "channelName", channel.Spec.Name, "destinationId", destinationID)
+
+		written, err = rc.notifications.CreateChannel(rc.accountID, input)
+	}
+
+	if err != nil {
+		r.Log.Error(err, "failed to write notification channel",
+			"channelId", channel.Status.ChannelID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, channel, reason, err)
+		return err
+	}
+
+	channel.Status.ChannelID = written.ID
+	channel.Status.DestinationID = destinationID
+	channel.Status.AppliedSpec = channel.Spec.Redacted()
+	r.Recorder.Eventf(channel, v1.EventTypeNormal, eventReason, "%s New Relic notification channel %s", eventReason, channel.Status.ChannelID)
+	setReadyConditions(channel)
+
+	if err := updateWithStatus(rc.ctx, r.Client, channel); err != nil {
+		r.Log.Error(err, "tried updating notification channel status", "name", channel.Name)
+		return err
+	}
+
+	return nil
+}
+
+// deleteChannel deletes the channel from New Relic and removes the finalizer once it is gone
+func (r *NotificationChannelReconciler) deleteChannel(rc *requestContext, channel *nrv1.NotificationChannel) error {
+	if !containsString(channel.Finalizers, notificationChannelDeleteFinalizer) {
+		return nil
+	}
+
+	defer rc.txn.StartSegment("deleteChannel").End()
+
+	if channel.Status.ChannelID != "" {
+		r.Log.Info("Deleting notification channel", "channelName", channel.Spec.Name, "channelId", channel.Status.ChannelID)
+
+		err := rc.notifications.DeleteChannel(rc.accountID, channel.Status.ChannelID)
+		if err != nil && !isNotFound(err) {
+			r.Log.Error(err, "Failed to delete notification channel",
+				"channelId", channel.Status.ChannelID,
+				"region", rc.region,
+				"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+			)
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, channel, nrv1.ReasonDeleteFailed, err)
+			return err
+		}
+
+		r.Recorder.Eventf(channel, v1.EventTypeNormal, eventReasonDeleted, "Deleted New Relic notification channel %s", channel.Status.ChannelID)
+	}
+
+	// remove our finalizer from the list and update it.
+	channel.Finalizers = removeString(channel.Finalizers, notificationChannelDeleteFinalizer)
+	if err := r.Client.Update(rc.ctx, channel); err != nil {
+		r.Log.Error(err, "Failed to update notification channel after deleting New Relic notification channel")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/notifications"
+)
+
+var _ = Describe("NotificationChannel reconciliation", func() {
+	var (
+		ctx                 context.Context
+		r                   *NotificationChannelReconciler
+		channel             *nrv1.NotificationChannel
+		destination         *nrv1.NotificationDestination
+		namespacedName      types.NamespacedName
+		notificationsClient *interfacesfakes.FakeNewRelicNotificationsClient
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		notificationsClient = &interfacesfakes.FakeNewRelicNotificationsClient{}
+		notificationsClient.CreateChannelReturns(&notifications.Channel{ID: "channel-id"}, nil)
+		notificationsClient.UpdateChannelReturns(&notifications.Channel{ID: "channel-id"}, nil)
+
+		r = &NotificationChannelReconciler{
+			Client:   k8sClient,
+			Log:      logf.Log,
+			Recorder: record.NewFakeRecorder(100),
+			NotificationsClientFunc: func(string, string) (interfaces.NewRelicNotificationsClient, error) {
+				return notificationsClient, nil
+			},
+			NewRelicAgent: newrelic.Application{},
+		}
+
+		destination = &nrv1.NotificationDestination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ops-webhook",
+				Namespace: "default",
+			},
+			Spec: nrv1.NotificationDestinationSpec{
+				Name:       "ops webhook",
+				Type:       "WEBHOOK",
+				Properties: []nrv1.NotificationProperty{{Key: "url", Value: "https://example.com/"}},
+				APIKey:     "api-key",
+				Region:     "US",
+				AccountID:  1,
+			},
+		}
+
+		channel = &nrv1.NotificationChannel{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ops-webhook",
+				Namespace: "default",
+			},
+			Spec: nrv1.NotificationChannelSpec{
+				Name:           "ops webhook",
+				Type:           "WEBHOOK",
+				DestinationRef: "ops-webhook",
+				Properties:     []nrv1.NotificationProperty{{Key: "payload", Value: "{}"}},
+				APIKey:         "api-key",
+				Region:         "US",
+				AccountID:      1,
+			},
+			Status: nrv1.NotificationChannelStatus{
+				AppliedSpec: &nrv1.NotificationChannelSpec{},
+			},
+		}
+		namespacedName = types.NamespacedName{Namespace: "default", Name: "ops-webhook"}
+
+		Expect(k8sClient.Create(ctx, channel)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		var current nrv1.NotificationChannel
+		if err := k8sClient.Get(ctx, namespacedName, &current); err == nil {
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		if err := k8sClient.Get(ctx, namespacedName, destination); err == nil {
+			Expect(k8sClient.Delete(ctx, destination)).To(Succeed())
+		}
+	})
+
+	Context("when the destination has not been created yet", func() {
+		It("reports the missing destination", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(MatchError("NotificationDestination default/ops-webhook not found"))
+			Expect(notificationsClient.CreateChannelCallCount()).To(Equal(0))
+
+			var updated nrv1.NotificationChannel
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			failed := nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionError)
+			Expect(failed).ToNot(BeNil())
+			Expect(failed.Reason).To(Equal(nrv1.ReasonNotificationDestinationNotFound))
+		})
+	})
+
+	Context("when the destination exists", func() {
+		BeforeEach(func() {
+			destination.Status.DestinationID = "destination-id"
+			Expect(createWithStatus(ctx, destination)).To(Succeed())
+		})
+
+		It("creates the channel for the destination", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(notificationsClient.CreateChannelCallCount()).To(Equal(1))
+			accountID, input := notificationsClient.CreateChannelArgsForCall(0)
+			Expect(accountID).To(Equal(1))
+			Expect(input.DestinationID).To(Equal("destination-id"))
+			Expect(input.Product).To(Equal(notifications.ProductIINT))
+			Expect(input.Properties).To(Equal([]notifications.Property{{Key: "payload", Value: "{}"}}))
+
+			var updated nrv1.NotificationChannel
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.ChannelID).To(Equal("channel-id"))
+			Expect(updated.Status.DestinationID).To(Equal("destination-id"))
+			Expect(nrv1.IsConditionTrue(updated.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+		})
+
+		It("recreates the channel when the destination is recreated", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			var current nrv1.NotificationDestination
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			current.Status.DestinationID = "new-destination-id"
+			Expect(k8sClient.Status().Update(ctx, &current)).To(Succeed())
+
+			_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(notificationsClient.DeleteChannelCallCount()).To(Equal(1))
+			Expect(notificationsClient.UpdateChannelCallCount()).To(Equal(0))
+			Expect(notificationsClient.CreateChannelCallCount()).To(Equal(2))
+			_, input := notificationsClient.CreateChannelArgsForCall(1)
+			Expect(input.DestinationID).To(Equal("new-destination-id"))
+		})
+	})
+})
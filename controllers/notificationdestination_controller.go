@@ -0,0 +1,193 @@
+package controllers
+
+import (
+	"reflect"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/notifications"
+)
+
+const notificationDestinationDeleteFinalizer = "notificationdestinations.finalizers.nr.k8s.newrelic.com"
+
+// NotificationDestinationReconciler reconciles a NotificationDestination object
+type NotificationDestinationReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	NotificationsClientFunc func(string, string) (interfaces.NewRelicNotificationsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=notificationdestinations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=notificationdestinations/status,verbs=get;update;patch
+
+// Reconcile is responsible for reconciling the spec and state of the NotificationDestination.
+func (r *NotificationDestinationReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Notifications/Destination")
+	defer rc.txn.End()
+
+	var destination nrv1.NotificationDestination
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &destination)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			r.Log.Info("NotificationDestination 'not found' after being deleted. This is expected and no cause for alarm", "error", err)
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET NotificationDestination", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	err = resolveCredentials(rc, r.Client, &destination)
+	if err != nil {
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &destination, failureReason(err, nrv1.ReasonCredentialsError), err)
+		return ctrl.Result{}, err
+	}
+
+	notificationsClient, errNotificationsClient := r.NotificationsClientFunc(rc.apiKey, rc.region)
+	if errNotificationsClient != nil {
+		r.Log.Error(errNotificationsClient, "Failed to create Notifications Client")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &destination, nrv1.ReasonCredentialsError, errNotificationsClient)
+		return ctrl.Result{}, errNotificationsClient
+	}
+	rc.notifications = notificationsClient
+
+	// examine DeletionTimestamp to determine if object is under deletion
+	if destination.DeletionTimestamp.IsZero() {
+		if !containsString(destination.Finalizers, notificationDestinationDeleteFinalizer) {
+			destination.Finalizers = append(destination.Finalizers, notificationDestinationDeleteFinalizer)
+		}
+	} else {
+		return ctrl.Result{}, r.deleteDestination(rc, &destination)
+	}
+
+	if reflect.DeepEqual(destination.Spec.Redacted(), destination.Status.AppliedSpec) {
+		if err := updateReadyConditionsIfNeeded(rc.ctx, r.Client, &destination); err != nil {
+			r.Log.Error(err, "tried updating notification destination status", "name", req.NamespacedName)
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	r.Log.Info("Reconciling", "notificationDestination", destination.Name)
+
+	if err := r.writeDestination(rc, &destination); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+//SetupWithManager - Sets up Controller for NotificationDestination
+func (r *NotificationDestinationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nrv1.NotificationDestination{}).
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nrv1.NotificationDestinationList{} }, true)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nrv1.NotificationDestination{}, r))
+}
+
+// writeDestination creates or updates the destination through NerdGraph and records the result
+// on the status of the NotificationDestination
+func (r *NotificationDestinationReconciler) writeDestination(rc *requestContext, destination *nrv1.NotificationDestination) error {
+	defer rc.txn.StartSegment("writeDestination").End()
+
+	reason := nrv1.ReasonCreateFailed
+	eventReason := eventReasonCreated
+
+	if destination.Status.DestinationID != "" {
+		reason = nrv1.ReasonUpdateFailed
+		eventReason = eventReasonUpdated
+	}
+
+	input, err := destination.Spec.DestinationInput(r.Client)
+	if err != nil {
+		r.Log.Error(err, "failed to read credentials of notification destination", "name", destination.Name)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, destination, failureReason(err, reason), err)
+		return err
+	}
+
+	var written *notifications.Destination
+
+	if destination.Status.DestinationID != "" {
+		r.Log.Info("updating notification destination", "destinationName", destination.Spec.Name, "destinationId", destination.Status.DestinationID)
+		written, err = rc.notifications.UpdateDestination(rc.accountID, destination.Status.DestinationID, input)
+	} else {
+		r.Log.Info("creating notification destination", "destinationName", destination.Spec.Name, "accountId", rc.accountID)
+		written, err = rc.notifications.CreateDestination(rc.accountID, input)
+	}
+
+	if err != nil {
+		r.Log.Error(err, "failed to write notification destination",
+			"destinationId", destination.Status.DestinationID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, destination, reason, err)
+		return err
+	}
+
+	destination.Status.DestinationID = written.ID
+	destination.Status.AppliedSpec = destination.Spec.Redacted()
+	r.Recorder.Eventf(destination, v1.EventTypeNormal, eventReason, "%s New Relic notification destination %s", eventReason, destination.Status.DestinationID)
+	setReadyConditions(destination)
+
+	if err := updateWithStatus(rc.ctx, r.Client, destination); err != nil {
+		r.Log.Error(err, "tried updating notification destination status", "name", destination.Name)
+		return err
+	}
+
+	return nil
+}
+
+// deleteDestination deletes the destination from New Relic and removes the finalizer once it is gone.
+// New Relic refuses to delete a destination while channels still send to it, the deletion is retried
+// until the channels are gone.
+func (r *NotificationDestinationReconciler) deleteDestination(rc *requestContext, destination *nrv1.NotificationDestination) error {
+	if !containsString(destination.Finalizers, notificationDestinationDeleteFinalizer) {
+		return nil
+	}
+
+	defer rc.txn.StartSegment("deleteDestination").End()
+
+	if destination.Status.DestinationID != "" {
+		r.Log.Info("Deleting notification destination", "destinationName", destination.Spec.Name, "destinationId", destination.Status.DestinationID)
+
+		err := rc.notifications.DeleteDestination(rc.accountID, destination.Status.DestinationID)
+		if err != nil && !isNotFound(err) {
+			r.Log.Error(err, "Failed to delete notification destination",
+				"destinationId", destination.Status.DestinationID,
+				"region", rc.region,
+				"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+			)
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, destination, nrv1.ReasonDeleteFailed, err)
+			return err
+		}
+
+		r.Recorder.Eventf(destination, v1.EventTypeNormal, eventReasonDeleted, "Deleted New Relic notification destination %s", destination.Status.DestinationID)
+	}
+
+	// remove our finalizer from the list and update it.
+	destination.Finalizers = removeString(destination.Finalizers, notificationDestinationDeleteFinalizer)
+	if err := r.Client.Update(rc.ctx, destination); err != nil {
+		r.Log.Error(err, "Failed to update notification destination after deleting New Relic notification destination")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/notifications"
+)
+
+var _ = Describe("NotificationDestination reconciliation", func() {
+	var (
+		ctx                 context.Context
+		r                   *NotificationDestinationReconciler
+		destination         *nrv1.NotificationDestination
+		secret              *v1.Secret
+		namespacedName      types.NamespacedName
+		notificationsClient *interfacesfakes.FakeNewRelicNotificationsClient
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		notificationsClient = &interfacesfakes.FakeNewRelicNotificationsClient{}
+		notificationsClient.CreateDestinationReturns(&notifications.Destination{ID: "destination-id"}, nil)
+		notificationsClient.UpdateDestinationReturns(&notifications.Destination{ID: "destination-id"}, nil)
+
+		r = &NotificationDestinationReconciler{
+			Client:   k8sClient,
+			Log:      logf.Log,
+			Recorder: record.NewFakeRecorder(100),
+			NotificationsClientFunc: func(string, string) (interfaces.NewRelicNotificationsClient, error) {
+				return notificationsClient, nil
+			},
+			NewRelicAgent: newrelic.Application{},
+		}
+
+		secret = &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "webhook-token",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{"token": []byte("s3cr3t")},
+		}
+		Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+
+		destination = &nrv1.NotificationDestination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ops-webhook",
+				Namespace: "default",
+			},
+			Spec: nrv1.NotificationDestinationSpec{
+				Name:       "ops webhook",
+				Type:       "WEBHOOK",
+				Properties: []nrv1.NotificationProperty{{Key: "url", Value: "https://example.com/"}},
+				Auth: &nrv1.NotificationDestinationAuth{
+					Type:      nrv1.NotificationAuthTypeToken,
+					Prefix:    "Bearer",
+					Secret:    "webhook-token",
+					Namespace: "default",
+					KeyName:   "token",
+				},
+				APIKey:    "api-key",
+				Region:    "US",
+				AccountID: 1,
+			},
+			Status: nrv1.NotificationDestinationStatus{
+				AppliedSpec: &nrv1.NotificationDestinationSpec{},
+			},
+		}
+		namespacedName = types.NamespacedName{Namespace: "default", Name: "ops-webhook"}
+
+		Expect(k8sClient.Create(ctx, destination)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		var current nrv1.NotificationDestination
+		if err := k8sClient.Get(ctx, namespacedName, &current); err == nil {
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+	})
+
+	It("creates the destination with the token from the secret", func() {
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(notificationsClient.CreateDestinationCallCount()).To(Equal(1))
+		accountID, input := notificationsClient.CreateDestinationArgsForCall(0)
+		Expect(accountID).To(Equal(1))
+		Expect(input.Type).To(Equal("WEBHOOK"))
+		Expect(input.Auth.Token).To(Equal(&notifications.TokenAuthInput{Prefix: "Bearer", Token: "s3cr3t"}))
+
+		var updated nrv1.NotificationDestination
+		Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+		Expect(updated.Status.DestinationID).To(Equal("destination-id"))
+		Expect(updated.Finalizers).To(ContainElement(notificationDestinationDeleteFinalizer))
+		Expect(nrv1.IsConditionTrue(updated.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+	})
+
+	It("reports a missing key in the secret", func() {
+		var current nrv1.NotificationDestination
+		Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+		current.Spec.Auth.KeyName = "missing"
+		Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).To(HaveOccurred())
+		Expect(notificationsClient.CreateDestinationCallCount()).To(Equal(0))
+
+		var updated nrv1.NotificationDestination
+		Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+		failed := nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionError)
+		Expect(failed).ToNot(BeNil())
+		Expect(failed.Reason).To(Equal(nrv1.ReasonSecretKeyNotFound))
+	})
+
+	It("removes the finalizer when the destination is already gone", func() {
+		_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		notificationsClient.DeleteDestinationReturns(nrErrors.NewNotFound("ENTITY_NOT_FOUND: destination not found"))
+
+		var current nrv1.NotificationDestination
+		Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+		_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, namespacedName, &current)).ToNot(Succeed())
+	})
+})
@@ -16,7 +16,6 @@ limitations under the License.
 package controllers
 
 import (
-	"context"
 	"errors"
 	"reflect"
 
@@ -30,8 +29,11 @@ import (
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	nralertsv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
 )
@@ -39,28 +41,26 @@ import (
 // NrqlAlertConditionReconciler reconciles a NrqlAlertCondition object
 type NrqlAlertConditionReconciler struct {
 	client.Client
-	Alerts          interfaces.NewRelicAlertsClient
-	Log             logr.Logger
-	Scheme          *runtime.Scheme
-	AlertClientFunc func(string, string) (interfaces.NewRelicAlertsClient, error)
-	apiKey          string
-	NewRelicAgent   newrelic.Application
-	txn             *newrelic.Transaction
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	AlertClientFunc         func(string, string) (interfaces.NewRelicAlertsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
 }
 
 // +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=nrqlalertconditions,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=nrqlalertconditions/status,verbs=get;update;patch
 
 func (r *NrqlAlertConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
-	ctx := context.Background()
 	_ = r.Log.WithValues("nrqlalertcondition", req.NamespacedName)
 
-	r.txn = r.NewRelicAgent.StartTransaction("Reconcile/NrqlCondition")
-	defer r.txn.End()
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/NrqlCondition")
+	defer rc.txn.End()
 
 	r.Log.Info("Starting reconcile action")
 	var condition nralertsv1.NrqlAlertCondition
-	err := r.Client.Get(ctx, req.NamespacedName, &condition)
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &condition)
 	if err != nil {
 		if kErr.IsNotFound(err) {
 			r.Log.Info("Expected error 'not found' after condition deleted", "error", err)
@@ -70,21 +70,31 @@ func (r *NrqlAlertConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result,
 		return ctrl.Result{}, err
 	}
 
-	r.apiKey, err = r.getAPIKeyOrSecret(condition)
+	rc.apiKey, err = r.getAPIKeyOrSecret(rc, condition)
 	if err != nil {
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &condition, failureReason(err, nralertsv1.ReasonCredentialsError), err)
 		return ctrl.Result{}, err
 	}
 
-	if r.apiKey == "" {
-		return ctrl.Result{}, errors.New("api key is blank")
+	if rc.apiKey == "" {
+		err = errors.New("api key is blank")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &condition, nralertsv1.ReasonCredentialsError, err)
+		return ctrl.Result{}, err
+	}
+
+	err = moveAPIKeyToSecret(rc, r.Client, &condition, condition.Spec.APIKeySecret)
+	if err != nil {
+		return ctrl.Result{}, err
 	}
+
 	//initial alertsClient
-	alertsClient, errAlertsClient := r.AlertClientFunc(r.apiKey, condition.Spec.Region)
+	alertsClient, errAlertsClient := r.AlertClientFunc(rc.apiKey, condition.Spec.Region)
 	if errAlertsClient != nil {
 		r.Log.Error(errAlertsClient, "Error thrown")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &condition, nralertsv1.ReasonCredentialsError, errAlertsClient)
 		return ctrl.Result{}, errAlertsClient
 	}
-	r.Alerts = alertsClient
+	rc.alerts = interfaces.NewTracedAlertsClient(alertsClient, rc.txn, rc.accountID, condition.Spec.Region)
 
 	deleteFinalizer := "nrqlalertconditions.finalizers.nr.k8s.newrelic.com"
 
@@ -100,19 +110,19 @@ func (r *NrqlAlertConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result,
 			if condition.Status.ConditionID == 0 {
 				r.Log.Info("No Condition ID set, just removing finalizer")
 				condition.Finalizers = removeString(condition.Finalizers, deleteFinalizer)
-				if err := r.Client.Update(ctx, &condition); err != nil {
+				if err := r.Client.Update(rc.ctx, &condition); err != nil {
 					r.Log.Error(err, "Failed to update condition after deleting New Relic Alert condition")
 					return ctrl.Result{}, err
 				}
 			} else {
 				// our finalizer is present, so lets handle any external dependency
-				if err := r.deleteNewRelicAlertCondition(condition); err != nil {
+				if err := r.deleteNewRelicAlertCondition(rc, condition); err != nil {
 					// if fail to delete the external dependency here, return with error
 					// so that it can be retried
 					r.Log.Error(err, "Failed to delete API Condition",
 						"conditionId", condition.Status.ConditionID,
 						"region", condition.Spec.Region,
-						"Api Key", interfaces.PartialAPIKey(r.apiKey),
+						"Api Key", interfaces.PartialAPIKey(rc.apiKey),
 					)
 					if err.Error() == "resource not found" {
 						r.Log.Info("New Relic API returned resource not found, deleting condition resource")
@@ -120,15 +130,16 @@ func (r *NrqlAlertConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result,
 						r.Log.Error(err, "Failed to delete API Condition",
 							"conditionId", condition.Status.ConditionID,
 							"region", condition.Spec.Region,
-							"Api Key", interfaces.PartialAPIKey(r.apiKey),
+							"Api Key", interfaces.PartialAPIKey(rc.apiKey),
 						)
+						updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &condition, nralertsv1.ReasonDeleteFailed, err)
 						return ctrl.Result{}, err
 					}
 				}
 				// remove our finalizer from the list and update it.
 				r.Log.Info("New Relic Alert condition deleted, Removing finalizer")
 				condition.Finalizers = removeString(condition.Finalizers, deleteFinalizer)
-				if err := r.Client.Update(ctx, &condition); err != nil {
+				if err := r.Client.Update(rc.ctx, &condition); err != nil {
 					r.Log.Error(err, "Failed to update condition after deleting New Relic Alert condition")
 					return ctrl.Result{}, err
 				}
@@ -141,31 +152,37 @@ func (r *NrqlAlertConditionReconciler) Reconcile(req ctrl.Request) (ctrl.Result,
 		return ctrl.Result{}, nil
 	}
 
-	if reflect.DeepEqual(&condition.Spec, condition.Status.AppliedSpec) {
+	if reflect.DeepEqual(condition.Spec.Redacted(), condition.Status.AppliedSpec) {
+		if err := updateReadyConditionsIfNeeded(rc.ctx, r.Client, &condition); err != nil {
+			r.Log.Error(err, "tried updating condition status", "name", req.NamespacedName)
+			return ctrl.Result{}, err
+		}
 		return ctrl.Result{}, nil
 	}
 
 	r.Log.Info("Reconciling", "condition", condition.Name)
 
 	//check if condition has condition id
-	r.checkForExistingCondition(&condition)
+	r.checkForExistingCondition(rc, &condition)
 
-	r.writeNewRelicAlertCondition(ctx, req, alertsClient, condition)
+	if err := r.writeNewRelicAlertCondition(rc, req, condition); err != nil {
+		return ctrl.Result{}, err
+	}
 
 	return ctrl.Result{}, nil
 }
 
-func (r *NrqlAlertConditionReconciler) checkForExistingCondition(condition *nralertsv1.NrqlAlertCondition) {
-	defer r.txn.StartSegment("checkForExistingCondition").End()
+func (r *NrqlAlertConditionReconciler) checkForExistingCondition(rc *requestContext, condition *nralertsv1.NrqlAlertCondition) {
+	defer rc.txn.StartSegment("checkForExistingCondition").End()
 	if condition.Status.ConditionID == 0 {
 		r.Log.Info("Checking for existing condition", "conditionName", condition.Name)
 		//if no conditionId, get list of conditions and compare name
-		existingConditions, err := r.Alerts.ListNrqlConditions(condition.Spec.ExistingPolicyID)
+		existingConditions, err := rc.alerts.ListNrqlConditions(condition.Spec.ExistingPolicyID)
 		if err != nil {
 			r.Log.Error(err, "failed to get list of NRQL conditions from New Relic API",
 				"conditionId", condition.Status.ConditionID,
 				"region", condition.Spec.Region,
-				"Api Key", interfaces.PartialAPIKey(r.apiKey),
+				"Api Key", interfaces.PartialAPIKey(rc.apiKey),
 			)
 		} else {
 			for _, existingCondition := range existingConditions {
@@ -179,43 +196,53 @@ func (r *NrqlAlertConditionReconciler) checkForExistingCondition(condition *nral
 	}
 }
 
-func (r *NrqlAlertConditionReconciler) writeNewRelicAlertCondition(ctx context.Context, req ctrl.Request, alertsClient interfaces.NewRelicAlertsClient, condition nralertsv1.NrqlAlertCondition) {
+func (r *NrqlAlertConditionReconciler) writeNewRelicAlertCondition(rc *requestContext, req ctrl.Request, condition nralertsv1.NrqlAlertCondition) error {
 	APICondition := condition.Spec.APICondition()
 
-	if condition.Status.ConditionID != 0 && !reflect.DeepEqual(&condition.Spec, condition.Status.AppliedSpec) {
+	if condition.Status.ConditionID != 0 && !reflect.DeepEqual(condition.Spec.Redacted(), condition.Status.AppliedSpec) {
 		r.Log.Info("updating condition", "ConditionName", condition.Name, "API fields", APICondition)
 		APICondition.ID = condition.Status.ConditionID
-		updatedCondition, err := alertsClient.UpdateNrqlCondition(APICondition)
+		updatedCondition, err := rc.alerts.UpdateNrqlCondition(APICondition)
 		if err != nil {
 			r.Log.Error(err, "failed to update condition")
+			recordFailure(r.Recorder, &condition, nralertsv1.ReasonUpdateFailed, err)
+			setFailedConditions(&condition, nralertsv1.ReasonUpdateFailed, err)
 		} else {
-			condition.Status.AppliedSpec = &condition.Spec
+			condition.Status.AppliedSpec = condition.Spec.Redacted()
 			condition.Status.ConditionID = updatedCondition.ID
+			setReadyConditions(&condition)
 		}
 
-		err = r.Client.Update(ctx, &condition)
-		if err != nil {
-			r.Log.Error(err, "tried updating condition status", "name", req.NamespacedName)
+		if updateErr := updateWithStatus(rc.ctx, r.Client, &condition); updateErr != nil {
+			r.Log.Error(updateErr, "tried updating condition status", "name", req.NamespacedName)
+			return updateErr
 		}
+
+		return err
+	}
+
+	r.Log.Info("Creating condition", "ConditionName", condition.Name, "API fields", APICondition)
+	createdCondition, err := rc.alerts.CreateNrqlCondition(condition.Spec.ExistingPolicyID, APICondition)
+	if err != nil {
+		r.Log.Error(err, "failed to create condition",
+			"conditionId", condition.Status.ConditionID,
+			"region", condition.Spec.Region,
+			"Api Key", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		recordFailure(r.Recorder, &condition, nralertsv1.ReasonCreateFailed, err)
+		setFailedConditions(&condition, nralertsv1.ReasonCreateFailed, err)
 	} else {
-		r.Log.Info("Creating condition", "ConditionName", condition.Name, "API fields", APICondition)
-		createdCondition, err := alertsClient.CreateNrqlCondition(condition.Spec.ExistingPolicyID, APICondition)
-		if err != nil {
-			r.Log.Error(err, "failed to create condition",
-				"conditionId", condition.Status.ConditionID,
-				"region", condition.Spec.Region,
-				"Api Key", interfaces.PartialAPIKey(r.apiKey),
-			)
-		} else {
-			condition.Status.AppliedSpec = &condition.Spec
-			condition.Status.ConditionID = createdCondition.ID
-		}
+		condition.Status.AppliedSpec = condition.Spec.Redacted()
+		condition.Status.ConditionID = createdCondition.ID
+		setReadyConditions(&condition)
+	}
 
-		err = r.Client.Update(ctx, &condition)
-		if err != nil {
-			r.Log.Error(err, "tried updating condition status", "name", req.NamespacedName)
-		}
+	if updateErr := updateWithStatus(rc.ctx, r.Client, &condition); updateErr != nil {
+		r.Log.Error(updateErr, "tried updating condition status", "name", req.NamespacedName)
+		return updateErr
 	}
+
+	return err
 }
 
 func (r *NrqlAlertConditionReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -223,7 +250,9 @@ func (r *NrqlAlertConditionReconciler) SetupWithManager(mgr ctrl.Manager) error
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&nralertsv1.NrqlAlertCondition{}).
-		Complete(r)
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nralertsv1.NrqlAlertConditionList{} }, false)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nralertsv1.NrqlAlertCondition{}, r))
 }
 
 func containsString(slice []string, s string) bool {
@@ -236,15 +265,15 @@ func containsString(slice []string, s string) bool {
 	return false
 }
 
-func (r *NrqlAlertConditionReconciler) deleteNewRelicAlertCondition(condition nralertsv1.NrqlAlertCondition) error {
-	defer r.txn.StartSegment("deleteNewRelicAlertCondition").End()
+func (r *NrqlAlertConditionReconciler) deleteNewRelicAlertCondition(rc *requestContext, condition nralertsv1.NrqlAlertCondition) error {
+	defer rc.txn.StartSegment("deleteNewRelicAlertCondition").End()
 	r.Log.Info("Deleting condition", "conditionName", condition.Spec.Name)
-	_, err := r.Alerts.DeleteNrqlCondition(condition.Status.ConditionID)
+	_, err := rc.alerts.DeleteNrqlCondition(condition.Status.ConditionID)
 	if err != nil {
 		r.Log.Error(err, "Error deleting condition",
 			"conditionId", condition.Status.ConditionID,
 			"region", condition.Spec.Region,
-			"Api Key", interfaces.PartialAPIKey(r.apiKey),
+			"Api Key", interfaces.PartialAPIKey(rc.apiKey),
 		)
 
 		return err
@@ -264,8 +293,8 @@ func removeString(slice []string, s string) (result []string) {
 	return
 }
 
-func (r *NrqlAlertConditionReconciler) getAPIKeyOrSecret(condition nralertsv1.NrqlAlertCondition) (string, error) {
-	defer r.txn.StartSegment("getAPIKeyOrSecret").End()
+func (r *NrqlAlertConditionReconciler) getAPIKeyOrSecret(rc *requestContext, condition nralertsv1.NrqlAlertCondition) (string, error) {
+	defer rc.txn.StartSegment("getAPIKeyOrSecret").End()
 	if condition.Spec.APIKey != "" {
 		return condition.Spec.APIKey, nil
 	}
@@ -273,7 +302,7 @@ func (r *NrqlAlertConditionReconciler) getAPIKeyOrSecret(condition nralertsv1.Nr
 	if condition.Spec.APIKeySecret != (nralertsv1.NewRelicAPIKeySecret{}) {
 		key := types.NamespacedName{Namespace: condition.Spec.APIKeySecret.Namespace, Name: condition.Spec.APIKeySecret.Name}
 		var apiKeySecret v1.Secret
-		if getErr := r.Client.Get(context.Background(), key, &apiKeySecret); getErr != nil {
+		if getErr := r.Client.Get(rc.ctx, key, &apiKeySecret); getErr != nil {
 			r.Log.Error(getErr, "Error retrieving secret", "secret", apiKeySecret)
 			return "", getErr
 		}
@@ -11,6 +11,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -77,6 +78,7 @@ var _ = Describe("NrqlCondition reconciliation", func() {
 		r = &NrqlAlertConditionReconciler{
 			Client:          k8sClient,
 			Log:             logf.Log,
+			Recorder:        record.NewFakeRecorder(100),
 			AlertClientFunc: fakeAlertFunc,
 			NewRelicAgent:   newRelicAgent,
 		}
@@ -172,7 +174,39 @@ var _ = Describe("NrqlCondition reconciliation", func() {
 					var endStateCondition nrv1.NrqlAlertCondition
 					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
 					Expect(err).To(BeNil())
-					Expect(endStateCondition.Status.AppliedSpec).To(Equal(&condition.Spec))
+					Expect(endStateCondition.Status.AppliedSpec).To(Equal(condition.Spec.Redacted()))
+				})
+
+				It("sets the Ready condition on the kubernetes object", func() {
+					err := k8sClient.Create(ctx, condition)
+					Expect(err).ToNot(HaveOccurred())
+
+					// call reconcile
+					_, err = r.Reconcile(request)
+					Expect(err).ToNot(HaveOccurred())
+
+					var endStateCondition nrv1.NrqlAlertCondition
+					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
+					Expect(err).To(BeNil())
+					Expect(nrv1.IsConditionTrue(endStateCondition.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+				})
+
+				It("records a failure to create the condition", func() {
+					alertsClient.CreateNrqlConditionReturns(nil, errors.New("invalid query"))
+
+					err := k8sClient.Create(ctx, condition)
+					Expect(err).ToNot(HaveOccurred())
+
+					// call reconcile
+					_, err = r.Reconcile(request)
+					Expect(err).To(MatchError("invalid query"))
+
+					var endStateCondition nrv1.NrqlAlertCondition
+					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
+					Expect(err).To(BeNil())
+					failed := nrv1.FindCondition(endStateCondition.Status.Conditions, nrv1.ConditionError)
+					Expect(failed).ToNot(BeNil())
+					Expect(failed.Reason).To(Equal(nrv1.ReasonCreateFailed))
 				})
 			})
 		})
@@ -241,7 +275,7 @@ var _ = Describe("NrqlCondition reconciliation", func() {
 					var endStateCondition nrv1.NrqlAlertCondition
 					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
 					Expect(err).To(BeNil())
-					Expect(endStateCondition.Status.AppliedSpec).To(Equal(&condition.Spec))
+					Expect(endStateCondition.Status.AppliedSpec).To(Equal(condition.Spec.Redacted()))
 				})
 			})
 		})
@@ -328,7 +362,7 @@ var _ = Describe("NrqlCondition reconciliation", func() {
 					var endStateCondition nrv1.NrqlAlertCondition
 					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
 					Expect(err).To(BeNil())
-					Expect(endStateCondition.Status.AppliedSpec).To(Equal(&condition.Spec))
+					Expect(endStateCondition.Status.AppliedSpec).To(Equal(condition.Spec.Redacted()))
 				})
 			})
 		})
@@ -394,7 +428,7 @@ var _ = Describe("NrqlCondition reconciliation", func() {
 					var endStateCondition nrv1.NrqlAlertCondition
 					err = k8sClient.Get(ctx, namespacedName, &endStateCondition)
 					Expect(err).To(BeNil())
-					Expect(endStateCondition.Status.AppliedSpec).To(Equal(&condition.Spec))
+					Expect(endStateCondition.Status.AppliedSpec).To(Equal(condition.Spec.Redacted()))
 				})
 			})
 
@@ -471,7 +505,7 @@ var _ = Describe("NrqlCondition reconciliation", func() {
 			Context("with a condition with no condition ID", func() {
 				BeforeEach(func() {
 					condition.Status.ConditionID = 0
-					err := k8sClient.Update(ctx, condition)
+					err := k8sClient.Status().Update(ctx, condition)
 					Expect(err).ToNot(HaveOccurred())
 
 				})
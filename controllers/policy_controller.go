@@ -16,7 +16,6 @@ limitations under the License.
 package controllers
 
 import (
-	"context"
 	"errors"
 	"reflect"
 
@@ -28,8 +27,11 @@ import (
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
 	customErrors "github.com/newrelic/newrelic-kubernetes-operator/errors"
@@ -39,28 +41,25 @@ import (
 // PolicyReconciler reconciles a Policy object
 type PolicyReconciler struct {
 	client.Client
-	Log             logr.Logger
-	Scheme          *runtime.Scheme
-	AlertClientFunc func(string, string) (interfaces.NewRelicAlertsClient, error)
-	apiKey          string
-	Alerts          interfaces.NewRelicAlertsClient
-	ctx             context.Context
-	NewRelicAgent   newrelic.Application
-	txn             *newrelic.Transaction
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	AlertClientFunc         func(string, string) (interfaces.NewRelicAlertsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
 }
 
 // +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=policies,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=policies/status,verbs=get;update;patch
 
 func (r *PolicyReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
-	r.ctx = context.Background()
 	_ = r.Log.WithValues("policy", req.NamespacedName)
 
-	r.txn = r.NewRelicAgent.StartTransaction("Reconcile/Policy")
-	defer r.txn.End()
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Policy")
+	defer rc.txn.End()
 
 	var policy nrv1.Policy
-	err := r.Client.Get(r.ctx, req.NamespacedName, &policy)
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &policy)
 	if err != nil {
 		if kErr.IsNotFound(err) {
 			r.Log.Info("Policy 'not found' after being deleted. This is expected and no cause for alarm", "error", err)
@@ -69,24 +68,41 @@ func (r *PolicyReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		r.Log.Error(err, "Failed to GET policy", "name", req.NamespacedName.String())
 		return ctrl.Result{}, err
 	}
+
 	r.Log.Info("Starting reconcile action")
-	r.Log.Info("policy", "policy.Spec.Condition", policy.Spec.Conditions, "policy.status.applied.conditions", policy.Status.AppliedSpec.Conditions)
 
-	r.apiKey, err = r.getAPIKeyOrSecret(policy)
+	rc.apiKey, err = r.getAPIKeyOrSecret(rc, policy)
 	if err != nil {
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &policy, failureReason(err, nrv1.ReasonCredentialsError), err)
 		return ctrl.Result{}, err
 	}
 
-	if r.apiKey == "" {
-		return ctrl.Result{}, errors.New("api key is blank")
+	if rc.apiKey == "" {
+		err = errors.New("api key is blank")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &policy, nrv1.ReasonCredentialsError, err)
+		return ctrl.Result{}, err
+	}
+
+	err = moveAPIKeyToSecret(rc, r.Client, &policy, policy.Spec.APIKeySecret)
+	if err != nil {
+		return ctrl.Result{}, err
 	}
+
 	//initial alertsClient
-	alertsClient, errAlertsClient := r.AlertClientFunc(r.apiKey, policy.Spec.Region)
+	alertsClient, errAlertsClient := r.AlertClientFunc(rc.apiKey, policy.Spec.Region)
 	if errAlertsClient != nil {
 		r.Log.Error(errAlertsClient, "Failed to create AlertsClient")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &policy, nrv1.ReasonCredentialsError, errAlertsClient)
 		return ctrl.Result{}, errAlertsClient
 	}
-	r.Alerts = alertsClient
+	rc.alerts = interfaces.NewTracedAlertsClient(alertsClient, rc.txn, rc.accountID, policy.Spec.Region)
+
+	// the status is not part of the create request, a new policy has no applied spec yet
+	if policy.Status.AppliedSpec == nil {
+		policy.Status.AppliedSpec = &nrv1.PolicySpec{}
+	}
+
+	r.Log.Info("policy", "policy.Spec.Condition", policy.Spec.Conditions, "policy.status.applied.conditions", policy.Status.AppliedSpec.Conditions)
 
 	deleteFinalizer := "policies.finalizers.nr.k8s.newrelic.com"
 
@@ -96,27 +112,33 @@ func (r *PolicyReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 			policy.Finalizers = append(policy.Finalizers, deleteFinalizer)
 		}
 	} else {
-		return r.deletePolicy(r.ctx, &policy, deleteFinalizer)
+		return r.deletePolicy(rc, &policy, deleteFinalizer)
 	}
 
-	if policy.Spec.Equals(*policy.Status.AppliedSpec) {
+	if policy.Spec.Redacted().Equals(*policy.Status.AppliedSpec) {
+		if err := updateReadyConditionsIfNeeded(rc.ctx, r.Client, &policy); err != nil {
+			r.Log.Error(err, "tried updating policy status", "name", req.NamespacedName)
+			return ctrl.Result{}, err
+		}
 		return ctrl.Result{}, nil
 	}
 
 	r.Log.Info("Reconciling", "policy", policy.Name)
 
-	r.checkForExistingPolicy(&policy)
+	r.checkForExistingPolicy(rc, &policy)
 
 	if policy.Status.PolicyID != 0 {
-		err := r.updatePolicy(&policy)
+		err := r.updatePolicy(rc, &policy)
 		if err != nil {
 			r.Log.Error(err, "error updating policy")
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &policy, nrv1.ReasonUpdateFailed, err)
 			return ctrl.Result{}, err
 		}
 	} else {
-		err := r.createPolicy(&policy)
+		err := r.createPolicy(rc, &policy)
 		if err != nil {
 			r.Log.Error(err, "Error creating policy")
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &policy, nrv1.ReasonCreateFailed, err)
 			return ctrl.Result{}, err
 		}
 	}
@@ -124,23 +146,23 @@ func (r *PolicyReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	return ctrl.Result{}, nil
 }
 
-func (r *PolicyReconciler) createPolicy(policy *nrv1.Policy) error {
-	defer r.txn.StartSegment("createPolicy").End()
+func (r *PolicyReconciler) createPolicy(rc *requestContext, policy *nrv1.Policy) error {
+	defer rc.txn.StartSegment("createPolicy").End()
 	r.Log.Info("Creating policy", "PolicyName", policy.Name)
 	APIPolicy := policy.Spec.APIPolicy()
-	createdPolicy, err := r.Alerts.CreatePolicy(APIPolicy)
+	createdPolicy, err := rc.alerts.CreatePolicy(APIPolicy)
 	if err != nil {
 		r.Log.Error(err, "failed to create policy via New Relic API",
 			"policyId", policy.Status.PolicyID,
 			"region", policy.Spec.Region,
-			"Api Key", interfaces.PartialAPIKey(r.apiKey),
+			"Api Key", interfaces.PartialAPIKey(rc.apiKey),
 		)
 
 		return err
 	}
 	policy.Status.PolicyID = createdPolicy.ID
 
-	errConditions := r.createConditions(policy)
+	errConditions := r.createConditions(rc, policy)
 	if errConditions != nil {
 		r.Log.Error(errConditions, "error creating or updating conditions")
 
@@ -148,9 +170,10 @@ func (r *PolicyReconciler) createPolicy(policy *nrv1.Policy) error {
 	}
 	r.Log.Info("policy after condition creation", "policyCondition", policy.Spec.Conditions, "pointer", &policy)
 
-	policy.Status.AppliedSpec = &policy.Spec
+	policy.Status.AppliedSpec = policy.Spec.Redacted()
+	setReadyConditions(policy)
 
-	err = r.Client.Update(r.ctx, policy)
+	err = updateWithStatus(rc.ctx, r.Client, policy)
 	if err != nil {
 		r.Log.Error(err, "tried updating policy status", "name", policy.Name)
 
@@ -160,8 +183,8 @@ func (r *PolicyReconciler) createPolicy(policy *nrv1.Policy) error {
 	return nil
 }
 
-func (r *PolicyReconciler) createConditions(policy *nrv1.Policy) error {
-	defer r.txn.StartSegment("createConditions").End()
+func (r *PolicyReconciler) createConditions(rc *requestContext, policy *nrv1.Policy) error {
+	defer rc.txn.StartSegment("createConditions").End()
 	r.Log.Info("initial policy creation so create all policies")
 	collectedErrors := new(customErrors.ErrorCollector)
 	for i, condition := range policy.Spec.Conditions {
@@ -169,9 +192,9 @@ func (r *PolicyReconciler) createConditions(policy *nrv1.Policy) error {
 		var err error
 		switch nrv1.GetConditionType(condition) {
 		case "ApmAlertCondition":
-			err = r.createApmCondition(policy, &condition)
+			err = r.createApmCondition(rc, policy, &condition)
 		case "NrqlAlertCondition":
-			err = r.createNrqlCondition(policy, &condition)
+			err = r.createNrqlCondition(rc, policy, &condition)
 		}
 
 		if err != nil {
@@ -195,8 +218,8 @@ type processedConditions struct {
 	condition nrv1.PolicyCondition
 }
 
-func (r *PolicyReconciler) createOrUpdateCondition(policy *nrv1.Policy, condition *nrv1.PolicyCondition) (*nrv1.PolicyCondition, error) {
-	defer r.txn.StartSegment("createOrUpdateCondition").End()
+func (r *PolicyReconciler) createOrUpdateCondition(rc *requestContext, policy *nrv1.Policy, condition *nrv1.PolicyCondition) (*nrv1.PolicyCondition, error) {
+	defer rc.txn.StartSegment("createOrUpdateCondition").End()
 	//loop through the policies, creating/updating as needed
 	r.Log.Info("Checking on condition", "resourceName", condition.Name, "conditionName", condition.Spec.Name)
 	//first we check to see if the name is set
@@ -219,9 +242,9 @@ func (r *PolicyReconciler) createOrUpdateCondition(policy *nrv1.Policy, conditio
 			var err error
 			switch nrv1.GetConditionType(*condition) {
 			case "ApmAlertCondition":
-				err = r.createApmCondition(policy, condition)
+				err = r.createApmCondition(rc, policy, condition)
 			case "NrqlAlertCondition":
-				err = r.createNrqlCondition(policy, condition)
+				err = r.createNrqlCondition(rc, policy, condition)
 			}
 			return condition, err
 		}
@@ -230,17 +253,17 @@ func (r *PolicyReconciler) createOrUpdateCondition(policy *nrv1.Policy, conditio
 	var err error
 	switch nrv1.GetConditionType(*condition) {
 	case "ApmAlertCondition":
-		err = r.updateApmCondition(policy, condition)
+		err = r.updateApmCondition(rc, policy, condition)
 	case "NrqlAlertCondition":
-		err = r.updateNrqlCondition(policy, condition)
+		err = r.updateNrqlCondition(rc, policy, condition)
 	}
 
 	return condition, err
 }
 
-func (r *PolicyReconciler) updateNrqlCondition(policy *nrv1.Policy, condition *nrv1.PolicyCondition) error {
-	defer r.txn.StartSegment("updateNrqlCondition").End()
-	nrqlAlertCondition := r.getNrqlConditionFromPolicyCondition(condition)
+func (r *PolicyReconciler) updateNrqlCondition(rc *requestContext, policy *nrv1.Policy, condition *nrv1.PolicyCondition) error {
+	defer rc.txn.StartSegment("updateNrqlCondition").End()
+	nrqlAlertCondition := r.getNrqlConditionFromPolicyCondition(rc, condition)
 
 	r.Log.Info("Found nrql condition to update", "retrievedCondition", nrqlAlertCondition)
 
@@ -261,15 +284,15 @@ func (r *PolicyReconciler) updateNrqlCondition(policy *nrv1.Policy, condition *n
 	//Set inherited values
 	nrqlAlertCondition.Spec.Region = policy.Spec.Region
 	nrqlAlertCondition.Spec.ExistingPolicyID = policy.Status.PolicyID
-	nrqlAlertCondition.Spec.APIKey = policy.Spec.APIKey
+	nrqlAlertCondition.Spec.APIKey = ""
 	nrqlAlertCondition.Spec.APIKeySecret = policy.Spec.APIKeySecret
 
-	return r.Client.Update(r.ctx, &nrqlAlertCondition)
+	return r.Client.Update(rc.ctx, &nrqlAlertCondition)
 }
 
-func (r *PolicyReconciler) updateApmCondition(policy *nrv1.Policy, condition *nrv1.PolicyCondition) error {
-	defer r.txn.StartSegment("updateApmCondition").End()
-	apmAlertCondition := r.getApmConditionFromPolicyCondition(condition)
+func (r *PolicyReconciler) updateApmCondition(rc *requestContext, policy *nrv1.Policy, condition *nrv1.PolicyCondition) error {
+	defer rc.txn.StartSegment("updateApmCondition").End()
+	apmAlertCondition := r.getApmConditionFromPolicyCondition(rc, condition)
 
 	r.Log.Info("Found apm condition to update", "retrievedCondition", apmAlertCondition)
 
@@ -289,17 +312,17 @@ func (r *PolicyReconciler) updateApmCondition(policy *nrv1.Policy, condition *nr
 	//Set inherited values
 	apmAlertCondition.Spec.Region = policy.Spec.Region
 	apmAlertCondition.Spec.ExistingPolicyID = policy.Status.PolicyID
-	apmAlertCondition.Spec.APIKey = policy.Spec.APIKey
+	apmAlertCondition.Spec.APIKey = ""
 	apmAlertCondition.Spec.APIKeySecret = policy.Spec.APIKeySecret
 
 	r.Log.Info("updating existing condition", "apmAlertCondition", apmAlertCondition)
 
-	return r.Client.Update(r.ctx, &apmAlertCondition)
+	return r.Client.Update(rc.ctx, &apmAlertCondition)
 }
 
-func (r *PolicyReconciler) createOrUpdateConditions(policy *nrv1.Policy) error {
-	defer r.txn.StartSegment("createOrUpdateConditions").End()
-	if reflect.DeepEqual(policy.Spec.Conditions, policy.Status.AppliedSpec.Conditions) {
+func (r *PolicyReconciler) createOrUpdateConditions(rc *requestContext, policy *nrv1.Policy) error {
+	defer rc.txn.StartSegment("createOrUpdateConditions").End()
+	if reflect.DeepEqual(policy.Spec.Redacted().Conditions, policy.Status.AppliedSpec.Conditions) {
 		return nil
 	}
 
@@ -315,7 +338,7 @@ func (r *PolicyReconciler) createOrUpdateConditions(policy *nrv1.Policy) error {
 	collectedErrors := new(customErrors.ErrorCollector)
 
 	for i, condition := range policy.Spec.Conditions {
-		condition, err := r.createOrUpdateCondition(policy, &condition)
+		condition, err := r.createOrUpdateCondition(rc, policy, &condition)
 		if err != nil {
 			r.Log.Error(err, "error creating condition")
 			collectedErrors.Collect(err)
@@ -340,7 +363,7 @@ func (r *PolicyReconciler) createOrUpdateConditions(policy *nrv1.Policy) error {
 		r.Log.Info("checking "+processedCondition.condition.Name, "bool is", processedCondition.processed)
 		if !processedCondition.processed {
 			r.Log.Info("Need to delete", "ppliedConditionName", conditionName)
-			err := r.deleteCondition(&processedCondition.condition)
+			err := r.deleteCondition(rc, &processedCondition.condition)
 			if err != nil {
 				r.Log.Error(err, "error deleting condition resource")
 				collectedErrors.Collect(err)
@@ -358,8 +381,8 @@ func (r *PolicyReconciler) createOrUpdateConditions(policy *nrv1.Policy) error {
 	return nil
 }
 
-func (r *PolicyReconciler) createNrqlCondition(policy *nrv1.Policy, condition *nrv1.PolicyCondition) error {
-	defer r.txn.StartSegment("createNrqlCondition").End()
+func (r *PolicyReconciler) createNrqlCondition(rc *requestContext, policy *nrv1.Policy, condition *nrv1.PolicyCondition) error {
+	defer rc.txn.StartSegment("createNrqlCondition").End()
 	var nrqlAlertCondition nrv1.NrqlAlertCondition
 	nrqlAlertCondition.GenerateName = policy.Name + "-condition-"
 	nrqlAlertCondition.Namespace = policy.Namespace
@@ -370,12 +393,12 @@ func (r *PolicyReconciler) createNrqlCondition(policy *nrv1.Policy, condition *n
 	nrqlAlertCondition.Spec = condition.ReturnNrqlConditionSpec()
 	nrqlAlertCondition.Spec.Region = policy.Spec.Region
 	nrqlAlertCondition.Spec.ExistingPolicyID = policy.Status.PolicyID
-	nrqlAlertCondition.Spec.APIKey = policy.Spec.APIKey
+	nrqlAlertCondition.Spec.APIKey = ""
 	nrqlAlertCondition.Spec.APIKeySecret = policy.Spec.APIKeySecret
 	nrqlAlertCondition.Status.AppliedSpec = &nrv1.NrqlAlertConditionSpec{}
 
 	r.Log.Info("creating nrql condition", "condition", condition.Name, "conditionName", condition.Spec.Name, "nrqlAlertCondition", nrqlAlertCondition)
-	errCondition := r.Create(r.ctx, &nrqlAlertCondition)
+	errCondition := r.Create(rc.ctx, &nrqlAlertCondition)
 	if errCondition != nil {
 		r.Log.Error(errCondition, "error creating condition")
 		return errCondition
@@ -388,8 +411,8 @@ func (r *PolicyReconciler) createNrqlCondition(policy *nrv1.Policy, condition *n
 	return nil
 }
 
-func (r *PolicyReconciler) createApmCondition(policy *nrv1.Policy, condition *nrv1.PolicyCondition) error {
-	defer r.txn.StartSegment("createApmCondition").End()
+func (r *PolicyReconciler) createApmCondition(rc *requestContext, policy *nrv1.Policy, condition *nrv1.PolicyCondition) error {
+	defer rc.txn.StartSegment("createApmCondition").End()
 	var apmAlertCondition nrv1.ApmAlertCondition
 	apmAlertCondition.GenerateName = policy.Name + "-condition-"
 	apmAlertCondition.Namespace = policy.Namespace
@@ -400,12 +423,12 @@ func (r *PolicyReconciler) createApmCondition(policy *nrv1.Policy, condition *nr
 	apmAlertCondition.Spec = condition.ReturnApmConditionSpec()
 	apmAlertCondition.Spec.Region = policy.Spec.Region
 	apmAlertCondition.Spec.ExistingPolicyID = policy.Status.PolicyID
-	apmAlertCondition.Spec.APIKey = policy.Spec.APIKey
+	apmAlertCondition.Spec.APIKey = ""
 	apmAlertCondition.Spec.APIKeySecret = policy.Spec.APIKeySecret
 	apmAlertCondition.Status.AppliedSpec = &nrv1.ApmAlertConditionSpec{}
 
 	r.Log.Info("creating apm condition", "condition", condition.Name, "conditionName", condition.Spec.Name, "apmAlertCondition", apmAlertCondition)
-	errCondition := r.Create(r.ctx, &apmAlertCondition)
+	errCondition := r.Create(rc.ctx, &apmAlertCondition)
 	if errCondition != nil {
 		r.Log.Error(errCondition, "error creating condition")
 		return errCondition
@@ -418,22 +441,22 @@ func (r *PolicyReconciler) createApmCondition(policy *nrv1.Policy, condition *nr
 	return nil
 }
 
-func (r *PolicyReconciler) deleteCondition(condition *nrv1.PolicyCondition) error {
-	defer r.txn.StartSegment("deleteCondition").End()
+func (r *PolicyReconciler) deleteCondition(rc *requestContext, condition *nrv1.PolicyCondition) error {
+	defer rc.txn.StartSegment("deleteCondition").End()
 	r.Log.Info("Deleting condition", "condition", condition.Name, "conditionName", condition.Spec.Name)
 
 	var retrievedCondition runtime.Object
 	switch nrv1.GetConditionType(*condition) {
 	case "ApmAlertCondition":
-		returnedCondition := r.getApmConditionFromPolicyCondition(condition)
+		returnedCondition := r.getApmConditionFromPolicyCondition(rc, condition)
 		retrievedCondition = &returnedCondition
 	case "NrqlAlertCondition":
-		returnedCondition := r.getNrqlConditionFromPolicyCondition(condition)
+		returnedCondition := r.getNrqlConditionFromPolicyCondition(rc, condition)
 		retrievedCondition = &returnedCondition
 	}
 
 	r.Log.Info("retrieved condition for deletion", "retrievedCondition", retrievedCondition)
-	err := r.Delete(r.ctx, retrievedCondition)
+	err := r.Delete(rc.ctx, retrievedCondition)
 	if err != nil {
 		r.Log.Error(err, "error deleting condition resource")
 
@@ -443,28 +466,28 @@ func (r *PolicyReconciler) deleteCondition(condition *nrv1.PolicyCondition) erro
 	return nil
 }
 
-func (r *PolicyReconciler) getNrqlConditionFromPolicyCondition(condition *nrv1.PolicyCondition) (nrqlAlertCondition nrv1.NrqlAlertCondition) {
-	defer r.txn.StartSegment("getNrqlConditionFromPolicyCondition").End()
+func (r *PolicyReconciler) getNrqlConditionFromPolicyCondition(rc *requestContext, condition *nrv1.PolicyCondition) (nrqlAlertCondition nrv1.NrqlAlertCondition) {
+	defer rc.txn.StartSegment("getNrqlConditionFromPolicyCondition").End()
 	r.Log.Info("nrql condition before retrieval", "condition", condition)
 	//throw away the error since empty conditions are expected
-	_ = r.Client.Get(r.ctx, condition.GetNamespace(), &nrqlAlertCondition)
+	_ = r.Client.Get(rc.ctx, condition.GetNamespace(), &nrqlAlertCondition)
 	r.Log.Info("retrieved condition", "nrqlAlertCondition", nrqlAlertCondition, "namespace", condition.GetNamespace())
 
 	return
 }
 
-func (r *PolicyReconciler) getApmConditionFromPolicyCondition(condition *nrv1.PolicyCondition) (apmAlertCondition nrv1.ApmAlertCondition) {
-	defer r.txn.StartSegment("getApmConditionFromPolicyCondition").End()
+func (r *PolicyReconciler) getApmConditionFromPolicyCondition(rc *requestContext, condition *nrv1.PolicyCondition) (apmAlertCondition nrv1.ApmAlertCondition) {
+	defer rc.txn.StartSegment("getApmConditionFromPolicyCondition").End()
 	r.Log.Info("apm condition before retrieval", "condition", condition)
 	//throw away the error since empty conditions are expected
-	_ = r.Client.Get(r.ctx, condition.GetNamespace(), &apmAlertCondition)
+	_ = r.Client.Get(rc.ctx, condition.GetNamespace(), &apmAlertCondition)
 	r.Log.Info("retrieved condition", "apmAlertCondition", apmAlertCondition, "namespace", condition.GetNamespace())
 
 	return
 }
 
-func (r *PolicyReconciler) updatePolicy(policy *nrv1.Policy) error {
-	defer r.txn.StartSegment("updatePolicy").End()
+func (r *PolicyReconciler) updatePolicy(rc *requestContext, policy *nrv1.Policy) error {
+	defer rc.txn.StartSegment("updatePolicy").End()
 	r.Log.Info("updating policy", "PolicyName", policy.Name)
 
 	//only update policy if policy fields have changed
@@ -478,12 +501,12 @@ func (r *PolicyReconciler) updatePolicy(policy *nrv1.Policy) error {
 			"Alert Policy Name", APIPolicy.Name,
 			"incident preference ", policy.Status.AppliedSpec.IncidentPreference,
 		)
-		updatedPolicy, err = r.Alerts.UpdatePolicy(APIPolicy)
+		updatedPolicy, err = rc.alerts.UpdatePolicy(APIPolicy)
 		if err != nil {
 			r.Log.Error(err, "failed to update policy via New Relic API",
 				"policyId", policy.Status.PolicyID,
 				"region", policy.Spec.Region,
-				"Api Key", interfaces.PartialAPIKey(r.apiKey),
+				"Api Key", interfaces.PartialAPIKey(rc.apiKey),
 			)
 
 			return err
@@ -491,7 +514,7 @@ func (r *PolicyReconciler) updatePolicy(policy *nrv1.Policy) error {
 		policy.Status.PolicyID = updatedPolicy.ID
 	}
 
-	errConditions := r.createOrUpdateConditions(policy)
+	errConditions := r.createOrUpdateConditions(rc, policy)
 	if errConditions != nil {
 		r.Log.Error(errConditions, "error creating or updating conditions")
 
@@ -499,9 +522,10 @@ func (r *PolicyReconciler) updatePolicy(policy *nrv1.Policy) error {
 	}
 	r.Log.Info("policySpecx before update", "policy.Spec", policy.Spec)
 
-	policy.Status.AppliedSpec = &policy.Spec
+	policy.Status.AppliedSpec = policy.Spec.Redacted()
+	setReadyConditions(policy)
 
-	err = r.Client.Update(r.ctx, policy)
+	err = updateWithStatus(rc.ctx, r.Client, policy)
 	if err != nil {
 		r.Log.Error(err, "failed to update policy status", "name", policy.Name)
 
@@ -511,8 +535,8 @@ func (r *PolicyReconciler) updatePolicy(policy *nrv1.Policy) error {
 	return nil
 }
 
-func (r *PolicyReconciler) deletePolicy(ctx context.Context, policy *nrv1.Policy, deleteFinalizer string) (ctrl.Result, error) {
-	defer r.txn.StartSegment("deletePolicy").End()
+func (r *PolicyReconciler) deletePolicy(rc *requestContext, policy *nrv1.Policy, deleteFinalizer string) (ctrl.Result, error) {
+	defer rc.txn.StartSegment("deletePolicy").End()
 	// The object is being deleted
 	if containsString(policy.Finalizers, deleteFinalizer) {
 		// catch invalid state
@@ -523,7 +547,7 @@ func (r *PolicyReconciler) deletePolicy(ctx context.Context, policy *nrv1.Policy
 			// our finalizer is present, so lets handle any external dependency
 			collectedErrors := new(customErrors.ErrorCollector)
 			for _, condition := range policy.Status.AppliedSpec.Conditions {
-				err := r.deleteCondition(&condition)
+				err := r.deleteCondition(rc, &condition)
 				if err != nil {
 					r.Log.Error(err, "error deleting condition resources")
 					collectedErrors.Collect(err)
@@ -531,23 +555,25 @@ func (r *PolicyReconciler) deletePolicy(ctx context.Context, policy *nrv1.Policy
 			}
 			if len(*collectedErrors) > 0 {
 				r.Log.Info("errors deleting condition resources", "collectedErrors", collectedErrors)
+				updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, policy, nrv1.ReasonDeleteFailed, collectedErrors)
 				return ctrl.Result{}, collectedErrors
 			}
 
-			if err := r.deleteNewRelicAlertPolicy(policy); err != nil {
+			if err := r.deleteNewRelicAlertPolicy(rc, policy); err != nil {
 				// if fail to delete the external dependency here, return with error
 				// so that it can be retried
 				r.Log.Error(err, "Failed to delete Alert Policy via New Relic API",
 					"policyId", policy.Status.PolicyID,
 					"region", policy.Spec.Region,
-					"Api Key", interfaces.PartialAPIKey(r.apiKey),
+					"Api Key", interfaces.PartialAPIKey(rc.apiKey),
 				)
+				updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, policy, nrv1.ReasonDeleteFailed, err)
 				return ctrl.Result{}, err
 			}
 			// remove our finalizer from the list and update it.
 			r.Log.Info("New Relic Alert policy deleted, Removing finalizer")
 			policy.Finalizers = removeString(policy.Finalizers, deleteFinalizer)
-			if err := r.Client.Update(ctx, policy); err != nil {
+			if err := r.Client.Update(rc.ctx, policy); err != nil {
 				r.Log.Error(err, "Failed to update k8s records for this policy after successfully deleting the policy via New Relic Alert API")
 
 				return ctrl.Result{}, err
@@ -564,23 +590,25 @@ func (r *PolicyReconciler) deletePolicy(ctx context.Context, policy *nrv1.Policy
 func (r *PolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&nrv1.Policy{}).
-		Complete(r)
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nrv1.PolicyList{} }, false)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nrv1.Policy{}, r))
 }
 
-func (r *PolicyReconciler) checkForExistingPolicy(policy *nrv1.Policy) {
-	defer r.txn.StartSegment("checkForExistingPolicy").End()
+func (r *PolicyReconciler) checkForExistingPolicy(rc *requestContext, policy *nrv1.Policy) {
+	defer rc.txn.StartSegment("checkForExistingPolicy").End()
 	if policy.Status.PolicyID == 0 {
 		r.Log.Info("Checking for existing policy", "policy", policy.Name, "policyName", policy.Spec.Name)
 		//if no policyId, get list of policies and compare name
 		alertParams := &alerts.ListPoliciesParams{
 			Name: policy.Spec.Name,
 		}
-		existingPolicies, err := r.Alerts.ListPolicies(alertParams)
+		existingPolicies, err := rc.alerts.ListPolicies(alertParams)
 		if err != nil {
 			r.Log.Error(err, "failed to get list of policies from New Relic API",
 				"policyId", policy.Status.PolicyID,
 				"region", policy.Spec.Region,
-				"Api Key", interfaces.PartialAPIKey(r.apiKey),
+				"Api Key", interfaces.PartialAPIKey(rc.apiKey),
 			)
 		} else {
 			for _, existingPolicy := range existingPolicies {
@@ -594,15 +622,15 @@ func (r *PolicyReconciler) checkForExistingPolicy(policy *nrv1.Policy) {
 	}
 }
 
-func (r *PolicyReconciler) deleteNewRelicAlertPolicy(policy *nrv1.Policy) error {
-	defer r.txn.StartSegment("deleteNewRelicAlertPolicy").End()
+func (r *PolicyReconciler) deleteNewRelicAlertPolicy(rc *requestContext, policy *nrv1.Policy) error {
+	defer rc.txn.StartSegment("deleteNewRelicAlertPolicy").End()
 	r.Log.Info("Deleting policy", "policyName", policy.Spec.Name)
-	_, err := r.Alerts.DeletePolicy(policy.Status.PolicyID)
+	_, err := rc.alerts.DeletePolicy(policy.Status.PolicyID)
 	if err != nil {
 		r.Log.Error(err, "Error deleting policy via New Relic API",
 			"policyId", policy.Status.PolicyID,
 			"region", policy.Spec.Region,
-			"Api Key", interfaces.PartialAPIKey(r.apiKey),
+			"Api Key", interfaces.PartialAPIKey(rc.apiKey),
 		)
 
 		return err
@@ -611,8 +639,8 @@ func (r *PolicyReconciler) deleteNewRelicAlertPolicy(policy *nrv1.Policy) error
 	return nil
 }
 
-func (r *PolicyReconciler) getAPIKeyOrSecret(policy nrv1.Policy) (string, error) {
-	defer r.txn.StartSegment("getAPIKeyOrSecret").End()
+func (r *PolicyReconciler) getAPIKeyOrSecret(rc *requestContext, policy nrv1.Policy) (string, error) {
+	defer rc.txn.StartSegment("getAPIKeyOrSecret").End()
 	if policy.Spec.APIKey != "" {
 		return policy.Spec.APIKey, nil
 	}
@@ -620,7 +648,7 @@ func (r *PolicyReconciler) getAPIKeyOrSecret(policy nrv1.Policy) (string, error)
 	if policy.Spec.APIKeySecret != (nrv1.NewRelicAPIKeySecret{}) {
 		key := types.NamespacedName{Namespace: policy.Spec.APIKeySecret.Namespace, Name: policy.Spec.APIKeySecret.Name}
 		var apiKeySecret v1.Secret
-		getErr := r.Client.Get(context.Background(), key, &apiKeySecret)
+		getErr := r.Client.Get(rc.ctx, key, &apiKeySecret)
 		if getErr != nil {
 			r.Log.Error(getErr, "Failed to retrieve secret", "secret", apiKeySecret)
 			return "", getErr
@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
@@ -148,6 +149,7 @@ func TestIntegrationPolicyController(t *testing.T) {
 	reconciler := &PolicyReconciler{
 		Client:          k8sClient,
 		Log:             logf.Log,
+		Recorder:        record.NewFakeRecorder(100),
 		AlertClientFunc: interfaces.InitializeAlertsClient,
 	}
 
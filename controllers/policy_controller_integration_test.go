@@ -19,6 +19,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -61,6 +62,7 @@ var _ = Describe("policy reconciliation", func() {
 		r = &PolicyReconciler{
 			Client:          k8sClient,
 			Log:             logf.Log,
+			Recorder:        record.NewFakeRecorder(100),
 			AlertClientFunc: fakeAlertFunc,
 			NewRelicAgent:   newRelicAgent,
 		}
@@ -97,6 +99,7 @@ var _ = Describe("policy reconciliation", func() {
 			r = &PolicyReconciler{
 				Client:          k8sClient,
 				Log:             logf.Log,
+				Recorder:        record.NewFakeRecorder(100),
 				AlertClientFunc: fakeAlertFunc,
 				NewRelicAgent:   newrelic.Application{},
 			}
@@ -184,6 +187,20 @@ var _ = Describe("policy reconciliation", func() {
 				Expect(endStatePolicy.Status.PolicyID).To(Equal(333))
 			})
 
+			It("sets the Ready condition on the Policy resource", func() {
+				err := k8sClient.Create(ctx, policy)
+				Expect(err).ToNot(HaveOccurred())
+
+				// call reconcile
+				_, err = r.Reconcile(request)
+				Expect(err).ToNot(HaveOccurred())
+
+				var endStatePolicy nrv1.Policy
+				err = k8sClient.Get(ctx, namespacedName, &endStatePolicy)
+				Expect(err).To(BeNil())
+				Expect(nrv1.IsConditionTrue(endStatePolicy.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+			})
+
 			It("creates the NRQL condition with attributes from the Policy", func() {
 				err := k8sClient.Create(ctx, policy)
 				Expect(err).ToNot(HaveOccurred())
@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+)
+
+// requestContext holds the state of a single reconcile request. A reconciler is shared by all
+// workers of its controller, so anything specific to one request has to live here instead.
+type requestContext struct {
+	ctx           context.Context
+	txn           *newrelic.Transaction
+	apiKey        string
+	region        string
+	accountID     int
+	alerts        interfaces.NewRelicAlertsClient
+	synthetics    interfaces.NewRelicSyntheticsClient
+	dashboards    interfaces.NewRelicDashboardsClient
+	apm           interfaces.NewRelicAPMClient
+	notifications interfaces.NewRelicNotificationsClient
+	serviceLevels interfaces.NewRelicServiceLevelsClient
+}
+
+// newRequestContext starts the New Relic transaction that tracks a single reconcile request
+func newRequestContext(agent *newrelic.Application, transactionName string) *requestContext {
+	return &requestContext{
+		ctx: context.Background(),
+		txn: agent.StartTransaction(transactionName),
+	}
+}
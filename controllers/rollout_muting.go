@@ -0,0 +1,294 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+)
+
+// Annotations on Deployments, StatefulSets and DaemonSets that mute the conditions of an AlertsPolicy
+// while a rollout is progressing.
+const (
+	// rolloutMutingAlertsPolicyAnnotation names an AlertsPolicy in the same namespace whose conditions are muted
+	rolloutMutingAlertsPolicyAnnotation = "newrelic.com/rollout-muting-alerts-policy"
+	// rolloutMutingMaxDurationAnnotation limits how long a single rollout is muted, e.g. 45m
+	rolloutMutingMaxDurationAnnotation = "newrelic.com/rollout-muting-max-duration"
+
+	defaultRolloutMutingMaxDuration = 30 * time.Minute
+)
+
+// Labels and annotations on the AlertsMutingRule created for a rollout.
+const (
+	rolloutSourceKindLabel = "nr.k8s.newrelic.com/rollout-source-kind"
+	rolloutSourceNameLabel = "nr.k8s.newrelic.com/rollout-source-name"
+
+	// rolloutGenerationAnnotation is the generation of the workload the muting window was opened for
+	rolloutGenerationAnnotation = "nr.k8s.newrelic.com/rollout-generation"
+
+	// rolloutMutingExpiredGenerationAnnotation is set on the workload by the operator to the generation whose
+	// muting window ended before the rollout completed, so no new window is opened for it
+	rolloutMutingExpiredGenerationAnnotation = "nr.k8s.newrelic.com/rollout-muting-expired-generation"
+)
+
+// rolloutMutingPolicyIDAttribute is the attribute of a violation matched by the muting rule
+const rolloutMutingPolicyIDAttribute = "policyId"
+
+// rolloutMutingRuleName returns the name of the AlertsMutingRule created for a rollout of the workload
+func rolloutMutingRuleName(kind string, name string) string {
+	return name + "-" + strings.ToLower(kind) + "-rollout"
+}
+
+// rolloutMutingMaxDuration returns how long a rollout of the workload is muted at most
+func rolloutMutingMaxDuration(obj metav1.Object) (time.Duration, error) {
+	value, ok := obj.GetAnnotations()[rolloutMutingMaxDurationAnnotation]
+	if !ok {
+		return defaultRolloutMutingMaxDuration, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return 0, fmt.Errorf("%s must be a positive duration like 30m, got %q", rolloutMutingMaxDurationAnnotation, value)
+	}
+
+	return duration, nil
+}
+
+// syncRolloutMutingRule makes sure the conditions of the AlertsPolicy referenced by owner are muted while
+// progressing is true. The AlertsMutingRule is owned by owner and deleted once the rollout completes, the
+// annotation is removed or the maximum duration expired, so a stuck rollout does not mute alerts forever.
+// A new generation of owner opens a new window. It returns how long the open window lasts, zero when
+// there is none.
+func syncRolloutMutingRule(ctx context.Context, k8sClient client.Client, scheme *runtime.Scheme, owner annotatedObject, kind string, progressing bool) (time.Duration, error) {
+	rule := &nrv1.AlertsMutingRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rolloutMutingRuleName(kind, owner.GetName()),
+			Namespace: owner.GetNamespace(),
+		},
+	}
+
+	policyName := owner.GetAnnotations()[rolloutMutingAlertsPolicyAnnotation]
+	generation := strconv.FormatInt(owner.GetGeneration(), 10)
+
+	if policyName == "" || !progressing || owner.GetAnnotations()[rolloutMutingExpiredGenerationAnnotation] == generation {
+		return 0, deleteRolloutMutingRule(ctx, k8sClient, owner, rule)
+	}
+
+	maxDuration, err := rolloutMutingMaxDuration(owner)
+	if err != nil {
+		return 0, err
+	}
+
+	err = k8sClient.Get(ctx, types.NamespacedName{Namespace: rule.Namespace, Name: rule.Name}, rule)
+	if client.IgnoreNotFound(err) != nil {
+		return 0, fmt.Errorf("failed to get AlertsMutingRule %s: %w", rule.Name, err)
+	}
+
+	if err == nil && rule.Annotations[rolloutGenerationAnnotation] == generation && rolloutMutingWindowEnded(rule) {
+		// the workload remembers the expired window, the rule is gone once the muting ends in New Relic
+		patch := client.MergeFrom(owner.DeepCopyObject())
+		annotations := owner.GetAnnotations()
+		annotations[rolloutMutingExpiredGenerationAnnotation] = generation
+		owner.SetAnnotations(annotations)
+
+		if err := k8sClient.Patch(ctx, owner, patch); err != nil {
+			return 0, fmt.Errorf("failed to store expired rollout generation on %s: %w", kind, err)
+		}
+
+		return 0, deleteRolloutMutingRule(ctx, k8sClient, owner, rule)
+	}
+
+	var policy nrv1.AlertsPolicy
+
+	err = k8sClient.Get(ctx, types.NamespacedName{Namespace: owner.GetNamespace(), Name: policyName}, &policy)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get AlertsPolicy %s: %w", policyName, err)
+	}
+
+	if policy.Status.PolicyID == "" {
+		// the AlertsPolicy watch brings us back once the policy exists in New Relic
+		return 0, fmt.Errorf("AlertsPolicy %s has not been created in New Relic yet", policyName)
+	}
+
+	if policy.Spec.APIKey != "" {
+		// only the secret reference is passed on, the AlertsPolicy watch brings us back once the key is moved
+		return 0, fmt.Errorf("api_key of AlertsPolicy %s has not been moved to a secret yet", policyName)
+	}
+
+	_, err = controllerutil.CreateOrUpdate(ctx, k8sClient, rule, func() error {
+		if rule.Labels == nil {
+			rule.Labels = map[string]string{}
+		}
+		rule.Labels[rolloutSourceKindLabel] = kind
+		rule.Labels[rolloutSourceNameLabel] = owner.GetName()
+
+		// a running window is left alone, it only restarts for a new generation of the workload
+		if rule.Annotations[rolloutGenerationAnnotation] != generation || rule.Spec.Schedule == nil {
+			if rule.Annotations == nil {
+				rule.Annotations = map[string]string{}
+			}
+			rule.Annotations[rolloutGenerationAnnotation] = generation
+
+			now := time.Now().UTC()
+			rule.Spec.Schedule = &nrv1.AlertsMutingRuleSchedule{
+				StartTime: now.Format(nrv1.MutingRuleTimeLayout),
+				EndTime:   now.Add(maxDuration).Format(nrv1.MutingRuleTimeLayout),
+				TimeZone:  "UTC",
+			}
+		}
+
+		rule.Spec.Name = fmt.Sprintf("Rollout of %s %s/%s", kind, owner.GetNamespace(), owner.GetName())
+		rule.Spec.Description = "Mutes the conditions of the AlertsPolicy " + policyName + " while the rollout is progressing"
+		rule.Spec.Enabled = true
+		rule.Spec.Condition = nrv1.AlertsMutingRuleConditionGroup{
+			Operator: "AND",
+			Conditions: []nrv1.AlertsMutingRuleCondition{
+				{Attribute: rolloutMutingPolicyIDAttribute, Operator: "EQUALS", Values: []string{policy.Status.PolicyID}},
+			},
+		}
+
+		// the rule is managed in the account of the policy, a raw key is never copied
+		rule.Spec.APIKey = ""
+		rule.Spec.APIKeySecret = policy.Spec.APIKeySecret
+		rule.Spec.AccountRef = policy.Spec.AccountRef
+		rule.Spec.Region = policy.Spec.Region
+		rule.Spec.AccountID = policy.Spec.AccountID
+
+		return controllerutil.SetControllerReference(owner, rule, scheme)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to write AlertsMutingRule %s: %w", rule.Name, err)
+	}
+
+	end, err := time.Parse(nrv1.MutingRuleTimeLayout, rule.Spec.Schedule.EndTime)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Until(end), nil
+}
+
+// deleteRolloutMutingRule deletes the muting rule of a rollout of owner if it exists
+func deleteRolloutMutingRule(ctx context.Context, k8sClient client.Client, owner metav1.Object, rule *nrv1.AlertsMutingRule) error {
+	err := k8sClient.Get(ctx, types.NamespacedName{Namespace: rule.Namespace, Name: rule.Name}, rule)
+	if err != nil || !metav1.IsControlledBy(rule, owner) {
+		return client.IgnoreNotFound(err)
+	}
+
+	return client.IgnoreNotFound(k8sClient.Delete(ctx, rule))
+}
+
+// rolloutMutingWindowEnded returns true once the schedule of the muting rule of a rollout ended
+func rolloutMutingWindowEnded(rule *nrv1.AlertsMutingRule) bool {
+	if rule.Spec.Schedule == nil {
+		return false
+	}
+
+	end, err := time.Parse(nrv1.MutingRuleTimeLayout, rule.Spec.Schedule.EndTime)
+
+	return err == nil && !time.Now().Before(end)
+}
+
+// enqueueForRolloutPolicy returns an event handler that enqueues the workloads annotated with the name
+// of an AlertsPolicy, so a rollout waiting for the policy to be created in New Relic is muted once it is.
+// newList returns an empty list of the reconciled kind.
+func enqueueForRolloutPolicy(k8sClient client.Client, log logr.Logger, newList func() runtime.Object) handler.EventHandler {
+	return &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(policy handler.MapObject) []reconcile.Request {
+			list := newList()
+
+			if err := k8sClient.List(context.Background(), list, client.InNamespace(policy.Meta.GetNamespace())); err != nil {
+				log.Error(err, "failed to list workloads for AlertsPolicy", "name", policy.Meta.GetName())
+				return nil
+			}
+
+			items, err := meta.ExtractList(list)
+			if err != nil {
+				log.Error(err, "failed to extract workloads for AlertsPolicy", "name", policy.Meta.GetName())
+				return nil
+			}
+
+			var requests []reconcile.Request
+
+			for _, item := range items {
+				obj, err := meta.Accessor(item)
+				if err != nil || obj.GetAnnotations()[rolloutMutingAlertsPolicyAnnotation] != policy.Meta.GetName() {
+					continue
+				}
+
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()},
+				})
+			}
+
+			return requests
+		}),
+	}
+}
+
+// deploymentRolloutProgressing returns true while replicas of an older pod template of the deployment
+// are running. Scaling only changes the replicas of the current pod template, so it is not a rollout.
+// Until the deployment controller observed the current generation its status can't tell them apart,
+// the status it writes next brings us back.
+func deploymentRolloutProgressing(deployment *appsv1.Deployment) bool {
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return false
+	}
+
+	return deployment.Status.Replicas > deployment.Status.UpdatedReplicas
+}
+
+// statefulSetRolloutProgressing returns true until all replicas of the stateful set covered by the
+// rolling update run the current revision. Scaling is not a rollout and OnDelete updates are never
+// progressing.
+func statefulSetRolloutProgressing(statefulSet *appsv1.StatefulSet) bool {
+	if statefulSet.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return false
+	}
+
+	if statefulSet.Generation > statefulSet.Status.ObservedGeneration {
+		return false
+	}
+
+	status := statefulSet.Status
+
+	if rollingUpdate := statefulSet.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil && rollingUpdate.Partition != nil {
+		replicas := int32(1)
+		if statefulSet.Spec.Replicas != nil {
+			replicas = *statefulSet.Spec.Replicas
+		}
+
+		return status.UpdateRevision != status.CurrentRevision && status.UpdatedReplicas < replicas-*rollingUpdate.Partition
+	}
+
+	return status.UpdateRevision != status.CurrentRevision
+}
+
+// daemonSetRolloutProgressing returns true until every node the daemon set is scheduled to runs the
+// current pod template. Pods started on new nodes are not a rollout and OnDelete updates are never
+// progressing.
+func daemonSetRolloutProgressing(daemonSet *appsv1.DaemonSet) bool {
+	if daemonSet.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType {
+		return false
+	}
+
+	if daemonSet.Generation > daemonSet.Status.ObservedGeneration {
+		return false
+	}
+
+	return daemonSet.Status.UpdatedNumberScheduled < daemonSet.Status.DesiredNumberScheduled
+}
@@ -0,0 +1,347 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+)
+
+const (
+	// secretIndexField indexes resources by every secret they read, as "<namespace>/<name>"
+	secretIndexField = "spec.api_key_secret"
+	// accountIndexField indexes resources by the account they reference, as "<kind>/<namespace>/<name>"
+	accountIndexField = "spec.account_ref"
+	// alertsPolicyIndexField indexes resources by the AlertsPolicy they reference, as "<namespace>/<name>"
+	alertsPolicyIndexField = "spec.alerts_policy_ref"
+	// notificationDestinationIndexField indexes NotificationChannels by their destination, as "<namespace>/<name>"
+	notificationDestinationIndexField = "spec.destination_ref"
+	// notificationChannelIndexField indexes Workflows by the channels they notify, as "<namespace>/<name>"
+	notificationChannelIndexField = "spec.channel_refs"
+	// alertsChannelIndexField indexes AlertsPolicies by the AlertsChannels they reference, as "<namespace>/<name>"
+	alertsChannelIndexField = "spec.channel_refs"
+)
+
+// SetupFieldIndexes registers the field indexes used to find the resources that depend on a Secret,
+// an AlertsPolicy, an AlertsChannel or a notification resource.
+// It must be called once, before the controllers are set up.
+func SetupFieldIndexes(ctx context.Context, mgr ctrl.Manager) error {
+	indexer := mgr.GetFieldIndexer()
+
+	for _, obj := range []runtime.Object{
+		&nrv1.AlertsPolicy{},
+		&nrv1.AlertsNrqlCondition{},
+		&nrv1.AlertsAPMCondition{},
+		&nrv1.AlertsInfraCondition{},
+		&nrv1.AlertsExternalServiceCondition{},
+		&nrv1.AlertsSyntheticsCondition{},
+		&nrv1.AlertsChannel{},
+		&nrv1.SyntheticsMonitor{},
+		&nrv1.Dashboard{},
+		&nrv1.AlertsMutingRule{},
+		&nrv1.NotificationDestination{},
+		&nrv1.NotificationChannel{},
+		&nrv1.Workflow{},
+		&nrv1.ServiceLevel{},
+	} {
+		if err := indexer.IndexField(ctx, obj, secretIndexField, indexSecrets); err != nil {
+			return err
+		}
+
+		if err := indexer.IndexField(ctx, obj, accountIndexField, indexAccountRef); err != nil {
+			return err
+		}
+	}
+
+	// the accounts and the legacy kinds have no account_ref, they only read their API key secret
+	for _, obj := range []runtime.Object{
+		&nrv1.NewRelicAccount{},
+		&nrv1.ClusterNewRelicAccount{},
+		&nrv1.Policy{},
+		&nrv1.NrqlAlertCondition{},
+		&nrv1.ApmAlertCondition{},
+	} {
+		if err := indexer.IndexField(ctx, obj, secretIndexField, indexSecrets); err != nil {
+			return err
+		}
+	}
+
+	for _, obj := range []runtime.Object{
+		&nrv1.SyntheticsMonitor{},
+		&nrv1.Workflow{},
+		&nrv1.ServiceLevel{},
+		&nrv1.BurnRateAlert{},
+	} {
+		if err := indexer.IndexField(ctx, obj, alertsPolicyIndexField, indexAlertsPolicyRef); err != nil {
+			return err
+		}
+	}
+
+	if err := indexer.IndexField(ctx, &nrv1.AlertsPolicy{}, alertsChannelIndexField, indexAlertsChannelRefs); err != nil {
+		return err
+	}
+
+	if err := indexer.IndexField(ctx, &nrv1.NotificationChannel{}, notificationDestinationIndexField, indexNotificationDestinationRef); err != nil {
+		return err
+	}
+
+	return indexer.IndexField(ctx, &nrv1.Workflow{}, notificationChannelIndexField, indexNotificationChannelRefs)
+}
+
+func secretIndexKey(namespace string, name string) string {
+	return namespace + "/" + name
+}
+
+func accountIndexKey(kind string, namespace string, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+func alertsPolicyIndexKey(namespace string, name string) string {
+	return namespace + "/" + name
+}
+
+func alertsChannelIndexKey(namespace string, name string) string {
+	return namespace + "/" + name
+}
+
+func notificationDestinationIndexKey(namespace string, name string) string {
+	return namespace + "/" + name
+}
+
+func notificationChannelIndexKey(namespace string, name string) string {
+	return namespace + "/" + name
+}
+
+// indexSecrets returns the keys of the secrets read by obj: its API key secret and,
+// for an AlertsChannel, the secrets holding its header and value_from configuration values or, for a
+// NotificationDestination, the secret holding its credentials
+func indexSecrets(obj runtime.Object) []string {
+	var keys []string
+
+	switch o := obj.(type) {
+	case *nrv1.NewRelicAccount:
+		// a namespaced account always reads its secret from its own namespace
+		keys = append(keys, secretIndexKey(o.Namespace, o.Spec.APIKeySecret.Name))
+	case *nrv1.ClusterNewRelicAccount:
+		keys = append(keys, secretIndexKey(o.Spec.APIKeySecret.Namespace, o.Spec.APIKeySecret.Name))
+	case accountObject:
+		if secret := o.GetAccountSettings().APIKeySecret; secret.Name != "" {
+			keys = append(keys, secretIndexKey(secret.Namespace, secret.Name))
+		}
+	case *nrv1.Policy:
+		keys = append(keys, legacyAPIKeySecretKeys(o.Spec.APIKeySecret)...)
+	case *nrv1.NrqlAlertCondition:
+		keys = append(keys, legacyAPIKeySecretKeys(o.Spec.APIKeySecret)...)
+	case *nrv1.ApmAlertCondition:
+		keys = append(keys, legacyAPIKeySecretKeys(o.Spec.APIKeySecret)...)
+	}
+
+	if channel, ok := obj.(*nrv1.AlertsChannel); ok {
+		for _, header := range channel.Spec.Configuration.Headers {
+			if header.Value == "" && header.Secret != "" {
+				keys = append(keys, secretIndexKey(header.Namespace, header.Secret))
+			}
+		}
+
+		if valueFrom := channel.Spec.Configuration.ValueFrom; valueFrom != nil {
+			for _, ref := range valueFrom.SecretKeyRefs() {
+				keys = append(keys, secretIndexKey(ref.Namespace, ref.Name))
+			}
+		}
+	}
+
+	if destination, ok := obj.(*nrv1.NotificationDestination); ok && destination.Spec.Auth != nil {
+		keys = append(keys, secretIndexKey(destination.Spec.Auth.Namespace, destination.Spec.Auth.Secret))
+	}
+
+	return keys
+}
+
+// legacyAPIKeySecretKeys returns the key of the API key secret of a Policy, NrqlAlertCondition or ApmAlertCondition
+func legacyAPIKeySecretKeys(secret nrv1.NewRelicAPIKeySecret) []string {
+	if secret.Name == "" {
+		return nil
+	}
+
+	return []string{secretIndexKey(secret.Namespace, secret.Name)}
+}
+
+// indexAccountRef returns the key of the NewRelicAccount or ClusterNewRelicAccount referenced by obj
+func indexAccountRef(obj runtime.Object) []string {
+	o, ok := obj.(accountObject)
+	if !ok {
+		return nil
+	}
+
+	ref := o.GetAccountSettings().AccountRef
+	if ref.Name == "" {
+		return nil
+	}
+
+	if ref.Kind == nrv1.ClusterNewRelicAccountKind {
+		return []string{accountIndexKey(ref.Kind, "", ref.Name)}
+	}
+
+	return []string{accountIndexKey(nrv1.NewRelicAccountKind, o.GetNamespace(), ref.Name)}
+}
+
+// indexAlertsPolicyRef returns the keys of the AlertsPolicies referenced by a SyntheticsMonitor, a Workflow,
+// a BurnRateAlert or the burn rate alerts of a ServiceLevel
+func indexAlertsPolicyRef(obj runtime.Object) []string {
+	switch o := obj.(type) {
+	case *nrv1.SyntheticsMonitor:
+		if o.Spec.AlertsPolicyRef == "" {
+			return nil
+		}
+
+		return []string{alertsPolicyIndexKey(o.Namespace, o.Spec.AlertsPolicyRef)}
+	case *nrv1.Workflow:
+		keys := make([]string, 0, len(o.Spec.IssuesFilter.PolicyRefs))
+		for _, ref := range o.Spec.IssuesFilter.PolicyRefs {
+			keys = append(keys, alertsPolicyIndexKey(o.Namespace, ref))
+		}
+
+		return keys
+	case *nrv1.ServiceLevel:
+		if o.Spec.BurnRateAlerts == nil || o.Spec.BurnRateAlerts.AlertsPolicyRef == "" {
+			return nil
+		}
+
+		return []string{alertsPolicyIndexKey(o.Namespace, o.Spec.BurnRateAlerts.AlertsPolicyRef)}
+	case *nrv1.BurnRateAlert:
+		if o.Spec.AlertsPolicyRef == "" {
+			return nil
+		}
+
+		return []string{alertsPolicyIndexKey(o.Namespace, o.Spec.AlertsPolicyRef)}
+	default:
+		return nil
+	}
+}
+
+// indexAlertsChannelRefs returns the keys of the AlertsChannels referenced by an AlertsPolicy
+func indexAlertsChannelRefs(obj runtime.Object) []string {
+	policy, ok := obj.(*nrv1.AlertsPolicy)
+	if !ok {
+		return nil
+	}
+
+	keys := make([]string, 0, len(policy.Spec.ChannelRefs))
+	for _, ref := range policy.Spec.ChannelRefs {
+		name := ref.GetNamespacedName(policy.Namespace)
+		keys = append(keys, alertsChannelIndexKey(name.Namespace, name.Name))
+	}
+
+	return keys
+}
+
+// indexNotificationDestinationRef returns the key of the NotificationDestination referenced by a NotificationChannel
+func indexNotificationDestinationRef(obj runtime.Object) []string {
+	channel, ok := obj.(*nrv1.NotificationChannel)
+	if !ok || channel.Spec.DestinationRef == "" {
+		return nil
+	}
+
+	return []string{notificationDestinationIndexKey(channel.Namespace, channel.Spec.DestinationRef)}
+}
+
+// indexNotificationChannelRefs returns the keys of the NotificationChannels notified by a Workflow
+func indexNotificationChannelRefs(obj runtime.Object) []string {
+	workflow, ok := obj.(*nrv1.Workflow)
+	if !ok {
+		return nil
+	}
+
+	keys := make([]string, 0, len(workflow.Spec.ChannelRefs))
+	for _, ref := range workflow.Spec.ChannelRefs {
+		keys = append(keys, notificationChannelIndexKey(workflow.Namespace, ref))
+	}
+
+	return keys
+}
+
+// enqueueForSecret returns an event handler that enqueues the resources reading a Secret. newList
+// returns an empty list of the reconciled kind. With throughAccounts, resources referencing an
+// account that reads the Secret are enqueued as well.
+func enqueueForSecret(k8sClient client.Client, log logr.Logger, newList func() runtime.Object, throughAccounts bool) handler.EventHandler {
+	return &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(secret handler.MapObject) []reconcile.Request {
+			ctx := context.Background()
+			secretKey := secretIndexKey(secret.Meta.GetNamespace(), secret.Meta.GetName())
+
+			requests := listRequests(ctx, k8sClient, log, newList(), client.MatchingFields{secretIndexField: secretKey})
+
+			if !throughAccounts {
+				return requests
+			}
+
+			for _, accountKey := range accountsReadingSecret(ctx, k8sClient, log, secretKey) {
+				requests = append(requests, listRequests(ctx, k8sClient, log, newList(), client.MatchingFields{accountIndexField: accountKey})...)
+			}
+
+			return requests
+		}),
+	}
+}
+
+// accountsReadingSecret returns the account index keys of the accounts reading the secret
+func accountsReadingSecret(ctx context.Context, k8sClient client.Client, log logr.Logger, secretKey string) []string {
+	var keys []string
+
+	var accounts nrv1.NewRelicAccountList
+	if err := k8sClient.List(ctx, &accounts, client.MatchingFields{secretIndexField: secretKey}); err != nil {
+		log.Error(err, "failed to list NewRelicAccounts for secret", "secret", secretKey)
+	}
+
+	for _, account := range accounts.Items {
+		keys = append(keys, accountIndexKey(nrv1.NewRelicAccountKind, account.Namespace, account.Name))
+	}
+
+	var clusterAccounts nrv1.ClusterNewRelicAccountList
+	if err := k8sClient.List(ctx, &clusterAccounts, client.MatchingFields{secretIndexField: secretKey}); err != nil {
+		log.Error(err, "failed to list ClusterNewRelicAccounts for secret", "secret", secretKey)
+	}
+
+	for _, account := range clusterAccounts.Items {
+		keys = append(keys, accountIndexKey(nrv1.ClusterNewRelicAccountKind, "", account.Name))
+	}
+
+	return keys
+}
+
+// listRequests lists resources into list and returns a reconcile request for each of them
+func listRequests(ctx context.Context, k8sClient client.Client, log logr.Logger, list runtime.Object, opts ...client.ListOption) []reconcile.Request {
+	if err := k8sClient.List(ctx, list, opts...); err != nil {
+		log.Error(err, "failed to list dependent resources")
+		return nil
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		log.Error(err, "failed to extract dependent resources")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(items))
+
+	for _, item := range items {
+		obj, err := meta.Accessor(item)
+		if err != nil {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()},
+		})
+	}
+
+	return requests
+}
@@ -0,0 +1,209 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+)
+
+var _ = Describe("secret indexes", func() {
+	Describe("indexSecrets", func() {
+		It("indexes the api_key_secret of a policy", func() {
+			policy := &nrv1.AlertsPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+				Spec: nrv1.AlertsPolicySpec{
+					APIKeySecret: nrv1.NewRelicAPIKeySecret{Name: "api-key", Namespace: "secrets", KeyName: "key"},
+				},
+			}
+
+			Expect(indexSecrets(policy)).To(ConsistOf("secrets/api-key"))
+		})
+
+		It("indexes the api_key_secret of the legacy kinds", func() {
+			secret := nrv1.NewRelicAPIKeySecret{Name: "api-key", Namespace: "secrets", KeyName: "key"}
+
+			Expect(indexSecrets(&nrv1.Policy{Spec: nrv1.PolicySpec{APIKeySecret: secret}})).To(ConsistOf("secrets/api-key"))
+			Expect(indexSecrets(&nrv1.NrqlAlertCondition{Spec: nrv1.NrqlAlertConditionSpec{
+				GenericConditionSpec: nrv1.GenericConditionSpec{APIKeySecret: secret},
+			}})).To(ConsistOf("secrets/api-key"))
+			Expect(indexSecrets(&nrv1.ApmAlertCondition{Spec: nrv1.ApmAlertConditionSpec{
+				GenericConditionSpec: nrv1.GenericConditionSpec{APIKeySecret: secret},
+			}})).To(ConsistOf("secrets/api-key"))
+			Expect(indexSecrets(&nrv1.Policy{Spec: nrv1.PolicySpec{APIKey: "inline"}})).To(BeEmpty())
+		})
+
+		It("does not index a policy with an inline api_key", func() {
+			policy := &nrv1.AlertsPolicy{
+				Spec: nrv1.AlertsPolicySpec{APIKey: "inline"},
+			}
+
+			Expect(indexSecrets(policy)).To(BeEmpty())
+		})
+
+		It("indexes the header secrets of a channel", func() {
+			channel := &nrv1.AlertsChannel{
+				Spec: nrv1.AlertsChannelSpec{
+					APIKeySecret: nrv1.NewRelicAPIKeySecret{Name: "api-key", Namespace: "default", KeyName: "key"},
+					Configuration: nrv1.AlertsChannelConfiguration{
+						Headers: []nrv1.ChannelHeader{
+							{Name: "inline", Value: "value"},
+							{Name: "from-secret", Secret: "header-secret", Namespace: "default", KeyName: "token"},
+						},
+					},
+				},
+			}
+
+			Expect(indexSecrets(channel)).To(ConsistOf("default/api-key", "default/header-secret"))
+		})
+
+		It("indexes the value_from secrets of a channel", func() {
+			channel := &nrv1.AlertsChannel{
+				Spec: nrv1.AlertsChannelSpec{
+					APIKey: "inline",
+					Configuration: nrv1.AlertsChannelConfiguration{
+						ValueFrom: &nrv1.AlertsChannelConfigurationValueFrom{
+							RouteKey: &nrv1.ChannelValueSource{
+								SecretKeyRef: &nrv1.SecretKeyReference{Name: "victorops", Namespace: "default", KeyName: "route-key"},
+							},
+							Payload: map[string]nrv1.ChannelValueSource{
+								"token": {SecretKeyRef: &nrv1.SecretKeyReference{Name: "payload", Namespace: "other", KeyName: "token"}},
+							},
+						},
+					},
+				},
+			}
+
+			Expect(indexSecrets(channel)).To(ConsistOf("default/victorops", "other/payload"))
+		})
+
+		It("indexes the secret of a NewRelicAccount in the namespace of the account", func() {
+			account := &nrv1.NewRelicAccount{
+				ObjectMeta: metav1.ObjectMeta{Name: "account", Namespace: "team-a"},
+				Spec: nrv1.NewRelicAccountSpec{
+					APIKeySecret: nrv1.NewRelicAPIKeySecret{Name: "api-key", KeyName: "key"},
+				},
+			}
+
+			Expect(indexSecrets(account)).To(ConsistOf("team-a/api-key"))
+		})
+
+		It("indexes the secret of a ClusterNewRelicAccount", func() {
+			account := &nrv1.ClusterNewRelicAccount{
+				ObjectMeta: metav1.ObjectMeta{Name: "account"},
+				Spec: nrv1.NewRelicAccountSpec{
+					APIKeySecret: nrv1.NewRelicAPIKeySecret{Name: "api-key", Namespace: "secrets", KeyName: "key"},
+				},
+			}
+
+			Expect(indexSecrets(account)).To(ConsistOf("secrets/api-key"))
+		})
+
+		It("indexes the auth secret of a NotificationDestination", func() {
+			destination := &nrv1.NotificationDestination{
+				Spec: nrv1.NotificationDestinationSpec{
+					APIKey: "inline",
+					Auth:   &nrv1.NotificationDestinationAuth{Type: nrv1.NotificationAuthTypeToken, Secret: "webhook-token", Namespace: "default", KeyName: "token"},
+				},
+			}
+
+			Expect(indexSecrets(destination)).To(ConsistOf("default/webhook-token"))
+		})
+	})
+
+	Describe("notification reference indexes", func() {
+		workflow := &nrv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "workflow", Namespace: "team-a"},
+			Spec: nrv1.WorkflowSpec{
+				ChannelRefs:  []string{"ops-webhook", "ops-email"},
+				IssuesFilter: nrv1.WorkflowIssuesFilter{PolicyRefs: []string{"shop"}},
+			},
+		}
+
+		It("indexes the channels of a Workflow", func() {
+			Expect(indexNotificationChannelRefs(workflow)).To(ConsistOf("team-a/ops-webhook", "team-a/ops-email"))
+		})
+
+		It("indexes the policies of a Workflow", func() {
+			Expect(indexAlertsPolicyRef(workflow)).To(ConsistOf("team-a/shop"))
+		})
+
+		It("indexes the destination of a NotificationChannel", func() {
+			channel := &nrv1.NotificationChannel{
+				ObjectMeta: metav1.ObjectMeta{Name: "channel", Namespace: "team-a"},
+				Spec:       nrv1.NotificationChannelSpec{DestinationRef: "ops-webhook"},
+			}
+
+			Expect(indexNotificationDestinationRef(channel)).To(ConsistOf("team-a/ops-webhook"))
+		})
+	})
+
+	Describe("indexAlertsChannelRefs", func() {
+		It("indexes the channels of an AlertsPolicy", func() {
+			policy := &nrv1.AlertsPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "team-a"},
+				Spec: nrv1.AlertsPolicySpec{
+					ChannelRefs: []nrv1.AlertsChannelReference{
+						{Name: "ops-email"},
+						{Name: "shared-slack", Namespace: "shared"},
+					},
+				},
+			}
+
+			Expect(indexAlertsChannelRefs(policy)).To(ConsistOf("team-a/ops-email", "shared/shared-slack"))
+		})
+	})
+
+	Describe("indexAccountRef", func() {
+		It("indexes a NewRelicAccount in the namespace of the resource", func() {
+			condition := &nrv1.AlertsNrqlCondition{
+				ObjectMeta: metav1.ObjectMeta{Name: "condition", Namespace: "team-a"},
+				Spec: nrv1.AlertsNrqlConditionSpec{
+					AlertsGenericConditionSpec: nrv1.AlertsGenericConditionSpec{
+						AccountRef: nrv1.NewRelicAccountReference{Name: "account"},
+					},
+				},
+			}
+
+			Expect(indexAccountRef(condition)).To(ConsistOf("NewRelicAccount/team-a/account"))
+		})
+
+		It("indexes a ClusterNewRelicAccount without a namespace", func() {
+			channel := &nrv1.AlertsChannel{
+				ObjectMeta: metav1.ObjectMeta{Name: "channel", Namespace: "team-a"},
+				Spec: nrv1.AlertsChannelSpec{
+					AccountRef: nrv1.NewRelicAccountReference{Kind: nrv1.ClusterNewRelicAccountKind, Name: "account"},
+				},
+			}
+
+			Expect(indexAccountRef(channel)).To(ConsistOf("ClusterNewRelicAccount//account"))
+		})
+
+		It("does not index a resource without an account_ref", func() {
+			Expect(indexAccountRef(&nrv1.AlertsAPMCondition{})).To(BeEmpty())
+		})
+	})
+
+	Describe("failureReason", func() {
+		secret := types.NamespacedName{Namespace: "default", Name: "api-key"}
+
+		It("reports a missing secret", func() {
+			err := fmt.Errorf("reading api key: %w", &nrv1.SecretNotFoundError{Secret: secret})
+			Expect(failureReason(err, nrv1.ReasonCredentialsError)).To(Equal(nrv1.ReasonSecretNotFound))
+		})
+
+		It("reports a missing key", func() {
+			err := &nrv1.SecretKeyNotFoundError{Secret: secret, Key: "key"}
+			Expect(failureReason(err, nrv1.ReasonCredentialsError)).To(Equal(nrv1.ReasonSecretKeyNotFound))
+		})
+
+		It("keeps the given reason for other errors", func() {
+			Expect(failureReason(errors.New("401 unauthorized"), nrv1.ReasonCreateFailed)).To(Equal(nrv1.ReasonCreateFailed))
+		})
+	})
+})
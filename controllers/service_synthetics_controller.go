@@ -0,0 +1,111 @@
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+)
+
+// ServiceSyntheticsReconciler derives SyntheticsMonitors from annotated Service objects
+type ServiceSyntheticsReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+
+// Reconcile creates a ping monitor for the address of a Service annotated with newrelic.com/synthetics: enabled
+func (r *ServiceSyntheticsReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Synthetics/Service")
+	defer rc.txn.End()
+
+	var service v1.Service
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &service)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			// the monitors are garbage collected through their owner reference
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET Service", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	if !service.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	err = syncAnnotatedMonitors(rc.ctx, r.Client, r.Scheme, &service, "Service", serviceMonitorURIs(&service))
+	if err != nil {
+		r.Log.Error(err, "failed to write monitors for Service", "name", req.NamespacedName.String())
+		recordFailure(r.Recorder, &service, eventReasonSyntheticsFailed, err)
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager - Sets up Controller for Service
+func (r *ServiceSyntheticsReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1.Service{}).
+		Owns(&nrv1.SyntheticsMonitor{}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &v1.Service{}, r))
+}
+
+// serviceMonitorURIs returns the URI to monitor for the service, keyed by host. The host is taken
+// from the synthetics-host annotation or the load balancer of the service, the first port of the
+// service is used and requested over https when it is 443.
+func serviceMonitorURIs(service *v1.Service) map[string]string {
+	host := service.Annotations[syntheticsHostAnnotation]
+
+	if host == "" {
+		for _, ingress := range service.Status.LoadBalancer.Ingress {
+			if ingress.Hostname != "" {
+				host = ingress.Hostname
+				break
+			}
+
+			if host == "" {
+				host = ingress.IP
+			}
+		}
+	}
+
+	// the load balancer has not been provisioned yet
+	if host == "" || len(service.Spec.Ports) == 0 {
+		return map[string]string{}
+	}
+
+	port := service.Spec.Ports[0].Port
+	path := monitorPath(service)
+
+	var uri string
+
+	switch port {
+	case 80:
+		uri = fmt.Sprintf("http://%s%s", host, path)
+	case 443:
+		uri = fmt.Sprintf("https://%s%s", host, path)
+	default:
+		uri = fmt.Sprintf("http://%s:%d%s", host, port, path)
+	}
+
+	return map[string]string{host: uri}
+}
@@ -0,0 +1,67 @@
+package controllers
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("serviceMonitorURIs", func() {
+	var service *v1.Service
+
+	BeforeEach(func() {
+		service = &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "shop",
+				Namespace: "default",
+				Annotations: map[string]string{
+					syntheticsAnnotation: syntheticsAnnotationEnabled,
+				},
+			},
+			Spec: v1.ServiceSpec{
+				Type:  v1.ServiceTypeLoadBalancer,
+				Ports: []v1.ServicePort{{Port: 443}},
+			},
+			Status: v1.ServiceStatus{
+				LoadBalancer: v1.LoadBalancerStatus{
+					Ingress: []v1.LoadBalancerIngress{
+						{IP: "10.0.0.1"},
+						{Hostname: "shop.elb.example.com"},
+					},
+				},
+			},
+		}
+	})
+
+	It("prefers the hostname of the load balancer", func() {
+		Expect(serviceMonitorURIs(service)).To(Equal(map[string]string{
+			"shop.elb.example.com": "https://shop.elb.example.com/",
+		}))
+	})
+
+	It("uses the host annotation and a non default port", func() {
+		service.Annotations[syntheticsHostAnnotation] = "shop.example.com"
+		service.Annotations[syntheticsPathAnnotation] = "/healthz"
+		service.Spec.Ports[0].Port = 8080
+
+		Expect(serviceMonitorURIs(service)).To(Equal(map[string]string{
+			"shop.example.com": "http://shop.example.com:8080/healthz",
+		}))
+	})
+
+	It("falls back to the IP of the load balancer", func() {
+		service.Status.LoadBalancer.Ingress = service.Status.LoadBalancer.Ingress[:1]
+		service.Spec.Ports[0].Port = 80
+
+		Expect(serviceMonitorURIs(service)).To(Equal(map[string]string{
+			"10.0.0.1": "http://10.0.0.1/",
+		}))
+	})
+
+	It("returns nothing before the load balancer is provisioned", func() {
+		service.Status.LoadBalancer.Ingress = nil
+
+		Expect(serviceMonitorURIs(service)).To(BeEmpty())
+	})
+})
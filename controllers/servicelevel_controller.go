@@ -0,0 +1,253 @@
+package controllers
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/servicelevels"
+)
+
+const (
+	serviceLevelDeleteFinalizer = "servicelevels.finalizers.nr.k8s.newrelic.com"
+	serviceLevelKind            = "ServiceLevel"
+)
+
+// ServiceLevelReconciler reconciles a ServiceLevel object
+type ServiceLevelReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	ServiceLevelsClientFunc func(string, string) (interfaces.NewRelicServiceLevelsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=servicelevels,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=servicelevels/status,verbs=get;update;patch
+
+// Reconcile is responsible for reconciling the spec and state of the ServiceLevel.
+func (r *ServiceLevelReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/ServiceLevels/ServiceLevel")
+	defer rc.txn.End()
+
+	var serviceLevel nrv1.ServiceLevel
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &serviceLevel)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			r.Log.Info("ServiceLevel 'not found' after being deleted. This is expected and no cause for alarm", "error", err)
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET ServiceLevel", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	err = resolveCredentials(rc, r.Client, &serviceLevel)
+	if err != nil {
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &serviceLevel, failureReason(err, nrv1.ReasonCredentialsError), err)
+		return ctrl.Result{}, err
+	}
+
+	serviceLevelsClient, errServiceLevelsClient := r.ServiceLevelsClientFunc(rc.apiKey, rc.region)
+	if errServiceLevelsClient != nil {
+		r.Log.Error(errServiceLevelsClient, "Failed to create ServiceLevels Client")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &serviceLevel, nrv1.ReasonCredentialsError, errServiceLevelsClient)
+		return ctrl.Result{}, errServiceLevelsClient
+	}
+	rc.serviceLevels = serviceLevelsClient
+
+	// examine DeletionTimestamp to determine if object is under deletion
+	if serviceLevel.DeletionTimestamp.IsZero() {
+		if !containsString(serviceLevel.Finalizers, serviceLevelDeleteFinalizer) {
+			serviceLevel.Finalizers = append(serviceLevel.Finalizers, serviceLevelDeleteFinalizer)
+		}
+	} else {
+		return ctrl.Result{}, r.deleteServiceLevel(rc, &serviceLevel)
+	}
+
+	if !reflect.DeepEqual(serviceLevel.Spec.Redacted(), serviceLevel.Status.AppliedSpec) || serviceLevel.Status.SLIGUID == "" {
+		r.Log.Info("Reconciling", "serviceLevel", serviceLevel.Name)
+
+		if err := r.writeServiceLevel(rc, &serviceLevel); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// the burn rate conditions are synced on every reconcile, they follow the AlertsPolicy when it is recreated
+	if reason, err := r.syncBurnRateAlerts(rc, &serviceLevel); err != nil {
+		r.Log.Error(err, "failed to sync burn rate alerts of service level", "name", req.NamespacedName)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &serviceLevel, reason, err)
+		return ctrl.Result{}, err
+	}
+
+	if err := updateReadyConditionsIfNeeded(rc.ctx, r.Client, &serviceLevel); err != nil {
+		r.Log.Error(err, "tried updating service level status", "name", req.NamespacedName)
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+//SetupWithManager - Sets up Controller for ServiceLevel
+func (r *ServiceLevelReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nrv1.ServiceLevel{}).
+		Owns(&nrv1.AlertsNrqlCondition{}).
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nrv1.ServiceLevelList{} }, true)).
+		Watches(&source.Kind{Type: &nrv1.AlertsPolicy{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(func(policy handler.MapObject) []reconcile.Request {
+				policyKey := alertsPolicyIndexKey(policy.Meta.GetNamespace(), policy.Meta.GetName())
+				return listRequests(context.Background(), r.Client, r.Log, &nrv1.ServiceLevelList{}, client.MatchingFields{alertsPolicyIndexField: policyKey})
+			}),
+		}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nrv1.ServiceLevel{}, r))
+}
+
+// entityGUID returns the GUID of the entity the service level belongs to. An APM application is
+// looked up by its name once, afterwards the GUID recorded on the status is used.
+func (r *ServiceLevelReconciler) entityGUID(rc *requestContext, serviceLevel *nrv1.ServiceLevel) (string, error) {
+	if serviceLevel.Spec.EntityGUID != "" {
+		return serviceLevel.Spec.EntityGUID, nil
+	}
+
+	if serviceLevel.Status.EntityGUID != "" {
+		return serviceLevel.Status.EntityGUID, nil
+	}
+
+	defer rc.txn.StartSegment("findAPMApplicationGUID").End()
+
+	return rc.serviceLevels.FindAPMApplicationGUID(rc.accountID, serviceLevel.Spec.APMApplicationName)
+}
+
+// writeServiceLevel creates or updates the service level indicator through NerdGraph and records
+// the result on the status of the ServiceLevel
+func (r *ServiceLevelReconciler) writeServiceLevel(rc *requestContext, serviceLevel *nrv1.ServiceLevel) error {
+	defer rc.txn.StartSegment("writeServiceLevel").End()
+
+	reason := nrv1.ReasonCreateFailed
+	eventReason := eventReasonCreated
+
+	if serviceLevel.Status.SLIGUID != "" {
+		reason = nrv1.ReasonUpdateFailed
+		eventReason = eventReasonUpdated
+	}
+
+	entityGUID, err := r.entityGUID(rc, serviceLevel)
+	if err != nil {
+		r.Log.Error(err, "failed to find entity of service level", "name", serviceLevel.Name, "apmApplicationName", serviceLevel.Spec.APMApplicationName)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, serviceLevel, reason, err)
+		return err
+	}
+
+	input := serviceLevel.Spec.IndicatorInput(rc.accountID)
+
+	var written *servicelevels.Indicator
+
+	if serviceLevel.Status.SLIGUID != "" {
+		r.Log.Info("updating service level", "serviceLevelName", serviceLevel.Spec.Name, "sliGuid", serviceLevel.Status.SLIGUID)
+		written, err = rc.serviceLevels.UpdateServiceLevel(serviceLevel.Status.SLIGUID, input)
+	} else {
+		r.Log.Info("creating service level", "serviceLevelName", serviceLevel.Spec.Name, "entityGuid", entityGUID)
+		written, err = rc.serviceLevels.CreateServiceLevel(entityGUID, input)
+	}
+
+	if err != nil {
+		r.Log.Error(err, "failed to write service level",
+			"sliGuid", serviceLevel.Status.SLIGUID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, serviceLevel, reason, err)
+		return err
+	}
+
+	serviceLevel.Status.EntityGUID = entityGUID
+	serviceLevel.Status.SLIID = written.ID
+	serviceLevel.Status.SLIGUID = written.GUID
+	serviceLevel.Status.AppliedSpec = serviceLevel.Spec.Redacted()
+	r.Recorder.Eventf(serviceLevel, v1.EventTypeNormal, eventReason, "%s New Relic service level %s", eventReason, serviceLevel.Status.SLIGUID)
+	setReadyConditions(serviceLevel)
+
+	if err := updateWithStatus(rc.ctx, r.Client, serviceLevel); err != nil {
+		r.Log.Error(err, "tried updating service level status", "name", serviceLevel.Name)
+		return err
+	}
+
+	return nil
+}
+
+// syncBurnRateAlerts makes the AlertsNrqlConditions alerting on the error budget of the service level
+// match its burn_rate_alerts. The returned reason describes a failure.
+func (r *ServiceLevelReconciler) syncBurnRateAlerts(rc *requestContext, serviceLevel *nrv1.ServiceLevel) (string, error) {
+	defer rc.txn.StartSegment("syncBurnRateAlerts").End()
+
+	var policyID string
+
+	if serviceLevel.Spec.BurnRateAlerts != nil {
+		var err error
+		if policyID, err = alertsPolicyIDByRef(rc.ctx, r.Client, serviceLevel.Namespace, serviceLevel.Spec.BurnRateAlerts.AlertsPolicyRef); err != nil {
+			return nrv1.ReasonAlertsPolicyNotFound, err
+		}
+	}
+
+	specs := serviceLevel.Spec.BurnRateConditionSpecs(serviceLevel.Status.SLIGUID)
+	if err := syncBurnRateConditions(rc.ctx, r.Client, r.Scheme, serviceLevel, serviceLevelKind, policyID, specs); err != nil {
+		return nrv1.ReasonUpdateFailed, err
+	}
+
+	return "", nil
+}
+
+// deleteServiceLevel deletes the service level indicator from New Relic and removes the finalizer once
+// it is gone. The burn rate conditions are garbage collected together with the ServiceLevel.
+func (r *ServiceLevelReconciler) deleteServiceLevel(rc *requestContext, serviceLevel *nrv1.ServiceLevel) error {
+	if !containsString(serviceLevel.Finalizers, serviceLevelDeleteFinalizer) {
+		return nil
+	}
+
+	defer rc.txn.StartSegment("deleteServiceLevel").End()
+
+	if serviceLevel.Status.SLIGUID != "" {
+		r.Log.Info("Deleting service level", "serviceLevelName", serviceLevel.Spec.Name, "sliGuid", serviceLevel.Status.SLIGUID)
+
+		err := rc.serviceLevels.DeleteServiceLevel(serviceLevel.Status.SLIGUID)
+		if err != nil && !isNotFound(err) {
+			r.Log.Error(err, "Failed to delete service level",
+				"sliGuid", serviceLevel.Status.SLIGUID,
+				"region", rc.region,
+				"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+			)
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, serviceLevel, nrv1.ReasonDeleteFailed, err)
+			return err
+		}
+
+		r.Recorder.Eventf(serviceLevel, v1.EventTypeNormal, eventReasonDeleted, "Deleted New Relic service level %s", serviceLevel.Status.SLIGUID)
+	}
+
+	// remove our finalizer from the list and update it.
+	serviceLevel.Finalizers = removeString(serviceLevel.Finalizers, serviceLevelDeleteFinalizer)
+	if err := r.Client.Update(rc.ctx, serviceLevel); err != nil {
+		r.Log.Error(err, "Failed to update service level after deleting New Relic service level")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/servicelevels"
+)
+
+var _ = Describe("ServiceLevel reconciliation", func() {
+	var (
+		ctx                 context.Context
+		r                   *ServiceLevelReconciler
+		serviceLevel        *nrv1.ServiceLevel
+		namespacedName      types.NamespacedName
+		serviceLevelsClient *interfacesfakes.FakeNewRelicServiceLevelsClient
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		serviceLevelsClient = &interfacesfakes.FakeNewRelicServiceLevelsClient{}
+		serviceLevelsClient.CreateServiceLevelReturns(&servicelevels.Indicator{ID: "sli-id", GUID: "sli-guid"}, nil)
+		serviceLevelsClient.UpdateServiceLevelReturns(&servicelevels.Indicator{ID: "sli-id", GUID: "sli-guid"}, nil)
+
+		r = &ServiceLevelReconciler{
+			Client:   k8sClient,
+			Log:      logf.Log,
+			Scheme:   scheme.Scheme,
+			Recorder: record.NewFakeRecorder(100),
+			ServiceLevelsClientFunc: func(string, string) (interfaces.NewRelicServiceLevelsClient, error) {
+				return serviceLevelsClient, nil
+			},
+			NewRelicAgent: newrelic.Application{},
+		}
+
+		serviceLevel = &nrv1.ServiceLevel{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "checkout-latency",
+				Namespace: "default",
+			},
+			Spec: nrv1.ServiceLevelSpec{
+				Name:       "checkout latency",
+				EntityGUID: "entity-guid",
+				Events: nrv1.ServiceLevelEvents{
+					ValidEvents: nrv1.ServiceLevelEventsQuery{From: "Transaction"},
+					GoodEvents:  &nrv1.ServiceLevelEventsQuery{From: "Transaction", Where: "duration < 0.5"},
+				},
+				Objectives: []nrv1.ServiceLevelObjective{{Target: "99.5", TimeWindowDays: 7}},
+				APIKey:     "api-key",
+				Region:     "US",
+				AccountID:  1,
+			},
+			Status: nrv1.ServiceLevelStatus{
+				AppliedSpec: &nrv1.ServiceLevelSpec{},
+			},
+		}
+		namespacedName = types.NamespacedName{Namespace: "default", Name: "checkout-latency"}
+	})
+
+	AfterEach(func() {
+		var current nrv1.ServiceLevel
+		if err := k8sClient.Get(ctx, namespacedName, &current); err == nil {
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		// the test environment does not garbage collect the burn rate conditions
+		Expect(k8sClient.DeleteAllOf(ctx, &nrv1.AlertsNrqlCondition{},
+			client.InNamespace("default"),
+			client.MatchingLabels{burnRateSourceKindLabel: serviceLevelKind},
+		)).To(Succeed())
+	})
+
+	Context("when creating a service level", func() {
+		It("creates the service level on the entity", func() {
+			Expect(k8sClient.Create(ctx, serviceLevel)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(serviceLevelsClient.CreateServiceLevelCallCount()).To(Equal(1))
+			entityGUID, indicator := serviceLevelsClient.CreateServiceLevelArgsForCall(0)
+			Expect(entityGUID).To(Equal("entity-guid"))
+			Expect(indicator.Events.AccountID).To(Equal(1))
+			Expect(indicator.Objectives[0].Target).To(Equal(99.5))
+			Expect(serviceLevelsClient.FindAPMApplicationGUIDCallCount()).To(Equal(0))
+
+			var updated nrv1.ServiceLevel
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.SLIGUID).To(Equal("sli-guid"))
+			Expect(updated.Status.SLIID).To(Equal("sli-id"))
+			Expect(updated.Finalizers).To(ContainElement(serviceLevelDeleteFinalizer))
+			Expect(nrv1.IsConditionTrue(updated.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+		})
+
+		It("looks up the entity of an APM application", func() {
+			serviceLevelsClient.FindAPMApplicationGUIDReturns("apm-guid", nil)
+			serviceLevel.Spec.EntityGUID = ""
+			serviceLevel.Spec.APMApplicationName = "checkout"
+			Expect(k8sClient.Create(ctx, serviceLevel)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			accountID, name := serviceLevelsClient.FindAPMApplicationGUIDArgsForCall(0)
+			Expect(accountID).To(Equal(1))
+			Expect(name).To(Equal("checkout"))
+			entityGUID, _ := serviceLevelsClient.CreateServiceLevelArgsForCall(0)
+			Expect(entityGUID).To(Equal("apm-guid"))
+
+			var updated nrv1.ServiceLevel
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.EntityGUID).To(Equal("apm-guid"))
+		})
+
+		It("records a missing APM application", func() {
+			serviceLevelsClient.FindAPMApplicationGUIDReturns("", nrErrors.NewNotFound("APM application checkout not found"))
+			serviceLevel.Spec.EntityGUID = ""
+			serviceLevel.Spec.APMApplicationName = "checkout"
+			Expect(k8sClient.Create(ctx, serviceLevel)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(HaveOccurred())
+
+			Expect(serviceLevelsClient.CreateServiceLevelCallCount()).To(Equal(0))
+			var updated nrv1.ServiceLevel
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionError).Reason).To(Equal(nrv1.ReasonCreateFailed))
+		})
+	})
+
+	Context("when the service level already exists", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Create(ctx, serviceLevel)).To(Succeed())
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("updates the service level", func() {
+			var current nrv1.ServiceLevel
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			current.Spec.Objectives[0].Target = "99.9"
+			Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(serviceLevelsClient.UpdateServiceLevelCallCount()).To(Equal(1))
+			guid, indicator := serviceLevelsClient.UpdateServiceLevelArgsForCall(0)
+			Expect(guid).To(Equal("sli-guid"))
+			Expect(indicator.Objectives[0].Target).To(Equal(99.9))
+		})
+
+		It("does not call New Relic when nothing changed", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(serviceLevelsClient.CreateServiceLevelCallCount()).To(Equal(1))
+			Expect(serviceLevelsClient.UpdateServiceLevelCallCount()).To(Equal(0))
+		})
+
+		It("deletes the service level from New Relic and removes the finalizer", func() {
+			var current nrv1.ServiceLevel
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(serviceLevelsClient.DeleteServiceLevelCallCount()).To(Equal(1))
+			Expect(serviceLevelsClient.DeleteServiceLevelArgsForCall(0)).To(Equal("sli-guid"))
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).ToNot(Succeed())
+		})
+
+		It("keeps the finalizer when New Relic fails to delete the service level", func() {
+			serviceLevelsClient.DeleteServiceLevelReturns(errors.New("server error"))
+
+			var current nrv1.ServiceLevel
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(HaveOccurred())
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+
+			// let the AfterEach remove the service level
+			serviceLevelsClient.DeleteServiceLevelReturns(nil)
+		})
+	})
+
+	Context("when the service level has burn rate alerts", func() {
+		var policy *nrv1.AlertsPolicy
+
+		BeforeEach(func() {
+			policy = &nrv1.AlertsPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "checkout-policy",
+					Namespace: "default",
+				},
+				Spec: nrv1.AlertsPolicySpec{
+					Name:   "checkout policy",
+					APIKey: "api-key",
+					Region: "US",
+				},
+				Status: nrv1.AlertsPolicyStatus{
+					PolicyID: "42",
+				},
+			}
+			Expect(createWithStatus(ctx, policy)).To(Succeed())
+
+			serviceLevel.Spec.BurnRateAlerts = &nrv1.ServiceLevelBurnRateAlerts{AlertsPolicyRef: "checkout-policy"}
+			Expect(k8sClient.Create(ctx, serviceLevel)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, policy)).To(Succeed())
+		})
+
+		It("creates a condition owned by the service level for every burn rate", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			var condition nrv1.AlertsNrqlCondition
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "checkout-latency-fast-burn"}, &condition)).To(Succeed())
+			Expect(condition.Spec.Name).To(Equal("checkout latency fast-burn"))
+			Expect(condition.Spec.ExistingPolicyID).To(Equal("42"))
+			Expect(condition.Spec.APIKey).To(Equal("api-key"))
+			Expect(condition.Spec.AccountID).To(Equal(1))
+			Expect(condition.Spec.Nrql.Query).To(ContainSubstring("sli.guid = 'sli-guid'"))
+			Expect(condition.Spec.Terms[0].Threshold).To(Equal("7.2"))
+
+			var updated nrv1.ServiceLevel
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(metav1.IsControlledBy(&condition, &updated)).To(BeTrue())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "checkout-latency-slow-burn"}, &condition)).To(Succeed())
+		})
+
+		It("deletes the conditions of removed burn rates", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			var current nrv1.ServiceLevel
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			current.Spec.BurnRateAlerts.BurnRates = []nrv1.BurnRate{{Name: "fast-burn", Rate: "14.4", WindowMinutes: 60}}
+			Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+
+			_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			var condition nrv1.AlertsNrqlCondition
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "checkout-latency-fast-burn"}, &condition)).To(Succeed())
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "checkout-latency-slow-burn"}, &condition)).ToNot(Succeed())
+		})
+
+		It("records a policy that has not been created yet", func() {
+			policy.Status.PolicyID = ""
+			Expect(k8sClient.Status().Update(ctx, policy)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(MatchError(ContainSubstring("has not been created in New Relic yet")))
+
+			var updated nrv1.ServiceLevel
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.SLIGUID).To(Equal("sli-guid"))
+			Expect(nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionError).Reason).To(Equal(nrv1.ReasonAlertsPolicyNotFound))
+		})
+	})
+})
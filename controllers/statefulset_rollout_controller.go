@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+)
+
+// StatefulSetRolloutReconciler mutes alerts while a rollout of an annotated StatefulSet is progressing
+type StatefulSetRolloutReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;patch
+
+// Reconcile mutes the conditions of the AlertsPolicy named by the newrelic.com/rollout-muting-alerts-policy
+// annotation of a StatefulSet until its rollout completes
+func (r *StatefulSetRolloutReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Rollouts/StatefulSet")
+	defer rc.txn.End()
+
+	var statefulSet appsv1.StatefulSet
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &statefulSet)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			// the muting rule is garbage collected through its owner reference
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET StatefulSet", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	if !statefulSet.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	window, err := syncRolloutMutingRule(rc.ctx, r.Client, r.Scheme, &statefulSet, "StatefulSet", statefulSetRolloutProgressing(&statefulSet))
+	if err != nil {
+		r.Log.Error(err, "failed to mute alerts for rollout of StatefulSet", "name", req.NamespacedName.String())
+		recordFailure(r.Recorder, &statefulSet, eventReasonRolloutMutingFailed, err)
+		return ctrl.Result{}, err
+	}
+
+	// the muting rule is deleted once the window ends
+	return ctrl.Result{RequeueAfter: window}, nil
+}
+
+// SetupWithManager - Sets up Controller for StatefulSet
+func (r *StatefulSetRolloutReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&appsv1.StatefulSet{}).
+		Owns(&nrv1.AlertsMutingRule{}).
+		Watches(&source.Kind{Type: &nrv1.AlertsPolicy{}}, enqueueForRolloutPolicy(r.Client, r.Log, func() runtime.Object { return &appsv1.StatefulSetList{} })).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &appsv1.StatefulSet{}, r))
+}
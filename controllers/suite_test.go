@@ -16,6 +16,7 @@ limitations under the License.
 package controllers
 
 import (
+	"context"
 	"path/filepath"
 	"testing"
 
@@ -25,6 +26,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -91,3 +93,16 @@ func ignoreAlreadyExists(err error) error {
 
 	return err
 }
+
+// createWithStatus creates obj together with its status, which the create request ignores.
+func createWithStatus(ctx context.Context, obj runtime.Object) error {
+	desired := obj.DeepCopyObject()
+
+	if err := k8sClient.Create(ctx, obj); err != nil {
+		return err
+	}
+
+	copyStatus(obj, desired)
+
+	return k8sClient.Status().Update(ctx, obj)
+}
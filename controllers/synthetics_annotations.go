@@ -0,0 +1,230 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	customErrors "github.com/newrelic/newrelic-kubernetes-operator/errors"
+)
+
+// Annotations on Ingress and Service objects that configure the ping monitors derived from them.
+const (
+	// syntheticsAnnotation must be set to "enabled" for monitors to be created
+	syntheticsAnnotation        = "newrelic.com/synthetics"
+	syntheticsAnnotationEnabled = "enabled"
+
+	// syntheticsAlertsPolicyAnnotation names an AlertsPolicy in the same namespace the monitors are added to
+	syntheticsAlertsPolicyAnnotation = "newrelic.com/synthetics-alerts-policy"
+
+	// credentials, either an account or an API key secret in the same namespace and the ID of the account
+	// the monitors are created in
+	syntheticsAccountAnnotation         = "newrelic.com/synthetics-account"
+	syntheticsClusterAccountAnnotation  = "newrelic.com/synthetics-cluster-account"
+	syntheticsAPIKeySecretAnnotation    = "newrelic.com/synthetics-api-key-secret"
+	syntheticsAPIKeySecretKeyAnnotation = "newrelic.com/synthetics-api-key-secret-key"
+	syntheticsAccountIDAnnotation       = "newrelic.com/synthetics-account-id"
+	syntheticsRegionAnnotation          = "newrelic.com/synthetics-region"
+
+	// syntheticsFrequencyAnnotation is the number of minutes between checks
+	syntheticsFrequencyAnnotation = "newrelic.com/synthetics-frequency"
+	// syntheticsLocationsAnnotation is a comma separated list of locations to check from
+	syntheticsLocationsAnnotation = "newrelic.com/synthetics-locations"
+	// syntheticsPathAnnotation is the path requested on every host
+	syntheticsPathAnnotation = "newrelic.com/synthetics-path"
+	// syntheticsHostAnnotation overrides the load balancer address of a Service
+	syntheticsHostAnnotation = "newrelic.com/synthetics-host"
+
+	defaultSyntheticsFrequency = 10
+	defaultSyntheticsLocation  = "AWS_US_EAST_1"
+	defaultSyntheticsPath      = "/"
+)
+
+// Labels identifying the object a generated SyntheticsMonitor belongs to.
+const (
+	syntheticsSourceKindLabel = "nr.k8s.newrelic.com/synthetics-source-kind"
+	syntheticsSourceNameLabel = "nr.k8s.newrelic.com/synthetics-source-name"
+)
+
+// monitorNameInvalidChars matches everything that may not appear in the name of a SyntheticsMonitor
+var monitorNameInvalidChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// annotatedObject is an Ingress or Service that may carry the synthetics annotations
+type annotatedObject interface {
+	runtime.Object
+	metav1.Object
+}
+
+// syntheticsEnabled returns true when the synthetics annotation of obj is set to enabled
+func syntheticsEnabled(obj metav1.Object) bool {
+	return obj.GetAnnotations()[syntheticsAnnotation] == syntheticsAnnotationEnabled
+}
+
+// annotatedMonitorSpec returns the spec shared by all monitors derived from obj, Name and URI
+// are set per monitor
+func annotatedMonitorSpec(obj metav1.Object) (nrv1.SyntheticsMonitorSpec, error) {
+	annotations := obj.GetAnnotations()
+
+	spec := nrv1.SyntheticsMonitorSpec{
+		Type:            synthetics.MonitorTypes.Ping,
+		Frequency:       defaultSyntheticsFrequency,
+		Locations:       []string{defaultSyntheticsLocation},
+		Status:          synthetics.MonitorStatus.Enabled,
+		Region:          annotations[syntheticsRegionAnnotation],
+		AlertsPolicyRef: annotations[syntheticsAlertsPolicyAnnotation],
+	}
+
+	if frequency, ok := annotations[syntheticsFrequencyAnnotation]; ok {
+		parsed, err := strconv.ParseUint(frequency, 10, 32)
+		if err != nil {
+			return spec, fmt.Errorf("%s must be a number of minutes, got %q", syntheticsFrequencyAnnotation, frequency)
+		}
+
+		spec.Frequency = uint(parsed)
+	}
+
+	if locations, ok := annotations[syntheticsLocationsAnnotation]; ok {
+		spec.Locations = nil
+
+		for _, location := range strings.Split(locations, ",") {
+			if location = strings.TrimSpace(location); location != "" {
+				spec.Locations = append(spec.Locations, location)
+			}
+		}
+	}
+
+	if accountID, ok := annotations[syntheticsAccountIDAnnotation]; ok {
+		parsed, err := strconv.Atoi(accountID)
+		if err != nil || parsed <= 0 {
+			return spec, fmt.Errorf("%s must be a New Relic account ID, got %q", syntheticsAccountIDAnnotation, accountID)
+		}
+
+		spec.AccountID = parsed
+	}
+
+	switch {
+	case annotations[syntheticsAccountAnnotation] != "":
+		spec.AccountRef = nrv1.NewRelicAccountReference{Kind: nrv1.NewRelicAccountKind, Name: annotations[syntheticsAccountAnnotation]}
+	case annotations[syntheticsClusterAccountAnnotation] != "":
+		spec.AccountRef = nrv1.NewRelicAccountReference{Kind: nrv1.ClusterNewRelicAccountKind, Name: annotations[syntheticsClusterAccountAnnotation]}
+	case annotations[syntheticsAPIKeySecretAnnotation] != "":
+		// the secret is always read from the namespace of the annotated object
+		spec.APIKeySecret = nrv1.NewRelicAPIKeySecret{
+			Name:      annotations[syntheticsAPIKeySecretAnnotation],
+			Namespace: obj.GetNamespace(),
+			KeyName:   annotations[syntheticsAPIKeySecretKeyAnnotation],
+		}
+	}
+
+	return spec, nil
+}
+
+// monitorPath returns the path requested by the monitors derived from obj
+func monitorPath(obj metav1.Object) string {
+	path := obj.GetAnnotations()[syntheticsPathAnnotation]
+	if path == "" {
+		return defaultSyntheticsPath
+	}
+
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return path
+}
+
+// annotatedMonitorName returns the name of the SyntheticsMonitor derived from obj for host
+func annotatedMonitorName(obj metav1.Object, host string) string {
+	name := monitorNameInvalidChars.ReplaceAllString(strings.ToLower(obj.GetName()+"-"+host), "-")
+
+	return strings.Trim(name, ".-")
+}
+
+// syncAnnotatedMonitors makes the SyntheticsMonitors derived from owner match uris, which are keyed by host.
+// Monitors are owned by owner, so they are garbage collected together with it. Monitors of hosts
+// that are no longer present, or of owners that are no longer annotated, are deleted.
+func syncAnnotatedMonitors(ctx context.Context, k8sClient client.Client, scheme *runtime.Scheme, owner annotatedObject, kind string, uris map[string]string) error {
+	collectedErrors := new(customErrors.ErrorCollector)
+
+	desired := map[string]bool{}
+
+	if syntheticsEnabled(owner) {
+		spec, err := annotatedMonitorSpec(owner)
+		if err != nil {
+			return err
+		}
+
+		for host, uri := range uris {
+			monitor := &nrv1.SyntheticsMonitor{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      annotatedMonitorName(owner, host),
+					Namespace: owner.GetNamespace(),
+				},
+			}
+			desired[monitor.Name] = true
+
+			_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, monitor, func() error {
+				if monitor.Labels == nil {
+					monitor.Labels = map[string]string{}
+				}
+				monitor.Labels[syntheticsSourceKindLabel] = kind
+				monitor.Labels[syntheticsSourceNameLabel] = owner.GetName()
+
+				// only the fields derived from annotations are set, the webhook defaults the rest
+				monitor.Spec.Name = fmt.Sprintf("%s/%s %s", owner.GetNamespace(), owner.GetName(), uri)
+				monitor.Spec.Type = spec.Type
+				monitor.Spec.Frequency = spec.Frequency
+				monitor.Spec.URI = uri
+				monitor.Spec.Locations = spec.Locations
+				monitor.Spec.Status = spec.Status
+				monitor.Spec.AccountRef = spec.AccountRef
+				monitor.Spec.AccountID = spec.AccountID
+				monitor.Spec.APIKeySecret = spec.APIKeySecret
+				monitor.Spec.Region = spec.Region
+				monitor.Spec.AlertsPolicyRef = spec.AlertsPolicyRef
+
+				return controllerutil.SetControllerReference(owner, monitor, scheme)
+			})
+			if err != nil {
+				collectedErrors.Collect(fmt.Errorf("failed to write SyntheticsMonitor %s: %w", monitor.Name, err))
+			}
+		}
+	}
+
+	var existing nrv1.SyntheticsMonitorList
+
+	err := k8sClient.List(ctx, &existing,
+		client.InNamespace(owner.GetNamespace()),
+		client.MatchingLabels{syntheticsSourceKindLabel: kind, syntheticsSourceNameLabel: owner.GetName()},
+	)
+	if err != nil {
+		collectedErrors.Collect(err)
+	}
+
+	for i := range existing.Items {
+		monitor := &existing.Items[i]
+		if desired[monitor.Name] || !metav1.IsControlledBy(monitor, owner) {
+			continue
+		}
+
+		if err := k8sClient.Delete(ctx, monitor); client.IgnoreNotFound(err) != nil {
+			collectedErrors.Collect(fmt.Errorf("failed to delete SyntheticsMonitor %s: %w", monitor.Name, err))
+		}
+	}
+
+	if len(*collectedErrors) > 0 {
+		return collectedErrors
+	}
+
+	return nil
+}
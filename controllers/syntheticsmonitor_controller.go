@@ -0,0 +1,553 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/syntheticsmonitors"
+)
+
+const (
+	syntheticsMonitorDeleteFinalizer = "syntheticsmonitors.finalizers.nr.k8s.newrelic.com"
+)
+
+// errPingMonitorAccount is returned for a SIMPLE monitor without an account, NerdGraph creates monitors in an account
+var errPingMonitorAccount = errors.New("account_id or account_ref must be set for SIMPLE monitors")
+
+// SyntheticsMonitorReconciler reconciles a SyntheticsMonitor object
+type SyntheticsMonitorReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	SyntheticsClientFunc    func(string, string) (interfaces.NewRelicSyntheticsClient, error)
+	AlertClientFunc         func(string, string) (interfaces.NewRelicAlertsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+	ResyncInterval          time.Duration
+	CorrectDrift            bool
+}
+
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=syntheticsmonitors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=syntheticsmonitors/status,verbs=get;update;patch
+
+// Reconcile is responsible for reconciling the spec and state of the SyntheticsMonitor.
+func (r *SyntheticsMonitorReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Synthetics/Monitor")
+	defer rc.txn.End()
+
+	var monitor nrv1.SyntheticsMonitor
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &monitor)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			r.Log.Info("SyntheticsMonitor 'not found' after being deleted. This is expected and no cause for alarm", "error", err)
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET SyntheticsMonitor", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	err = resolveCredentials(rc, r.Client, &monitor)
+	if err != nil {
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &monitor, failureReason(err, nrv1.ReasonCredentialsError), err)
+		return ctrl.Result{}, err
+	}
+
+	syntheticsClient, errSyntheticsClient := r.SyntheticsClientFunc(rc.apiKey, rc.region)
+	if errSyntheticsClient != nil {
+		r.Log.Error(errSyntheticsClient, "Failed to create Synthetics Client")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &monitor, nrv1.ReasonCredentialsError, errSyntheticsClient)
+		return ctrl.Result{}, errSyntheticsClient
+	}
+	rc.synthetics = syntheticsClient
+
+	// examine DeletionTimestamp to determine if object is under deletion
+	if monitor.DeletionTimestamp.IsZero() {
+		if !containsString(monitor.Finalizers, syntheticsMonitorDeleteFinalizer) {
+			monitor.Finalizers = append(monitor.Finalizers, syntheticsMonitorDeleteFinalizer)
+		}
+	} else {
+		return ctrl.Result{}, r.deleteMonitor(rc, &monitor)
+	}
+
+	if reflect.DeepEqual(monitor.Spec.Redacted(), monitor.Status.AppliedSpec) && !r.alertsPolicyChanged(rc, &monitor) {
+		drifted, err := r.checkForMonitorDrift(rc, &monitor)
+		if err != nil {
+			r.Log.Error(err, "failed to resync monitor with New Relic", "name", req.NamespacedName)
+			recordFailure(r.Recorder, &monitor, eventReasonResyncFailed, err)
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
+
+		if !drifted {
+			if err := updateReadyConditionsIfNeeded(rc.ctx, r.Client, &monitor); err != nil {
+				r.Log.Error(err, "tried updating monitor status", "name", req.NamespacedName)
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
+		}
+	}
+
+	r.Log.Info("Reconciling", "monitor", monitor.Name)
+
+	r.checkForExistingMonitor(rc, &monitor)
+
+	if err := r.writeMonitor(rc, &monitor); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+//SetupWithManager - Sets up Controller for SyntheticsMonitor
+func (r *SyntheticsMonitorReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nrv1.SyntheticsMonitor{}).
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nrv1.SyntheticsMonitorList{} }, true)).
+		Watches(&source.Kind{Type: &nrv1.AlertsPolicy{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(func(policy handler.MapObject) []reconcile.Request {
+				policyKey := alertsPolicyIndexKey(policy.Meta.GetNamespace(), policy.Meta.GetName())
+				return listRequests(context.Background(), r.Client, r.Log, &nrv1.SyntheticsMonitorList{}, client.MatchingFields{alertsPolicyIndexField: policyKey})
+			}),
+		}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nrv1.SyntheticsMonitor{}, r))
+}
+
+// checkForExistingMonitor adopts a monitor of the same name and type that already exists in New Relic
+func (r *SyntheticsMonitorReconciler) checkForExistingMonitor(rc *requestContext, monitor *nrv1.SyntheticsMonitor) {
+	defer rc.txn.StartSegment("checkForExistingMonitor").End()
+	if monitor.Status.MonitorID != "" {
+		return
+	}
+
+	r.Log.Info("Checking for existing monitor", "monitorName", monitor.Spec.Name)
+	existingMonitors, err := rc.synthetics.ListMonitors()
+	if err != nil {
+		r.Log.Error(err, "failed to get list of monitors from New Relic API",
+			"monitorName", monitor.Spec.Name,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		recordFailure(r.Recorder, monitor, eventReasonLookupFailed, err)
+		return
+	}
+
+	for _, existingMonitor := range existingMonitors {
+		if existingMonitor.Name == monitor.Spec.Name && existingMonitor.Type == monitor.Spec.Type {
+			r.Log.Info("Matched on existing monitor, updating MonitorID", "monitorId", existingMonitor.ID)
+			monitor.Status.MonitorID = existingMonitor.ID
+			r.Recorder.Eventf(monitor, v1.EventTypeNormal, eventReasonAdopted, "Adopted existing New Relic monitor %s", existingMonitor.ID)
+			return
+		}
+	}
+}
+
+// checkForMonitorDrift compares the monitor in New Relic with the spec when a resync interval is
+// configured and records the result in the Drifted condition. It returns true when the monitor drifted
+// and has been prepared to be written again.
+func (r *SyntheticsMonitorReconciler) checkForMonitorDrift(rc *requestContext, monitor *nrv1.SyntheticsMonitor) (bool, error) {
+	if r.ResyncInterval == 0 || monitor.Status.MonitorID == "" {
+		return false, nil
+	}
+
+	defer rc.txn.StartSegment("checkForMonitorDrift").End()
+
+	remoteMonitor, err := rc.synthetics.GetMonitor(monitor.Status.MonitorID)
+	if err != nil && !isNotFound(err) {
+		r.Log.Error(err, "failed to get monitor from New Relic API",
+			"monitorId", monitor.Status.MonitorID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		return false, err
+	}
+
+	var differences []string
+	if err != nil || remoteMonitor == nil {
+		differences = []string{fmt.Sprintf("monitor %s not found in New Relic", monitor.Status.MonitorID)}
+		remoteMonitor = nil
+	} else {
+		var remoteScript *synthetics.MonitorScript
+		if monitor.Spec.IsScripted() {
+			remoteScript, err = rc.synthetics.GetMonitorScript(monitor.Status.MonitorID)
+			if err != nil {
+				r.Log.Error(err, "failed to get monitor script from New Relic API", "monitorId", monitor.Status.MonitorID)
+				return false, err
+			}
+		}
+
+		differences = monitor.Spec.Diff(*remoteMonitor, remoteScript)
+	}
+
+	if len(differences) == 0 || !r.CorrectDrift {
+		if setDriftedCondition(r.Recorder, monitor, differences) {
+			return false, updateWithStatus(rc.ctx, r.Client, monitor)
+		}
+		return false, nil
+	}
+
+	r.Log.Info("correcting drift of monitor", "monitorId", monitor.Status.MonitorID, "differences", differences)
+	setDriftedCondition(r.Recorder, monitor, differences)
+
+	if remoteMonitor == nil {
+		monitor.Status.MonitorID = ""
+	}
+
+	// forget the applied spec so the monitor is written again
+	monitor.Status.AppliedSpec = &nrv1.SyntheticsMonitorSpec{}
+
+	return true, nil
+}
+
+// writeMonitor creates or updates the monitor in New Relic, uploads the script of scripted
+// monitors and records the result on the status of the SyntheticsMonitor
+func (r *SyntheticsMonitorReconciler) writeMonitor(rc *requestContext, monitor *nrv1.SyntheticsMonitor) error {
+	defer rc.txn.StartSegment("writeMonitor").End()
+
+	reason := nrv1.ReasonCreateFailed
+	eventReason := eventReasonCreated
+
+	var err error
+
+	if monitor.Status.MonitorID != "" {
+		r.Log.Info("updating monitor", "monitorName", monitor.Spec.Name, "monitorId", monitor.Status.MonitorID)
+		reason = nrv1.ReasonUpdateFailed
+		eventReason = eventReasonUpdated
+
+		err = r.updateMonitor(rc, monitor)
+	} else {
+		r.Log.Info("creating monitor", "monitorName", monitor.Spec.Name)
+
+		err = r.createMonitor(rc, monitor)
+	}
+
+	if err == nil && monitor.Spec.IsScripted() {
+		_, err = rc.synthetics.UpdateMonitorScript(monitor.Status.MonitorID, monitor.Spec.ToMonitorScript())
+	}
+
+	if err == nil {
+		var conditionReason string
+		if conditionReason, err = r.writeAlertsCondition(rc, monitor); err != nil {
+			reason = conditionReason
+		}
+	}
+
+	if err != nil {
+		r.Log.Error(err, "failed to write monitor",
+			"monitorId", monitor.Status.MonitorID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, monitor, reason, err)
+		return err
+	}
+
+	monitor.Status.AppliedSpec = monitor.Spec.Redacted()
+	r.Recorder.Eventf(monitor, v1.EventTypeNormal, eventReason, "%s New Relic monitor %s", eventReason, monitor.Status.MonitorID)
+	setReadyConditions(monitor)
+
+	if err := updateWithStatus(rc.ctx, r.Client, monitor); err != nil {
+		r.Log.Error(err, "tried updating monitor status", "name", monitor.Name)
+		return err
+	}
+
+	return nil
+}
+
+// createMonitor creates the monitor in New Relic and records its ID. SIMPLE monitors are created through
+// NerdGraph, the other types through the Synthetics REST API.
+func (r *SyntheticsMonitorReconciler) createMonitor(rc *requestContext, monitor *nrv1.SyntheticsMonitor) error {
+	if monitor.Spec.Type != synthetics.MonitorTypes.Ping {
+		createdMonitor, err := rc.synthetics.CreateMonitor(monitor.Spec.ToMonitor())
+		if err != nil {
+			return err
+		}
+
+		monitor.Status.MonitorID = createdMonitor.ID
+
+		return nil
+	}
+
+	if rc.accountID == 0 {
+		return errPingMonitorAccount
+	}
+
+	input, err := simpleMonitorInput(monitor.Spec)
+	if err != nil {
+		return err
+	}
+
+	createdMonitor, err := rc.synthetics.CreateSimpleMonitor(rc.accountID, input)
+	if err != nil {
+		return err
+	}
+
+	monitor.Status.MonitorID = createdMonitor.ID
+
+	return nil
+}
+
+// updateMonitor replaces the settings of the monitor in New Relic, through NerdGraph for SIMPLE monitors
+func (r *SyntheticsMonitorReconciler) updateMonitor(rc *requestContext, monitor *nrv1.SyntheticsMonitor) error {
+	if monitor.Spec.Type != synthetics.MonitorTypes.Ping {
+		apiMonitor := monitor.Spec.ToMonitor()
+		apiMonitor.ID = monitor.Status.MonitorID
+
+		_, err := rc.synthetics.UpdateMonitor(apiMonitor)
+
+		return err
+	}
+
+	if rc.accountID == 0 {
+		return errPingMonitorAccount
+	}
+
+	input, err := simpleMonitorInput(monitor.Spec)
+	if err != nil {
+		return err
+	}
+
+	_, err = rc.synthetics.UpdateSimpleMonitor(syntheticsmonitors.MonitorGUID(rc.accountID, monitor.Status.MonitorID), input)
+
+	return err
+}
+
+// deleteMonitor deletes the monitor from New Relic and removes the finalizer once it is gone
+func (r *SyntheticsMonitorReconciler) deleteMonitor(rc *requestContext, monitor *nrv1.SyntheticsMonitor) error {
+	if !containsString(monitor.Finalizers, syntheticsMonitorDeleteFinalizer) {
+		return nil
+	}
+
+	defer rc.txn.StartSegment("deleteMonitor").End()
+
+	if monitor.Status.AlertsConditionID != 0 {
+		if err := r.deleteAlertsCondition(rc, monitor); err != nil {
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, monitor, nrv1.ReasonDeleteFailed, err)
+			return err
+		}
+	}
+
+	if monitor.Status.MonitorID != "" {
+		r.Log.Info("Deleting monitor", "monitorName", monitor.Spec.Name, "monitorId", monitor.Status.MonitorID)
+
+		var err error
+
+		// monitors of an unknown account can only be deleted through the REST API
+		if monitor.Spec.Type == synthetics.MonitorTypes.Ping && rc.accountID != 0 {
+			err = rc.synthetics.DeleteMonitorMutation(syntheticsmonitors.MonitorGUID(rc.accountID, monitor.Status.MonitorID))
+		} else {
+			err = rc.synthetics.DeleteMonitor(monitor.Status.MonitorID)
+		}
+
+		if err != nil && !isNotFound(err) {
+			r.Log.Error(err, "Failed to delete monitor",
+				"monitorId", monitor.Status.MonitorID,
+				"region", rc.region,
+				"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+			)
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, monitor, nrv1.ReasonDeleteFailed, err)
+			return err
+		}
+
+		r.Recorder.Eventf(monitor, v1.EventTypeNormal, eventReasonDeleted, "Deleted New Relic monitor %s", monitor.Status.MonitorID)
+	}
+
+	// remove our finalizer from the list and update it.
+	monitor.Finalizers = removeString(monitor.Finalizers, syntheticsMonitorDeleteFinalizer)
+	if err := r.Client.Update(rc.ctx, monitor); err != nil {
+		r.Log.Error(err, "Failed to update monitor after deleting New Relic monitor")
+		return err
+	}
+
+	return nil
+}
+
+// alertsPolicyID returns the ID New Relic assigned to the AlertsPolicy referenced by the monitor
+func (r *SyntheticsMonitorReconciler) alertsPolicyID(rc *requestContext, monitor *nrv1.SyntheticsMonitor) (string, error) {
+	return alertsPolicyIDByRef(rc.ctx, r.Client, monitor.Namespace, monitor.Spec.AlertsPolicyRef)
+}
+
+// alertsPolicyChanged returns true when the synthetics condition of an already applied monitor
+// does not belong to the referenced AlertsPolicy, e.g. because the policy has been recreated
+func (r *SyntheticsMonitorReconciler) alertsPolicyChanged(rc *requestContext, monitor *nrv1.SyntheticsMonitor) bool {
+	if monitor.Spec.AlertsPolicyRef == "" {
+		return false
+	}
+
+	policyID, _ := r.alertsPolicyID(rc, monitor)
+
+	return policyID != monitor.Status.AlertsPolicyID
+}
+
+// writeAlertsCondition adds a synthetics condition for the monitor to the AlertsPolicy referenced by
+// alerts_policy_ref, removing it from a previously referenced policy. On failure it returns the reason
+// to record on the monitor.
+func (r *SyntheticsMonitorReconciler) writeAlertsCondition(rc *requestContext, monitor *nrv1.SyntheticsMonitor) (string, error) {
+	if monitor.Spec.AlertsPolicyRef == "" && monitor.Status.AlertsConditionID == 0 {
+		return "", nil
+	}
+
+	defer rc.txn.StartSegment("writeAlertsCondition").End()
+
+	policyID := ""
+	if monitor.Spec.AlertsPolicyRef != "" {
+		var err error
+		if policyID, err = r.alertsPolicyID(rc, monitor); err != nil {
+			return nrv1.ReasonAlertsPolicyNotFound, err
+		}
+	}
+
+	if monitor.Status.AlertsConditionID != 0 && monitor.Status.AlertsPolicyID != policyID {
+		if err := r.deleteAlertsCondition(rc, monitor); err != nil {
+			return nrv1.ReasonDeleteFailed, err
+		}
+	}
+
+	if policyID == "" {
+		return "", nil
+	}
+
+	if err := r.initAlertsClient(rc); err != nil {
+		return nrv1.ReasonCredentialsError, err
+	}
+
+	condition := alerts.SyntheticsCondition{
+		Name:      monitor.Spec.Name,
+		Enabled:   true,
+		MonitorID: monitor.Status.MonitorID,
+	}
+
+	if monitor.Status.AlertsConditionID != 0 {
+		condition.ID = monitor.Status.AlertsConditionID
+
+		_, err := rc.alerts.UpdateSyntheticsCondition(condition)
+		if err == nil {
+			return "", nil
+		}
+
+		if !isNotFound(err) {
+			return nrv1.ReasonUpdateFailed, err
+		}
+
+		// the condition has been removed in New Relic, create it again
+		condition.ID = 0
+	}
+
+	numericPolicyID, err := strconv.Atoi(policyID)
+	if err != nil {
+		return nrv1.ReasonCreateFailed, fmt.Errorf("invalid policy ID %q of AlertsPolicy %s", policyID, monitor.Spec.AlertsPolicyRef)
+	}
+
+	r.Log.Info("adding monitor to alerts policy", "monitorId", monitor.Status.MonitorID, "policyId", policyID)
+
+	createdCondition, err := rc.alerts.CreateSyntheticsCondition(numericPolicyID, condition)
+	if err != nil {
+		return nrv1.ReasonCreateFailed, err
+	}
+
+	monitor.Status.AlertsPolicyID = policyID
+	monitor.Status.AlertsConditionID = createdCondition.ID
+
+	return "", nil
+}
+
+// deleteAlertsCondition removes the synthetics condition of the monitor from its alerts policy
+func (r *SyntheticsMonitorReconciler) deleteAlertsCondition(rc *requestContext, monitor *nrv1.SyntheticsMonitor) error {
+	if err := r.initAlertsClient(rc); err != nil {
+		return err
+	}
+
+	r.Log.Info("removing monitor from alerts policy", "conditionId", monitor.Status.AlertsConditionID, "policyId", monitor.Status.AlertsPolicyID)
+
+	_, err := rc.alerts.DeleteSyntheticsCondition(monitor.Status.AlertsConditionID)
+	if err != nil && !isNotFound(err) {
+		r.Log.Error(err, "failed to delete synthetics condition",
+			"conditionId", monitor.Status.AlertsConditionID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		return err
+	}
+
+	monitor.Status.AlertsPolicyID = ""
+	monitor.Status.AlertsConditionID = 0
+
+	return nil
+}
+
+// initAlertsClient creates the alerts client of the request, it is only needed for monitors in an alerts policy
+func (r *SyntheticsMonitorReconciler) initAlertsClient(rc *requestContext) error {
+	if rc.alerts != nil {
+		return nil
+	}
+
+	alertsClient, err := r.AlertClientFunc(rc.apiKey, rc.region)
+	if err != nil {
+		r.Log.Error(err, "Failed to create AlertsClient")
+		return err
+	}
+	rc.alerts = interfaces.NewTracedAlertsClient(alertsClient, rc.txn, rc.accountID, rc.region)
+
+	return nil
+}
+
+// simpleMonitorInput converts the spec of a SIMPLE monitor to the NerdGraph input of a ping monitor
+func simpleMonitorInput(spec nrv1.SyntheticsMonitorSpec) (syntheticsmonitors.SimpleMonitorInput, error) {
+	period, err := syntheticsmonitors.Period(spec.Frequency)
+	if err != nil {
+		return syntheticsmonitors.SimpleMonitorInput{}, err
+	}
+
+	status := spec.Status
+	if status == "" {
+		status = synthetics.MonitorStatus.Enabled
+	}
+
+	input := syntheticsmonitors.SimpleMonitorInput{
+		Name:      spec.Name,
+		URI:       spec.URI,
+		Period:    period,
+		Status:    string(status),
+		Locations: syntheticsmonitors.Locations{Public: spec.Locations},
+		AdvancedOptions: syntheticsmonitors.AdvancedOptions{
+			RedirectIsFailure:       spec.Options.TreatRedirectAsFailure,
+			ResponseValidationText:  spec.Options.ValidationString,
+			ShouldBypassHeadRequest: spec.Options.BypassHEADRequest,
+			UseTLSValidation:        spec.Options.VerifySSL,
+		},
+	}
+
+	if spec.SLAThreshold != "" {
+		apdexTarget, err := strconv.ParseFloat(spec.SLAThreshold, 64)
+		if err != nil {
+			return input, fmt.Errorf("sla_threshold must be a number, got %q", spec.SLAThreshold)
+		}
+
+		input.ApdexTarget = &apdexTarget
+	}
+
+	return input, nil
+}
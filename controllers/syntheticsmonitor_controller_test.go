@@ -0,0 +1,417 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/syntheticsmonitors"
+)
+
+var _ = Describe("SyntheticsMonitor reconciliation", func() {
+	var (
+		ctx              context.Context
+		r                *SyntheticsMonitorReconciler
+		monitor          *nrv1.SyntheticsMonitor
+		namespacedName   types.NamespacedName
+		syntheticsClient *interfacesfakes.FakeNewRelicSyntheticsClient
+		conditionClient  *interfacesfakes.FakeNewRelicAlertsClient
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		syntheticsClient = &interfacesfakes.FakeNewRelicSyntheticsClient{}
+		syntheticsClient.CreateMonitorStub = func(monitor synthetics.Monitor) (*synthetics.Monitor, error) {
+			monitor.ID = "monitor-1"
+			return &monitor, nil
+		}
+		conditionClient = &interfacesfakes.FakeNewRelicAlertsClient{}
+		conditionClient.CreateSyntheticsConditionStub = func(policyID int, condition alerts.SyntheticsCondition) (*alerts.SyntheticsCondition, error) {
+			condition.ID = 333
+			return &condition, nil
+		}
+
+		r = &SyntheticsMonitorReconciler{
+			Client:   k8sClient,
+			Log:      logf.Log,
+			Recorder: record.NewFakeRecorder(100),
+			SyntheticsClientFunc: func(string, string) (interfaces.NewRelicSyntheticsClient, error) {
+				return syntheticsClient, nil
+			},
+			AlertClientFunc: func(string, string) (interfaces.NewRelicAlertsClient, error) {
+				return conditionClient, nil
+			},
+			NewRelicAgent: newrelic.Application{},
+		}
+
+		monitor = &nrv1.SyntheticsMonitor{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-monitor",
+				Namespace: "default",
+			},
+			Spec: nrv1.SyntheticsMonitorSpec{
+				Name:         "my monitor",
+				Type:         synthetics.MonitorTypes.Browser,
+				Frequency:    5,
+				URI:          "https://example.com/",
+				Locations:    []string{"AWS_US_EAST_1"},
+				Status:       synthetics.MonitorStatus.Enabled,
+				SLAThreshold: "7",
+				APIKey:       "api-key",
+				Region:       "US",
+			},
+			Status: nrv1.SyntheticsMonitorStatus{
+				AppliedSpec: &nrv1.SyntheticsMonitorSpec{},
+			},
+		}
+		namespacedName = types.NamespacedName{Namespace: "default", Name: "my-monitor"}
+	})
+
+	AfterEach(func() {
+		var current nrv1.SyntheticsMonitor
+		if err := k8sClient.Get(ctx, namespacedName, &current); err == nil {
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	Context("when creating a monitor", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Create(ctx, monitor)).To(Succeed())
+		})
+
+		It("creates the monitor in New Relic and records its ID", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(syntheticsClient.CreateMonitorCallCount()).To(Equal(1))
+			created := syntheticsClient.CreateMonitorArgsForCall(0)
+			Expect(created.Name).To(Equal("my monitor"))
+			Expect(created.URI).To(Equal("https://example.com/"))
+			Expect(created.SLAThreshold).To(Equal(7.0))
+			Expect(syntheticsClient.UpdateMonitorScriptCallCount()).To(Equal(0))
+
+			var updated nrv1.SyntheticsMonitor
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.MonitorID).To(Equal("monitor-1"))
+			Expect(updated.Status.AppliedSpec).To(Equal(updated.Spec.Redacted()))
+			Expect(updated.Finalizers).To(ContainElement(syntheticsMonitorDeleteFinalizer))
+			Expect(nrv1.IsConditionTrue(updated.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+		})
+
+		It("adopts an existing monitor with the same name and type", func() {
+			syntheticsClient.ListMonitorsReturns([]*synthetics.Monitor{
+				{ID: "other", Name: "my monitor", Type: synthetics.MonitorTypes.Ping},
+				{ID: "existing", Name: "my monitor", Type: synthetics.MonitorTypes.Browser},
+			}, nil)
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(syntheticsClient.CreateMonitorCallCount()).To(Equal(0))
+			Expect(syntheticsClient.UpdateMonitorCallCount()).To(Equal(1))
+			Expect(syntheticsClient.UpdateMonitorArgsForCall(0).ID).To(Equal("existing"))
+
+			var updated nrv1.SyntheticsMonitor
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.MonitorID).To(Equal("existing"))
+		})
+
+		It("reports a failure to create the monitor", func() {
+			syntheticsClient.CreateMonitorStub = nil
+			syntheticsClient.CreateMonitorReturns(nil, errors.New("invalid location"))
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(HaveOccurred())
+
+			var updated nrv1.SyntheticsMonitor
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.MonitorID).To(BeEmpty())
+			failed := nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionError)
+			Expect(failed).ToNot(BeNil())
+			Expect(failed.Reason).To(Equal(nrv1.ReasonCreateFailed))
+			Expect(failed.Message).To(Equal("invalid location"))
+		})
+	})
+
+	Context("when writing a ping monitor", func() {
+		BeforeEach(func() {
+			monitor.Spec.Type = synthetics.MonitorTypes.Ping
+			monitor.Spec.AccountID = 12345
+			syntheticsClient.CreateSimpleMonitorReturns(&syntheticsmonitors.SimpleMonitor{GUID: "guid-1", ID: "monitor-1"}, nil)
+			Expect(k8sClient.Create(ctx, monitor)).To(Succeed())
+		})
+
+		It("creates the monitor through NerdGraph", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(syntheticsClient.CreateMonitorCallCount()).To(Equal(0))
+			Expect(syntheticsClient.CreateSimpleMonitorCallCount()).To(Equal(1))
+			accountID, created := syntheticsClient.CreateSimpleMonitorArgsForCall(0)
+			Expect(accountID).To(Equal(12345))
+			Expect(created.Name).To(Equal("my monitor"))
+			Expect(created.URI).To(Equal("https://example.com/"))
+			Expect(created.Period).To(Equal("EVERY_5_MINUTES"))
+			Expect(created.Status).To(Equal("ENABLED"))
+			Expect(created.Locations.Public).To(Equal([]string{"AWS_US_EAST_1"}))
+			Expect(*created.ApdexTarget).To(Equal(7.0))
+
+			var updated nrv1.SyntheticsMonitor
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.MonitorID).To(Equal("monitor-1"))
+			Expect(nrv1.IsConditionTrue(updated.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+		})
+
+		It("updates the monitor through NerdGraph", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			var current nrv1.SyntheticsMonitor
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			current.Spec.Frequency = 10
+			Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+
+			_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(syntheticsClient.UpdateMonitorCallCount()).To(Equal(0))
+			Expect(syntheticsClient.UpdateSimpleMonitorCallCount()).To(Equal(1))
+			guid, updated := syntheticsClient.UpdateSimpleMonitorArgsForCall(0)
+			Expect(guid).To(Equal(syntheticsmonitors.MonitorGUID(12345, "monitor-1")))
+			Expect(updated.Period).To(Equal("EVERY_10_MINUTES"))
+		})
+
+		It("deletes the monitor through NerdGraph", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			var current nrv1.SyntheticsMonitor
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(syntheticsClient.DeleteMonitorCallCount()).To(Equal(0))
+			Expect(syntheticsClient.DeleteMonitorMutationCallCount()).To(Equal(1))
+			Expect(syntheticsClient.DeleteMonitorMutationArgsForCall(0)).To(Equal(syntheticsmonitors.MonitorGUID(12345, "monitor-1")))
+		})
+	})
+
+	Context("when creating a scripted API monitor", func() {
+		BeforeEach(func() {
+			monitor.Spec.Type = synthetics.MonitorTypes.APITest
+			monitor.Spec.URI = ""
+			monitor.Spec.Script = "$http.get('https://example.com/')"
+			Expect(k8sClient.Create(ctx, monitor)).To(Succeed())
+		})
+
+		It("uploads the encoded script", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(syntheticsClient.UpdateMonitorScriptCallCount()).To(Equal(1))
+			monitorID, script := syntheticsClient.UpdateMonitorScriptArgsForCall(0)
+			Expect(monitorID).To(Equal("monitor-1"))
+			Expect(script).To(Equal(monitor.Spec.ToMonitorScript()))
+		})
+	})
+
+	Context("when updating a monitor", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Create(ctx, monitor)).To(Succeed())
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("updates the existing monitor", func() {
+			var current nrv1.SyntheticsMonitor
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			current.Spec.Frequency = 10
+			Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(syntheticsClient.CreateMonitorCallCount()).To(Equal(1))
+			Expect(syntheticsClient.UpdateMonitorCallCount()).To(Equal(1))
+			updatedMonitor := syntheticsClient.UpdateMonitorArgsForCall(0)
+			Expect(updatedMonitor.ID).To(Equal("monitor-1"))
+			Expect(updatedMonitor.Frequency).To(Equal(uint(10)))
+		})
+
+		It("does not call New Relic when nothing changed", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(syntheticsClient.CreateMonitorCallCount()).To(Equal(1))
+			Expect(syntheticsClient.UpdateMonitorCallCount()).To(Equal(0))
+			Expect(syntheticsClient.GetMonitorCallCount()).To(Equal(0))
+		})
+
+		It("reports drift when a resync interval is set", func() {
+			r.ResyncInterval = time.Minute
+			remote := monitor.Spec.ToMonitor()
+			remote.ID = "monitor-1"
+			remote.Status = synthetics.MonitorStatus.Disabled
+			syntheticsClient.GetMonitorReturns(&remote, nil)
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(syntheticsClient.UpdateMonitorCallCount()).To(Equal(0))
+
+			var updated nrv1.SyntheticsMonitor
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			drifted := nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionDrifted)
+			Expect(drifted).ToNot(BeNil())
+			Expect(drifted.Message).To(ContainSubstring(`status is "DISABLED", expected "ENABLED"`))
+		})
+	})
+
+	Context("when the monitor references an alerts policy", func() {
+		var policy *nrv1.AlertsPolicy
+
+		BeforeEach(func() {
+			policy = &nrv1.AlertsPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "monitor-policy",
+					Namespace: "default",
+				},
+				Spec: nrv1.AlertsPolicySpec{
+					Name:   "monitor policy",
+					APIKey: "api-key",
+					Region: "US",
+				},
+				Status: nrv1.AlertsPolicyStatus{
+					PolicyID: "42",
+				},
+			}
+			Expect(createWithStatus(ctx, policy)).To(Succeed())
+
+			monitor.Spec.AlertsPolicyRef = "monitor-policy"
+			Expect(k8sClient.Create(ctx, monitor)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, policy)).To(Succeed())
+		})
+
+		It("adds a synthetics condition for the monitor to the policy", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(conditionClient.CreateSyntheticsConditionCallCount()).To(Equal(1))
+			policyID, condition := conditionClient.CreateSyntheticsConditionArgsForCall(0)
+			Expect(policyID).To(Equal(42))
+			Expect(condition.MonitorID).To(Equal("monitor-1"))
+			Expect(condition.Name).To(Equal("my monitor"))
+			Expect(condition.Enabled).To(BeTrue())
+
+			var updated nrv1.SyntheticsMonitor
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.AlertsPolicyID).To(Equal("42"))
+			Expect(updated.Status.AlertsConditionID).To(Equal(333))
+			Expect(nrv1.IsConditionTrue(updated.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+		})
+
+		It("reports a policy that has not been created in New Relic yet", func() {
+			policy.Status.PolicyID = ""
+			Expect(k8sClient.Status().Update(ctx, policy)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(HaveOccurred())
+			Expect(conditionClient.CreateSyntheticsConditionCallCount()).To(Equal(0))
+
+			var updated nrv1.SyntheticsMonitor
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.MonitorID).To(Equal("monitor-1"))
+			Expect(nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionError).Reason).To(Equal(nrv1.ReasonAlertsPolicyNotFound))
+		})
+
+		It("moves the condition when the policy is recreated", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			policy.Status.PolicyID = "43"
+			Expect(k8sClient.Status().Update(ctx, policy)).To(Succeed())
+
+			_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(conditionClient.DeleteSyntheticsConditionCallCount()).To(Equal(1))
+			Expect(conditionClient.DeleteSyntheticsConditionArgsForCall(0)).To(Equal(333))
+			Expect(conditionClient.CreateSyntheticsConditionCallCount()).To(Equal(2))
+			policyID, _ := conditionClient.CreateSyntheticsConditionArgsForCall(1)
+			Expect(policyID).To(Equal(43))
+		})
+
+		It("removes the condition when the monitor is deleted", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			var current nrv1.SyntheticsMonitor
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(conditionClient.DeleteSyntheticsConditionCallCount()).To(Equal(1))
+			Expect(syntheticsClient.DeleteMonitorCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when deleting a monitor", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Create(ctx, monitor)).To(Succeed())
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deletes the monitor from New Relic and removes the finalizer", func() {
+			var current nrv1.SyntheticsMonitor
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(syntheticsClient.DeleteMonitorCallCount()).To(Equal(1))
+			Expect(syntheticsClient.DeleteMonitorArgsForCall(0)).To(Equal("monitor-1"))
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).ToNot(Succeed())
+		})
+
+		It("keeps the finalizer when New Relic fails to delete the monitor", func() {
+			syntheticsClient.DeleteMonitorReturns(errors.New("server error"))
+
+			var current nrv1.SyntheticsMonitor
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(HaveOccurred())
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(current.Finalizers).To(ContainElement(syntheticsMonitorDeleteFinalizer))
+
+			// let the AfterEach clean up
+			syntheticsClient.DeleteMonitorReturns(nil)
+		})
+	})
+})
@@ -0,0 +1,274 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	kErr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/notifications"
+)
+
+const workflowDeleteFinalizer = "workflows.finalizers.nr.k8s.newrelic.com"
+
+// WorkflowReconciler reconciles a Workflow object
+type WorkflowReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	NotificationsClientFunc func(string, string) (interfaces.NewRelicNotificationsClient, error)
+	NewRelicAgent           newrelic.Application
+	MaxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=workflows,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=nr.k8s.newrelic.com,resources=workflows/status,verbs=get;update;patch
+
+// Reconcile is responsible for reconciling the spec and state of the Workflow. The referenced channels
+// and policies are resolved to the IDs New Relic assigned to them on every reconcile, so a workflow is
+// written again when one of them is recreated.
+func (r *WorkflowReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	rc := newRequestContext(&r.NewRelicAgent, "Reconcile/Notifications/Workflow")
+	defer rc.txn.End()
+
+	var workflow nrv1.Workflow
+
+	err := r.Client.Get(rc.ctx, req.NamespacedName, &workflow)
+	if err != nil {
+		if kErr.IsNotFound(err) {
+			r.Log.Info("Workflow 'not found' after being deleted. This is expected and no cause for alarm", "error", err)
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "Failed to GET Workflow", "name", req.NamespacedName.String())
+		return ctrl.Result{}, err
+	}
+
+	err = resolveCredentials(rc, r.Client, &workflow)
+	if err != nil {
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &workflow, failureReason(err, nrv1.ReasonCredentialsError), err)
+		return ctrl.Result{}, err
+	}
+
+	notificationsClient, errNotificationsClient := r.NotificationsClientFunc(rc.apiKey, rc.region)
+	if errNotificationsClient != nil {
+		r.Log.Error(errNotificationsClient, "Failed to create Notifications Client")
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &workflow, nrv1.ReasonCredentialsError, errNotificationsClient)
+		return ctrl.Result{}, errNotificationsClient
+	}
+	rc.notifications = notificationsClient
+
+	// examine DeletionTimestamp to determine if object is under deletion
+	if workflow.DeletionTimestamp.IsZero() {
+		if !containsString(workflow.Finalizers, workflowDeleteFinalizer) {
+			workflow.Finalizers = append(workflow.Finalizers, workflowDeleteFinalizer)
+		}
+	} else {
+		return ctrl.Result{}, r.deleteWorkflow(rc, &workflow)
+	}
+
+	channelIDs, err := r.channelIDs(rc, &workflow)
+	if err != nil {
+		r.Log.Error(err, "failed to resolve channels of workflow", "name", req.NamespacedName)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &workflow, nrv1.ReasonNotificationChannelNotFound, err)
+		return ctrl.Result{}, err
+	}
+
+	policyIDs, err := r.policyIDs(rc, &workflow)
+	if err != nil {
+		r.Log.Error(err, "failed to resolve policies of workflow", "name", req.NamespacedName)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, &workflow, nrv1.ReasonAlertsPolicyNotFound, err)
+		return ctrl.Result{}, err
+	}
+
+	if reflect.DeepEqual(workflow.Spec.Redacted(), workflow.Status.AppliedSpec) &&
+		reflect.DeepEqual(channelIDs, workflow.Status.ChannelIDs) &&
+		reflect.DeepEqual(policyIDs, workflow.Status.PolicyIDs) {
+		if err := updateReadyConditionsIfNeeded(rc.ctx, r.Client, &workflow); err != nil {
+			r.Log.Error(err, "tried updating workflow status", "name", req.NamespacedName)
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	r.Log.Info("Reconciling", "workflow", workflow.Name)
+
+	if err := r.writeWorkflow(rc, &workflow, channelIDs, policyIDs); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+//SetupWithManager - Sets up Controller for Workflow
+func (r *WorkflowReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nrv1.Workflow{}).
+		Watches(&source.Kind{Type: &v1.Secret{}}, enqueueForSecret(r.Client, r.Log, func() runtime.Object { return &nrv1.WorkflowList{} }, true)).
+		Watches(&source.Kind{Type: &nrv1.NotificationChannel{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(func(channel handler.MapObject) []reconcile.Request {
+				channelKey := notificationChannelIndexKey(channel.Meta.GetNamespace(), channel.Meta.GetName())
+				return listRequests(context.Background(), r.Client, r.Log, &nrv1.WorkflowList{}, client.MatchingFields{notificationChannelIndexField: channelKey})
+			}),
+		}).
+		Watches(&source.Kind{Type: &nrv1.AlertsPolicy{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(func(policy handler.MapObject) []reconcile.Request {
+				policyKey := alertsPolicyIndexKey(policy.Meta.GetNamespace(), policy.Meta.GetName())
+				return listRequests(context.Background(), r.Client, r.Log, &nrv1.WorkflowList{}, client.MatchingFields{alertsPolicyIndexField: policyKey})
+			}),
+		}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(instrumentReconciler(mgr.GetClient(), &nrv1.Workflow{}, r))
+}
+
+// channelIDs returns the IDs New Relic assigned to the NotificationChannels referenced by the workflow
+func (r *WorkflowReconciler) channelIDs(rc *requestContext, workflow *nrv1.Workflow) ([]string, error) {
+	var ids []string
+
+	for _, ref := range workflow.Spec.ChannelRefs {
+		var channel nrv1.NotificationChannel
+
+		key := types.NamespacedName{Namespace: workflow.Namespace, Name: ref}
+		if err := r.Client.Get(rc.ctx, key, &channel); err != nil {
+			if kErr.IsNotFound(err) {
+				return nil, fmt.Errorf("NotificationChannel %s not found", key)
+			}
+			return nil, err
+		}
+
+		if channel.Status.ChannelID == "" {
+			return nil, fmt.Errorf("NotificationChannel %s has not been created in New Relic yet", key)
+		}
+
+		ids = append(ids, channel.Status.ChannelID)
+	}
+
+	return ids, nil
+}
+
+// policyIDs returns the IDs New Relic assigned to the AlertsPolicies the workflow filters issues by
+func (r *WorkflowReconciler) policyIDs(rc *requestContext, workflow *nrv1.Workflow) ([]string, error) {
+	var ids []string
+
+	for _, ref := range workflow.Spec.IssuesFilter.PolicyRefs {
+		var policy nrv1.AlertsPolicy
+
+		key := types.NamespacedName{Namespace: workflow.Namespace, Name: ref}
+		if err := r.Client.Get(rc.ctx, key, &policy); err != nil {
+			if kErr.IsNotFound(err) {
+				return nil, fmt.Errorf("AlertsPolicy %s not found", key)
+			}
+			return nil, err
+		}
+
+		if policy.Status.PolicyID == "" {
+			return nil, fmt.Errorf("AlertsPolicy %s has not been created in New Relic yet", key)
+		}
+
+		ids = append(ids, policy.Status.PolicyID)
+	}
+
+	return ids, nil
+}
+
+// writeWorkflow creates or updates the workflow through NerdGraph and records the result on the
+// status of the Workflow
+func (r *WorkflowReconciler) writeWorkflow(rc *requestContext, workflow *nrv1.Workflow, channelIDs []string, policyIDs []string) error {
+	defer rc.txn.StartSegment("writeWorkflow").End()
+
+	input := workflow.Spec.WorkflowInput(channelIDs, policyIDs)
+
+	reason := nrv1.ReasonCreateFailed
+	eventReason := eventReasonCreated
+
+	var written *notifications.Workflow
+	var err error
+
+	if workflow.Status.WorkflowID != "" {
+		r.Log.Info("updating workflow", "workflowName", workflow.Spec.Name, "workflowId", workflow.Status.WorkflowID)
+		reason = nrv1.ReasonUpdateFailed
+		eventReason = eventReasonUpdated
+
+		written, err = rc.notifications.UpdateWorkflow(rc.accountID, workflow.Status.WorkflowID, input)
+	} else {
+		r.Log.Info("creating workflow", "workflowName", workflow.Spec.Name, "accountId", rc.accountID)
+
+		written, err = rc.notifications.CreateWorkflow(rc.accountID, input)
+	}
+
+	if err != nil {
+		r.Log.Error(err, "failed to write workflow",
+			"workflowId", workflow.Status.WorkflowID,
+			"region", rc.region,
+			"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+		)
+		updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, workflow, reason, err)
+		return err
+	}
+
+	workflow.Status.WorkflowID = written.ID
+	workflow.Status.ChannelIDs = channelIDs
+	workflow.Status.PolicyIDs = policyIDs
+	workflow.Status.AppliedSpec = workflow.Spec.Redacted()
+	r.Recorder.Eventf(workflow, v1.EventTypeNormal, eventReason, "%s New Relic workflow %s", eventReason, workflow.Status.WorkflowID)
+	setReadyConditions(workflow)
+
+	if err := updateWithStatus(rc.ctx, r.Client, workflow); err != nil {
+		r.Log.Error(err, "tried updating workflow status", "name", workflow.Name)
+		return err
+	}
+
+	return nil
+}
+
+// deleteWorkflow deletes the workflow from New Relic and removes the finalizer once it is gone. The
+// channels of the workflow are managed by their own NotificationChannels and kept.
+func (r *WorkflowReconciler) deleteWorkflow(rc *requestContext, workflow *nrv1.Workflow) error {
+	if !containsString(workflow.Finalizers, workflowDeleteFinalizer) {
+		return nil
+	}
+
+	defer rc.txn.StartSegment("deleteWorkflow").End()
+
+	if workflow.Status.WorkflowID != "" {
+		r.Log.Info("Deleting workflow", "workflowName", workflow.Spec.Name, "workflowId", workflow.Status.WorkflowID)
+
+		err := rc.notifications.DeleteWorkflow(rc.accountID, workflow.Status.WorkflowID)
+		if err != nil && !isNotFound(err) {
+			r.Log.Error(err, "Failed to delete workflow",
+				"workflowId", workflow.Status.WorkflowID,
+				"region", rc.region,
+				"apiKey", interfaces.PartialAPIKey(rc.apiKey),
+			)
+			updateFailedConditions(rc.ctx, r.Client, r.Recorder, r.Log, workflow, nrv1.ReasonDeleteFailed, err)
+			return err
+		}
+
+		r.Recorder.Eventf(workflow, v1.EventTypeNormal, eventReasonDeleted, "Deleted New Relic workflow %s", workflow.Status.WorkflowID)
+	}
+
+	// remove our finalizer from the list and update it.
+	workflow.Finalizers = removeString(workflow.Finalizers, workflowDeleteFinalizer)
+	if err := r.Client.Update(rc.ctx, workflow); err != nil {
+		r.Log.Error(err, "Failed to update workflow after deleting New Relic workflow")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/notifications"
+)
+
+var _ = Describe("Workflow reconciliation", func() {
+	var (
+		ctx                 context.Context
+		r                   *WorkflowReconciler
+		workflow            *nrv1.Workflow
+		channel             *nrv1.NotificationChannel
+		policy              *nrv1.AlertsPolicy
+		namespacedName      types.NamespacedName
+		notificationsClient *interfacesfakes.FakeNewRelicNotificationsClient
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		notificationsClient = &interfacesfakes.FakeNewRelicNotificationsClient{}
+		notificationsClient.CreateWorkflowReturns(&notifications.Workflow{ID: "workflow-id"}, nil)
+		notificationsClient.UpdateWorkflowReturns(&notifications.Workflow{ID: "workflow-id"}, nil)
+
+		r = &WorkflowReconciler{
+			Client:   k8sClient,
+			Log:      logf.Log,
+			Recorder: record.NewFakeRecorder(100),
+			NotificationsClientFunc: func(string, string) (interfaces.NewRelicNotificationsClient, error) {
+				return notificationsClient, nil
+			},
+			NewRelicAgent: newrelic.Application{},
+		}
+
+		channel = &nrv1.NotificationChannel{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ops-webhook",
+				Namespace: "default",
+			},
+			Spec: nrv1.NotificationChannelSpec{
+				Name:           "ops webhook",
+				Type:           "WEBHOOK",
+				DestinationRef: "ops-webhook",
+				APIKey:         "api-key",
+				Region:         "US",
+				AccountID:      1,
+			},
+			Status: nrv1.NotificationChannelStatus{
+				ChannelID: "channel-id",
+			},
+		}
+		Expect(createWithStatus(ctx, channel)).To(Succeed())
+
+		policy = &nrv1.AlertsPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "workflow-policy",
+				Namespace: "default",
+			},
+			Spec: nrv1.AlertsPolicySpec{
+				Name:   "workflow policy",
+				APIKey: "api-key",
+				Region: "US",
+			},
+			Status: nrv1.AlertsPolicyStatus{
+				PolicyID: "42",
+			},
+		}
+		Expect(createWithStatus(ctx, policy)).To(Succeed())
+
+		enabled := true
+		workflow = &nrv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "shop",
+				Namespace: "default",
+			},
+			Spec: nrv1.WorkflowSpec{
+				Name:                "shop",
+				Enabled:             &enabled,
+				ChannelRefs:         []string{"ops-webhook"},
+				MutingRulesHandling: nrv1.WorkflowDontNotifyFullyMutedIssues,
+				IssuesFilter: nrv1.WorkflowIssuesFilter{
+					PolicyRefs: []string{"workflow-policy"},
+				},
+				APIKey:    "api-key",
+				Region:    "US",
+				AccountID: 1,
+			},
+			Status: nrv1.WorkflowStatus{
+				AppliedSpec: &nrv1.WorkflowSpec{},
+			},
+		}
+		namespacedName = types.NamespacedName{Namespace: "default", Name: "shop"}
+
+		Expect(k8sClient.Create(ctx, workflow)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		var current nrv1.Workflow
+		if err := k8sClient.Get(ctx, namespacedName, &current); err == nil {
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		Expect(k8sClient.Delete(ctx, channel)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, policy)).To(Succeed())
+	})
+
+	Context("when creating a workflow", func() {
+		It("notifies the referenced channels about the issues of the referenced policies", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(notificationsClient.CreateWorkflowCallCount()).To(Equal(1))
+			accountID, input := notificationsClient.CreateWorkflowArgsForCall(0)
+			Expect(accountID).To(Equal(1))
+			Expect(input.DestinationConfigurations).To(Equal([]notifications.DestinationConfiguration{{ChannelID: "channel-id"}}))
+			Expect(input.IssuesFilter.Predicates).To(ConsistOf(notifications.Predicate{
+				Attribute: notifications.PolicyIDsAttribute,
+				Operator:  notifications.OperatorExactlyMatches,
+				Values:    []string{"42"},
+			}))
+
+			var updated nrv1.Workflow
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			Expect(updated.Status.WorkflowID).To(Equal("workflow-id"))
+			Expect(updated.Status.ChannelIDs).To(Equal([]string{"channel-id"}))
+			Expect(updated.Status.PolicyIDs).To(Equal([]string{"42"}))
+			Expect(updated.Finalizers).To(ContainElement(workflowDeleteFinalizer))
+			Expect(nrv1.IsConditionTrue(updated.Status.Conditions, nrv1.ConditionReady)).To(BeTrue())
+		})
+
+		It("writes the workflow only once", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(notificationsClient.CreateWorkflowCallCount()).To(Equal(1))
+			Expect(notificationsClient.UpdateWorkflowCallCount()).To(Equal(0))
+		})
+
+		It("reports errors of the API", func() {
+			notificationsClient.CreateWorkflowReturns(nil, errors.New("INVALID_PARAMETER: name is taken"))
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(HaveOccurred())
+
+			var updated nrv1.Workflow
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			failed := nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionError)
+			Expect(failed).ToNot(BeNil())
+			Expect(failed.Reason).To(Equal(nrv1.ReasonCreateFailed))
+		})
+	})
+
+	Context("when a referenced policy is recreated", func() {
+		It("updates the issues filter", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			var current nrv1.AlertsPolicy
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "workflow-policy"}, &current)).To(Succeed())
+			current.Status.PolicyID = "43"
+			Expect(k8sClient.Status().Update(ctx, &current)).To(Succeed())
+
+			_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(notificationsClient.UpdateWorkflowCallCount()).To(Equal(1))
+			_, workflowID, input := notificationsClient.UpdateWorkflowArgsForCall(0)
+			Expect(workflowID).To(Equal("workflow-id"))
+			Expect(input.IssuesFilter.Predicates[0].Values).To(Equal([]string{"43"}))
+		})
+	})
+
+	Context("when a referenced channel does not exist", func() {
+		BeforeEach(func() {
+			var current nrv1.Workflow
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			current.Spec.ChannelRefs = []string{"missing"}
+			Expect(k8sClient.Update(ctx, &current)).To(Succeed())
+		})
+
+		It("reports the missing channel", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).To(MatchError("NotificationChannel default/missing not found"))
+			Expect(notificationsClient.CreateWorkflowCallCount()).To(Equal(0))
+
+			var updated nrv1.Workflow
+			Expect(k8sClient.Get(ctx, namespacedName, &updated)).To(Succeed())
+			failed := nrv1.FindCondition(updated.Status.Conditions, nrv1.ConditionError)
+			Expect(failed).ToNot(BeNil())
+			Expect(failed.Reason).To(Equal(nrv1.ReasonNotificationChannelNotFound))
+		})
+	})
+
+	Context("when deleting a workflow", func() {
+		It("deletes the workflow from New Relic", func() {
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			var current nrv1.Workflow
+			Expect(k8sClient.Get(ctx, namespacedName, &current)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &current)).To(Succeed())
+
+			_, err = r.Reconcile(ctrl.Request{NamespacedName: namespacedName})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(notificationsClient.DeleteWorkflowCallCount()).To(Equal(1))
+			_, workflowID := notificationsClient.DeleteWorkflowArgsForCall(0)
+			Expect(workflowID).To(Equal("workflow-id"))
+		})
+	})
+})
@@ -0,0 +1,58 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/controllers"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/concurrency"
+)
+
+func registerDashboards(mgr *ctrl.Manager, nrApp *newrelic.Application, maxConcurrentReconciles *concurrency.MaxConcurrentReconciles, resyncInterval time.Duration, correctDrift bool) error {
+
+	// dashboard
+	dashboardReconciler := &controllers.DashboardReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("Dashboard"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("dashboard-controller"),
+		DashboardsClientFunc:    interfaces.InitializeDashboardsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("Dashboard"),
+		ResyncInterval:          resyncInterval,
+		CorrectDrift:            correctDrift,
+	}
+
+	if err := dashboardReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Dashboard")
+		os.Exit(1)
+	}
+
+	dashboard := &nrv1.Dashboard{}
+	if err := dashboard.SetupWebhookWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "Dashboard")
+		os.Exit(1)
+	}
+
+	return nil
+}
@@ -17,7 +17,7 @@ require (
 	github.com/newrelic/newrelic-client-go v0.60.0
 	github.com/onsi/ginkgo v1.13.0
 	github.com/onsi/gomega v1.10.1
-	github.com/prometheus/client_golang v1.6.0 // indirect
+	github.com/prometheus/client_golang v1.6.0
 	github.com/prometheus/common v0.10.0 // indirect
 	github.com/psampaz/go-mod-outdated v0.8.0 // indirect
 	github.com/stretchr/testify v1.7.0
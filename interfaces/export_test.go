@@ -0,0 +1,45 @@
+package interfaces
+
+// RecordedSegment is a segment started by a RecordingTracer
+type RecordedSegment struct {
+	URL        string
+	Method     string
+	Attributes map[string]interface{}
+	Ended      bool
+}
+
+func (s *RecordedSegment) AddAttribute(key string, val interface{}) {
+	s.Attributes[key] = val
+}
+
+func (s *RecordedSegment) End() {
+	s.Ended = true
+}
+
+// RecordedEvent is a custom event recorded by a RecordingTracer
+type RecordedEvent struct {
+	EventType string
+	Params    map[string]interface{}
+}
+
+// RecordingTracer records the segments and events of the traced calls
+type RecordingTracer struct {
+	Segments []*RecordedSegment
+	Events   []RecordedEvent
+}
+
+func (t *RecordingTracer) startSegment(url string, method string) apiSegment {
+	segment := &RecordedSegment{URL: url, Method: method, Attributes: map[string]interface{}{}}
+	t.Segments = append(t.Segments, segment)
+
+	return segment
+}
+
+func (t *RecordingTracer) recordEvent(eventType string, params map[string]interface{}) {
+	t.Events = append(t.Events, RecordedEvent{EventType: eventType, Params: params})
+}
+
+// NewRecordedAlertsClient wraps client so its calls are reported to tracer
+func NewRecordedAlertsClient(client NewRelicAlertsClient, tracer *RecordingTracer, accountID int, regionName string) NewRelicAlertsClient {
+	return newTracedAlertsClient(client, tracer, accountID, regionName)
+}
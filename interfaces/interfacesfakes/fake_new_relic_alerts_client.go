@@ -7,6 +7,8 @@ import (
 	"github.com/newrelic/newrelic-client-go/pkg/alerts"
 
 	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/externalservice"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/nrqlsignal"
 )
 
 type FakeNewRelicAlertsClient struct {
@@ -37,6 +39,61 @@ type FakeNewRelicAlertsClient struct {
 		result1 *alerts.Condition
 		result2 error
 	}
+	CreateExternalServiceConditionStub        func(int, externalservice.Condition) (*externalservice.Condition, error)
+	createExternalServiceConditionMutex       sync.RWMutex
+	createExternalServiceConditionArgsForCall []struct {
+		arg1 int
+		arg2 externalservice.Condition
+	}
+	createExternalServiceConditionReturns struct {
+		result1 *externalservice.Condition
+		result2 error
+	}
+	createExternalServiceConditionReturnsOnCall map[int]struct {
+		result1 *externalservice.Condition
+		result2 error
+	}
+	CreateInfrastructureConditionStub        func(alerts.InfrastructureCondition) (*alerts.InfrastructureCondition, error)
+	createInfrastructureConditionMutex       sync.RWMutex
+	createInfrastructureConditionArgsForCall []struct {
+		arg1 alerts.InfrastructureCondition
+	}
+	createInfrastructureConditionReturns struct {
+		result1 *alerts.InfrastructureCondition
+		result2 error
+	}
+	createInfrastructureConditionReturnsOnCall map[int]struct {
+		result1 *alerts.InfrastructureCondition
+		result2 error
+	}
+	CreateMultiLocationSyntheticsConditionStub        func(alerts.MultiLocationSyntheticsCondition, int) (*alerts.MultiLocationSyntheticsCondition, error)
+	createMultiLocationSyntheticsConditionMutex       sync.RWMutex
+	createMultiLocationSyntheticsConditionArgsForCall []struct {
+		arg1 alerts.MultiLocationSyntheticsCondition
+		arg2 int
+	}
+	createMultiLocationSyntheticsConditionReturns struct {
+		result1 *alerts.MultiLocationSyntheticsCondition
+		result2 error
+	}
+	createMultiLocationSyntheticsConditionReturnsOnCall map[int]struct {
+		result1 *alerts.MultiLocationSyntheticsCondition
+		result2 error
+	}
+	CreateMutingRuleStub        func(int, alerts.MutingRuleCreateInput) (*alerts.MutingRule, error)
+	createMutingRuleMutex       sync.RWMutex
+	createMutingRuleArgsForCall []struct {
+		arg1 int
+		arg2 alerts.MutingRuleCreateInput
+	}
+	createMutingRuleReturns struct {
+		result1 *alerts.MutingRule
+		result2 error
+	}
+	createMutingRuleReturnsOnCall map[int]struct {
+		result1 *alerts.MutingRule
+		result2 error
+	}
 	CreateNrqlConditionStub        func(int, alerts.NrqlCondition) (*alerts.NrqlCondition, error)
 	createNrqlConditionMutex       sync.RWMutex
 	createNrqlConditionArgsForCall []struct {
@@ -66,6 +123,21 @@ type FakeNewRelicAlertsClient struct {
 		result1 *alerts.NrqlAlertCondition
 		result2 error
 	}
+	CreateNrqlConditionOutlierMutationStub        func(int, string, alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error)
+	createNrqlConditionOutlierMutationMutex       sync.RWMutex
+	createNrqlConditionOutlierMutationArgsForCall []struct {
+		arg1 int
+		arg2 string
+		arg3 alerts.NrqlConditionInput
+	}
+	createNrqlConditionOutlierMutationReturns struct {
+		result1 *alerts.NrqlAlertCondition
+		result2 error
+	}
+	createNrqlConditionOutlierMutationReturnsOnCall map[int]struct {
+		result1 *alerts.NrqlAlertCondition
+		result2 error
+	}
 	CreateNrqlConditionStaticMutationStub        func(int, string, alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error)
 	createNrqlConditionStaticMutationMutex       sync.RWMutex
 	createNrqlConditionStaticMutationArgsForCall []struct {
@@ -108,6 +180,20 @@ type FakeNewRelicAlertsClient struct {
 		result1 *alerts.AlertsPolicy
 		result2 error
 	}
+	CreateSyntheticsConditionStub        func(int, alerts.SyntheticsCondition) (*alerts.SyntheticsCondition, error)
+	createSyntheticsConditionMutex       sync.RWMutex
+	createSyntheticsConditionArgsForCall []struct {
+		arg1 int
+		arg2 alerts.SyntheticsCondition
+	}
+	createSyntheticsConditionReturns struct {
+		result1 *alerts.SyntheticsCondition
+		result2 error
+	}
+	createSyntheticsConditionReturnsOnCall map[int]struct {
+		result1 *alerts.SyntheticsCondition
+		result2 error
+	}
 	DeleteChannelStub        func(int) (*alerts.Channel, error)
 	deleteChannelMutex       sync.RWMutex
 	deleteChannelArgsForCall []struct {
@@ -148,6 +234,55 @@ type FakeNewRelicAlertsClient struct {
 		result1 string
 		result2 error
 	}
+	DeleteExternalServiceConditionStub        func(int) (*externalservice.Condition, error)
+	deleteExternalServiceConditionMutex       sync.RWMutex
+	deleteExternalServiceConditionArgsForCall []struct {
+		arg1 int
+	}
+	deleteExternalServiceConditionReturns struct {
+		result1 *externalservice.Condition
+		result2 error
+	}
+	deleteExternalServiceConditionReturnsOnCall map[int]struct {
+		result1 *externalservice.Condition
+		result2 error
+	}
+	DeleteInfrastructureConditionStub        func(int) error
+	deleteInfrastructureConditionMutex       sync.RWMutex
+	deleteInfrastructureConditionArgsForCall []struct {
+		arg1 int
+	}
+	deleteInfrastructureConditionReturns struct {
+		result1 error
+	}
+	deleteInfrastructureConditionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteMultiLocationSyntheticsConditionStub        func(int) (*alerts.MultiLocationSyntheticsCondition, error)
+	deleteMultiLocationSyntheticsConditionMutex       sync.RWMutex
+	deleteMultiLocationSyntheticsConditionArgsForCall []struct {
+		arg1 int
+	}
+	deleteMultiLocationSyntheticsConditionReturns struct {
+		result1 *alerts.MultiLocationSyntheticsCondition
+		result2 error
+	}
+	deleteMultiLocationSyntheticsConditionReturnsOnCall map[int]struct {
+		result1 *alerts.MultiLocationSyntheticsCondition
+		result2 error
+	}
+	DeleteMutingRuleStub        func(int, int) error
+	deleteMutingRuleMutex       sync.RWMutex
+	deleteMutingRuleArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	deleteMutingRuleReturns struct {
+		result1 error
+	}
+	deleteMutingRuleReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteNrqlConditionStub        func(int) (*alerts.NrqlCondition, error)
 	deleteNrqlConditionMutex       sync.RWMutex
 	deleteNrqlConditionArgsForCall []struct {
@@ -202,6 +337,33 @@ type FakeNewRelicAlertsClient struct {
 		result1 *alerts.AlertsPolicy
 		result2 error
 	}
+	DeleteSyntheticsConditionStub        func(int) (*alerts.SyntheticsCondition, error)
+	deleteSyntheticsConditionMutex       sync.RWMutex
+	deleteSyntheticsConditionArgsForCall []struct {
+		arg1 int
+	}
+	deleteSyntheticsConditionReturns struct {
+		result1 *alerts.SyntheticsCondition
+		result2 error
+	}
+	deleteSyntheticsConditionReturnsOnCall map[int]struct {
+		result1 *alerts.SyntheticsCondition
+		result2 error
+	}
+	GetMutingRuleStub        func(int, int) (*alerts.MutingRule, error)
+	getMutingRuleMutex       sync.RWMutex
+	getMutingRuleArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getMutingRuleReturns struct {
+		result1 *alerts.MutingRule
+		result2 error
+	}
+	getMutingRuleReturnsOnCall map[int]struct {
+		result1 *alerts.MutingRule
+		result2 error
+	}
 	GetNrqlConditionQueryStub        func(int, string) (*alerts.NrqlAlertCondition, error)
 	getNrqlConditionQueryMutex       sync.RWMutex
 	getNrqlConditionQueryArgsForCall []struct {
@@ -216,6 +378,20 @@ type FakeNewRelicAlertsClient struct {
 		result1 *alerts.NrqlAlertCondition
 		result2 error
 	}
+	GetNrqlConditionSignalStub        func(int, string) (*nrqlsignal.Signal, error)
+	getNrqlConditionSignalMutex       sync.RWMutex
+	getNrqlConditionSignalArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	getNrqlConditionSignalReturns struct {
+		result1 *nrqlsignal.Signal
+		result2 error
+	}
+	getNrqlConditionSignalReturnsOnCall map[int]struct {
+		result1 *nrqlsignal.Signal
+		result2 error
+	}
 	GetPolicyStub        func(int) (*alerts.Policy, error)
 	getPolicyMutex       sync.RWMutex
 	getPolicyArgsForCall []struct {
@@ -254,6 +430,45 @@ type FakeNewRelicAlertsClient struct {
 		result1 []*alerts.Condition
 		result2 error
 	}
+	ListExternalServiceConditionsStub        func(int) ([]*externalservice.Condition, error)
+	listExternalServiceConditionsMutex       sync.RWMutex
+	listExternalServiceConditionsArgsForCall []struct {
+		arg1 int
+	}
+	listExternalServiceConditionsReturns struct {
+		result1 []*externalservice.Condition
+		result2 error
+	}
+	listExternalServiceConditionsReturnsOnCall map[int]struct {
+		result1 []*externalservice.Condition
+		result2 error
+	}
+	ListInfrastructureConditionsStub        func(int) ([]alerts.InfrastructureCondition, error)
+	listInfrastructureConditionsMutex       sync.RWMutex
+	listInfrastructureConditionsArgsForCall []struct {
+		arg1 int
+	}
+	listInfrastructureConditionsReturns struct {
+		result1 []alerts.InfrastructureCondition
+		result2 error
+	}
+	listInfrastructureConditionsReturnsOnCall map[int]struct {
+		result1 []alerts.InfrastructureCondition
+		result2 error
+	}
+	ListMultiLocationSyntheticsConditionsStub        func(int) ([]*alerts.MultiLocationSyntheticsCondition, error)
+	listMultiLocationSyntheticsConditionsMutex       sync.RWMutex
+	listMultiLocationSyntheticsConditionsArgsForCall []struct {
+		arg1 int
+	}
+	listMultiLocationSyntheticsConditionsReturns struct {
+		result1 []*alerts.MultiLocationSyntheticsCondition
+		result2 error
+	}
+	listMultiLocationSyntheticsConditionsReturnsOnCall map[int]struct {
+		result1 []*alerts.MultiLocationSyntheticsCondition
+		result2 error
+	}
 	ListNrqlConditionsStub        func(int) ([]*alerts.NrqlCondition, error)
 	listNrqlConditionsMutex       sync.RWMutex
 	listNrqlConditionsArgsForCall []struct {
@@ -280,6 +495,19 @@ type FakeNewRelicAlertsClient struct {
 		result1 []alerts.Policy
 		result2 error
 	}
+	ListSyntheticsConditionsStub        func(int) ([]*alerts.SyntheticsCondition, error)
+	listSyntheticsConditionsMutex       sync.RWMutex
+	listSyntheticsConditionsArgsForCall []struct {
+		arg1 int
+	}
+	listSyntheticsConditionsReturns struct {
+		result1 []*alerts.SyntheticsCondition
+		result2 error
+	}
+	listSyntheticsConditionsReturnsOnCall map[int]struct {
+		result1 []*alerts.SyntheticsCondition
+		result2 error
+	}
 	QueryPolicyStub        func(int, string) (*alerts.AlertsPolicy, error)
 	queryPolicyMutex       sync.RWMutex
 	queryPolicyArgsForCall []struct {
@@ -335,6 +563,60 @@ type FakeNewRelicAlertsClient struct {
 		result1 *alerts.Condition
 		result2 error
 	}
+	UpdateExternalServiceConditionStub        func(externalservice.Condition) (*externalservice.Condition, error)
+	updateExternalServiceConditionMutex       sync.RWMutex
+	updateExternalServiceConditionArgsForCall []struct {
+		arg1 externalservice.Condition
+	}
+	updateExternalServiceConditionReturns struct {
+		result1 *externalservice.Condition
+		result2 error
+	}
+	updateExternalServiceConditionReturnsOnCall map[int]struct {
+		result1 *externalservice.Condition
+		result2 error
+	}
+	UpdateInfrastructureConditionStub        func(alerts.InfrastructureCondition) (*alerts.InfrastructureCondition, error)
+	updateInfrastructureConditionMutex       sync.RWMutex
+	updateInfrastructureConditionArgsForCall []struct {
+		arg1 alerts.InfrastructureCondition
+	}
+	updateInfrastructureConditionReturns struct {
+		result1 *alerts.InfrastructureCondition
+		result2 error
+	}
+	updateInfrastructureConditionReturnsOnCall map[int]struct {
+		result1 *alerts.InfrastructureCondition
+		result2 error
+	}
+	UpdateMultiLocationSyntheticsConditionStub        func(alerts.MultiLocationSyntheticsCondition) (*alerts.MultiLocationSyntheticsCondition, error)
+	updateMultiLocationSyntheticsConditionMutex       sync.RWMutex
+	updateMultiLocationSyntheticsConditionArgsForCall []struct {
+		arg1 alerts.MultiLocationSyntheticsCondition
+	}
+	updateMultiLocationSyntheticsConditionReturns struct {
+		result1 *alerts.MultiLocationSyntheticsCondition
+		result2 error
+	}
+	updateMultiLocationSyntheticsConditionReturnsOnCall map[int]struct {
+		result1 *alerts.MultiLocationSyntheticsCondition
+		result2 error
+	}
+	UpdateMutingRuleStub        func(int, int, alerts.MutingRuleUpdateInput) (*alerts.MutingRule, error)
+	updateMutingRuleMutex       sync.RWMutex
+	updateMutingRuleArgsForCall []struct {
+		arg1 int
+		arg2 int
+		arg3 alerts.MutingRuleUpdateInput
+	}
+	updateMutingRuleReturns struct {
+		result1 *alerts.MutingRule
+		result2 error
+	}
+	updateMutingRuleReturnsOnCall map[int]struct {
+		result1 *alerts.MutingRule
+		result2 error
+	}
 	UpdateNrqlConditionStub        func(alerts.NrqlCondition) (*alerts.NrqlCondition, error)
 	updateNrqlConditionMutex       sync.RWMutex
 	updateNrqlConditionArgsForCall []struct {
@@ -363,6 +645,37 @@ type FakeNewRelicAlertsClient struct {
 		result1 *alerts.NrqlAlertCondition
 		result2 error
 	}
+	UpdateNrqlConditionOutlierMutationStub        func(int, string, alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error)
+	updateNrqlConditionOutlierMutationMutex       sync.RWMutex
+	updateNrqlConditionOutlierMutationArgsForCall []struct {
+		arg1 int
+		arg2 string
+		arg3 alerts.NrqlConditionInput
+	}
+	updateNrqlConditionOutlierMutationReturns struct {
+		result1 *alerts.NrqlAlertCondition
+		result2 error
+	}
+	updateNrqlConditionOutlierMutationReturnsOnCall map[int]struct {
+		result1 *alerts.NrqlAlertCondition
+		result2 error
+	}
+	UpdateNrqlConditionSignalStub        func(int, alerts.NrqlConditionType, string, nrqlsignal.Signal) (*nrqlsignal.Signal, error)
+	updateNrqlConditionSignalMutex       sync.RWMutex
+	updateNrqlConditionSignalArgsForCall []struct {
+		arg1 int
+		arg2 alerts.NrqlConditionType
+		arg3 string
+		arg4 nrqlsignal.Signal
+	}
+	updateNrqlConditionSignalReturns struct {
+		result1 *nrqlsignal.Signal
+		result2 error
+	}
+	updateNrqlConditionSignalReturnsOnCall map[int]struct {
+		result1 *nrqlsignal.Signal
+		result2 error
+	}
 	UpdateNrqlConditionStaticMutationStub        func(int, string, alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error)
 	updateNrqlConditionStaticMutationMutex       sync.RWMutex
 	updateNrqlConditionStaticMutationArgsForCall []struct {
@@ -420,6 +733,19 @@ type FakeNewRelicAlertsClient struct {
 		result1 *alerts.AlertsPolicy
 		result2 error
 	}
+	UpdateSyntheticsConditionStub        func(alerts.SyntheticsCondition) (*alerts.SyntheticsCondition, error)
+	updateSyntheticsConditionMutex       sync.RWMutex
+	updateSyntheticsConditionArgsForCall []struct {
+		arg1 alerts.SyntheticsCondition
+	}
+	updateSyntheticsConditionReturns struct {
+		result1 *alerts.SyntheticsCondition
+		result2 error
+	}
+	updateSyntheticsConditionReturnsOnCall map[int]struct {
+		result1 *alerts.SyntheticsCondition
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -551,6 +877,261 @@ func (fake *FakeNewRelicAlertsClient) CreateConditionReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
+func (fake *FakeNewRelicAlertsClient) CreateExternalServiceCondition(arg1 int, arg2 externalservice.Condition) (*externalservice.Condition, error) {
+	fake.createExternalServiceConditionMutex.Lock()
+	ret, specificReturn := fake.createExternalServiceConditionReturnsOnCall[len(fake.createExternalServiceConditionArgsForCall)]
+	fake.createExternalServiceConditionArgsForCall = append(fake.createExternalServiceConditionArgsForCall, struct {
+		arg1 int
+		arg2 externalservice.Condition
+	}{arg1, arg2})
+	fake.recordInvocation("CreateExternalServiceCondition", []interface{}{arg1, arg2})
+	fake.createExternalServiceConditionMutex.Unlock()
+	if fake.CreateExternalServiceConditionStub != nil {
+		return fake.CreateExternalServiceConditionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createExternalServiceConditionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateExternalServiceConditionCallCount() int {
+	fake.createExternalServiceConditionMutex.RLock()
+	defer fake.createExternalServiceConditionMutex.RUnlock()
+	return len(fake.createExternalServiceConditionArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateExternalServiceConditionCalls(stub func(int, externalservice.Condition) (*externalservice.Condition, error)) {
+	fake.createExternalServiceConditionMutex.Lock()
+	defer fake.createExternalServiceConditionMutex.Unlock()
+	fake.CreateExternalServiceConditionStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateExternalServiceConditionArgsForCall(i int) (int, externalservice.Condition) {
+	fake.createExternalServiceConditionMutex.RLock()
+	defer fake.createExternalServiceConditionMutex.RUnlock()
+	argsForCall := fake.createExternalServiceConditionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateExternalServiceConditionReturns(result1 *externalservice.Condition, result2 error) {
+	fake.createExternalServiceConditionMutex.Lock()
+	defer fake.createExternalServiceConditionMutex.Unlock()
+	fake.CreateExternalServiceConditionStub = nil
+	fake.createExternalServiceConditionReturns = struct {
+		result1 *externalservice.Condition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateExternalServiceConditionReturnsOnCall(i int, result1 *externalservice.Condition, result2 error) {
+	fake.createExternalServiceConditionMutex.Lock()
+	defer fake.createExternalServiceConditionMutex.Unlock()
+	fake.CreateExternalServiceConditionStub = nil
+	if fake.createExternalServiceConditionReturnsOnCall == nil {
+		fake.createExternalServiceConditionReturnsOnCall = make(map[int]struct {
+			result1 *externalservice.Condition
+			result2 error
+		})
+	}
+	fake.createExternalServiceConditionReturnsOnCall[i] = struct {
+		result1 *externalservice.Condition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateInfrastructureCondition(arg1 alerts.InfrastructureCondition) (*alerts.InfrastructureCondition, error) {
+	fake.createInfrastructureConditionMutex.Lock()
+	ret, specificReturn := fake.createInfrastructureConditionReturnsOnCall[len(fake.createInfrastructureConditionArgsForCall)]
+	fake.createInfrastructureConditionArgsForCall = append(fake.createInfrastructureConditionArgsForCall, struct {
+		arg1 alerts.InfrastructureCondition
+	}{arg1})
+	fake.recordInvocation("CreateInfrastructureCondition", []interface{}{arg1})
+	fake.createInfrastructureConditionMutex.Unlock()
+	if fake.CreateInfrastructureConditionStub != nil {
+		return fake.CreateInfrastructureConditionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createInfrastructureConditionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateInfrastructureConditionCallCount() int {
+	fake.createInfrastructureConditionMutex.RLock()
+	defer fake.createInfrastructureConditionMutex.RUnlock()
+	return len(fake.createInfrastructureConditionArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateInfrastructureConditionCalls(stub func(alerts.InfrastructureCondition) (*alerts.InfrastructureCondition, error)) {
+	fake.createInfrastructureConditionMutex.Lock()
+	defer fake.createInfrastructureConditionMutex.Unlock()
+	fake.CreateInfrastructureConditionStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateInfrastructureConditionArgsForCall(i int) alerts.InfrastructureCondition {
+	fake.createInfrastructureConditionMutex.RLock()
+	defer fake.createInfrastructureConditionMutex.RUnlock()
+	argsForCall := fake.createInfrastructureConditionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateInfrastructureConditionReturns(result1 *alerts.InfrastructureCondition, result2 error) {
+	fake.createInfrastructureConditionMutex.Lock()
+	defer fake.createInfrastructureConditionMutex.Unlock()
+	fake.CreateInfrastructureConditionStub = nil
+	fake.createInfrastructureConditionReturns = struct {
+		result1 *alerts.InfrastructureCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateInfrastructureConditionReturnsOnCall(i int, result1 *alerts.InfrastructureCondition, result2 error) {
+	fake.createInfrastructureConditionMutex.Lock()
+	defer fake.createInfrastructureConditionMutex.Unlock()
+	fake.CreateInfrastructureConditionStub = nil
+	if fake.createInfrastructureConditionReturnsOnCall == nil {
+		fake.createInfrastructureConditionReturnsOnCall = make(map[int]struct {
+			result1 *alerts.InfrastructureCondition
+			result2 error
+		})
+	}
+	fake.createInfrastructureConditionReturnsOnCall[i] = struct {
+		result1 *alerts.InfrastructureCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateMultiLocationSyntheticsCondition(arg1 alerts.MultiLocationSyntheticsCondition, arg2 int) (*alerts.MultiLocationSyntheticsCondition, error) {
+	fake.createMultiLocationSyntheticsConditionMutex.Lock()
+	ret, specificReturn := fake.createMultiLocationSyntheticsConditionReturnsOnCall[len(fake.createMultiLocationSyntheticsConditionArgsForCall)]
+	fake.createMultiLocationSyntheticsConditionArgsForCall = append(fake.createMultiLocationSyntheticsConditionArgsForCall, struct {
+		arg1 alerts.MultiLocationSyntheticsCondition
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("CreateMultiLocationSyntheticsCondition", []interface{}{arg1, arg2})
+	fake.createMultiLocationSyntheticsConditionMutex.Unlock()
+	if fake.CreateMultiLocationSyntheticsConditionStub != nil {
+		return fake.CreateMultiLocationSyntheticsConditionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createMultiLocationSyntheticsConditionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateMultiLocationSyntheticsConditionCallCount() int {
+	fake.createMultiLocationSyntheticsConditionMutex.RLock()
+	defer fake.createMultiLocationSyntheticsConditionMutex.RUnlock()
+	return len(fake.createMultiLocationSyntheticsConditionArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateMultiLocationSyntheticsConditionCalls(stub func(alerts.MultiLocationSyntheticsCondition, int) (*alerts.MultiLocationSyntheticsCondition, error)) {
+	fake.createMultiLocationSyntheticsConditionMutex.Lock()
+	defer fake.createMultiLocationSyntheticsConditionMutex.Unlock()
+	fake.CreateMultiLocationSyntheticsConditionStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateMultiLocationSyntheticsConditionArgsForCall(i int) (alerts.MultiLocationSyntheticsCondition, int) {
+	fake.createMultiLocationSyntheticsConditionMutex.RLock()
+	defer fake.createMultiLocationSyntheticsConditionMutex.RUnlock()
+	argsForCall := fake.createMultiLocationSyntheticsConditionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateMultiLocationSyntheticsConditionReturns(result1 *alerts.MultiLocationSyntheticsCondition, result2 error) {
+	fake.createMultiLocationSyntheticsConditionMutex.Lock()
+	defer fake.createMultiLocationSyntheticsConditionMutex.Unlock()
+	fake.CreateMultiLocationSyntheticsConditionStub = nil
+	fake.createMultiLocationSyntheticsConditionReturns = struct {
+		result1 *alerts.MultiLocationSyntheticsCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateMultiLocationSyntheticsConditionReturnsOnCall(i int, result1 *alerts.MultiLocationSyntheticsCondition, result2 error) {
+	fake.createMultiLocationSyntheticsConditionMutex.Lock()
+	defer fake.createMultiLocationSyntheticsConditionMutex.Unlock()
+	fake.CreateMultiLocationSyntheticsConditionStub = nil
+	if fake.createMultiLocationSyntheticsConditionReturnsOnCall == nil {
+		fake.createMultiLocationSyntheticsConditionReturnsOnCall = make(map[int]struct {
+			result1 *alerts.MultiLocationSyntheticsCondition
+			result2 error
+		})
+	}
+	fake.createMultiLocationSyntheticsConditionReturnsOnCall[i] = struct {
+		result1 *alerts.MultiLocationSyntheticsCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateMutingRule(arg1 int, arg2 alerts.MutingRuleCreateInput) (*alerts.MutingRule, error) {
+	fake.createMutingRuleMutex.Lock()
+	ret, specificReturn := fake.createMutingRuleReturnsOnCall[len(fake.createMutingRuleArgsForCall)]
+	fake.createMutingRuleArgsForCall = append(fake.createMutingRuleArgsForCall, struct {
+		arg1 int
+		arg2 alerts.MutingRuleCreateInput
+	}{arg1, arg2})
+	fake.recordInvocation("CreateMutingRule", []interface{}{arg1, arg2})
+	fake.createMutingRuleMutex.Unlock()
+	if fake.CreateMutingRuleStub != nil {
+		return fake.CreateMutingRuleStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createMutingRuleReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateMutingRuleCallCount() int {
+	fake.createMutingRuleMutex.RLock()
+	defer fake.createMutingRuleMutex.RUnlock()
+	return len(fake.createMutingRuleArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateMutingRuleCalls(stub func(int, alerts.MutingRuleCreateInput) (*alerts.MutingRule, error)) {
+	fake.createMutingRuleMutex.Lock()
+	defer fake.createMutingRuleMutex.Unlock()
+	fake.CreateMutingRuleStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateMutingRuleArgsForCall(i int) (int, alerts.MutingRuleCreateInput) {
+	fake.createMutingRuleMutex.RLock()
+	defer fake.createMutingRuleMutex.RUnlock()
+	argsForCall := fake.createMutingRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateMutingRuleReturns(result1 *alerts.MutingRule, result2 error) {
+	fake.createMutingRuleMutex.Lock()
+	defer fake.createMutingRuleMutex.Unlock()
+	fake.CreateMutingRuleStub = nil
+	fake.createMutingRuleReturns = struct {
+		result1 *alerts.MutingRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateMutingRuleReturnsOnCall(i int, result1 *alerts.MutingRule, result2 error) {
+	fake.createMutingRuleMutex.Lock()
+	defer fake.createMutingRuleMutex.Unlock()
+	fake.CreateMutingRuleStub = nil
+	if fake.createMutingRuleReturnsOnCall == nil {
+		fake.createMutingRuleReturnsOnCall = make(map[int]struct {
+			result1 *alerts.MutingRule
+			result2 error
+		})
+	}
+	fake.createMutingRuleReturnsOnCall[i] = struct {
+		result1 *alerts.MutingRule
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeNewRelicAlertsClient) CreateNrqlCondition(arg1 int, arg2 alerts.NrqlCondition) (*alerts.NrqlCondition, error) {
 	fake.createNrqlConditionMutex.Lock()
 	ret, specificReturn := fake.createNrqlConditionReturnsOnCall[len(fake.createNrqlConditionArgsForCall)]
@@ -680,8 +1261,73 @@ func (fake *FakeNewRelicAlertsClient) CreateNrqlConditionBaselineMutationReturns
 	}{result1, result2}
 }
 
-func (fake *FakeNewRelicAlertsClient) CreateNrqlConditionStaticMutation(arg1 int, arg2 string, arg3 alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
-	fake.createNrqlConditionStaticMutationMutex.Lock()
+func (fake *FakeNewRelicAlertsClient) CreateNrqlConditionOutlierMutation(arg1 int, arg2 string, arg3 alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+	fake.createNrqlConditionOutlierMutationMutex.Lock()
+	ret, specificReturn := fake.createNrqlConditionOutlierMutationReturnsOnCall[len(fake.createNrqlConditionOutlierMutationArgsForCall)]
+	fake.createNrqlConditionOutlierMutationArgsForCall = append(fake.createNrqlConditionOutlierMutationArgsForCall, struct {
+		arg1 int
+		arg2 string
+		arg3 alerts.NrqlConditionInput
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateNrqlConditionOutlierMutation", []interface{}{arg1, arg2, arg3})
+	fake.createNrqlConditionOutlierMutationMutex.Unlock()
+	if fake.CreateNrqlConditionOutlierMutationStub != nil {
+		return fake.CreateNrqlConditionOutlierMutationStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createNrqlConditionOutlierMutationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateNrqlConditionOutlierMutationCallCount() int {
+	fake.createNrqlConditionOutlierMutationMutex.RLock()
+	defer fake.createNrqlConditionOutlierMutationMutex.RUnlock()
+	return len(fake.createNrqlConditionOutlierMutationArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateNrqlConditionOutlierMutationCalls(stub func(int, string, alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error)) {
+	fake.createNrqlConditionOutlierMutationMutex.Lock()
+	defer fake.createNrqlConditionOutlierMutationMutex.Unlock()
+	fake.CreateNrqlConditionOutlierMutationStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateNrqlConditionOutlierMutationArgsForCall(i int) (int, string, alerts.NrqlConditionInput) {
+	fake.createNrqlConditionOutlierMutationMutex.RLock()
+	defer fake.createNrqlConditionOutlierMutationMutex.RUnlock()
+	argsForCall := fake.createNrqlConditionOutlierMutationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateNrqlConditionOutlierMutationReturns(result1 *alerts.NrqlAlertCondition, result2 error) {
+	fake.createNrqlConditionOutlierMutationMutex.Lock()
+	defer fake.createNrqlConditionOutlierMutationMutex.Unlock()
+	fake.CreateNrqlConditionOutlierMutationStub = nil
+	fake.createNrqlConditionOutlierMutationReturns = struct {
+		result1 *alerts.NrqlAlertCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateNrqlConditionOutlierMutationReturnsOnCall(i int, result1 *alerts.NrqlAlertCondition, result2 error) {
+	fake.createNrqlConditionOutlierMutationMutex.Lock()
+	defer fake.createNrqlConditionOutlierMutationMutex.Unlock()
+	fake.CreateNrqlConditionOutlierMutationStub = nil
+	if fake.createNrqlConditionOutlierMutationReturnsOnCall == nil {
+		fake.createNrqlConditionOutlierMutationReturnsOnCall = make(map[int]struct {
+			result1 *alerts.NrqlAlertCondition
+			result2 error
+		})
+	}
+	fake.createNrqlConditionOutlierMutationReturnsOnCall[i] = struct {
+		result1 *alerts.NrqlAlertCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateNrqlConditionStaticMutation(arg1 int, arg2 string, arg3 alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+	fake.createNrqlConditionStaticMutationMutex.Lock()
 	ret, specificReturn := fake.createNrqlConditionStaticMutationReturnsOnCall[len(fake.createNrqlConditionStaticMutationArgsForCall)]
 	fake.createNrqlConditionStaticMutationArgsForCall = append(fake.createNrqlConditionStaticMutationArgsForCall, struct {
 		arg1 int
@@ -872,6 +1518,70 @@ func (fake *FakeNewRelicAlertsClient) CreatePolicyMutationReturnsOnCall(i int, r
 	}{result1, result2}
 }
 
+func (fake *FakeNewRelicAlertsClient) CreateSyntheticsCondition(arg1 int, arg2 alerts.SyntheticsCondition) (*alerts.SyntheticsCondition, error) {
+	fake.createSyntheticsConditionMutex.Lock()
+	ret, specificReturn := fake.createSyntheticsConditionReturnsOnCall[len(fake.createSyntheticsConditionArgsForCall)]
+	fake.createSyntheticsConditionArgsForCall = append(fake.createSyntheticsConditionArgsForCall, struct {
+		arg1 int
+		arg2 alerts.SyntheticsCondition
+	}{arg1, arg2})
+	fake.recordInvocation("CreateSyntheticsCondition", []interface{}{arg1, arg2})
+	fake.createSyntheticsConditionMutex.Unlock()
+	if fake.CreateSyntheticsConditionStub != nil {
+		return fake.CreateSyntheticsConditionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createSyntheticsConditionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateSyntheticsConditionCallCount() int {
+	fake.createSyntheticsConditionMutex.RLock()
+	defer fake.createSyntheticsConditionMutex.RUnlock()
+	return len(fake.createSyntheticsConditionArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateSyntheticsConditionCalls(stub func(int, alerts.SyntheticsCondition) (*alerts.SyntheticsCondition, error)) {
+	fake.createSyntheticsConditionMutex.Lock()
+	defer fake.createSyntheticsConditionMutex.Unlock()
+	fake.CreateSyntheticsConditionStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateSyntheticsConditionArgsForCall(i int) (int, alerts.SyntheticsCondition) {
+	fake.createSyntheticsConditionMutex.RLock()
+	defer fake.createSyntheticsConditionMutex.RUnlock()
+	argsForCall := fake.createSyntheticsConditionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateSyntheticsConditionReturns(result1 *alerts.SyntheticsCondition, result2 error) {
+	fake.createSyntheticsConditionMutex.Lock()
+	defer fake.createSyntheticsConditionMutex.Unlock()
+	fake.CreateSyntheticsConditionStub = nil
+	fake.createSyntheticsConditionReturns = struct {
+		result1 *alerts.SyntheticsCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) CreateSyntheticsConditionReturnsOnCall(i int, result1 *alerts.SyntheticsCondition, result2 error) {
+	fake.createSyntheticsConditionMutex.Lock()
+	defer fake.createSyntheticsConditionMutex.Unlock()
+	fake.CreateSyntheticsConditionStub = nil
+	if fake.createSyntheticsConditionReturnsOnCall == nil {
+		fake.createSyntheticsConditionReturnsOnCall = make(map[int]struct {
+			result1 *alerts.SyntheticsCondition
+			result2 error
+		})
+	}
+	fake.createSyntheticsConditionReturnsOnCall[i] = struct {
+		result1 *alerts.SyntheticsCondition
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeNewRelicAlertsClient) DeleteChannel(arg1 int) (*alerts.Channel, error) {
 	fake.deleteChannelMutex.Lock()
 	ret, specificReturn := fake.deleteChannelReturnsOnCall[len(fake.deleteChannelArgsForCall)]
@@ -1062,6 +1772,253 @@ func (fake *FakeNewRelicAlertsClient) DeleteConditionMutationReturnsOnCall(i int
 	}{result1, result2}
 }
 
+func (fake *FakeNewRelicAlertsClient) DeleteExternalServiceCondition(arg1 int) (*externalservice.Condition, error) {
+	fake.deleteExternalServiceConditionMutex.Lock()
+	ret, specificReturn := fake.deleteExternalServiceConditionReturnsOnCall[len(fake.deleteExternalServiceConditionArgsForCall)]
+	fake.deleteExternalServiceConditionArgsForCall = append(fake.deleteExternalServiceConditionArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("DeleteExternalServiceCondition", []interface{}{arg1})
+	fake.deleteExternalServiceConditionMutex.Unlock()
+	if fake.DeleteExternalServiceConditionStub != nil {
+		return fake.DeleteExternalServiceConditionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteExternalServiceConditionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteExternalServiceConditionCallCount() int {
+	fake.deleteExternalServiceConditionMutex.RLock()
+	defer fake.deleteExternalServiceConditionMutex.RUnlock()
+	return len(fake.deleteExternalServiceConditionArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteExternalServiceConditionCalls(stub func(int) (*externalservice.Condition, error)) {
+	fake.deleteExternalServiceConditionMutex.Lock()
+	defer fake.deleteExternalServiceConditionMutex.Unlock()
+	fake.DeleteExternalServiceConditionStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteExternalServiceConditionArgsForCall(i int) int {
+	fake.deleteExternalServiceConditionMutex.RLock()
+	defer fake.deleteExternalServiceConditionMutex.RUnlock()
+	argsForCall := fake.deleteExternalServiceConditionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteExternalServiceConditionReturns(result1 *externalservice.Condition, result2 error) {
+	fake.deleteExternalServiceConditionMutex.Lock()
+	defer fake.deleteExternalServiceConditionMutex.Unlock()
+	fake.DeleteExternalServiceConditionStub = nil
+	fake.deleteExternalServiceConditionReturns = struct {
+		result1 *externalservice.Condition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteExternalServiceConditionReturnsOnCall(i int, result1 *externalservice.Condition, result2 error) {
+	fake.deleteExternalServiceConditionMutex.Lock()
+	defer fake.deleteExternalServiceConditionMutex.Unlock()
+	fake.DeleteExternalServiceConditionStub = nil
+	if fake.deleteExternalServiceConditionReturnsOnCall == nil {
+		fake.deleteExternalServiceConditionReturnsOnCall = make(map[int]struct {
+			result1 *externalservice.Condition
+			result2 error
+		})
+	}
+	fake.deleteExternalServiceConditionReturnsOnCall[i] = struct {
+		result1 *externalservice.Condition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteInfrastructureCondition(arg1 int) error {
+	fake.deleteInfrastructureConditionMutex.Lock()
+	ret, specificReturn := fake.deleteInfrastructureConditionReturnsOnCall[len(fake.deleteInfrastructureConditionArgsForCall)]
+	fake.deleteInfrastructureConditionArgsForCall = append(fake.deleteInfrastructureConditionArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("DeleteInfrastructureCondition", []interface{}{arg1})
+	fake.deleteInfrastructureConditionMutex.Unlock()
+	if fake.DeleteInfrastructureConditionStub != nil {
+		return fake.DeleteInfrastructureConditionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteInfrastructureConditionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteInfrastructureConditionCallCount() int {
+	fake.deleteInfrastructureConditionMutex.RLock()
+	defer fake.deleteInfrastructureConditionMutex.RUnlock()
+	return len(fake.deleteInfrastructureConditionArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteInfrastructureConditionCalls(stub func(int) error) {
+	fake.deleteInfrastructureConditionMutex.Lock()
+	defer fake.deleteInfrastructureConditionMutex.Unlock()
+	fake.DeleteInfrastructureConditionStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteInfrastructureConditionArgsForCall(i int) int {
+	fake.deleteInfrastructureConditionMutex.RLock()
+	defer fake.deleteInfrastructureConditionMutex.RUnlock()
+	argsForCall := fake.deleteInfrastructureConditionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteInfrastructureConditionReturns(result1 error) {
+	fake.deleteInfrastructureConditionMutex.Lock()
+	defer fake.deleteInfrastructureConditionMutex.Unlock()
+	fake.DeleteInfrastructureConditionStub = nil
+	fake.deleteInfrastructureConditionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteInfrastructureConditionReturnsOnCall(i int, result1 error) {
+	fake.deleteInfrastructureConditionMutex.Lock()
+	defer fake.deleteInfrastructureConditionMutex.Unlock()
+	fake.DeleteInfrastructureConditionStub = nil
+	if fake.deleteInfrastructureConditionReturnsOnCall == nil {
+		fake.deleteInfrastructureConditionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteInfrastructureConditionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteMultiLocationSyntheticsCondition(arg1 int) (*alerts.MultiLocationSyntheticsCondition, error) {
+	fake.deleteMultiLocationSyntheticsConditionMutex.Lock()
+	ret, specificReturn := fake.deleteMultiLocationSyntheticsConditionReturnsOnCall[len(fake.deleteMultiLocationSyntheticsConditionArgsForCall)]
+	fake.deleteMultiLocationSyntheticsConditionArgsForCall = append(fake.deleteMultiLocationSyntheticsConditionArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("DeleteMultiLocationSyntheticsCondition", []interface{}{arg1})
+	fake.deleteMultiLocationSyntheticsConditionMutex.Unlock()
+	if fake.DeleteMultiLocationSyntheticsConditionStub != nil {
+		return fake.DeleteMultiLocationSyntheticsConditionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteMultiLocationSyntheticsConditionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteMultiLocationSyntheticsConditionCallCount() int {
+	fake.deleteMultiLocationSyntheticsConditionMutex.RLock()
+	defer fake.deleteMultiLocationSyntheticsConditionMutex.RUnlock()
+	return len(fake.deleteMultiLocationSyntheticsConditionArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteMultiLocationSyntheticsConditionCalls(stub func(int) (*alerts.MultiLocationSyntheticsCondition, error)) {
+	fake.deleteMultiLocationSyntheticsConditionMutex.Lock()
+	defer fake.deleteMultiLocationSyntheticsConditionMutex.Unlock()
+	fake.DeleteMultiLocationSyntheticsConditionStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteMultiLocationSyntheticsConditionArgsForCall(i int) int {
+	fake.deleteMultiLocationSyntheticsConditionMutex.RLock()
+	defer fake.deleteMultiLocationSyntheticsConditionMutex.RUnlock()
+	argsForCall := fake.deleteMultiLocationSyntheticsConditionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteMultiLocationSyntheticsConditionReturns(result1 *alerts.MultiLocationSyntheticsCondition, result2 error) {
+	fake.deleteMultiLocationSyntheticsConditionMutex.Lock()
+	defer fake.deleteMultiLocationSyntheticsConditionMutex.Unlock()
+	fake.DeleteMultiLocationSyntheticsConditionStub = nil
+	fake.deleteMultiLocationSyntheticsConditionReturns = struct {
+		result1 *alerts.MultiLocationSyntheticsCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteMultiLocationSyntheticsConditionReturnsOnCall(i int, result1 *alerts.MultiLocationSyntheticsCondition, result2 error) {
+	fake.deleteMultiLocationSyntheticsConditionMutex.Lock()
+	defer fake.deleteMultiLocationSyntheticsConditionMutex.Unlock()
+	fake.DeleteMultiLocationSyntheticsConditionStub = nil
+	if fake.deleteMultiLocationSyntheticsConditionReturnsOnCall == nil {
+		fake.deleteMultiLocationSyntheticsConditionReturnsOnCall = make(map[int]struct {
+			result1 *alerts.MultiLocationSyntheticsCondition
+			result2 error
+		})
+	}
+	fake.deleteMultiLocationSyntheticsConditionReturnsOnCall[i] = struct {
+		result1 *alerts.MultiLocationSyntheticsCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteMutingRule(arg1 int, arg2 int) error {
+	fake.deleteMutingRuleMutex.Lock()
+	ret, specificReturn := fake.deleteMutingRuleReturnsOnCall[len(fake.deleteMutingRuleArgsForCall)]
+	fake.deleteMutingRuleArgsForCall = append(fake.deleteMutingRuleArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteMutingRule", []interface{}{arg1, arg2})
+	fake.deleteMutingRuleMutex.Unlock()
+	if fake.DeleteMutingRuleStub != nil {
+		return fake.DeleteMutingRuleStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteMutingRuleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteMutingRuleCallCount() int {
+	fake.deleteMutingRuleMutex.RLock()
+	defer fake.deleteMutingRuleMutex.RUnlock()
+	return len(fake.deleteMutingRuleArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteMutingRuleCalls(stub func(int, int) error) {
+	fake.deleteMutingRuleMutex.Lock()
+	defer fake.deleteMutingRuleMutex.Unlock()
+	fake.DeleteMutingRuleStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteMutingRuleArgsForCall(i int) (int, int) {
+	fake.deleteMutingRuleMutex.RLock()
+	defer fake.deleteMutingRuleMutex.RUnlock()
+	argsForCall := fake.deleteMutingRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteMutingRuleReturns(result1 error) {
+	fake.deleteMutingRuleMutex.Lock()
+	defer fake.deleteMutingRuleMutex.Unlock()
+	fake.DeleteMutingRuleStub = nil
+	fake.deleteMutingRuleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteMutingRuleReturnsOnCall(i int, result1 error) {
+	fake.deleteMutingRuleMutex.Lock()
+	defer fake.deleteMutingRuleMutex.Unlock()
+	fake.DeleteMutingRuleStub = nil
+	if fake.deleteMutingRuleReturnsOnCall == nil {
+		fake.deleteMutingRuleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteMutingRuleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeNewRelicAlertsClient) DeleteNrqlCondition(arg1 int) (*alerts.NrqlCondition, error) {
 	fake.deleteNrqlConditionMutex.Lock()
 	ret, specificReturn := fake.deleteNrqlConditionReturnsOnCall[len(fake.deleteNrqlConditionArgsForCall)]
@@ -1316,6 +2273,133 @@ func (fake *FakeNewRelicAlertsClient) DeletePolicyMutationReturnsOnCall(i int, r
 	}{result1, result2}
 }
 
+func (fake *FakeNewRelicAlertsClient) DeleteSyntheticsCondition(arg1 int) (*alerts.SyntheticsCondition, error) {
+	fake.deleteSyntheticsConditionMutex.Lock()
+	ret, specificReturn := fake.deleteSyntheticsConditionReturnsOnCall[len(fake.deleteSyntheticsConditionArgsForCall)]
+	fake.deleteSyntheticsConditionArgsForCall = append(fake.deleteSyntheticsConditionArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("DeleteSyntheticsCondition", []interface{}{arg1})
+	fake.deleteSyntheticsConditionMutex.Unlock()
+	if fake.DeleteSyntheticsConditionStub != nil {
+		return fake.DeleteSyntheticsConditionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteSyntheticsConditionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteSyntheticsConditionCallCount() int {
+	fake.deleteSyntheticsConditionMutex.RLock()
+	defer fake.deleteSyntheticsConditionMutex.RUnlock()
+	return len(fake.deleteSyntheticsConditionArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteSyntheticsConditionCalls(stub func(int) (*alerts.SyntheticsCondition, error)) {
+	fake.deleteSyntheticsConditionMutex.Lock()
+	defer fake.deleteSyntheticsConditionMutex.Unlock()
+	fake.DeleteSyntheticsConditionStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteSyntheticsConditionArgsForCall(i int) int {
+	fake.deleteSyntheticsConditionMutex.RLock()
+	defer fake.deleteSyntheticsConditionMutex.RUnlock()
+	argsForCall := fake.deleteSyntheticsConditionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteSyntheticsConditionReturns(result1 *alerts.SyntheticsCondition, result2 error) {
+	fake.deleteSyntheticsConditionMutex.Lock()
+	defer fake.deleteSyntheticsConditionMutex.Unlock()
+	fake.DeleteSyntheticsConditionStub = nil
+	fake.deleteSyntheticsConditionReturns = struct {
+		result1 *alerts.SyntheticsCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) DeleteSyntheticsConditionReturnsOnCall(i int, result1 *alerts.SyntheticsCondition, result2 error) {
+	fake.deleteSyntheticsConditionMutex.Lock()
+	defer fake.deleteSyntheticsConditionMutex.Unlock()
+	fake.DeleteSyntheticsConditionStub = nil
+	if fake.deleteSyntheticsConditionReturnsOnCall == nil {
+		fake.deleteSyntheticsConditionReturnsOnCall = make(map[int]struct {
+			result1 *alerts.SyntheticsCondition
+			result2 error
+		})
+	}
+	fake.deleteSyntheticsConditionReturnsOnCall[i] = struct {
+		result1 *alerts.SyntheticsCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) GetMutingRule(arg1 int, arg2 int) (*alerts.MutingRule, error) {
+	fake.getMutingRuleMutex.Lock()
+	ret, specificReturn := fake.getMutingRuleReturnsOnCall[len(fake.getMutingRuleArgsForCall)]
+	fake.getMutingRuleArgsForCall = append(fake.getMutingRuleArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetMutingRule", []interface{}{arg1, arg2})
+	fake.getMutingRuleMutex.Unlock()
+	if fake.GetMutingRuleStub != nil {
+		return fake.GetMutingRuleStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getMutingRuleReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) GetMutingRuleCallCount() int {
+	fake.getMutingRuleMutex.RLock()
+	defer fake.getMutingRuleMutex.RUnlock()
+	return len(fake.getMutingRuleArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) GetMutingRuleCalls(stub func(int, int) (*alerts.MutingRule, error)) {
+	fake.getMutingRuleMutex.Lock()
+	defer fake.getMutingRuleMutex.Unlock()
+	fake.GetMutingRuleStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) GetMutingRuleArgsForCall(i int) (int, int) {
+	fake.getMutingRuleMutex.RLock()
+	defer fake.getMutingRuleMutex.RUnlock()
+	argsForCall := fake.getMutingRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNewRelicAlertsClient) GetMutingRuleReturns(result1 *alerts.MutingRule, result2 error) {
+	fake.getMutingRuleMutex.Lock()
+	defer fake.getMutingRuleMutex.Unlock()
+	fake.GetMutingRuleStub = nil
+	fake.getMutingRuleReturns = struct {
+		result1 *alerts.MutingRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) GetMutingRuleReturnsOnCall(i int, result1 *alerts.MutingRule, result2 error) {
+	fake.getMutingRuleMutex.Lock()
+	defer fake.getMutingRuleMutex.Unlock()
+	fake.GetMutingRuleStub = nil
+	if fake.getMutingRuleReturnsOnCall == nil {
+		fake.getMutingRuleReturnsOnCall = make(map[int]struct {
+			result1 *alerts.MutingRule
+			result2 error
+		})
+	}
+	fake.getMutingRuleReturnsOnCall[i] = struct {
+		result1 *alerts.MutingRule
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeNewRelicAlertsClient) GetNrqlConditionQuery(arg1 int, arg2 string) (*alerts.NrqlAlertCondition, error) {
 	fake.getNrqlConditionQueryMutex.Lock()
 	ret, specificReturn := fake.getNrqlConditionQueryReturnsOnCall[len(fake.getNrqlConditionQueryArgsForCall)]
@@ -1380,6 +2464,70 @@ func (fake *FakeNewRelicAlertsClient) GetNrqlConditionQueryReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeNewRelicAlertsClient) GetNrqlConditionSignal(arg1 int, arg2 string) (*nrqlsignal.Signal, error) {
+	fake.getNrqlConditionSignalMutex.Lock()
+	ret, specificReturn := fake.getNrqlConditionSignalReturnsOnCall[len(fake.getNrqlConditionSignalArgsForCall)]
+	fake.getNrqlConditionSignalArgsForCall = append(fake.getNrqlConditionSignalArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetNrqlConditionSignal", []interface{}{arg1, arg2})
+	fake.getNrqlConditionSignalMutex.Unlock()
+	if fake.GetNrqlConditionSignalStub != nil {
+		return fake.GetNrqlConditionSignalStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getNrqlConditionSignalReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) GetNrqlConditionSignalCallCount() int {
+	fake.getNrqlConditionSignalMutex.RLock()
+	defer fake.getNrqlConditionSignalMutex.RUnlock()
+	return len(fake.getNrqlConditionSignalArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) GetNrqlConditionSignalCalls(stub func(int, string) (*nrqlsignal.Signal, error)) {
+	fake.getNrqlConditionSignalMutex.Lock()
+	defer fake.getNrqlConditionSignalMutex.Unlock()
+	fake.GetNrqlConditionSignalStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) GetNrqlConditionSignalArgsForCall(i int) (int, string) {
+	fake.getNrqlConditionSignalMutex.RLock()
+	defer fake.getNrqlConditionSignalMutex.RUnlock()
+	argsForCall := fake.getNrqlConditionSignalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNewRelicAlertsClient) GetNrqlConditionSignalReturns(result1 *nrqlsignal.Signal, result2 error) {
+	fake.getNrqlConditionSignalMutex.Lock()
+	defer fake.getNrqlConditionSignalMutex.Unlock()
+	fake.GetNrqlConditionSignalStub = nil
+	fake.getNrqlConditionSignalReturns = struct {
+		result1 *nrqlsignal.Signal
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) GetNrqlConditionSignalReturnsOnCall(i int, result1 *nrqlsignal.Signal, result2 error) {
+	fake.getNrqlConditionSignalMutex.Lock()
+	defer fake.getNrqlConditionSignalMutex.Unlock()
+	fake.GetNrqlConditionSignalStub = nil
+	if fake.getNrqlConditionSignalReturnsOnCall == nil {
+		fake.getNrqlConditionSignalReturnsOnCall = make(map[int]struct {
+			result1 *nrqlsignal.Signal
+			result2 error
+		})
+	}
+	fake.getNrqlConditionSignalReturnsOnCall[i] = struct {
+		result1 *nrqlsignal.Signal
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeNewRelicAlertsClient) GetPolicy(arg1 int) (*alerts.Policy, error) {
 	fake.getPolicyMutex.Lock()
 	ret, specificReturn := fake.getPolicyReturnsOnCall[len(fake.getPolicyArgsForCall)]
@@ -1492,71 +2640,260 @@ func (fake *FakeNewRelicAlertsClient) ListChannelsReturnsOnCall(i int, result1 [
 			result2 error
 		})
 	}
-	fake.listChannelsReturnsOnCall[i] = struct {
-		result1 []*alerts.Channel
+	fake.listChannelsReturnsOnCall[i] = struct {
+		result1 []*alerts.Channel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) ListConditions(arg1 int) ([]*alerts.Condition, error) {
+	fake.listConditionsMutex.Lock()
+	ret, specificReturn := fake.listConditionsReturnsOnCall[len(fake.listConditionsArgsForCall)]
+	fake.listConditionsArgsForCall = append(fake.listConditionsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListConditions", []interface{}{arg1})
+	fake.listConditionsMutex.Unlock()
+	if fake.ListConditionsStub != nil {
+		return fake.ListConditionsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listConditionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) ListConditionsCallCount() int {
+	fake.listConditionsMutex.RLock()
+	defer fake.listConditionsMutex.RUnlock()
+	return len(fake.listConditionsArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) ListConditionsCalls(stub func(int) ([]*alerts.Condition, error)) {
+	fake.listConditionsMutex.Lock()
+	defer fake.listConditionsMutex.Unlock()
+	fake.ListConditionsStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) ListConditionsArgsForCall(i int) int {
+	fake.listConditionsMutex.RLock()
+	defer fake.listConditionsMutex.RUnlock()
+	argsForCall := fake.listConditionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNewRelicAlertsClient) ListConditionsReturns(result1 []*alerts.Condition, result2 error) {
+	fake.listConditionsMutex.Lock()
+	defer fake.listConditionsMutex.Unlock()
+	fake.ListConditionsStub = nil
+	fake.listConditionsReturns = struct {
+		result1 []*alerts.Condition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) ListConditionsReturnsOnCall(i int, result1 []*alerts.Condition, result2 error) {
+	fake.listConditionsMutex.Lock()
+	defer fake.listConditionsMutex.Unlock()
+	fake.ListConditionsStub = nil
+	if fake.listConditionsReturnsOnCall == nil {
+		fake.listConditionsReturnsOnCall = make(map[int]struct {
+			result1 []*alerts.Condition
+			result2 error
+		})
+	}
+	fake.listConditionsReturnsOnCall[i] = struct {
+		result1 []*alerts.Condition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) ListExternalServiceConditions(arg1 int) ([]*externalservice.Condition, error) {
+	fake.listExternalServiceConditionsMutex.Lock()
+	ret, specificReturn := fake.listExternalServiceConditionsReturnsOnCall[len(fake.listExternalServiceConditionsArgsForCall)]
+	fake.listExternalServiceConditionsArgsForCall = append(fake.listExternalServiceConditionsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListExternalServiceConditions", []interface{}{arg1})
+	fake.listExternalServiceConditionsMutex.Unlock()
+	if fake.ListExternalServiceConditionsStub != nil {
+		return fake.ListExternalServiceConditionsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listExternalServiceConditionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) ListExternalServiceConditionsCallCount() int {
+	fake.listExternalServiceConditionsMutex.RLock()
+	defer fake.listExternalServiceConditionsMutex.RUnlock()
+	return len(fake.listExternalServiceConditionsArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) ListExternalServiceConditionsCalls(stub func(int) ([]*externalservice.Condition, error)) {
+	fake.listExternalServiceConditionsMutex.Lock()
+	defer fake.listExternalServiceConditionsMutex.Unlock()
+	fake.ListExternalServiceConditionsStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) ListExternalServiceConditionsArgsForCall(i int) int {
+	fake.listExternalServiceConditionsMutex.RLock()
+	defer fake.listExternalServiceConditionsMutex.RUnlock()
+	argsForCall := fake.listExternalServiceConditionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNewRelicAlertsClient) ListExternalServiceConditionsReturns(result1 []*externalservice.Condition, result2 error) {
+	fake.listExternalServiceConditionsMutex.Lock()
+	defer fake.listExternalServiceConditionsMutex.Unlock()
+	fake.ListExternalServiceConditionsStub = nil
+	fake.listExternalServiceConditionsReturns = struct {
+		result1 []*externalservice.Condition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) ListExternalServiceConditionsReturnsOnCall(i int, result1 []*externalservice.Condition, result2 error) {
+	fake.listExternalServiceConditionsMutex.Lock()
+	defer fake.listExternalServiceConditionsMutex.Unlock()
+	fake.ListExternalServiceConditionsStub = nil
+	if fake.listExternalServiceConditionsReturnsOnCall == nil {
+		fake.listExternalServiceConditionsReturnsOnCall = make(map[int]struct {
+			result1 []*externalservice.Condition
+			result2 error
+		})
+	}
+	fake.listExternalServiceConditionsReturnsOnCall[i] = struct {
+		result1 []*externalservice.Condition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) ListInfrastructureConditions(arg1 int) ([]alerts.InfrastructureCondition, error) {
+	fake.listInfrastructureConditionsMutex.Lock()
+	ret, specificReturn := fake.listInfrastructureConditionsReturnsOnCall[len(fake.listInfrastructureConditionsArgsForCall)]
+	fake.listInfrastructureConditionsArgsForCall = append(fake.listInfrastructureConditionsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListInfrastructureConditions", []interface{}{arg1})
+	fake.listInfrastructureConditionsMutex.Unlock()
+	if fake.ListInfrastructureConditionsStub != nil {
+		return fake.ListInfrastructureConditionsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listInfrastructureConditionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) ListInfrastructureConditionsCallCount() int {
+	fake.listInfrastructureConditionsMutex.RLock()
+	defer fake.listInfrastructureConditionsMutex.RUnlock()
+	return len(fake.listInfrastructureConditionsArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) ListInfrastructureConditionsCalls(stub func(int) ([]alerts.InfrastructureCondition, error)) {
+	fake.listInfrastructureConditionsMutex.Lock()
+	defer fake.listInfrastructureConditionsMutex.Unlock()
+	fake.ListInfrastructureConditionsStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) ListInfrastructureConditionsArgsForCall(i int) int {
+	fake.listInfrastructureConditionsMutex.RLock()
+	defer fake.listInfrastructureConditionsMutex.RUnlock()
+	argsForCall := fake.listInfrastructureConditionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNewRelicAlertsClient) ListInfrastructureConditionsReturns(result1 []alerts.InfrastructureCondition, result2 error) {
+	fake.listInfrastructureConditionsMutex.Lock()
+	defer fake.listInfrastructureConditionsMutex.Unlock()
+	fake.ListInfrastructureConditionsStub = nil
+	fake.listInfrastructureConditionsReturns = struct {
+		result1 []alerts.InfrastructureCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) ListInfrastructureConditionsReturnsOnCall(i int, result1 []alerts.InfrastructureCondition, result2 error) {
+	fake.listInfrastructureConditionsMutex.Lock()
+	defer fake.listInfrastructureConditionsMutex.Unlock()
+	fake.ListInfrastructureConditionsStub = nil
+	if fake.listInfrastructureConditionsReturnsOnCall == nil {
+		fake.listInfrastructureConditionsReturnsOnCall = make(map[int]struct {
+			result1 []alerts.InfrastructureCondition
+			result2 error
+		})
+	}
+	fake.listInfrastructureConditionsReturnsOnCall[i] = struct {
+		result1 []alerts.InfrastructureCondition
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeNewRelicAlertsClient) ListConditions(arg1 int) ([]*alerts.Condition, error) {
-	fake.listConditionsMutex.Lock()
-	ret, specificReturn := fake.listConditionsReturnsOnCall[len(fake.listConditionsArgsForCall)]
-	fake.listConditionsArgsForCall = append(fake.listConditionsArgsForCall, struct {
+func (fake *FakeNewRelicAlertsClient) ListMultiLocationSyntheticsConditions(arg1 int) ([]*alerts.MultiLocationSyntheticsCondition, error) {
+	fake.listMultiLocationSyntheticsConditionsMutex.Lock()
+	ret, specificReturn := fake.listMultiLocationSyntheticsConditionsReturnsOnCall[len(fake.listMultiLocationSyntheticsConditionsArgsForCall)]
+	fake.listMultiLocationSyntheticsConditionsArgsForCall = append(fake.listMultiLocationSyntheticsConditionsArgsForCall, struct {
 		arg1 int
 	}{arg1})
-	fake.recordInvocation("ListConditions", []interface{}{arg1})
-	fake.listConditionsMutex.Unlock()
-	if fake.ListConditionsStub != nil {
-		return fake.ListConditionsStub(arg1)
+	fake.recordInvocation("ListMultiLocationSyntheticsConditions", []interface{}{arg1})
+	fake.listMultiLocationSyntheticsConditionsMutex.Unlock()
+	if fake.ListMultiLocationSyntheticsConditionsStub != nil {
+		return fake.ListMultiLocationSyntheticsConditionsStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listConditionsReturns
+	fakeReturns := fake.listMultiLocationSyntheticsConditionsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeNewRelicAlertsClient) ListConditionsCallCount() int {
-	fake.listConditionsMutex.RLock()
-	defer fake.listConditionsMutex.RUnlock()
-	return len(fake.listConditionsArgsForCall)
+func (fake *FakeNewRelicAlertsClient) ListMultiLocationSyntheticsConditionsCallCount() int {
+	fake.listMultiLocationSyntheticsConditionsMutex.RLock()
+	defer fake.listMultiLocationSyntheticsConditionsMutex.RUnlock()
+	return len(fake.listMultiLocationSyntheticsConditionsArgsForCall)
 }
 
-func (fake *FakeNewRelicAlertsClient) ListConditionsCalls(stub func(int) ([]*alerts.Condition, error)) {
-	fake.listConditionsMutex.Lock()
-	defer fake.listConditionsMutex.Unlock()
-	fake.ListConditionsStub = stub
+func (fake *FakeNewRelicAlertsClient) ListMultiLocationSyntheticsConditionsCalls(stub func(int) ([]*alerts.MultiLocationSyntheticsCondition, error)) {
+	fake.listMultiLocationSyntheticsConditionsMutex.Lock()
+	defer fake.listMultiLocationSyntheticsConditionsMutex.Unlock()
+	fake.ListMultiLocationSyntheticsConditionsStub = stub
 }
 
-func (fake *FakeNewRelicAlertsClient) ListConditionsArgsForCall(i int) int {
-	fake.listConditionsMutex.RLock()
-	defer fake.listConditionsMutex.RUnlock()
-	argsForCall := fake.listConditionsArgsForCall[i]
+func (fake *FakeNewRelicAlertsClient) ListMultiLocationSyntheticsConditionsArgsForCall(i int) int {
+	fake.listMultiLocationSyntheticsConditionsMutex.RLock()
+	defer fake.listMultiLocationSyntheticsConditionsMutex.RUnlock()
+	argsForCall := fake.listMultiLocationSyntheticsConditionsArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeNewRelicAlertsClient) ListConditionsReturns(result1 []*alerts.Condition, result2 error) {
-	fake.listConditionsMutex.Lock()
-	defer fake.listConditionsMutex.Unlock()
-	fake.ListConditionsStub = nil
-	fake.listConditionsReturns = struct {
-		result1 []*alerts.Condition
+func (fake *FakeNewRelicAlertsClient) ListMultiLocationSyntheticsConditionsReturns(result1 []*alerts.MultiLocationSyntheticsCondition, result2 error) {
+	fake.listMultiLocationSyntheticsConditionsMutex.Lock()
+	defer fake.listMultiLocationSyntheticsConditionsMutex.Unlock()
+	fake.ListMultiLocationSyntheticsConditionsStub = nil
+	fake.listMultiLocationSyntheticsConditionsReturns = struct {
+		result1 []*alerts.MultiLocationSyntheticsCondition
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeNewRelicAlertsClient) ListConditionsReturnsOnCall(i int, result1 []*alerts.Condition, result2 error) {
-	fake.listConditionsMutex.Lock()
-	defer fake.listConditionsMutex.Unlock()
-	fake.ListConditionsStub = nil
-	if fake.listConditionsReturnsOnCall == nil {
-		fake.listConditionsReturnsOnCall = make(map[int]struct {
-			result1 []*alerts.Condition
+func (fake *FakeNewRelicAlertsClient) ListMultiLocationSyntheticsConditionsReturnsOnCall(i int, result1 []*alerts.MultiLocationSyntheticsCondition, result2 error) {
+	fake.listMultiLocationSyntheticsConditionsMutex.Lock()
+	defer fake.listMultiLocationSyntheticsConditionsMutex.Unlock()
+	fake.ListMultiLocationSyntheticsConditionsStub = nil
+	if fake.listMultiLocationSyntheticsConditionsReturnsOnCall == nil {
+		fake.listMultiLocationSyntheticsConditionsReturnsOnCall = make(map[int]struct {
+			result1 []*alerts.MultiLocationSyntheticsCondition
 			result2 error
 		})
 	}
-	fake.listConditionsReturnsOnCall[i] = struct {
-		result1 []*alerts.Condition
+	fake.listMultiLocationSyntheticsConditionsReturnsOnCall[i] = struct {
+		result1 []*alerts.MultiLocationSyntheticsCondition
 		result2 error
 	}{result1, result2}
 }
@@ -1687,6 +3024,69 @@ func (fake *FakeNewRelicAlertsClient) ListPoliciesReturnsOnCall(i int, result1 [
 	}{result1, result2}
 }
 
+func (fake *FakeNewRelicAlertsClient) ListSyntheticsConditions(arg1 int) ([]*alerts.SyntheticsCondition, error) {
+	fake.listSyntheticsConditionsMutex.Lock()
+	ret, specificReturn := fake.listSyntheticsConditionsReturnsOnCall[len(fake.listSyntheticsConditionsArgsForCall)]
+	fake.listSyntheticsConditionsArgsForCall = append(fake.listSyntheticsConditionsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ListSyntheticsConditions", []interface{}{arg1})
+	fake.listSyntheticsConditionsMutex.Unlock()
+	if fake.ListSyntheticsConditionsStub != nil {
+		return fake.ListSyntheticsConditionsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listSyntheticsConditionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) ListSyntheticsConditionsCallCount() int {
+	fake.listSyntheticsConditionsMutex.RLock()
+	defer fake.listSyntheticsConditionsMutex.RUnlock()
+	return len(fake.listSyntheticsConditionsArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) ListSyntheticsConditionsCalls(stub func(int) ([]*alerts.SyntheticsCondition, error)) {
+	fake.listSyntheticsConditionsMutex.Lock()
+	defer fake.listSyntheticsConditionsMutex.Unlock()
+	fake.ListSyntheticsConditionsStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) ListSyntheticsConditionsArgsForCall(i int) int {
+	fake.listSyntheticsConditionsMutex.RLock()
+	defer fake.listSyntheticsConditionsMutex.RUnlock()
+	argsForCall := fake.listSyntheticsConditionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNewRelicAlertsClient) ListSyntheticsConditionsReturns(result1 []*alerts.SyntheticsCondition, result2 error) {
+	fake.listSyntheticsConditionsMutex.Lock()
+	defer fake.listSyntheticsConditionsMutex.Unlock()
+	fake.ListSyntheticsConditionsStub = nil
+	fake.listSyntheticsConditionsReturns = struct {
+		result1 []*alerts.SyntheticsCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) ListSyntheticsConditionsReturnsOnCall(i int, result1 []*alerts.SyntheticsCondition, result2 error) {
+	fake.listSyntheticsConditionsMutex.Lock()
+	defer fake.listSyntheticsConditionsMutex.Unlock()
+	fake.ListSyntheticsConditionsStub = nil
+	if fake.listSyntheticsConditionsReturnsOnCall == nil {
+		fake.listSyntheticsConditionsReturnsOnCall = make(map[int]struct {
+			result1 []*alerts.SyntheticsCondition
+			result2 error
+		})
+	}
+	fake.listSyntheticsConditionsReturnsOnCall[i] = struct {
+		result1 []*alerts.SyntheticsCondition
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeNewRelicAlertsClient) QueryPolicy(arg1 int, arg2 string) (*alerts.AlertsPolicy, error) {
 	fake.queryPolicyMutex.Lock()
 	ret, specificReturn := fake.queryPolicyReturnsOnCall[len(fake.queryPolicyArgsForCall)]
@@ -1942,6 +3342,260 @@ func (fake *FakeNewRelicAlertsClient) UpdateConditionReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
+func (fake *FakeNewRelicAlertsClient) UpdateExternalServiceCondition(arg1 externalservice.Condition) (*externalservice.Condition, error) {
+	fake.updateExternalServiceConditionMutex.Lock()
+	ret, specificReturn := fake.updateExternalServiceConditionReturnsOnCall[len(fake.updateExternalServiceConditionArgsForCall)]
+	fake.updateExternalServiceConditionArgsForCall = append(fake.updateExternalServiceConditionArgsForCall, struct {
+		arg1 externalservice.Condition
+	}{arg1})
+	fake.recordInvocation("UpdateExternalServiceCondition", []interface{}{arg1})
+	fake.updateExternalServiceConditionMutex.Unlock()
+	if fake.UpdateExternalServiceConditionStub != nil {
+		return fake.UpdateExternalServiceConditionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateExternalServiceConditionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateExternalServiceConditionCallCount() int {
+	fake.updateExternalServiceConditionMutex.RLock()
+	defer fake.updateExternalServiceConditionMutex.RUnlock()
+	return len(fake.updateExternalServiceConditionArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateExternalServiceConditionCalls(stub func(externalservice.Condition) (*externalservice.Condition, error)) {
+	fake.updateExternalServiceConditionMutex.Lock()
+	defer fake.updateExternalServiceConditionMutex.Unlock()
+	fake.UpdateExternalServiceConditionStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateExternalServiceConditionArgsForCall(i int) externalservice.Condition {
+	fake.updateExternalServiceConditionMutex.RLock()
+	defer fake.updateExternalServiceConditionMutex.RUnlock()
+	argsForCall := fake.updateExternalServiceConditionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateExternalServiceConditionReturns(result1 *externalservice.Condition, result2 error) {
+	fake.updateExternalServiceConditionMutex.Lock()
+	defer fake.updateExternalServiceConditionMutex.Unlock()
+	fake.UpdateExternalServiceConditionStub = nil
+	fake.updateExternalServiceConditionReturns = struct {
+		result1 *externalservice.Condition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateExternalServiceConditionReturnsOnCall(i int, result1 *externalservice.Condition, result2 error) {
+	fake.updateExternalServiceConditionMutex.Lock()
+	defer fake.updateExternalServiceConditionMutex.Unlock()
+	fake.UpdateExternalServiceConditionStub = nil
+	if fake.updateExternalServiceConditionReturnsOnCall == nil {
+		fake.updateExternalServiceConditionReturnsOnCall = make(map[int]struct {
+			result1 *externalservice.Condition
+			result2 error
+		})
+	}
+	fake.updateExternalServiceConditionReturnsOnCall[i] = struct {
+		result1 *externalservice.Condition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateInfrastructureCondition(arg1 alerts.InfrastructureCondition) (*alerts.InfrastructureCondition, error) {
+	fake.updateInfrastructureConditionMutex.Lock()
+	ret, specificReturn := fake.updateInfrastructureConditionReturnsOnCall[len(fake.updateInfrastructureConditionArgsForCall)]
+	fake.updateInfrastructureConditionArgsForCall = append(fake.updateInfrastructureConditionArgsForCall, struct {
+		arg1 alerts.InfrastructureCondition
+	}{arg1})
+	fake.recordInvocation("UpdateInfrastructureCondition", []interface{}{arg1})
+	fake.updateInfrastructureConditionMutex.Unlock()
+	if fake.UpdateInfrastructureConditionStub != nil {
+		return fake.UpdateInfrastructureConditionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateInfrastructureConditionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateInfrastructureConditionCallCount() int {
+	fake.updateInfrastructureConditionMutex.RLock()
+	defer fake.updateInfrastructureConditionMutex.RUnlock()
+	return len(fake.updateInfrastructureConditionArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateInfrastructureConditionCalls(stub func(alerts.InfrastructureCondition) (*alerts.InfrastructureCondition, error)) {
+	fake.updateInfrastructureConditionMutex.Lock()
+	defer fake.updateInfrastructureConditionMutex.Unlock()
+	fake.UpdateInfrastructureConditionStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateInfrastructureConditionArgsForCall(i int) alerts.InfrastructureCondition {
+	fake.updateInfrastructureConditionMutex.RLock()
+	defer fake.updateInfrastructureConditionMutex.RUnlock()
+	argsForCall := fake.updateInfrastructureConditionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateInfrastructureConditionReturns(result1 *alerts.InfrastructureCondition, result2 error) {
+	fake.updateInfrastructureConditionMutex.Lock()
+	defer fake.updateInfrastructureConditionMutex.Unlock()
+	fake.UpdateInfrastructureConditionStub = nil
+	fake.updateInfrastructureConditionReturns = struct {
+		result1 *alerts.InfrastructureCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateInfrastructureConditionReturnsOnCall(i int, result1 *alerts.InfrastructureCondition, result2 error) {
+	fake.updateInfrastructureConditionMutex.Lock()
+	defer fake.updateInfrastructureConditionMutex.Unlock()
+	fake.UpdateInfrastructureConditionStub = nil
+	if fake.updateInfrastructureConditionReturnsOnCall == nil {
+		fake.updateInfrastructureConditionReturnsOnCall = make(map[int]struct {
+			result1 *alerts.InfrastructureCondition
+			result2 error
+		})
+	}
+	fake.updateInfrastructureConditionReturnsOnCall[i] = struct {
+		result1 *alerts.InfrastructureCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateMultiLocationSyntheticsCondition(arg1 alerts.MultiLocationSyntheticsCondition) (*alerts.MultiLocationSyntheticsCondition, error) {
+	fake.updateMultiLocationSyntheticsConditionMutex.Lock()
+	ret, specificReturn := fake.updateMultiLocationSyntheticsConditionReturnsOnCall[len(fake.updateMultiLocationSyntheticsConditionArgsForCall)]
+	fake.updateMultiLocationSyntheticsConditionArgsForCall = append(fake.updateMultiLocationSyntheticsConditionArgsForCall, struct {
+		arg1 alerts.MultiLocationSyntheticsCondition
+	}{arg1})
+	fake.recordInvocation("UpdateMultiLocationSyntheticsCondition", []interface{}{arg1})
+	fake.updateMultiLocationSyntheticsConditionMutex.Unlock()
+	if fake.UpdateMultiLocationSyntheticsConditionStub != nil {
+		return fake.UpdateMultiLocationSyntheticsConditionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateMultiLocationSyntheticsConditionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateMultiLocationSyntheticsConditionCallCount() int {
+	fake.updateMultiLocationSyntheticsConditionMutex.RLock()
+	defer fake.updateMultiLocationSyntheticsConditionMutex.RUnlock()
+	return len(fake.updateMultiLocationSyntheticsConditionArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateMultiLocationSyntheticsConditionCalls(stub func(alerts.MultiLocationSyntheticsCondition) (*alerts.MultiLocationSyntheticsCondition, error)) {
+	fake.updateMultiLocationSyntheticsConditionMutex.Lock()
+	defer fake.updateMultiLocationSyntheticsConditionMutex.Unlock()
+	fake.UpdateMultiLocationSyntheticsConditionStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateMultiLocationSyntheticsConditionArgsForCall(i int) alerts.MultiLocationSyntheticsCondition {
+	fake.updateMultiLocationSyntheticsConditionMutex.RLock()
+	defer fake.updateMultiLocationSyntheticsConditionMutex.RUnlock()
+	argsForCall := fake.updateMultiLocationSyntheticsConditionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateMultiLocationSyntheticsConditionReturns(result1 *alerts.MultiLocationSyntheticsCondition, result2 error) {
+	fake.updateMultiLocationSyntheticsConditionMutex.Lock()
+	defer fake.updateMultiLocationSyntheticsConditionMutex.Unlock()
+	fake.UpdateMultiLocationSyntheticsConditionStub = nil
+	fake.updateMultiLocationSyntheticsConditionReturns = struct {
+		result1 *alerts.MultiLocationSyntheticsCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateMultiLocationSyntheticsConditionReturnsOnCall(i int, result1 *alerts.MultiLocationSyntheticsCondition, result2 error) {
+	fake.updateMultiLocationSyntheticsConditionMutex.Lock()
+	defer fake.updateMultiLocationSyntheticsConditionMutex.Unlock()
+	fake.UpdateMultiLocationSyntheticsConditionStub = nil
+	if fake.updateMultiLocationSyntheticsConditionReturnsOnCall == nil {
+		fake.updateMultiLocationSyntheticsConditionReturnsOnCall = make(map[int]struct {
+			result1 *alerts.MultiLocationSyntheticsCondition
+			result2 error
+		})
+	}
+	fake.updateMultiLocationSyntheticsConditionReturnsOnCall[i] = struct {
+		result1 *alerts.MultiLocationSyntheticsCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateMutingRule(arg1 int, arg2 int, arg3 alerts.MutingRuleUpdateInput) (*alerts.MutingRule, error) {
+	fake.updateMutingRuleMutex.Lock()
+	ret, specificReturn := fake.updateMutingRuleReturnsOnCall[len(fake.updateMutingRuleArgsForCall)]
+	fake.updateMutingRuleArgsForCall = append(fake.updateMutingRuleArgsForCall, struct {
+		arg1 int
+		arg2 int
+		arg3 alerts.MutingRuleUpdateInput
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateMutingRule", []interface{}{arg1, arg2, arg3})
+	fake.updateMutingRuleMutex.Unlock()
+	if fake.UpdateMutingRuleStub != nil {
+		return fake.UpdateMutingRuleStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateMutingRuleReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateMutingRuleCallCount() int {
+	fake.updateMutingRuleMutex.RLock()
+	defer fake.updateMutingRuleMutex.RUnlock()
+	return len(fake.updateMutingRuleArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateMutingRuleCalls(stub func(int, int, alerts.MutingRuleUpdateInput) (*alerts.MutingRule, error)) {
+	fake.updateMutingRuleMutex.Lock()
+	defer fake.updateMutingRuleMutex.Unlock()
+	fake.UpdateMutingRuleStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateMutingRuleArgsForCall(i int) (int, int, alerts.MutingRuleUpdateInput) {
+	fake.updateMutingRuleMutex.RLock()
+	defer fake.updateMutingRuleMutex.RUnlock()
+	argsForCall := fake.updateMutingRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateMutingRuleReturns(result1 *alerts.MutingRule, result2 error) {
+	fake.updateMutingRuleMutex.Lock()
+	defer fake.updateMutingRuleMutex.Unlock()
+	fake.UpdateMutingRuleStub = nil
+	fake.updateMutingRuleReturns = struct {
+		result1 *alerts.MutingRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateMutingRuleReturnsOnCall(i int, result1 *alerts.MutingRule, result2 error) {
+	fake.updateMutingRuleMutex.Lock()
+	defer fake.updateMutingRuleMutex.Unlock()
+	fake.UpdateMutingRuleStub = nil
+	if fake.updateMutingRuleReturnsOnCall == nil {
+		fake.updateMutingRuleReturnsOnCall = make(map[int]struct {
+			result1 *alerts.MutingRule
+			result2 error
+		})
+	}
+	fake.updateMutingRuleReturnsOnCall[i] = struct {
+		result1 *alerts.MutingRule
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeNewRelicAlertsClient) UpdateNrqlCondition(arg1 alerts.NrqlCondition) (*alerts.NrqlCondition, error) {
 	fake.updateNrqlConditionMutex.Lock()
 	ret, specificReturn := fake.updateNrqlConditionReturnsOnCall[len(fake.updateNrqlConditionArgsForCall)]
@@ -2070,6 +3724,137 @@ func (fake *FakeNewRelicAlertsClient) UpdateNrqlConditionBaselineMutationReturns
 	}{result1, result2}
 }
 
+func (fake *FakeNewRelicAlertsClient) UpdateNrqlConditionOutlierMutation(arg1 int, arg2 string, arg3 alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+	fake.updateNrqlConditionOutlierMutationMutex.Lock()
+	ret, specificReturn := fake.updateNrqlConditionOutlierMutationReturnsOnCall[len(fake.updateNrqlConditionOutlierMutationArgsForCall)]
+	fake.updateNrqlConditionOutlierMutationArgsForCall = append(fake.updateNrqlConditionOutlierMutationArgsForCall, struct {
+		arg1 int
+		arg2 string
+		arg3 alerts.NrqlConditionInput
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateNrqlConditionOutlierMutation", []interface{}{arg1, arg2, arg3})
+	fake.updateNrqlConditionOutlierMutationMutex.Unlock()
+	if fake.UpdateNrqlConditionOutlierMutationStub != nil {
+		return fake.UpdateNrqlConditionOutlierMutationStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateNrqlConditionOutlierMutationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateNrqlConditionOutlierMutationCallCount() int {
+	fake.updateNrqlConditionOutlierMutationMutex.RLock()
+	defer fake.updateNrqlConditionOutlierMutationMutex.RUnlock()
+	return len(fake.updateNrqlConditionOutlierMutationArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateNrqlConditionOutlierMutationCalls(stub func(int, string, alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error)) {
+	fake.updateNrqlConditionOutlierMutationMutex.Lock()
+	defer fake.updateNrqlConditionOutlierMutationMutex.Unlock()
+	fake.UpdateNrqlConditionOutlierMutationStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateNrqlConditionOutlierMutationArgsForCall(i int) (int, string, alerts.NrqlConditionInput) {
+	fake.updateNrqlConditionOutlierMutationMutex.RLock()
+	defer fake.updateNrqlConditionOutlierMutationMutex.RUnlock()
+	argsForCall := fake.updateNrqlConditionOutlierMutationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateNrqlConditionOutlierMutationReturns(result1 *alerts.NrqlAlertCondition, result2 error) {
+	fake.updateNrqlConditionOutlierMutationMutex.Lock()
+	defer fake.updateNrqlConditionOutlierMutationMutex.Unlock()
+	fake.UpdateNrqlConditionOutlierMutationStub = nil
+	fake.updateNrqlConditionOutlierMutationReturns = struct {
+		result1 *alerts.NrqlAlertCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateNrqlConditionOutlierMutationReturnsOnCall(i int, result1 *alerts.NrqlAlertCondition, result2 error) {
+	fake.updateNrqlConditionOutlierMutationMutex.Lock()
+	defer fake.updateNrqlConditionOutlierMutationMutex.Unlock()
+	fake.UpdateNrqlConditionOutlierMutationStub = nil
+	if fake.updateNrqlConditionOutlierMutationReturnsOnCall == nil {
+		fake.updateNrqlConditionOutlierMutationReturnsOnCall = make(map[int]struct {
+			result1 *alerts.NrqlAlertCondition
+			result2 error
+		})
+	}
+	fake.updateNrqlConditionOutlierMutationReturnsOnCall[i] = struct {
+		result1 *alerts.NrqlAlertCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateNrqlConditionSignal(arg1 int, arg2 alerts.NrqlConditionType, arg3 string, arg4 nrqlsignal.Signal) (*nrqlsignal.Signal, error) {
+	fake.updateNrqlConditionSignalMutex.Lock()
+	ret, specificReturn := fake.updateNrqlConditionSignalReturnsOnCall[len(fake.updateNrqlConditionSignalArgsForCall)]
+	fake.updateNrqlConditionSignalArgsForCall = append(fake.updateNrqlConditionSignalArgsForCall, struct {
+		arg1 int
+		arg2 alerts.NrqlConditionType
+		arg3 string
+		arg4 nrqlsignal.Signal
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateNrqlConditionSignal", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateNrqlConditionSignalMutex.Unlock()
+	if fake.UpdateNrqlConditionSignalStub != nil {
+		return fake.UpdateNrqlConditionSignalStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateNrqlConditionSignalReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateNrqlConditionSignalCallCount() int {
+	fake.updateNrqlConditionSignalMutex.RLock()
+	defer fake.updateNrqlConditionSignalMutex.RUnlock()
+	return len(fake.updateNrqlConditionSignalArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateNrqlConditionSignalCalls(stub func(int, alerts.NrqlConditionType, string, nrqlsignal.Signal) (*nrqlsignal.Signal, error)) {
+	fake.updateNrqlConditionSignalMutex.Lock()
+	defer fake.updateNrqlConditionSignalMutex.Unlock()
+	fake.UpdateNrqlConditionSignalStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateNrqlConditionSignalArgsForCall(i int) (int, alerts.NrqlConditionType, string, nrqlsignal.Signal) {
+	fake.updateNrqlConditionSignalMutex.RLock()
+	defer fake.updateNrqlConditionSignalMutex.RUnlock()
+	argsForCall := fake.updateNrqlConditionSignalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateNrqlConditionSignalReturns(result1 *nrqlsignal.Signal, result2 error) {
+	fake.updateNrqlConditionSignalMutex.Lock()
+	defer fake.updateNrqlConditionSignalMutex.Unlock()
+	fake.UpdateNrqlConditionSignalStub = nil
+	fake.updateNrqlConditionSignalReturns = struct {
+		result1 *nrqlsignal.Signal
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateNrqlConditionSignalReturnsOnCall(i int, result1 *nrqlsignal.Signal, result2 error) {
+	fake.updateNrqlConditionSignalMutex.Lock()
+	defer fake.updateNrqlConditionSignalMutex.Unlock()
+	fake.UpdateNrqlConditionSignalStub = nil
+	if fake.updateNrqlConditionSignalReturnsOnCall == nil {
+		fake.updateNrqlConditionSignalReturnsOnCall = make(map[int]struct {
+			result1 *nrqlsignal.Signal
+			result2 error
+		})
+	}
+	fake.updateNrqlConditionSignalReturnsOnCall[i] = struct {
+		result1 *nrqlsignal.Signal
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeNewRelicAlertsClient) UpdateNrqlConditionStaticMutation(arg1 int, arg2 string, arg3 alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
 	fake.updateNrqlConditionStaticMutationMutex.Lock()
 	ret, specificReturn := fake.updateNrqlConditionStaticMutationReturnsOnCall[len(fake.updateNrqlConditionStaticMutationArgsForCall)]
@@ -2332,6 +4117,69 @@ func (fake *FakeNewRelicAlertsClient) UpdatePolicyMutationReturnsOnCall(i int, r
 	}{result1, result2}
 }
 
+func (fake *FakeNewRelicAlertsClient) UpdateSyntheticsCondition(arg1 alerts.SyntheticsCondition) (*alerts.SyntheticsCondition, error) {
+	fake.updateSyntheticsConditionMutex.Lock()
+	ret, specificReturn := fake.updateSyntheticsConditionReturnsOnCall[len(fake.updateSyntheticsConditionArgsForCall)]
+	fake.updateSyntheticsConditionArgsForCall = append(fake.updateSyntheticsConditionArgsForCall, struct {
+		arg1 alerts.SyntheticsCondition
+	}{arg1})
+	fake.recordInvocation("UpdateSyntheticsCondition", []interface{}{arg1})
+	fake.updateSyntheticsConditionMutex.Unlock()
+	if fake.UpdateSyntheticsConditionStub != nil {
+		return fake.UpdateSyntheticsConditionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateSyntheticsConditionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateSyntheticsConditionCallCount() int {
+	fake.updateSyntheticsConditionMutex.RLock()
+	defer fake.updateSyntheticsConditionMutex.RUnlock()
+	return len(fake.updateSyntheticsConditionArgsForCall)
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateSyntheticsConditionCalls(stub func(alerts.SyntheticsCondition) (*alerts.SyntheticsCondition, error)) {
+	fake.updateSyntheticsConditionMutex.Lock()
+	defer fake.updateSyntheticsConditionMutex.Unlock()
+	fake.UpdateSyntheticsConditionStub = stub
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateSyntheticsConditionArgsForCall(i int) alerts.SyntheticsCondition {
+	fake.updateSyntheticsConditionMutex.RLock()
+	defer fake.updateSyntheticsConditionMutex.RUnlock()
+	argsForCall := fake.updateSyntheticsConditionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateSyntheticsConditionReturns(result1 *alerts.SyntheticsCondition, result2 error) {
+	fake.updateSyntheticsConditionMutex.Lock()
+	defer fake.updateSyntheticsConditionMutex.Unlock()
+	fake.UpdateSyntheticsConditionStub = nil
+	fake.updateSyntheticsConditionReturns = struct {
+		result1 *alerts.SyntheticsCondition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNewRelicAlertsClient) UpdateSyntheticsConditionReturnsOnCall(i int, result1 *alerts.SyntheticsCondition, result2 error) {
+	fake.updateSyntheticsConditionMutex.Lock()
+	defer fake.updateSyntheticsConditionMutex.Unlock()
+	fake.UpdateSyntheticsConditionStub = nil
+	if fake.updateSyntheticsConditionReturnsOnCall == nil {
+		fake.updateSyntheticsConditionReturnsOnCall = make(map[int]struct {
+			result1 *alerts.SyntheticsCondition
+			result2 error
+		})
+	}
+	fake.updateSyntheticsConditionReturnsOnCall[i] = struct {
+		result1 *alerts.SyntheticsCondition
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeNewRelicAlertsClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -2339,22 +4187,42 @@ func (fake *FakeNewRelicAlertsClient) Invocations() map[string][][]interface{} {
 	defer fake.createChannelMutex.RUnlock()
 	fake.createConditionMutex.RLock()
 	defer fake.createConditionMutex.RUnlock()
+	fake.createExternalServiceConditionMutex.RLock()
+	defer fake.createExternalServiceConditionMutex.RUnlock()
+	fake.createInfrastructureConditionMutex.RLock()
+	defer fake.createInfrastructureConditionMutex.RUnlock()
+	fake.createMultiLocationSyntheticsConditionMutex.RLock()
+	defer fake.createMultiLocationSyntheticsConditionMutex.RUnlock()
+	fake.createMutingRuleMutex.RLock()
+	defer fake.createMutingRuleMutex.RUnlock()
 	fake.createNrqlConditionMutex.RLock()
 	defer fake.createNrqlConditionMutex.RUnlock()
 	fake.createNrqlConditionBaselineMutationMutex.RLock()
 	defer fake.createNrqlConditionBaselineMutationMutex.RUnlock()
+	fake.createNrqlConditionOutlierMutationMutex.RLock()
+	defer fake.createNrqlConditionOutlierMutationMutex.RUnlock()
 	fake.createNrqlConditionStaticMutationMutex.RLock()
 	defer fake.createNrqlConditionStaticMutationMutex.RUnlock()
 	fake.createPolicyMutex.RLock()
 	defer fake.createPolicyMutex.RUnlock()
 	fake.createPolicyMutationMutex.RLock()
 	defer fake.createPolicyMutationMutex.RUnlock()
+	fake.createSyntheticsConditionMutex.RLock()
+	defer fake.createSyntheticsConditionMutex.RUnlock()
 	fake.deleteChannelMutex.RLock()
 	defer fake.deleteChannelMutex.RUnlock()
 	fake.deleteConditionMutex.RLock()
 	defer fake.deleteConditionMutex.RUnlock()
 	fake.deleteConditionMutationMutex.RLock()
 	defer fake.deleteConditionMutationMutex.RUnlock()
+	fake.deleteExternalServiceConditionMutex.RLock()
+	defer fake.deleteExternalServiceConditionMutex.RUnlock()
+	fake.deleteInfrastructureConditionMutex.RLock()
+	defer fake.deleteInfrastructureConditionMutex.RUnlock()
+	fake.deleteMultiLocationSyntheticsConditionMutex.RLock()
+	defer fake.deleteMultiLocationSyntheticsConditionMutex.RUnlock()
+	fake.deleteMutingRuleMutex.RLock()
+	defer fake.deleteMutingRuleMutex.RUnlock()
 	fake.deleteNrqlConditionMutex.RLock()
 	defer fake.deleteNrqlConditionMutex.RUnlock()
 	fake.deletePolicyMutex.RLock()
@@ -2363,18 +4231,32 @@ func (fake *FakeNewRelicAlertsClient) Invocations() map[string][][]interface{} {
 	defer fake.deletePolicyChannelMutex.RUnlock()
 	fake.deletePolicyMutationMutex.RLock()
 	defer fake.deletePolicyMutationMutex.RUnlock()
+	fake.deleteSyntheticsConditionMutex.RLock()
+	defer fake.deleteSyntheticsConditionMutex.RUnlock()
+	fake.getMutingRuleMutex.RLock()
+	defer fake.getMutingRuleMutex.RUnlock()
 	fake.getNrqlConditionQueryMutex.RLock()
 	defer fake.getNrqlConditionQueryMutex.RUnlock()
+	fake.getNrqlConditionSignalMutex.RLock()
+	defer fake.getNrqlConditionSignalMutex.RUnlock()
 	fake.getPolicyMutex.RLock()
 	defer fake.getPolicyMutex.RUnlock()
 	fake.listChannelsMutex.RLock()
 	defer fake.listChannelsMutex.RUnlock()
 	fake.listConditionsMutex.RLock()
 	defer fake.listConditionsMutex.RUnlock()
+	fake.listExternalServiceConditionsMutex.RLock()
+	defer fake.listExternalServiceConditionsMutex.RUnlock()
+	fake.listInfrastructureConditionsMutex.RLock()
+	defer fake.listInfrastructureConditionsMutex.RUnlock()
+	fake.listMultiLocationSyntheticsConditionsMutex.RLock()
+	defer fake.listMultiLocationSyntheticsConditionsMutex.RUnlock()
 	fake.listNrqlConditionsMutex.RLock()
 	defer fake.listNrqlConditionsMutex.RUnlock()
 	fake.listPoliciesMutex.RLock()
 	defer fake.listPoliciesMutex.RUnlock()
+	fake.listSyntheticsConditionsMutex.RLock()
+	defer fake.listSyntheticsConditionsMutex.RUnlock()
 	fake.queryPolicyMutex.RLock()
 	defer fake.queryPolicyMutex.RUnlock()
 	fake.queryPolicySearchMutex.RLock()
@@ -2383,10 +4265,22 @@ func (fake *FakeNewRelicAlertsClient) Invocations() map[string][][]interface{} {
 	defer fake.searchNrqlConditionsQueryMutex.RUnlock()
 	fake.updateConditionMutex.RLock()
 	defer fake.updateConditionMutex.RUnlock()
+	fake.updateExternalServiceConditionMutex.RLock()
+	defer fake.updateExternalServiceConditionMutex.RUnlock()
+	fake.updateInfrastructureConditionMutex.RLock()
+	defer fake.updateInfrastructureConditionMutex.RUnlock()
+	fake.updateMultiLocationSyntheticsConditionMutex.RLock()
+	defer fake.updateMultiLocationSyntheticsConditionMutex.RUnlock()
+	fake.updateMutingRuleMutex.RLock()
+	defer fake.updateMutingRuleMutex.RUnlock()
 	fake.updateNrqlConditionMutex.RLock()
 	defer fake.updateNrqlConditionMutex.RUnlock()
 	fake.updateNrqlConditionBaselineMutationMutex.RLock()
 	defer fake.updateNrqlConditionBaselineMutationMutex.RUnlock()
+	fake.updateNrqlConditionOutlierMutationMutex.RLock()
+	defer fake.updateNrqlConditionOutlierMutationMutex.RUnlock()
+	fake.updateNrqlConditionSignalMutex.RLock()
+	defer fake.updateNrqlConditionSignalMutex.RUnlock()
 	fake.updateNrqlConditionStaticMutationMutex.RLock()
 	defer fake.updateNrqlConditionStaticMutationMutex.RUnlock()
 	fake.updatePolicyMutex.RLock()
@@ -2395,6 +4289,8 @@ func (fake *FakeNewRelicAlertsClient) Invocations() map[string][][]interface{} {
 	defer fake.updatePolicyChannelsMutex.RUnlock()
 	fake.updatePolicyMutationMutex.RLock()
 	defer fake.updatePolicyMutationMutex.RUnlock()
+	fake.updateSyntheticsConditionMutex.RLock()
+	defer fake.updateSyntheticsConditionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,713 @@
+package interfaces
+
+import (
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/pkg/apm"
+	"github.com/newrelic/newrelic-client-go/pkg/dashboards"
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/externalservice"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/metrics"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/notifications"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/nrqlsignal"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/servicelevels"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/syntheticsmonitors"
+)
+
+// The clients returned by the Initialize functions record every call of the New Relic API in the
+// API call metrics, labeled with the client and the name of the method.
+
+// metricsAlertsClient records the calls of the alerts client
+type metricsAlertsClient struct {
+	client NewRelicAlertsClient
+}
+
+func (c *metricsAlertsClient) CreateNrqlCondition(policyID int, condition alerts.NrqlCondition) (*alerts.NrqlCondition, error) {
+	observe := metrics.StartAPICall("alerts", "CreateNrqlCondition")
+	result, err := c.client.CreateNrqlCondition(policyID, condition)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) UpdateNrqlCondition(condition alerts.NrqlCondition) (*alerts.NrqlCondition, error) {
+	observe := metrics.StartAPICall("alerts", "UpdateNrqlCondition")
+	result, err := c.client.UpdateNrqlCondition(condition)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) ListNrqlConditions(policyID int) ([]*alerts.NrqlCondition, error) {
+	observe := metrics.StartAPICall("alerts", "ListNrqlConditions")
+	result, err := c.client.ListNrqlConditions(policyID)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) DeleteNrqlCondition(conditionID int) (*alerts.NrqlCondition, error) {
+	observe := metrics.StartAPICall("alerts", "DeleteNrqlCondition")
+	result, err := c.client.DeleteNrqlCondition(conditionID)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) ListConditions(policyID int) ([]*alerts.Condition, error) {
+	observe := metrics.StartAPICall("alerts", "ListConditions")
+	result, err := c.client.ListConditions(policyID)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) CreateCondition(policyID int, condition alerts.Condition) (*alerts.Condition, error) {
+	observe := metrics.StartAPICall("alerts", "CreateCondition")
+	result, err := c.client.CreateCondition(policyID, condition)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) UpdateCondition(condition alerts.Condition) (*alerts.Condition, error) {
+	observe := metrics.StartAPICall("alerts", "UpdateCondition")
+	result, err := c.client.UpdateCondition(condition)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) DeleteCondition(id int) (*alerts.Condition, error) {
+	observe := metrics.StartAPICall("alerts", "DeleteCondition")
+	result, err := c.client.DeleteCondition(id)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) GetPolicy(id int) (*alerts.Policy, error) {
+	observe := metrics.StartAPICall("alerts", "GetPolicy")
+	result, err := c.client.GetPolicy(id)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) CreatePolicy(policy alerts.Policy) (*alerts.Policy, error) {
+	observe := metrics.StartAPICall("alerts", "CreatePolicy")
+	result, err := c.client.CreatePolicy(policy)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) UpdatePolicy(policy alerts.Policy) (*alerts.Policy, error) {
+	observe := metrics.StartAPICall("alerts", "UpdatePolicy")
+	result, err := c.client.UpdatePolicy(policy)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) DeletePolicy(id int) (*alerts.Policy, error) {
+	observe := metrics.StartAPICall("alerts", "DeletePolicy")
+	result, err := c.client.DeletePolicy(id)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) ListPolicies(params *alerts.ListPoliciesParams) ([]alerts.Policy, error) {
+	observe := metrics.StartAPICall("alerts", "ListPolicies")
+	result, err := c.client.ListPolicies(params)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) CreateChannel(channel alerts.Channel) (*alerts.Channel, error) {
+	observe := metrics.StartAPICall("alerts", "CreateChannel")
+	result, err := c.client.CreateChannel(channel)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) DeleteChannel(id int) (*alerts.Channel, error) {
+	observe := metrics.StartAPICall("alerts", "DeleteChannel")
+	result, err := c.client.DeleteChannel(id)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) ListChannels() ([]*alerts.Channel, error) {
+	observe := metrics.StartAPICall("alerts", "ListChannels")
+	result, err := c.client.ListChannels()
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) UpdatePolicyChannels(policyID int, channelIDs []int) (*alerts.PolicyChannels, error) {
+	observe := metrics.StartAPICall("alerts", "UpdatePolicyChannels")
+	result, err := c.client.UpdatePolicyChannels(policyID, channelIDs)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) DeletePolicyChannel(policyID int, channelID int) (*alerts.Channel, error) {
+	observe := metrics.StartAPICall("alerts", "DeletePolicyChannel")
+	result, err := c.client.DeletePolicyChannel(policyID, channelID)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) CreateSyntheticsCondition(policyID int, condition alerts.SyntheticsCondition) (*alerts.SyntheticsCondition, error) {
+	observe := metrics.StartAPICall("alerts", "CreateSyntheticsCondition")
+	result, err := c.client.CreateSyntheticsCondition(policyID, condition)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) UpdateSyntheticsCondition(condition alerts.SyntheticsCondition) (*alerts.SyntheticsCondition, error) {
+	observe := metrics.StartAPICall("alerts", "UpdateSyntheticsCondition")
+	result, err := c.client.UpdateSyntheticsCondition(condition)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) DeleteSyntheticsCondition(conditionID int) (*alerts.SyntheticsCondition, error) {
+	observe := metrics.StartAPICall("alerts", "DeleteSyntheticsCondition")
+	result, err := c.client.DeleteSyntheticsCondition(conditionID)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) ListSyntheticsConditions(policyID int) ([]*alerts.SyntheticsCondition, error) {
+	observe := metrics.StartAPICall("alerts", "ListSyntheticsConditions")
+	result, err := c.client.ListSyntheticsConditions(policyID)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) CreateMultiLocationSyntheticsCondition(condition alerts.MultiLocationSyntheticsCondition, policyID int) (*alerts.MultiLocationSyntheticsCondition, error) {
+	observe := metrics.StartAPICall("alerts", "CreateMultiLocationSyntheticsCondition")
+	result, err := c.client.CreateMultiLocationSyntheticsCondition(condition, policyID)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) UpdateMultiLocationSyntheticsCondition(condition alerts.MultiLocationSyntheticsCondition) (*alerts.MultiLocationSyntheticsCondition, error) {
+	observe := metrics.StartAPICall("alerts", "UpdateMultiLocationSyntheticsCondition")
+	result, err := c.client.UpdateMultiLocationSyntheticsCondition(condition)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) DeleteMultiLocationSyntheticsCondition(conditionID int) (*alerts.MultiLocationSyntheticsCondition, error) {
+	observe := metrics.StartAPICall("alerts", "DeleteMultiLocationSyntheticsCondition")
+	result, err := c.client.DeleteMultiLocationSyntheticsCondition(conditionID)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) ListMultiLocationSyntheticsConditions(policyID int) ([]*alerts.MultiLocationSyntheticsCondition, error) {
+	observe := metrics.StartAPICall("alerts", "ListMultiLocationSyntheticsConditions")
+	result, err := c.client.ListMultiLocationSyntheticsConditions(policyID)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) ListInfrastructureConditions(policyID int) ([]alerts.InfrastructureCondition, error) {
+	observe := metrics.StartAPICall("alerts", "ListInfrastructureConditions")
+	result, err := c.client.ListInfrastructureConditions(policyID)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) CreateInfrastructureCondition(condition alerts.InfrastructureCondition) (*alerts.InfrastructureCondition, error) {
+	observe := metrics.StartAPICall("alerts", "CreateInfrastructureCondition")
+	result, err := c.client.CreateInfrastructureCondition(condition)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) UpdateInfrastructureCondition(condition alerts.InfrastructureCondition) (*alerts.InfrastructureCondition, error) {
+	observe := metrics.StartAPICall("alerts", "UpdateInfrastructureCondition")
+	result, err := c.client.UpdateInfrastructureCondition(condition)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) DeleteInfrastructureCondition(conditionID int) error {
+	observe := metrics.StartAPICall("alerts", "DeleteInfrastructureCondition")
+	err := c.client.DeleteInfrastructureCondition(conditionID)
+	observe(err)
+
+	return err
+}
+
+func (c *metricsAlertsClient) ListExternalServiceConditions(policyID int) ([]*externalservice.Condition, error) {
+	observe := metrics.StartAPICall("alerts", "ListExternalServiceConditions")
+	result, err := c.client.ListExternalServiceConditions(policyID)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) CreateExternalServiceCondition(policyID int, condition externalservice.Condition) (*externalservice.Condition, error) {
+	observe := metrics.StartAPICall("alerts", "CreateExternalServiceCondition")
+	result, err := c.client.CreateExternalServiceCondition(policyID, condition)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) UpdateExternalServiceCondition(condition externalservice.Condition) (*externalservice.Condition, error) {
+	observe := metrics.StartAPICall("alerts", "UpdateExternalServiceCondition")
+	result, err := c.client.UpdateExternalServiceCondition(condition)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) DeleteExternalServiceCondition(conditionID int) (*externalservice.Condition, error) {
+	observe := metrics.StartAPICall("alerts", "DeleteExternalServiceCondition")
+	result, err := c.client.DeleteExternalServiceCondition(conditionID)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) CreatePolicyMutation(accountID int, policy alerts.AlertsPolicyInput) (*alerts.AlertsPolicy, error) {
+	observe := metrics.StartAPICall("alerts", "CreatePolicyMutation")
+	result, err := c.client.CreatePolicyMutation(accountID, policy)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) UpdatePolicyMutation(accountID int, policyID string, policy alerts.AlertsPolicyUpdateInput) (*alerts.AlertsPolicy, error) {
+	observe := metrics.StartAPICall("alerts", "UpdatePolicyMutation")
+	result, err := c.client.UpdatePolicyMutation(accountID, policyID, policy)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) DeletePolicyMutation(accountID int, id string) (*alerts.AlertsPolicy, error) {
+	observe := metrics.StartAPICall("alerts", "DeletePolicyMutation")
+	result, err := c.client.DeletePolicyMutation(accountID, id)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) QueryPolicySearch(accountID int, params alerts.AlertsPoliciesSearchCriteriaInput) ([]*alerts.AlertsPolicy, error) {
+	observe := metrics.StartAPICall("alerts", "QueryPolicySearch")
+	result, err := c.client.QueryPolicySearch(accountID, params)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) QueryPolicy(accountID int, id string) (*alerts.AlertsPolicy, error) {
+	observe := metrics.StartAPICall("alerts", "QueryPolicy")
+	result, err := c.client.QueryPolicy(accountID, id)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) CreateNrqlConditionStaticMutation(accountID int, policyID string, nrqlCondition alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+	observe := metrics.StartAPICall("alerts", "CreateNrqlConditionStaticMutation")
+	result, err := c.client.CreateNrqlConditionStaticMutation(accountID, policyID, nrqlCondition)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) UpdateNrqlConditionStaticMutation(accountID int, conditionID string, nrqlCondition alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+	observe := metrics.StartAPICall("alerts", "UpdateNrqlConditionStaticMutation")
+	result, err := c.client.UpdateNrqlConditionStaticMutation(accountID, conditionID, nrqlCondition)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) CreateNrqlConditionBaselineMutation(accountID int, policyID string, nrqlCondition alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+	observe := metrics.StartAPICall("alerts", "CreateNrqlConditionBaselineMutation")
+	result, err := c.client.CreateNrqlConditionBaselineMutation(accountID, policyID, nrqlCondition)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) UpdateNrqlConditionBaselineMutation(accountID int, conditionID string, nrqlCondition alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+	observe := metrics.StartAPICall("alerts", "UpdateNrqlConditionBaselineMutation")
+	result, err := c.client.UpdateNrqlConditionBaselineMutation(accountID, conditionID, nrqlCondition)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) CreateNrqlConditionOutlierMutation(accountID int, policyID string, nrqlCondition alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+	observe := metrics.StartAPICall("alerts", "CreateNrqlConditionOutlierMutation")
+	result, err := c.client.CreateNrqlConditionOutlierMutation(accountID, policyID, nrqlCondition)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) UpdateNrqlConditionOutlierMutation(accountID int, conditionID string, nrqlCondition alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+	observe := metrics.StartAPICall("alerts", "UpdateNrqlConditionOutlierMutation")
+	result, err := c.client.UpdateNrqlConditionOutlierMutation(accountID, conditionID, nrqlCondition)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) DeleteConditionMutation(accountID int, conditionID string) (string, error) {
+	observe := metrics.StartAPICall("alerts", "DeleteConditionMutation")
+	result, err := c.client.DeleteConditionMutation(accountID, conditionID)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) SearchNrqlConditionsQuery(accountID int, searchCriteria alerts.NrqlConditionsSearchCriteria) ([]*alerts.NrqlAlertCondition, error) {
+	observe := metrics.StartAPICall("alerts", "SearchNrqlConditionsQuery")
+	result, err := c.client.SearchNrqlConditionsQuery(accountID, searchCriteria)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) GetNrqlConditionQuery(accountID int, conditionID string) (*alerts.NrqlAlertCondition, error) {
+	observe := metrics.StartAPICall("alerts", "GetNrqlConditionQuery")
+	result, err := c.client.GetNrqlConditionQuery(accountID, conditionID)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) UpdateNrqlConditionSignal(accountID int, conditionType alerts.NrqlConditionType, conditionID string, signal nrqlsignal.Signal) (*nrqlsignal.Signal, error) {
+	observe := metrics.StartAPICall("alerts", "UpdateNrqlConditionSignal")
+	result, err := c.client.UpdateNrqlConditionSignal(accountID, conditionType, conditionID, signal)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) GetNrqlConditionSignal(accountID int, conditionID string) (*nrqlsignal.Signal, error) {
+	observe := metrics.StartAPICall("alerts", "GetNrqlConditionSignal")
+	result, err := c.client.GetNrqlConditionSignal(accountID, conditionID)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) CreateMutingRule(accountID int, rule alerts.MutingRuleCreateInput) (*alerts.MutingRule, error) {
+	observe := metrics.StartAPICall("alerts", "CreateMutingRule")
+	result, err := c.client.CreateMutingRule(accountID, rule)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) UpdateMutingRule(accountID int, ruleID int, rule alerts.MutingRuleUpdateInput) (*alerts.MutingRule, error) {
+	observe := metrics.StartAPICall("alerts", "UpdateMutingRule")
+	result, err := c.client.UpdateMutingRule(accountID, ruleID, rule)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAlertsClient) DeleteMutingRule(accountID int, ruleID int) error {
+	observe := metrics.StartAPICall("alerts", "DeleteMutingRule")
+	err := c.client.DeleteMutingRule(accountID, ruleID)
+	observe(err)
+
+	return err
+}
+
+func (c *metricsAlertsClient) GetMutingRule(accountID int, ruleID int) (*alerts.MutingRule, error) {
+	observe := metrics.StartAPICall("alerts", "GetMutingRule")
+	result, err := c.client.GetMutingRule(accountID, ruleID)
+	observe(err)
+
+	return result, err
+}
+
+// metricsAPMClient records the calls of the APM client
+type metricsAPMClient struct {
+	client NewRelicAPMClient
+}
+
+func (c *metricsAPMClient) ListApplications(params *apm.ListApplicationsParams) ([]*apm.Application, error) {
+	observe := metrics.StartAPICall("apm", "ListApplications")
+	result, err := c.client.ListApplications(params)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsAPMClient) CreateDeployment(applicationID int, deployment apm.Deployment) (*apm.Deployment, error) {
+	observe := metrics.StartAPICall("apm", "CreateDeployment")
+	result, err := c.client.CreateDeployment(applicationID, deployment)
+	observe(err)
+
+	return result, err
+}
+
+// metricsDashboardsClient records the calls of the dashboards client
+type metricsDashboardsClient struct {
+	client NewRelicDashboardsClient
+}
+
+func (c *metricsDashboardsClient) DashboardCreate(accountID int, dashboard dashboards.DashboardInput) (*dashboards.DashboardCreateResult, error) {
+	observe := metrics.StartAPICall("dashboards", "DashboardCreate")
+	result, err := c.client.DashboardCreate(accountID, dashboard)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsDashboardsClient) DashboardUpdate(dashboard dashboards.DashboardInput, guid entities.EntityGUID) (*dashboards.DashboardUpdateResult, error) {
+	observe := metrics.StartAPICall("dashboards", "DashboardUpdate")
+	result, err := c.client.DashboardUpdate(dashboard, guid)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsDashboardsClient) DashboardDelete(guid entities.EntityGUID) (*dashboards.DashboardDeleteResult, error) {
+	observe := metrics.StartAPICall("dashboards", "DashboardDelete")
+	result, err := c.client.DashboardDelete(guid)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsDashboardsClient) GetDashboardEntity(guid entities.EntityGUID) (*entities.DashboardEntity, error) {
+	observe := metrics.StartAPICall("dashboards", "GetDashboardEntity")
+	result, err := c.client.GetDashboardEntity(guid)
+	observe(err)
+
+	return result, err
+}
+
+// metricsNotificationsClient records the calls of the notifications client
+type metricsNotificationsClient struct {
+	client NewRelicNotificationsClient
+}
+
+func (c *metricsNotificationsClient) CreateDestination(accountID int, destination notifications.DestinationInput) (*notifications.Destination, error) {
+	observe := metrics.StartAPICall("notifications", "CreateDestination")
+	result, err := c.client.CreateDestination(accountID, destination)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsNotificationsClient) UpdateDestination(accountID int, destinationID string, destination notifications.DestinationInput) (*notifications.Destination, error) {
+	observe := metrics.StartAPICall("notifications", "UpdateDestination")
+	result, err := c.client.UpdateDestination(accountID, destinationID, destination)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsNotificationsClient) DeleteDestination(accountID int, destinationID string) error {
+	observe := metrics.StartAPICall("notifications", "DeleteDestination")
+	err := c.client.DeleteDestination(accountID, destinationID)
+	observe(err)
+
+	return err
+}
+
+func (c *metricsNotificationsClient) CreateChannel(accountID int, channel notifications.ChannelInput) (*notifications.Channel, error) {
+	observe := metrics.StartAPICall("notifications", "CreateChannel")
+	result, err := c.client.CreateChannel(accountID, channel)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsNotificationsClient) UpdateChannel(accountID int, channelID string, channel notifications.ChannelInput) (*notifications.Channel, error) {
+	observe := metrics.StartAPICall("notifications", "UpdateChannel")
+	result, err := c.client.UpdateChannel(accountID, channelID, channel)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsNotificationsClient) DeleteChannel(accountID int, channelID string) error {
+	observe := metrics.StartAPICall("notifications", "DeleteChannel")
+	err := c.client.DeleteChannel(accountID, channelID)
+	observe(err)
+
+	return err
+}
+
+func (c *metricsNotificationsClient) CreateWorkflow(accountID int, workflow notifications.WorkflowInput) (*notifications.Workflow, error) {
+	observe := metrics.StartAPICall("notifications", "CreateWorkflow")
+	result, err := c.client.CreateWorkflow(accountID, workflow)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsNotificationsClient) UpdateWorkflow(accountID int, workflowID string, workflow notifications.WorkflowInput) (*notifications.Workflow, error) {
+	observe := metrics.StartAPICall("notifications", "UpdateWorkflow")
+	result, err := c.client.UpdateWorkflow(accountID, workflowID, workflow)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsNotificationsClient) DeleteWorkflow(accountID int, workflowID string) error {
+	observe := metrics.StartAPICall("notifications", "DeleteWorkflow")
+	err := c.client.DeleteWorkflow(accountID, workflowID)
+	observe(err)
+
+	return err
+}
+
+// metricsServiceLevelsClient records the calls of the service levels client
+type metricsServiceLevelsClient struct {
+	client NewRelicServiceLevelsClient
+}
+
+func (c *metricsServiceLevelsClient) CreateServiceLevel(entityGUID string, indicator servicelevels.IndicatorInput) (*servicelevels.Indicator, error) {
+	observe := metrics.StartAPICall("service_levels", "CreateServiceLevel")
+	result, err := c.client.CreateServiceLevel(entityGUID, indicator)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsServiceLevelsClient) UpdateServiceLevel(guid string, indicator servicelevels.IndicatorInput) (*servicelevels.Indicator, error) {
+	observe := metrics.StartAPICall("service_levels", "UpdateServiceLevel")
+	result, err := c.client.UpdateServiceLevel(guid, indicator)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsServiceLevelsClient) DeleteServiceLevel(guid string) error {
+	observe := metrics.StartAPICall("service_levels", "DeleteServiceLevel")
+	err := c.client.DeleteServiceLevel(guid)
+	observe(err)
+
+	return err
+}
+
+func (c *metricsServiceLevelsClient) FindAPMApplicationGUID(accountID int, name string) (string, error) {
+	observe := metrics.StartAPICall("service_levels", "FindAPMApplicationGUID")
+	result, err := c.client.FindAPMApplicationGUID(accountID, name)
+	observe(err)
+
+	return result, err
+}
+
+// metricsSyntheticsClient records the calls of the synthetics client
+type metricsSyntheticsClient struct {
+	client NewRelicSyntheticsClient
+}
+
+func (c *metricsSyntheticsClient) ListMonitors() ([]*synthetics.Monitor, error) {
+	observe := metrics.StartAPICall("synthetics", "ListMonitors")
+	result, err := c.client.ListMonitors()
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsSyntheticsClient) GetMonitor(monitorID string) (*synthetics.Monitor, error) {
+	observe := metrics.StartAPICall("synthetics", "GetMonitor")
+	result, err := c.client.GetMonitor(monitorID)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsSyntheticsClient) CreateMonitor(monitor synthetics.Monitor) (*synthetics.Monitor, error) {
+	observe := metrics.StartAPICall("synthetics", "CreateMonitor")
+	result, err := c.client.CreateMonitor(monitor)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsSyntheticsClient) UpdateMonitor(monitor synthetics.Monitor) (*synthetics.Monitor, error) {
+	observe := metrics.StartAPICall("synthetics", "UpdateMonitor")
+	result, err := c.client.UpdateMonitor(monitor)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsSyntheticsClient) DeleteMonitor(monitorID string) error {
+	observe := metrics.StartAPICall("synthetics", "DeleteMonitor")
+	err := c.client.DeleteMonitor(monitorID)
+	observe(err)
+
+	return err
+}
+
+func (c *metricsSyntheticsClient) GetMonitorScript(monitorID string) (*synthetics.MonitorScript, error) {
+	observe := metrics.StartAPICall("synthetics", "GetMonitorScript")
+	result, err := c.client.GetMonitorScript(monitorID)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsSyntheticsClient) UpdateMonitorScript(monitorID string, script synthetics.MonitorScript) (*synthetics.MonitorScript, error) {
+	observe := metrics.StartAPICall("synthetics", "UpdateMonitorScript")
+	result, err := c.client.UpdateMonitorScript(monitorID, script)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsSyntheticsClient) CreateSimpleMonitor(accountID int, monitor syntheticsmonitors.SimpleMonitorInput) (*syntheticsmonitors.SimpleMonitor, error) {
+	observe := metrics.StartAPICall("synthetics", "CreateSimpleMonitor")
+	result, err := c.client.CreateSimpleMonitor(accountID, monitor)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsSyntheticsClient) UpdateSimpleMonitor(guid string, monitor syntheticsmonitors.SimpleMonitorInput) (*syntheticsmonitors.SimpleMonitor, error) {
+	observe := metrics.StartAPICall("synthetics", "UpdateSimpleMonitor")
+	result, err := c.client.UpdateSimpleMonitor(guid, monitor)
+	observe(err)
+
+	return result, err
+}
+
+func (c *metricsSyntheticsClient) DeleteMonitorMutation(guid string) error {
+	observe := metrics.StartAPICall("synthetics", "DeleteMonitorMutation")
+	err := c.client.DeleteMonitorMutation(guid)
+	observe(err)
+
+	return err
+}
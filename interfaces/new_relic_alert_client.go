@@ -7,7 +7,9 @@ import (
 	"github.com/newrelic/newrelic-client-go/pkg/alerts"
 	"github.com/newrelic/newrelic-client-go/pkg/config"
 
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/externalservice"
 	"github.com/newrelic/newrelic-kubernetes-operator/internal/info"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/nrqlsignal"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . NewRelicAlertsClient
@@ -30,6 +32,24 @@ type NewRelicAlertsClient interface {
 	ListChannels() ([]*alerts.Channel, error)
 	UpdatePolicyChannels(policyID int, channelIDs []int) (*alerts.PolicyChannels, error)
 	DeletePolicyChannel(policyID int, ChannelID int) (*alerts.Channel, error)
+	CreateSyntheticsCondition(policyID int, condition alerts.SyntheticsCondition) (*alerts.SyntheticsCondition, error)
+	UpdateSyntheticsCondition(condition alerts.SyntheticsCondition) (*alerts.SyntheticsCondition, error)
+	DeleteSyntheticsCondition(conditionID int) (*alerts.SyntheticsCondition, error)
+	ListSyntheticsConditions(policyID int) ([]*alerts.SyntheticsCondition, error)
+	CreateMultiLocationSyntheticsCondition(condition alerts.MultiLocationSyntheticsCondition, policyID int) (*alerts.MultiLocationSyntheticsCondition, error)
+	UpdateMultiLocationSyntheticsCondition(condition alerts.MultiLocationSyntheticsCondition) (*alerts.MultiLocationSyntheticsCondition, error)
+	DeleteMultiLocationSyntheticsCondition(conditionID int) (*alerts.MultiLocationSyntheticsCondition, error)
+	ListMultiLocationSyntheticsConditions(policyID int) ([]*alerts.MultiLocationSyntheticsCondition, error)
+	ListInfrastructureConditions(policyID int) ([]alerts.InfrastructureCondition, error)
+	CreateInfrastructureCondition(condition alerts.InfrastructureCondition) (*alerts.InfrastructureCondition, error)
+	UpdateInfrastructureCondition(condition alerts.InfrastructureCondition) (*alerts.InfrastructureCondition, error)
+	DeleteInfrastructureCondition(conditionID int) error
+
+	// REST, not supported by newrelic-client-go
+	ListExternalServiceConditions(policyID int) ([]*externalservice.Condition, error)
+	CreateExternalServiceCondition(policyID int, condition externalservice.Condition) (*externalservice.Condition, error)
+	UpdateExternalServiceCondition(condition externalservice.Condition) (*externalservice.Condition, error)
+	DeleteExternalServiceCondition(conditionID int) (*externalservice.Condition, error)
 
 	// NerdGraph
 	CreatePolicyMutation(accountID int, policy alerts.AlertsPolicyInput) (*alerts.AlertsPolicy, error)
@@ -42,9 +62,20 @@ type NewRelicAlertsClient interface {
 	UpdateNrqlConditionStaticMutation(accountID int, conditionID string, nrqlCondition alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error)
 	CreateNrqlConditionBaselineMutation(accountID int, policyID string, nrqlCondition alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error)
 	UpdateNrqlConditionBaselineMutation(accountID int, conditionID string, nrqlCondition alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error)
+	CreateNrqlConditionOutlierMutation(accountID int, policyID string, nrqlCondition alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error)
+	UpdateNrqlConditionOutlierMutation(accountID int, conditionID string, nrqlCondition alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error)
 	DeleteConditionMutation(accountID int, conditionID string) (string, error)
 	SearchNrqlConditionsQuery(accountID int, searchCriteria alerts.NrqlConditionsSearchCriteria) ([]*alerts.NrqlAlertCondition, error)
 	GetNrqlConditionQuery(accountID int, conditionID string) (*alerts.NrqlAlertCondition, error)
+
+	// NerdGraph, the streaming settings of the signal are not supported by newrelic-client-go
+	UpdateNrqlConditionSignal(accountID int, conditionType alerts.NrqlConditionType, conditionID string, signal nrqlsignal.Signal) (*nrqlsignal.Signal, error)
+	GetNrqlConditionSignal(accountID int, conditionID string) (*nrqlsignal.Signal, error)
+
+	CreateMutingRule(accountID int, rule alerts.MutingRuleCreateInput) (*alerts.MutingRule, error)
+	UpdateMutingRule(accountID int, ruleID int, rule alerts.MutingRuleUpdateInput) (*alerts.MutingRule, error)
+	DeleteMutingRule(accountID int, ruleID int) error
+	GetMutingRule(accountID int, ruleID int) (*alerts.MutingRule, error)
 }
 
 func NewClient(apiKey string, regionValue string) (*newrelic.NewRelic, error) {
@@ -71,7 +102,22 @@ func InitializeAlertsClient(apiKey string, regionName string) (NewRelicAlertsCli
 		return nil, fmt.Errorf("unable to create New Relic client with error: %s", err)
 	}
 
-	return &client.Alerts, nil
+	externalServiceClient, err := externalservice.New(apiKey, regionName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create New Relic external service client with error: %s", err)
+	}
+
+	signalsClient := nrqlsignal.New(client.NerdGraph)
+
+	return &metricsAlertsClient{client: &alertsClient{Alerts: &client.Alerts, Client: externalServiceClient, Signals: signalsClient}}, nil
+}
+
+// alertsClient adds the external service conditions and the streaming settings of NRQL condition
+// signals to the alerts client of newrelic-client-go
+type alertsClient struct {
+	*alerts.Alerts
+	*externalservice.Client
+	*nrqlsignal.Signals
 }
 
 //PartialAPIKey - Returns a partial API key to ensure we don't log the full API Key
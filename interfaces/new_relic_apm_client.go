@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/newrelic/newrelic-client-go/pkg/apm"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . NewRelicAPMClient
+type NewRelicAPMClient interface {
+	ListApplications(params *apm.ListApplicationsParams) ([]*apm.Application, error)
+	CreateDeployment(applicationID int, deployment apm.Deployment) (*apm.Deployment, error)
+}
+
+func InitializeAPMClient(apiKey string, regionName string) (NewRelicAPMClient, error) {
+	client, err := NewClient(apiKey, regionName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create New Relic client with error: %s", err)
+	}
+
+	return &metricsAPMClient{client: &client.APM}, nil
+}
@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/newrelic/newrelic-client-go/pkg/dashboards"
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . NewRelicDashboardsClient
+type NewRelicDashboardsClient interface {
+	// NerdGraph
+	DashboardCreate(accountID int, dashboard dashboards.DashboardInput) (*dashboards.DashboardCreateResult, error)
+	DashboardUpdate(dashboard dashboards.DashboardInput, guid entities.EntityGUID) (*dashboards.DashboardUpdateResult, error)
+	DashboardDelete(guid entities.EntityGUID) (*dashboards.DashboardDeleteResult, error)
+	GetDashboardEntity(guid entities.EntityGUID) (*entities.DashboardEntity, error)
+}
+
+func InitializeDashboardsClient(apiKey string, regionName string) (NewRelicDashboardsClient, error) {
+	client, err := NewClient(apiKey, regionName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create New Relic client with error: %s", err)
+	}
+
+	return &metricsDashboardsClient{client: &client.Dashboards}, nil
+}
@@ -0,0 +1,30 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/notifications"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . NewRelicNotificationsClient
+type NewRelicNotificationsClient interface {
+	// NerdGraph
+	CreateDestination(accountID int, destination notifications.DestinationInput) (*notifications.Destination, error)
+	UpdateDestination(accountID int, destinationID string, destination notifications.DestinationInput) (*notifications.Destination, error)
+	DeleteDestination(accountID int, destinationID string) error
+	CreateChannel(accountID int, channel notifications.ChannelInput) (*notifications.Channel, error)
+	UpdateChannel(accountID int, channelID string, channel notifications.ChannelInput) (*notifications.Channel, error)
+	DeleteChannel(accountID int, channelID string) error
+	CreateWorkflow(accountID int, workflow notifications.WorkflowInput) (*notifications.Workflow, error)
+	UpdateWorkflow(accountID int, workflowID string, workflow notifications.WorkflowInput) (*notifications.Workflow, error)
+	DeleteWorkflow(accountID int, workflowID string) error
+}
+
+func InitializeNotificationsClient(apiKey string, regionName string) (NewRelicNotificationsClient, error) {
+	client, err := NewClient(apiKey, regionName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create New Relic notifications client with error: %s", err)
+	}
+
+	return &metricsNotificationsClient{client: notifications.New(client.NerdGraph)}, nil
+}
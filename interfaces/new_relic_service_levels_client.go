@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/servicelevels"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . NewRelicServiceLevelsClient
+type NewRelicServiceLevelsClient interface {
+	// NerdGraph
+	CreateServiceLevel(entityGUID string, indicator servicelevels.IndicatorInput) (*servicelevels.Indicator, error)
+	UpdateServiceLevel(guid string, indicator servicelevels.IndicatorInput) (*servicelevels.Indicator, error)
+	DeleteServiceLevel(guid string) error
+	FindAPMApplicationGUID(accountID int, name string) (string, error)
+}
+
+func InitializeServiceLevelsClient(apiKey string, regionName string) (NewRelicServiceLevelsClient, error) {
+	client, err := NewClient(apiKey, regionName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create New Relic service levels client with error: %s", err)
+	}
+
+	return &metricsServiceLevelsClient{client: servicelevels.New(client.NerdGraph)}, nil
+}
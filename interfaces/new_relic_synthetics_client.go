@@ -0,0 +1,42 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/syntheticsmonitors"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . NewRelicSyntheticsClient
+type NewRelicSyntheticsClient interface {
+	ListMonitors() ([]*synthetics.Monitor, error)
+	GetMonitor(monitorID string) (*synthetics.Monitor, error)
+	CreateMonitor(monitor synthetics.Monitor) (*synthetics.Monitor, error)
+	UpdateMonitor(monitor synthetics.Monitor) (*synthetics.Monitor, error)
+	DeleteMonitor(monitorID string) error
+	GetMonitorScript(monitorID string) (*synthetics.MonitorScript, error)
+	UpdateMonitorScript(monitorID string, script synthetics.MonitorScript) (*synthetics.MonitorScript, error)
+
+	// NerdGraph, ping monitors are only written through the REST API by newrelic-client-go
+	CreateSimpleMonitor(accountID int, monitor syntheticsmonitors.SimpleMonitorInput) (*syntheticsmonitors.SimpleMonitor, error)
+	UpdateSimpleMonitor(guid string, monitor syntheticsmonitors.SimpleMonitorInput) (*syntheticsmonitors.SimpleMonitor, error)
+	DeleteMonitorMutation(guid string) error
+}
+
+func InitializeSyntheticsClient(apiKey string, regionName string) (NewRelicSyntheticsClient, error) {
+	client, err := NewClient(apiKey, regionName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create New Relic client with error: %s", err)
+	}
+
+	monitorsClient := syntheticsmonitors.New(client.NerdGraph)
+
+	return &metricsSyntheticsClient{client: &syntheticsClient{Synthetics: &client.Synthetics, Monitors: monitorsClient}}, nil
+}
+
+// syntheticsClient adds the NerdGraph ping monitor mutations to the synthetics client of newrelic-client-go
+type syntheticsClient struct {
+	*synthetics.Synthetics
+	*syntheticsmonitors.Monitors
+}
@@ -0,0 +1,553 @@
+package interfaces
+
+import (
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/pkg/region"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/externalservice"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/metrics"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/nrqlsignal"
+)
+
+// APICallEventType is the type of the custom events recorded for every call of the New Relic API
+const APICallEventType = "NewRelicOperatorAPICall"
+
+// tracedAlertsClient reports every call of the alerts client as an external segment of the
+// transaction of the reconcile request and as a custom event
+type tracedAlertsClient struct {
+	client    NewRelicAlertsClient
+	tracer    apiTracer
+	accountID int
+	region    string
+	url       string
+}
+
+// NewTracedAlertsClient wraps client so its calls are reported in txn with the account and region
+// they were made for. txn may be nil, e.g. when the agent is not configured.
+func NewTracedAlertsClient(client NewRelicAlertsClient, txn *newrelic.Transaction, accountID int, regionName string) NewRelicAlertsClient {
+	return newTracedAlertsClient(client, &transactionTracer{txn: txn}, accountID, regionName)
+}
+
+func newTracedAlertsClient(client NewRelicAlertsClient, tracer apiTracer, accountID int, regionName string) *tracedAlertsClient {
+	return &tracedAlertsClient{
+		client:    client,
+		tracer:    tracer,
+		accountID: accountID,
+		region:    regionName,
+		url:       apiURL(regionName),
+	}
+}
+
+// apiURL returns the URL of NerdGraph in the region, unknown regions use the default region like the client does
+func apiURL(regionName string) string {
+	name, _ := region.Parse(regionName)
+	r, _ := region.Get(name)
+
+	return r.NerdGraphURL()
+}
+
+// apiSegment is the segment of a call of the New Relic API
+type apiSegment interface {
+	AddAttribute(key string, val interface{})
+	End()
+}
+
+// apiTracer reports the calls of the New Relic API
+type apiTracer interface {
+	// startSegment starts the segment of a call of method at url
+	startSegment(url string, method string) apiSegment
+	// recordEvent records a custom event of eventType
+	recordEvent(eventType string, params map[string]interface{})
+}
+
+// transactionTracer reports the calls as external segments of txn and custom events of its application
+type transactionTracer struct {
+	txn *newrelic.Transaction
+}
+
+func (t *transactionTracer) startSegment(url string, method string) apiSegment {
+	return &newrelic.ExternalSegment{
+		StartTime: t.txn.StartSegmentNow(),
+		URL:       url,
+		Procedure: method,
+		Library:   "newrelic-client-go",
+	}
+}
+
+func (t *transactionTracer) recordEvent(eventType string, params map[string]interface{}) {
+	t.txn.Application().RecordCustomEvent(eventType, params)
+}
+
+// tracedCall is a call of the New Relic API in progress
+type tracedCall struct {
+	client  *tracedAlertsClient
+	method  string
+	start   time.Time
+	segment apiSegment
+}
+
+// startCall starts the external segment of a call of method
+func (c *tracedAlertsClient) startCall(method string) *tracedCall {
+	segment := c.tracer.startSegment(c.url, method)
+	segment.AddAttribute("method", method)
+	segment.AddAttribute("accountId", c.accountID)
+	segment.AddAttribute("region", c.region)
+
+	return &tracedCall{client: c, method: method, start: time.Now(), segment: segment}
+}
+
+// end ends the segment of the call with its outcome and records the call as a custom event
+func (t *tracedCall) end(err error) {
+	outcome := metrics.StatusSuccess
+	if err != nil {
+		outcome = metrics.StatusError
+		t.segment.AddAttribute("error", metrics.ErrorClass(err))
+	}
+
+	t.segment.AddAttribute("outcome", outcome)
+	t.segment.End()
+
+	t.client.tracer.recordEvent(APICallEventType, map[string]interface{}{
+		"method":    t.method,
+		"accountId": t.client.accountID,
+		"region":    t.client.region,
+		"outcome":   outcome,
+		"error":     metrics.ErrorClass(err),
+		"duration":  time.Since(t.start).Seconds(),
+	})
+}
+
+func (c *tracedAlertsClient) CreateNrqlCondition(policyID int, condition alerts.NrqlCondition) (*alerts.NrqlCondition, error) {
+	call := c.startCall("CreateNrqlCondition")
+	result, err := c.client.CreateNrqlCondition(policyID, condition)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) UpdateNrqlCondition(condition alerts.NrqlCondition) (*alerts.NrqlCondition, error) {
+	call := c.startCall("UpdateNrqlCondition")
+	result, err := c.client.UpdateNrqlCondition(condition)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) ListNrqlConditions(policyID int) ([]*alerts.NrqlCondition, error) {
+	call := c.startCall("ListNrqlConditions")
+	result, err := c.client.ListNrqlConditions(policyID)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) DeleteNrqlCondition(conditionID int) (*alerts.NrqlCondition, error) {
+	call := c.startCall("DeleteNrqlCondition")
+	result, err := c.client.DeleteNrqlCondition(conditionID)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) ListConditions(policyID int) ([]*alerts.Condition, error) {
+	call := c.startCall("ListConditions")
+	result, err := c.client.ListConditions(policyID)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) CreateCondition(policyID int, condition alerts.Condition) (*alerts.Condition, error) {
+	call := c.startCall("CreateCondition")
+	result, err := c.client.CreateCondition(policyID, condition)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) UpdateCondition(condition alerts.Condition) (*alerts.Condition, error) {
+	call := c.startCall("UpdateCondition")
+	result, err := c.client.UpdateCondition(condition)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) DeleteCondition(id int) (*alerts.Condition, error) {
+	call := c.startCall("DeleteCondition")
+	result, err := c.client.DeleteCondition(id)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) GetPolicy(id int) (*alerts.Policy, error) {
+	call := c.startCall("GetPolicy")
+	result, err := c.client.GetPolicy(id)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) CreatePolicy(policy alerts.Policy) (*alerts.Policy, error) {
+	call := c.startCall("CreatePolicy")
+	result, err := c.client.CreatePolicy(policy)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) UpdatePolicy(policy alerts.Policy) (*alerts.Policy, error) {
+	call := c.startCall("UpdatePolicy")
+	result, err := c.client.UpdatePolicy(policy)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) DeletePolicy(id int) (*alerts.Policy, error) {
+	call := c.startCall("DeletePolicy")
+	result, err := c.client.DeletePolicy(id)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) ListPolicies(params *alerts.ListPoliciesParams) ([]alerts.Policy, error) {
+	call := c.startCall("ListPolicies")
+	result, err := c.client.ListPolicies(params)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) CreateChannel(channel alerts.Channel) (*alerts.Channel, error) {
+	call := c.startCall("CreateChannel")
+	result, err := c.client.CreateChannel(channel)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) DeleteChannel(id int) (*alerts.Channel, error) {
+	call := c.startCall("DeleteChannel")
+	result, err := c.client.DeleteChannel(id)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) ListChannels() ([]*alerts.Channel, error) {
+	call := c.startCall("ListChannels")
+	result, err := c.client.ListChannels()
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) UpdatePolicyChannels(policyID int, channelIDs []int) (*alerts.PolicyChannels, error) {
+	call := c.startCall("UpdatePolicyChannels")
+	result, err := c.client.UpdatePolicyChannels(policyID, channelIDs)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) DeletePolicyChannel(policyID int, channelID int) (*alerts.Channel, error) {
+	call := c.startCall("DeletePolicyChannel")
+	result, err := c.client.DeletePolicyChannel(policyID, channelID)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) CreateSyntheticsCondition(policyID int, condition alerts.SyntheticsCondition) (*alerts.SyntheticsCondition, error) {
+	call := c.startCall("CreateSyntheticsCondition")
+	result, err := c.client.CreateSyntheticsCondition(policyID, condition)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) UpdateSyntheticsCondition(condition alerts.SyntheticsCondition) (*alerts.SyntheticsCondition, error) {
+	call := c.startCall("UpdateSyntheticsCondition")
+	result, err := c.client.UpdateSyntheticsCondition(condition)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) DeleteSyntheticsCondition(conditionID int) (*alerts.SyntheticsCondition, error) {
+	call := c.startCall("DeleteSyntheticsCondition")
+	result, err := c.client.DeleteSyntheticsCondition(conditionID)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) ListSyntheticsConditions(policyID int) ([]*alerts.SyntheticsCondition, error) {
+	call := c.startCall("ListSyntheticsConditions")
+	result, err := c.client.ListSyntheticsConditions(policyID)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) CreateMultiLocationSyntheticsCondition(condition alerts.MultiLocationSyntheticsCondition, policyID int) (*alerts.MultiLocationSyntheticsCondition, error) {
+	call := c.startCall("CreateMultiLocationSyntheticsCondition")
+	result, err := c.client.CreateMultiLocationSyntheticsCondition(condition, policyID)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) UpdateMultiLocationSyntheticsCondition(condition alerts.MultiLocationSyntheticsCondition) (*alerts.MultiLocationSyntheticsCondition, error) {
+	call := c.startCall("UpdateMultiLocationSyntheticsCondition")
+	result, err := c.client.UpdateMultiLocationSyntheticsCondition(condition)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) DeleteMultiLocationSyntheticsCondition(conditionID int) (*alerts.MultiLocationSyntheticsCondition, error) {
+	call := c.startCall("DeleteMultiLocationSyntheticsCondition")
+	result, err := c.client.DeleteMultiLocationSyntheticsCondition(conditionID)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) ListMultiLocationSyntheticsConditions(policyID int) ([]*alerts.MultiLocationSyntheticsCondition, error) {
+	call := c.startCall("ListMultiLocationSyntheticsConditions")
+	result, err := c.client.ListMultiLocationSyntheticsConditions(policyID)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) ListInfrastructureConditions(policyID int) ([]alerts.InfrastructureCondition, error) {
+	call := c.startCall("ListInfrastructureConditions")
+	result, err := c.client.ListInfrastructureConditions(policyID)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) CreateInfrastructureCondition(condition alerts.InfrastructureCondition) (*alerts.InfrastructureCondition, error) {
+	call := c.startCall("CreateInfrastructureCondition")
+	result, err := c.client.CreateInfrastructureCondition(condition)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) UpdateInfrastructureCondition(condition alerts.InfrastructureCondition) (*alerts.InfrastructureCondition, error) {
+	call := c.startCall("UpdateInfrastructureCondition")
+	result, err := c.client.UpdateInfrastructureCondition(condition)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) DeleteInfrastructureCondition(conditionID int) error {
+	call := c.startCall("DeleteInfrastructureCondition")
+	err := c.client.DeleteInfrastructureCondition(conditionID)
+	call.end(err)
+
+	return err
+}
+
+func (c *tracedAlertsClient) ListExternalServiceConditions(policyID int) ([]*externalservice.Condition, error) {
+	call := c.startCall("ListExternalServiceConditions")
+	result, err := c.client.ListExternalServiceConditions(policyID)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) CreateExternalServiceCondition(policyID int, condition externalservice.Condition) (*externalservice.Condition, error) {
+	call := c.startCall("CreateExternalServiceCondition")
+	result, err := c.client.CreateExternalServiceCondition(policyID, condition)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) UpdateExternalServiceCondition(condition externalservice.Condition) (*externalservice.Condition, error) {
+	call := c.startCall("UpdateExternalServiceCondition")
+	result, err := c.client.UpdateExternalServiceCondition(condition)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) DeleteExternalServiceCondition(conditionID int) (*externalservice.Condition, error) {
+	call := c.startCall("DeleteExternalServiceCondition")
+	result, err := c.client.DeleteExternalServiceCondition(conditionID)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) CreatePolicyMutation(accountID int, policy alerts.AlertsPolicyInput) (*alerts.AlertsPolicy, error) {
+	call := c.startCall("CreatePolicyMutation")
+	result, err := c.client.CreatePolicyMutation(accountID, policy)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) UpdatePolicyMutation(accountID int, policyID string, policy alerts.AlertsPolicyUpdateInput) (*alerts.AlertsPolicy, error) {
+	call := c.startCall("UpdatePolicyMutation")
+	result, err := c.client.UpdatePolicyMutation(accountID, policyID, policy)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) DeletePolicyMutation(accountID int, id string) (*alerts.AlertsPolicy, error) {
+	call := c.startCall("DeletePolicyMutation")
+	result, err := c.client.DeletePolicyMutation(accountID, id)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) QueryPolicySearch(accountID int, params alerts.AlertsPoliciesSearchCriteriaInput) ([]*alerts.AlertsPolicy, error) {
+	call := c.startCall("QueryPolicySearch")
+	result, err := c.client.QueryPolicySearch(accountID, params)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) QueryPolicy(accountID int, id string) (*alerts.AlertsPolicy, error) {
+	call := c.startCall("QueryPolicy")
+	result, err := c.client.QueryPolicy(accountID, id)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) CreateNrqlConditionStaticMutation(accountID int, policyID string, nrqlCondition alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+	call := c.startCall("CreateNrqlConditionStaticMutation")
+	result, err := c.client.CreateNrqlConditionStaticMutation(accountID, policyID, nrqlCondition)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) UpdateNrqlConditionStaticMutation(accountID int, conditionID string, nrqlCondition alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+	call := c.startCall("UpdateNrqlConditionStaticMutation")
+	result, err := c.client.UpdateNrqlConditionStaticMutation(accountID, conditionID, nrqlCondition)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) CreateNrqlConditionBaselineMutation(accountID int, policyID string, nrqlCondition alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+	call := c.startCall("CreateNrqlConditionBaselineMutation")
+	result, err := c.client.CreateNrqlConditionBaselineMutation(accountID, policyID, nrqlCondition)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) UpdateNrqlConditionBaselineMutation(accountID int, conditionID string, nrqlCondition alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+	call := c.startCall("UpdateNrqlConditionBaselineMutation")
+	result, err := c.client.UpdateNrqlConditionBaselineMutation(accountID, conditionID, nrqlCondition)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) CreateNrqlConditionOutlierMutation(accountID int, policyID string, nrqlCondition alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+	call := c.startCall("CreateNrqlConditionOutlierMutation")
+	result, err := c.client.CreateNrqlConditionOutlierMutation(accountID, policyID, nrqlCondition)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) UpdateNrqlConditionOutlierMutation(accountID int, conditionID string, nrqlCondition alerts.NrqlConditionInput) (*alerts.NrqlAlertCondition, error) {
+	call := c.startCall("UpdateNrqlConditionOutlierMutation")
+	result, err := c.client.UpdateNrqlConditionOutlierMutation(accountID, conditionID, nrqlCondition)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) DeleteConditionMutation(accountID int, conditionID string) (string, error) {
+	call := c.startCall("DeleteConditionMutation")
+	result, err := c.client.DeleteConditionMutation(accountID, conditionID)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) SearchNrqlConditionsQuery(accountID int, searchCriteria alerts.NrqlConditionsSearchCriteria) ([]*alerts.NrqlAlertCondition, error) {
+	call := c.startCall("SearchNrqlConditionsQuery")
+	result, err := c.client.SearchNrqlConditionsQuery(accountID, searchCriteria)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) GetNrqlConditionQuery(accountID int, conditionID string) (*alerts.NrqlAlertCondition, error) {
+	call := c.startCall("GetNrqlConditionQuery")
+	result, err := c.client.GetNrqlConditionQuery(accountID, conditionID)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) UpdateNrqlConditionSignal(accountID int, conditionType alerts.NrqlConditionType, conditionID string, signal nrqlsignal.Signal) (*nrqlsignal.Signal, error) {
+	call := c.startCall("UpdateNrqlConditionSignal")
+	result, err := c.client.UpdateNrqlConditionSignal(accountID, conditionType, conditionID, signal)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) GetNrqlConditionSignal(accountID int, conditionID string) (*nrqlsignal.Signal, error) {
+	call := c.startCall("GetNrqlConditionSignal")
+	result, err := c.client.GetNrqlConditionSignal(accountID, conditionID)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) CreateMutingRule(accountID int, rule alerts.MutingRuleCreateInput) (*alerts.MutingRule, error) {
+	call := c.startCall("CreateMutingRule")
+	result, err := c.client.CreateMutingRule(accountID, rule)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) UpdateMutingRule(accountID int, ruleID int, rule alerts.MutingRuleUpdateInput) (*alerts.MutingRule, error) {
+	call := c.startCall("UpdateMutingRule")
+	result, err := c.client.UpdateMutingRule(accountID, ruleID, rule)
+	call.end(err)
+
+	return result, err
+}
+
+func (c *tracedAlertsClient) DeleteMutingRule(accountID int, ruleID int) error {
+	call := c.startCall("DeleteMutingRule")
+	err := c.client.DeleteMutingRule(accountID, ruleID)
+	call.end(err)
+
+	return err
+}
+
+func (c *tracedAlertsClient) GetMutingRule(accountID int, ruleID int) (*alerts.MutingRule, error) {
+	call := c.startCall("GetMutingRule")
+	result, err := c.client.GetMutingRule(accountID, ruleID)
+	call.end(err)
+
+	return result, err
+}
@@ -0,0 +1,116 @@
+package interfaces_test
+
+import (
+	"testing"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces/interfacesfakes"
+)
+
+func TestTracedAlertsClient(t *testing.T) {
+	app, err := newrelic.NewApplication(newrelic.ConfigAppName("operator-test"), newrelic.ConfigEnabled(false))
+	require.NoError(t, err)
+
+	txn := app.StartTransaction("Reconcile/Alerts/Policy")
+	defer txn.End()
+
+	fakeClient := &interfacesfakes.FakeNewRelicAlertsClient{}
+	fakeClient.GetPolicyReturns(&alerts.Policy{ID: 123}, nil)
+	fakeClient.DeletePolicyMutationReturns(nil, nrErrors.NewNotFound("no such policy"))
+
+	client := interfaces.NewTracedAlertsClient(fakeClient, txn, 111, "EU")
+
+	policy, err := client.GetPolicy(123)
+	require.NoError(t, err)
+	assert.Equal(t, 123, policy.ID)
+	assert.Equal(t, 123, fakeClient.GetPolicyArgsForCall(0))
+
+	_, err = client.DeletePolicyMutation(111, "123")
+	assert.IsType(t, &nrErrors.NotFound{}, err)
+	accountID, id := fakeClient.DeletePolicyMutationArgsForCall(0)
+	assert.Equal(t, 111, accountID)
+	assert.Equal(t, "123", id)
+}
+
+func TestTracedAlertsClientWithoutTransaction(t *testing.T) {
+	fakeClient := &interfacesfakes.FakeNewRelicAlertsClient{}
+	fakeClient.GetPolicyReturns(&alerts.Policy{ID: 123}, nil)
+
+	client := interfaces.NewTracedAlertsClient(fakeClient, nil, 111, "unknown")
+
+	policy, err := client.GetPolicy(123)
+	require.NoError(t, err)
+	assert.Equal(t, 123, policy.ID)
+	assert.Equal(t, 1, fakeClient.GetPolicyCallCount())
+}
+
+func TestTracedAlertsClientSegments(t *testing.T) {
+	tracer := &interfaces.RecordingTracer{}
+	fakeClient := &interfacesfakes.FakeNewRelicAlertsClient{}
+	fakeClient.GetPolicyReturns(&alerts.Policy{ID: 123}, nil)
+	fakeClient.DeletePolicyMutationReturns(nil, nrErrors.NewNotFound("no such policy"))
+
+	client := interfaces.NewRecordedAlertsClient(fakeClient, tracer, 111, "EU")
+
+	_, err := client.GetPolicy(123)
+	require.NoError(t, err)
+	_, err = client.DeletePolicyMutation(111, "123")
+	require.Error(t, err)
+
+	require.Len(t, tracer.Segments, 2)
+
+	assert.Equal(t, "https://api.eu.newrelic.com/graphql", tracer.Segments[0].URL)
+	assert.Equal(t, "GetPolicy", tracer.Segments[0].Method)
+	assert.True(t, tracer.Segments[0].Ended)
+	assert.Equal(t, map[string]interface{}{
+		"method":    "GetPolicy",
+		"accountId": 111,
+		"region":    "EU",
+		"outcome":   "success",
+	}, tracer.Segments[0].Attributes)
+
+	assert.True(t, tracer.Segments[1].Ended)
+	assert.Equal(t, map[string]interface{}{
+		"method":    "DeletePolicyMutation",
+		"accountId": 111,
+		"region":    "EU",
+		"outcome":   "error",
+		"error":     "not_found",
+	}, tracer.Segments[1].Attributes)
+}
+
+func TestTracedAlertsClientEvents(t *testing.T) {
+	tracer := &interfaces.RecordingTracer{}
+	fakeClient := &interfacesfakes.FakeNewRelicAlertsClient{}
+	fakeClient.DeletePolicyMutationReturns(nil, nrErrors.NewNotFound("no such policy"))
+
+	client := interfaces.NewRecordedAlertsClient(fakeClient, tracer, 111, "US")
+
+	_, err := client.ListPolicies(nil)
+	require.NoError(t, err)
+	_, err = client.DeletePolicyMutation(111, "123")
+	require.Error(t, err)
+
+	require.Len(t, tracer.Events, 2)
+
+	for _, event := range tracer.Events {
+		assert.Equal(t, interfaces.APICallEventType, event.EventType)
+		assert.Equal(t, 111, event.Params["accountId"])
+		assert.Equal(t, "US", event.Params["region"])
+		assert.IsType(t, float64(0), event.Params["duration"])
+	}
+
+	assert.Equal(t, "ListPolicies", tracer.Events[0].Params["method"])
+	assert.Equal(t, "success", tracer.Events[0].Params["outcome"])
+	assert.Equal(t, "", tracer.Events[0].Params["error"])
+
+	assert.Equal(t, "DeletePolicyMutation", tracer.Events[1].Params["method"])
+	assert.Equal(t, "error", tracer.Events[1].Params["outcome"])
+	assert.Equal(t, "not_found", tracer.Events[1].Params["error"])
+}
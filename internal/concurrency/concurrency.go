@@ -0,0 +1,90 @@
+// Package concurrency configures how many requests each controller of the operator reconciles in parallel.
+package concurrency
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MaxConcurrentReconciles is the maximum number of concurrent reconciles of every controller, with
+// overrides for single controllers. Controllers are named after their reconciler without the Reconciler
+// suffix, e.g. AlertsPolicy. As a flag.Value it reads the overrides as <controller>=<number> pairs
+// separated by commas.
+type MaxConcurrentReconciles struct {
+	Default int
+
+	overrides map[string]int
+	used      map[string]bool
+}
+
+// String implements flag.Value
+func (m *MaxConcurrentReconciles) String() string {
+	if m == nil {
+		return ""
+	}
+
+	pairs := make([]string, 0, len(m.overrides))
+	for controller, n := range m.overrides {
+		pairs = append(pairs, fmt.Sprintf("%s=%d", controller, n))
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+// Set implements flag.Value
+func (m *MaxConcurrentReconciles) Set(value string) error {
+	overrides := map[string]int{}
+
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return fmt.Errorf("%q is not of the form <controller>=<number>", pair)
+		}
+
+		n, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil || n < 1 {
+			return fmt.Errorf("the maximum number of concurrent reconciles of %s must be a positive number", parts[0])
+		}
+
+		overrides[strings.TrimSpace(parts[0])] = n
+	}
+
+	m.overrides = overrides
+
+	return nil
+}
+
+// For returns the maximum number of concurrent reconciles of controller
+func (m *MaxConcurrentReconciles) For(controller string) int {
+	if m.used == nil {
+		m.used = map[string]bool{}
+	}
+	m.used[controller] = true
+
+	if n, ok := m.overrides[controller]; ok {
+		return n
+	}
+
+	return m.Default
+}
+
+// Unknown returns the overridden controllers that were never asked for, e.g. misspelled ones
+func (m *MaxConcurrentReconciles) Unknown() []string {
+	var unknown []string
+	for controller := range m.overrides {
+		if !m.used[controller] {
+			unknown = append(unknown, controller)
+		}
+	}
+	sort.Strings(unknown)
+
+	return unknown
+}
@@ -0,0 +1,53 @@
+package concurrency
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxConcurrentReconciles(t *testing.T) {
+	m := &MaxConcurrentReconciles{}
+	flags := flag.NewFlagSet("operator", flag.ContinueOnError)
+	flags.IntVar(&m.Default, "max-concurrent-reconciles", 1, "")
+	flags.Var(m, "max-concurrent-reconciles-per-controller", "")
+
+	err := flags.Parse([]string{
+		"--max-concurrent-reconciles=2",
+		"--max-concurrent-reconciles-per-controller=AlertsPolicy=4, AlertsNrqlCondition=8",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, m.For("AlertsPolicy"))
+	assert.Equal(t, 8, m.For("AlertsNrqlCondition"))
+	assert.Equal(t, 2, m.For("AlertsChannel"))
+	assert.Empty(t, m.Unknown())
+	assert.Equal(t, "AlertsNrqlCondition=8,AlertsPolicy=4", m.String())
+}
+
+func TestMaxConcurrentReconcilesDefault(t *testing.T) {
+	m := &MaxConcurrentReconciles{Default: 1}
+
+	assert.Equal(t, 1, m.For("AlertsPolicy"))
+	assert.Equal(t, "", m.String())
+}
+
+func TestMaxConcurrentReconcilesUnknown(t *testing.T) {
+	m := &MaxConcurrentReconciles{Default: 1}
+	require.NoError(t, m.Set("AlertsPolicy=4,AlertsPolicies=2"))
+
+	m.For("AlertsPolicy")
+
+	assert.Equal(t, []string{"AlertsPolicies"}, m.Unknown())
+}
+
+func TestMaxConcurrentReconcilesInvalid(t *testing.T) {
+	m := &MaxConcurrentReconciles{}
+
+	assert.EqualError(t, m.Set("AlertsPolicy"), `"AlertsPolicy" is not of the form <controller>=<number>`)
+	assert.EqualError(t, m.Set("=4"), `"=4" is not of the form <controller>=<number>`)
+	assert.EqualError(t, m.Set("AlertsPolicy=0"), "the maximum number of concurrent reconciles of AlertsPolicy must be a positive number")
+	assert.EqualError(t, m.Set("AlertsPolicy=many"), "the maximum number of concurrent reconciles of AlertsPolicy must be a positive number")
+}
@@ -0,0 +1,174 @@
+// Package externalservice manages external service alert conditions through the REST API.
+// The version of newrelic-client-go the operator is built against does not support them.
+package externalservice
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/newrelic/newrelic-client-go/pkg/region"
+
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/info"
+)
+
+// Types of external service conditions
+const (
+	ConditionTypeAPM    = "apm_external_service"
+	ConditionTypeMobile = "mobile_external_service"
+)
+
+// Condition is an alert condition on the calls of APM or mobile applications to an external service
+type Condition struct {
+	ID                 int                    `json:"id,omitempty"`
+	Type               string                 `json:"type,omitempty"`
+	Name               string                 `json:"name,omitempty"`
+	Enabled            bool                   `json:"enabled"`
+	Entities           []string               `json:"entities"`
+	ExternalServiceURL string                 `json:"external_service_url,omitempty"`
+	Metric             string                 `json:"metric,omitempty"`
+	RunbookURL         string                 `json:"runbook_url,omitempty"`
+	Terms              []alerts.ConditionTerm `json:"terms,omitempty"`
+}
+
+// Client is a REST client for the external service conditions API
+type Client struct {
+	apiKey string
+	url    string
+	client *http.Client
+}
+
+// New returns a client that authenticates with a personal API key against the REST endpoint of the region
+func New(apiKey string, regionName string) (*Client, error) {
+	name, err := region.Parse(regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	reg, err := region.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return newWithURL(apiKey, reg.RestURL()), nil
+}
+
+func newWithURL(apiKey string, url string) *Client {
+	return &Client{
+		apiKey: apiKey,
+		url:    strings.TrimSuffix(url, "/"),
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type conditionsResponse struct {
+	Conditions []*Condition `json:"external_service_conditions"`
+}
+
+type conditionBody struct {
+	Condition Condition `json:"external_service_condition"`
+}
+
+// ListExternalServiceConditions returns the external service conditions of a policy
+func (c *Client) ListExternalServiceConditions(policyID int) ([]*Condition, error) {
+	var resp conditionsResponse
+
+	err := c.do(http.MethodGet, "/alerts_external_service_conditions.json?policy_id="+strconv.Itoa(policyID), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Conditions, nil
+}
+
+// CreateExternalServiceCondition adds an external service condition to a policy
+func (c *Client) CreateExternalServiceCondition(policyID int, condition Condition) (*Condition, error) {
+	var resp conditionBody
+
+	err := c.do(http.MethodPost, fmt.Sprintf("/alerts_external_service_conditions/policies/%d.json", policyID), &conditionBody{Condition: condition}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Condition, nil
+}
+
+// UpdateExternalServiceCondition updates the external service condition with the ID of condition
+func (c *Client) UpdateExternalServiceCondition(condition Condition) (*Condition, error) {
+	var resp conditionBody
+
+	err := c.do(http.MethodPut, fmt.Sprintf("/alerts_external_service_conditions/%d.json", condition.ID), &conditionBody{Condition: condition}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Condition, nil
+}
+
+// DeleteExternalServiceCondition deletes an external service condition
+func (c *Client) DeleteExternalServiceCondition(conditionID int) (*Condition, error) {
+	var resp conditionBody
+
+	err := c.do(http.MethodDelete, fmt.Sprintf("/alerts_external_service_conditions/%d.json", conditionID), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Condition, nil
+}
+
+// do sends a request to the REST API and decodes the response into respBody. A 404 is
+// reported as a NotFound error, so callers can treat it like the other New Relic APIs.
+func (c *Client) do(method string, path string, reqBody interface{}, respBody interface{}) error {
+	var body io.Reader
+
+	if reqBody != nil {
+		data, err := json.Marshal(reqBody)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, c.url+path, body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Api-Key", c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", info.UserAgent())
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nrErrors.NewNotFound(strings.TrimSpace(string(data)))
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("REST request failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(data, respBody)
+}
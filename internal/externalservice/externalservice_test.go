@@ -0,0 +1,96 @@
+package externalservice
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient returns a client whose requests are answered with status and response, the method,
+// path and body of the request sent last are stored in sent. The returned server has to be closed by the test.
+func newTestClient(t *testing.T, status int, response string, sent *http.Request, sentBody *map[string]interface{}) (*Client, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "api-key", r.Header.Get("Api-Key"))
+
+		*sent = *r
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		if len(body) > 0 {
+			require.NoError(t, json.Unmarshal(body, sentBody))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+
+	return newWithURL("api-key", server.URL+"/v2/"), server
+}
+
+func TestListExternalServiceConditions(t *testing.T) {
+	var sent http.Request
+	var sentBody map[string]interface{}
+	c, server := newTestClient(t, http.StatusOK, `{"external_service_conditions": [
+		{"id": 1, "type": "apm_external_service", "name": "slow payments", "entities": ["42"], "terms": [
+			{"duration": "5", "operator": "above", "priority": "critical", "threshold": "1.5", "time_function": "all"}
+		]}
+	]}`, &sent, &sentBody)
+	defer server.Close()
+
+	conditions, err := c.ListExternalServiceConditions(123)
+
+	require.NoError(t, err)
+	assert.Equal(t, "/v2/alerts_external_service_conditions.json", sent.URL.Path)
+	assert.Equal(t, "123", sent.URL.Query().Get("policy_id"))
+	require.Len(t, conditions, 1)
+	assert.Equal(t, "slow payments", conditions[0].Name)
+	assert.Equal(t, 1.5, conditions[0].Terms[0].Threshold)
+}
+
+func TestCreateExternalServiceCondition(t *testing.T) {
+	var sent http.Request
+	var sentBody map[string]interface{}
+	c, server := newTestClient(t, http.StatusCreated, `{"external_service_condition": {"id": 7, "name": "slow payments"}}`, &sent, &sentBody)
+	defer server.Close()
+
+	condition, err := c.CreateExternalServiceCondition(123, Condition{
+		Type:               ConditionTypeAPM,
+		Name:               "slow payments",
+		Enabled:            true,
+		Entities:           []string{"42"},
+		ExternalServiceURL: "payments.example.com",
+		Metric:             "response_time_average",
+		Terms: []alerts.ConditionTerm{
+			{Duration: 5, Operator: alerts.OperatorTypes.Above, Priority: alerts.PriorityTypes.Critical, Threshold: 1.5, TimeFunction: alerts.TimeFunctionTypes.All},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 7, condition.ID)
+	assert.Equal(t, http.MethodPost, sent.Method)
+	assert.Equal(t, "/v2/alerts_external_service_conditions/policies/123.json", sent.URL.Path)
+	body := sentBody["external_service_condition"].(map[string]interface{})
+	assert.Equal(t, "payments.example.com", body["external_service_url"])
+	assert.Equal(t, "1.5", body["terms"].([]interface{})[0].(map[string]interface{})["threshold"])
+}
+
+func TestDeleteMissingExternalServiceCondition(t *testing.T) {
+	var sent http.Request
+	var sentBody map[string]interface{}
+	c, server := newTestClient(t, http.StatusNotFound, `{"error": {"title": "Resource not found"}}`, &sent, &sentBody)
+	defer server.Close()
+
+	_, err := c.DeleteExternalServiceCondition(7)
+
+	var notFound *nrErrors.NotFound
+	assert.ErrorAs(t, err, &notFound)
+	assert.Equal(t, http.MethodDelete, sent.Method)
+	assert.Equal(t, "/v2/alerts_external_service_conditions/7.json", sent.URL.Path)
+}
@@ -0,0 +1,145 @@
+// Package metrics defines the Prometheus metrics of the operator. They are registered with the
+// controller-runtime registry and served on its metrics endpoint together with the controller metrics.
+package metrics
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const namespace = "newrelic_operator"
+
+// Values of the status and result labels
+const (
+	StatusSuccess = "success"
+	StatusError   = "error"
+	ResultRequeue = "requeue"
+)
+
+var (
+	// APICalls counts the calls of the New Relic API by client, method, status and error class
+	APICalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "api_calls_total",
+		Help:      "Number of New Relic API calls by client, method, status and error.",
+	}, []string{"client", "method", "status", "error"})
+
+	// APICallDuration observes the duration of the calls of the New Relic API by client and method
+	APICallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "api_call_duration_seconds",
+		Help:      "Duration of New Relic API calls by client and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"client", "method"})
+
+	// Reconciles counts the outcomes of reconcile requests by kind
+	Reconciles = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reconcile_total",
+		Help:      "Number of reconcile requests by kind and result.",
+	}, []string{"kind", "result"})
+
+	// DriftDetections counts the times new drift from New Relic was found by kind
+	DriftDetections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "drift_detections_total",
+		Help:      "Number of times an object was found to differ from New Relic by kind.",
+	}, []string{"kind"})
+
+	// ObjectsInError is the number of objects whose last reconcile failed by kind
+	ObjectsInError = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "objects_in_error",
+		Help:      "Number of objects in error state by kind.",
+	}, []string{"kind"})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(APICalls, APICallDuration, Reconciles, DriftDetections, ObjectsInError)
+}
+
+// StartAPICall starts timing a call of method of client. The returned function records the call
+// with the error it returned.
+func StartAPICall(client string, method string) func(error) {
+	start := time.Now()
+
+	return func(err error) {
+		APICallDuration.WithLabelValues(client, method).Observe(time.Since(start).Seconds())
+
+		status := StatusSuccess
+		if err != nil {
+			status = StatusError
+		}
+
+		APICalls.WithLabelValues(client, method, status, ErrorClass(err)).Inc()
+	}
+}
+
+// ErrorClass returns the value of the error label of err. It is empty for successful calls.
+func ErrorClass(err error) string {
+	var notFound *nrErrors.NotFound
+	var unauthorized *nrErrors.UnauthorizedError
+	var unexpectedStatusCode *nrErrors.UnexpectedStatusCode
+	var maxRetriesReached *nrErrors.MaxRetriesReached
+
+	switch {
+	case err == nil:
+		return ""
+	case errors.As(err, &notFound):
+		return "not_found"
+	case errors.As(err, &unauthorized):
+		return "unauthorized"
+	case errors.As(err, &unexpectedStatusCode):
+		return "unexpected_status_code"
+	case errors.As(err, &maxRetriesReached):
+		return "max_retries_reached"
+	default:
+		return "other"
+	}
+}
+
+// ObserveReconcile records the outcome of a reconcile request of kind
+func ObserveReconcile(kind string, requeue bool, err error) {
+	result := StatusSuccess
+
+	switch {
+	case err != nil:
+		result = StatusError
+	case requeue:
+		result = ResultRequeue
+	}
+
+	Reconciles.WithLabelValues(kind, result).Inc()
+}
+
+// objectsInError holds the keys of the objects in error state by kind, so an object failing
+// repeatedly is counted once
+var objectsInError = struct {
+	sync.Mutex
+	keys map[string]map[string]bool
+}{keys: map[string]map[string]bool{}}
+
+// SetObjectInError records whether the object of kind identified by key is in error state
+func SetObjectInError(kind string, key string, inError bool) {
+	objectsInError.Lock()
+	defer objectsInError.Unlock()
+
+	keys, ok := objectsInError.keys[kind]
+	if !ok {
+		keys = map[string]bool{}
+		objectsInError.keys[kind] = keys
+	}
+
+	if inError {
+		keys[key] = true
+	} else {
+		delete(keys, key)
+	}
+
+	ObjectsInError.WithLabelValues(kind).Set(float64(len(keys)))
+}
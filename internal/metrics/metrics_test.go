@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorClass(t *testing.T) {
+	assert.Equal(t, "", ErrorClass(nil))
+	assert.Equal(t, "not_found", ErrorClass(nrErrors.NewNotFound("no such policy")))
+	assert.Equal(t, "not_found", ErrorClass(fmt.Errorf("wrapped: %w", nrErrors.NewNotFound("no such policy"))))
+	assert.Equal(t, "unauthorized", ErrorClass(nrErrors.NewUnauthorizedError()))
+	assert.Equal(t, "unexpected_status_code", ErrorClass(nrErrors.NewUnexpectedStatusCode(500, "internal server error")))
+	assert.Equal(t, "max_retries_reached", ErrorClass(nrErrors.NewMaxRetriesReached("timeout")))
+	assert.Equal(t, "other", ErrorClass(errors.New("connection refused")))
+}
+
+func TestStartAPICall(t *testing.T) {
+	StartAPICall("alerts", "TestStartAPICall")(nil)
+	StartAPICall("alerts", "TestStartAPICall")(nrErrors.NewNotFound("no such policy"))
+	StartAPICall("alerts", "TestStartAPICall")(nrErrors.NewNotFound("no such policy"))
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(APICalls.WithLabelValues("alerts", "TestStartAPICall", StatusSuccess, "")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(APICalls.WithLabelValues("alerts", "TestStartAPICall", StatusError, "not_found")))
+}
+
+func TestObserveReconcile(t *testing.T) {
+	ObserveReconcile("TestObserveReconcile", false, nil)
+	ObserveReconcile("TestObserveReconcile", true, nil)
+	ObserveReconcile("TestObserveReconcile", true, errors.New("update failed"))
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(Reconciles.WithLabelValues("TestObserveReconcile", StatusSuccess)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(Reconciles.WithLabelValues("TestObserveReconcile", ResultRequeue)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(Reconciles.WithLabelValues("TestObserveReconcile", StatusError)))
+}
+
+func TestSetObjectInError(t *testing.T) {
+	gauge := ObjectsInError.WithLabelValues("TestSetObjectInError")
+
+	SetObjectInError("TestSetObjectInError", "default/first", true)
+	SetObjectInError("TestSetObjectInError", "default/first", true)
+	SetObjectInError("TestSetObjectInError", "default/second", true)
+	assert.Equal(t, float64(2), testutil.ToFloat64(gauge))
+
+	SetObjectInError("TestSetObjectInError", "default/first", false)
+	assert.Equal(t, float64(1), testutil.ToFloat64(gauge))
+
+	SetObjectInError("TestSetObjectInError", "default/unknown", false)
+	assert.Equal(t, float64(1), testutil.ToFloat64(gauge))
+}
@@ -0,0 +1,123 @@
+package notifications
+
+// ProductIINT is the product of channels used by workflows
+const ProductIINT = "IINT"
+
+// Channel is a message template sending notifications of a product to a destination
+type Channel struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	Type          string     `json:"type"`
+	Product       string     `json:"product"`
+	DestinationID string     `json:"destinationId"`
+	Active        bool       `json:"active"`
+	Properties    []Property `json:"properties"`
+}
+
+// ChannelInput is the desired state of a channel. The type, product and destination of a channel
+// cannot be updated.
+type ChannelInput struct {
+	Name          string     `json:"name"`
+	Type          string     `json:"type,omitempty"`
+	Product       string     `json:"product,omitempty"`
+	DestinationID string     `json:"destinationId,omitempty"`
+	Properties    []Property `json:"properties"`
+}
+
+const channelFields = `id name type product destinationId active properties { key value }`
+
+const createChannelMutation = `mutation($accountId: Int!, $channel: AiNotificationsChannelInput!) {
+	aiNotificationsCreateChannel(accountId: $accountId, channel: $channel) {
+		channel { ` + channelFields + ` }
+		` + responseErrorFields + `
+	}
+}`
+
+const updateChannelMutation = `mutation($accountId: Int!, $channelId: ID!, $channel: AiNotificationsChannelUpdate!) {
+	aiNotificationsUpdateChannel(accountId: $accountId, channelId: $channelId, channel: $channel) {
+		channel { ` + channelFields + ` }
+		` + responseErrorFields + `
+	}
+}`
+
+const deleteChannelMutation = `mutation($accountId: Int!, $channelId: ID!) {
+	aiNotificationsDeleteChannel(accountId: $accountId, channelId: $channelId) {
+		ids
+		` + responseErrorFields + `
+	}
+}`
+
+type channelResponse struct {
+	Channel *Channel       `json:"channel"`
+	Error   *ResponseError `json:"error"`
+}
+
+// CreateChannel creates a channel sending to an existing destination
+func (n *Notifications) CreateChannel(accountID int, channel ChannelInput) (*Channel, error) {
+	var resp struct {
+		Result channelResponse `json:"aiNotificationsCreateChannel"`
+	}
+
+	vars := map[string]interface{}{
+		"accountId": accountID,
+		"channel":   channel,
+	}
+
+	if err := n.client.QueryWithResponse(createChannelMutation, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	if err := mutationError(resp.Result.Error); err != nil {
+		return nil, err
+	}
+
+	return resp.Result.Channel, nil
+}
+
+// UpdateChannel replaces the name and properties of a channel
+func (n *Notifications) UpdateChannel(accountID int, channelID string, channel ChannelInput) (*Channel, error) {
+	var resp struct {
+		Result channelResponse `json:"aiNotificationsUpdateChannel"`
+	}
+
+	channel.Type = ""
+	channel.Product = ""
+	channel.DestinationID = ""
+
+	vars := map[string]interface{}{
+		"accountId": accountID,
+		"channelId": channelID,
+		"channel":   channel,
+	}
+
+	if err := n.client.QueryWithResponse(updateChannelMutation, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	if err := mutationError(resp.Result.Error); err != nil {
+		return nil, err
+	}
+
+	return resp.Result.Channel, nil
+}
+
+// DeleteChannel deletes a channel
+func (n *Notifications) DeleteChannel(accountID int, channelID string) error {
+	var resp struct {
+		Result struct {
+			IDs   []string       `json:"ids"`
+			Error *ResponseError `json:"error"`
+		} `json:"aiNotificationsDeleteChannel"`
+	}
+
+	vars := map[string]interface{}{
+		"accountId": accountID,
+		"channelId": channelID,
+	}
+
+	if err := n.client.QueryWithResponse(deleteChannelMutation, vars, &resp); err != nil {
+		return err
+	}
+
+	return mutationError(resp.Result.Error)
+}
@@ -0,0 +1,145 @@
+package notifications
+
+// Authentication types of a destination
+const (
+	AuthTypeBasic = "BASIC"
+	AuthTypeToken = "TOKEN"
+)
+
+// Destination is where notifications are sent to, e.g. a webhook endpoint or a PagerDuty service
+type Destination struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Type       string     `json:"type"`
+	Active     bool       `json:"active"`
+	Properties []Property `json:"properties"`
+}
+
+// Property is a key value pair configuring a destination or channel
+type Property struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// DestinationInput is the desired state of a destination. The type of a destination cannot be updated.
+type DestinationInput struct {
+	Name       string     `json:"name"`
+	Type       string     `json:"type,omitempty"`
+	Properties []Property `json:"properties"`
+	Auth       *AuthInput `json:"auth,omitempty"`
+}
+
+// AuthInput holds the credentials a destination authenticates with
+type AuthInput struct {
+	Type  string          `json:"type"`
+	Basic *BasicAuthInput `json:"basic,omitempty"`
+	Token *TokenAuthInput `json:"token,omitempty"`
+}
+
+// BasicAuthInput - credentials of BASIC authentication
+type BasicAuthInput struct {
+	User     string `json:"user"`
+	Password string `json:"password"`
+}
+
+// TokenAuthInput - credentials of TOKEN authentication, the prefix is sent in front of the token, e.g. Bearer
+type TokenAuthInput struct {
+	Prefix string `json:"prefix,omitempty"`
+	Token  string `json:"token"`
+}
+
+const destinationFields = `id name type active properties { key value }`
+
+const createDestinationMutation = `mutation($accountId: Int!, $destination: AiNotificationsDestinationInput!) {
+	aiNotificationsCreateDestination(accountId: $accountId, destination: $destination) {
+		destination { ` + destinationFields + ` }
+		` + responseErrorFields + `
+	}
+}`
+
+const updateDestinationMutation = `mutation($accountId: Int!, $destinationId: ID!, $destination: AiNotificationsDestinationUpdate!) {
+	aiNotificationsUpdateDestination(accountId: $accountId, destinationId: $destinationId, destination: $destination) {
+		destination { ` + destinationFields + ` }
+		` + responseErrorFields + `
+	}
+}`
+
+const deleteDestinationMutation = `mutation($accountId: Int!, $destinationId: ID!) {
+	aiNotificationsDeleteDestination(accountId: $accountId, destinationId: $destinationId) {
+		ids
+		` + responseErrorFields + `
+	}
+}`
+
+type destinationResponse struct {
+	Destination *Destination   `json:"destination"`
+	Error       *ResponseError `json:"error"`
+}
+
+// CreateDestination creates a destination in the account
+func (n *Notifications) CreateDestination(accountID int, destination DestinationInput) (*Destination, error) {
+	var resp struct {
+		Result destinationResponse `json:"aiNotificationsCreateDestination"`
+	}
+
+	vars := map[string]interface{}{
+		"accountId":   accountID,
+		"destination": destination,
+	}
+
+	if err := n.client.QueryWithResponse(createDestinationMutation, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	if err := mutationError(resp.Result.Error); err != nil {
+		return nil, err
+	}
+
+	return resp.Result.Destination, nil
+}
+
+// UpdateDestination replaces the name, properties and credentials of a destination
+func (n *Notifications) UpdateDestination(accountID int, destinationID string, destination DestinationInput) (*Destination, error) {
+	var resp struct {
+		Result destinationResponse `json:"aiNotificationsUpdateDestination"`
+	}
+
+	destination.Type = ""
+
+	vars := map[string]interface{}{
+		"accountId":     accountID,
+		"destinationId": destinationID,
+		"destination":   destination,
+	}
+
+	if err := n.client.QueryWithResponse(updateDestinationMutation, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	if err := mutationError(resp.Result.Error); err != nil {
+		return nil, err
+	}
+
+	return resp.Result.Destination, nil
+}
+
+// DeleteDestination deletes a destination, which fails while channels still send to it
+func (n *Notifications) DeleteDestination(accountID int, destinationID string) error {
+	var resp struct {
+		Result struct {
+			IDs   []string       `json:"ids"`
+			Error *ResponseError `json:"error"`
+		} `json:"aiNotificationsDeleteDestination"`
+	}
+
+	vars := map[string]interface{}{
+		"accountId":     accountID,
+		"destinationId": destinationID,
+	}
+
+	if err := n.client.QueryWithResponse(deleteDestinationMutation, vars, &resp); err != nil {
+		return err
+	}
+
+	return mutationError(resp.Result.Error)
+}
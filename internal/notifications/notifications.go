@@ -0,0 +1,70 @@
+// Package notifications manages notification destinations, channels and workflows through NerdGraph.
+package notifications
+
+import (
+	"errors"
+	"strings"
+
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/newrelic/newrelic-client-go/pkg/nerdgraph"
+)
+
+// Notifications is a NerdGraph client for the notifications and workflows APIs
+type Notifications struct {
+	client nerdgraph.NerdGraph
+}
+
+// New returns a client sending its requests through the NerdGraph client of newrelic-client-go
+func New(client nerdgraph.NerdGraph) *Notifications {
+	return &Notifications{client: client}
+}
+
+// ResponseError is an error reported in the result of a mutation
+type ResponseError struct {
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Details     string `json:"details"`
+}
+
+func (e *ResponseError) Error() string {
+	message := e.Description
+	if message == "" {
+		message = e.Details
+	}
+
+	if e.Type == "" {
+		return message
+	}
+
+	return e.Type + ": " + message
+}
+
+// responseErrorFields selects the members of the error union returned by notification mutations
+const responseErrorFields = `error {
+	... on AiNotificationsResponseError { type description details }
+	... on AiNotificationsDataValidationError { details }
+}`
+
+// mutationError turns the errors reported in the result of a mutation into an error. Entities that do
+// not exist are reported as a NotFound error, so callers can treat them like the other New Relic APIs.
+func mutationError(errs ...*ResponseError) error {
+	var messages []string
+
+	for _, e := range errs {
+		if e == nil || (e.Type == "" && e.Description == "" && e.Details == "") {
+			continue
+		}
+
+		if strings.Contains(e.Type, "NOT_FOUND") {
+			return nrErrors.NewNotFound(e.Error())
+		}
+
+		messages = append(messages, e.Error())
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(messages, ", "))
+}
@@ -0,0 +1,126 @@
+package notifications
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/newrelic"
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// graphQLRequest is the body of a request sent to NerdGraph
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// newTestClient returns a client whose requests are answered with response, the request
+// sent last is stored in sent. The returned server has to be closed by the test.
+func newTestClient(t *testing.T, response string, sent *graphQLRequest) (*Notifications, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "api-key", r.Header.Get("Api-Key"))
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, sent))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+
+	client, err := newrelic.New(
+		newrelic.ConfigPersonalAPIKey("api-key"),
+		newrelic.ConfigNerdGraphBaseURL(server.URL),
+	)
+	require.NoError(t, err)
+
+	return New(client.NerdGraph), server
+}
+
+func TestCreateDestination(t *testing.T) {
+	var sent graphQLRequest
+	n, server := newTestClient(t, `{"data": {"aiNotificationsCreateDestination": {
+		"destination": {"id": "destination-id", "name": "ops", "type": "WEBHOOK", "active": true}
+	}}}`, &sent)
+	defer server.Close()
+
+	destination, err := n.CreateDestination(1, DestinationInput{
+		Name:       "ops",
+		Type:       "WEBHOOK",
+		Properties: []Property{{Key: "url", Value: "https://example.com"}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "destination-id", destination.ID)
+	assert.Equal(t, float64(1), sent.Variables["accountId"])
+	assert.Contains(t, sent.Query, "aiNotificationsCreateDestination")
+}
+
+func TestUpdateChannelOmitsImmutableFields(t *testing.T) {
+	var sent graphQLRequest
+	n, server := newTestClient(t, `{"data": {"aiNotificationsUpdateChannel": {"channel": {"id": "channel-id"}}}}`, &sent)
+	defer server.Close()
+
+	_, err := n.UpdateChannel(1, "channel-id", ChannelInput{
+		Name:          "ops",
+		Type:          "WEBHOOK",
+		Product:       ProductIINT,
+		DestinationID: "destination-id",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "ops", "properties": nil}, sent.Variables["channel"])
+}
+
+func TestUpdateWorkflowSendsID(t *testing.T) {
+	var sent graphQLRequest
+	n, server := newTestClient(t, `{"data": {"aiWorkflowsUpdateWorkflow": {"workflow": {"id": "workflow-id"}, "errors": []}}}`, &sent)
+	defer server.Close()
+
+	_, err := n.UpdateWorkflow(1, "workflow-id", WorkflowInput{Name: "ops"})
+
+	require.NoError(t, err)
+	data := sent.Variables["updateWorkflowData"].(map[string]interface{})
+	assert.Equal(t, "workflow-id", data["id"])
+	assert.Equal(t, "ops", data["name"])
+}
+
+func TestMutationErrors(t *testing.T) {
+	var sent graphQLRequest
+	n, server := newTestClient(t, `{"data": {"aiNotificationsCreateChannel": {
+		"error": {"type": "INVALID_PARAMETER", "description": "destination is not active"}
+	}}}`, &sent)
+	defer server.Close()
+
+	_, err := n.CreateChannel(1, ChannelInput{Name: "ops"})
+
+	require.EqualError(t, err, "INVALID_PARAMETER: destination is not active")
+}
+
+func TestNotFoundErrors(t *testing.T) {
+	var sent graphQLRequest
+	n, server := newTestClient(t, `{"data": {"aiWorkflowsDeleteWorkflow": {
+		"errors": [{"type": "ENTITY_NOT_FOUND", "description": "workflow not found"}]
+	}}}`, &sent)
+	defer server.Close()
+
+	err := n.DeleteWorkflow(1, "workflow-id")
+
+	require.Error(t, err)
+	assert.IsType(t, &nrErrors.NotFound{}, err)
+}
+
+func TestGraphQLErrors(t *testing.T) {
+	var sent graphQLRequest
+	n, server := newTestClient(t, `{"errors": [{"message": "unauthorized"}, {"message": "invalid account"}]}`, &sent)
+	defer server.Close()
+
+	err := n.DeleteDestination(1, "destination-id")
+
+	require.EqualError(t, err, "unauthorized, invalid account")
+}
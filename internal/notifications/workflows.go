@@ -0,0 +1,180 @@
+package notifications
+
+import (
+	"errors"
+	"strings"
+
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+)
+
+// Attributes and operators of issue filter predicates
+const (
+	// PolicyIDsAttribute is the attribute holding the IDs of the policies an issue was opened by
+	PolicyIDsAttribute = "labels.policyIds"
+
+	OperatorExactlyMatches = "EXACTLY_MATCHES"
+)
+
+// Workflow routes the issues matching its filter to channels
+type Workflow struct {
+	ID                        string                     `json:"id"`
+	Name                      string                     `json:"name"`
+	WorkflowEnabled           bool                       `json:"workflowEnabled"`
+	MutingRulesHandling       string                     `json:"mutingRulesHandling"`
+	DestinationConfigurations []DestinationConfiguration `json:"destinationConfigurations"`
+	IssuesFilter              *IssuesFilter              `json:"issuesFilter"`
+}
+
+// WorkflowInput is the desired state of a workflow
+type WorkflowInput struct {
+	Name                      string                     `json:"name"`
+	WorkflowEnabled           bool                       `json:"workflowEnabled"`
+	DestinationsEnabled       bool                       `json:"destinationsEnabled"`
+	EnrichmentsEnabled        bool                       `json:"enrichmentsEnabled"`
+	MutingRulesHandling       string                     `json:"mutingRulesHandling"`
+	DestinationConfigurations []DestinationConfiguration `json:"destinationConfigurations"`
+	IssuesFilter              IssuesFilter               `json:"issuesFilter"`
+}
+
+// DestinationConfiguration - a channel notified by a workflow
+type DestinationConfiguration struct {
+	ChannelID string `json:"channelId"`
+}
+
+// IssuesFilter selects the issues of a workflow, an issue has to match every predicate
+type IssuesFilter struct {
+	Name       string      `json:"name"`
+	Type       string      `json:"type"`
+	Predicates []Predicate `json:"predicates"`
+}
+
+// Predicate matches an attribute of an issue against values
+type Predicate struct {
+	Attribute string   `json:"attribute"`
+	Operator  string   `json:"operator"`
+	Values    []string `json:"values"`
+}
+
+// workflowError is an error reported in the result of a workflow mutation
+type workflowError struct {
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+const workflowFields = `id name workflowEnabled mutingRulesHandling
+	destinationConfigurations { channelId }
+	issuesFilter { name type predicates { attribute operator values } }`
+
+const createWorkflowMutation = `mutation($accountId: Int!, $createWorkflowData: AiWorkflowsCreateWorkflowInput!) {
+	aiWorkflowsCreateWorkflow(accountId: $accountId, createWorkflowData: $createWorkflowData) {
+		workflow { ` + workflowFields + ` }
+		errors { type description }
+	}
+}`
+
+const updateWorkflowMutation = `mutation($accountId: Int!, $updateWorkflowData: AiWorkflowsUpdateWorkflowInput!) {
+	aiWorkflowsUpdateWorkflow(accountId: $accountId, updateWorkflowData: $updateWorkflowData) {
+		workflow { ` + workflowFields + ` }
+		errors { type description }
+	}
+}`
+
+const deleteWorkflowMutation = `mutation($accountId: Int!, $id: ID!) {
+	aiWorkflowsDeleteWorkflow(accountId: $accountId, id: $id, deleteChannels: false) {
+		id
+		errors { type description }
+	}
+}`
+
+type workflowResponse struct {
+	Workflow *Workflow       `json:"workflow"`
+	Errors   []workflowError `json:"errors"`
+}
+
+// CreateWorkflow creates a workflow in the account
+func (n *Notifications) CreateWorkflow(accountID int, workflow WorkflowInput) (*Workflow, error) {
+	var resp struct {
+		Result workflowResponse `json:"aiWorkflowsCreateWorkflow"`
+	}
+
+	vars := map[string]interface{}{
+		"accountId":          accountID,
+		"createWorkflowData": workflow,
+	}
+
+	if err := n.client.QueryWithResponse(createWorkflowMutation, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	if err := workflowMutationError(resp.Result.Errors); err != nil {
+		return nil, err
+	}
+
+	return resp.Result.Workflow, nil
+}
+
+// UpdateWorkflow replaces the settings, channels and issues filter of a workflow
+func (n *Notifications) UpdateWorkflow(accountID int, workflowID string, workflow WorkflowInput) (*Workflow, error) {
+	var resp struct {
+		Result workflowResponse `json:"aiWorkflowsUpdateWorkflow"`
+	}
+
+	// the update input has the same fields as the create input plus the ID of the workflow
+	vars := map[string]interface{}{
+		"accountId": accountID,
+		"updateWorkflowData": struct {
+			ID string `json:"id"`
+			WorkflowInput
+		}{workflowID, workflow},
+	}
+
+	if err := n.client.QueryWithResponse(updateWorkflowMutation, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	if err := workflowMutationError(resp.Result.Errors); err != nil {
+		return nil, err
+	}
+
+	return resp.Result.Workflow, nil
+}
+
+// DeleteWorkflow deletes a workflow, the channels it notifies are kept
+func (n *Notifications) DeleteWorkflow(accountID int, workflowID string) error {
+	var resp struct {
+		Result struct {
+			ID     string          `json:"id"`
+			Errors []workflowError `json:"errors"`
+		} `json:"aiWorkflowsDeleteWorkflow"`
+	}
+
+	vars := map[string]interface{}{
+		"accountId": accountID,
+		"id":        workflowID,
+	}
+
+	if err := n.client.QueryWithResponse(deleteWorkflowMutation, vars, &resp); err != nil {
+		return err
+	}
+
+	return workflowMutationError(resp.Result.Errors)
+}
+
+// workflowMutationError turns the errors reported in the result of a workflow mutation into an error
+func workflowMutationError(errs []workflowError) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(errs))
+
+	for _, e := range errs {
+		if strings.Contains(e.Type, "NOT_FOUND") {
+			return nrErrors.NewNotFound(e.Type + ": " + e.Description)
+		}
+
+		messages = append(messages, e.Type+": "+e.Description)
+	}
+
+	return errors.New(strings.Join(messages, ", "))
+}
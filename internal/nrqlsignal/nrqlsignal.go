@@ -0,0 +1,139 @@
+// Package nrqlsignal manages the signal of NRQL conditions through NerdGraph, including the streaming
+// aggregation settings.
+package nrqlsignal
+
+import (
+	"fmt"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/newrelic/newrelic-client-go/pkg/nerdgraph"
+)
+
+// AggregationMethod decides when the data of an aggregation window is evaluated
+type AggregationMethod string
+
+// Aggregation methods of a signal
+const (
+	// AggregationMethodEventFlow evaluates a window once data of a later window arrived
+	AggregationMethodEventFlow AggregationMethod = "EVENT_FLOW"
+	// AggregationMethodEventTimer evaluates a window once no data arrived for the aggregation timer
+	AggregationMethodEventTimer AggregationMethod = "EVENT_TIMER"
+	// AggregationMethodCadence evaluates a window after the aggregation delay by the wall clock
+	AggregationMethodCadence AggregationMethod = "CADENCE"
+)
+
+// Signal is the signal of a NRQL condition. Durations are in seconds. The streaming settings are always
+// sent, a missing one is sent as null so that it is cleared in New Relic.
+type Signal struct {
+	AggregationWindow *int                     `json:"aggregationWindow,omitempty"`
+	AggregationMethod *AggregationMethod       `json:"aggregationMethod"`
+	AggregationDelay  *int                     `json:"aggregationDelay"`
+	AggregationTimer  *int                     `json:"aggregationTimer"`
+	EvaluationOffset  *int                     `json:"evaluationOffset,omitempty"`
+	FillOption        *alerts.AlertsFillOption `json:"fillOption,omitempty"`
+	FillValue         *float64                 `json:"fillValue,omitempty"`
+	SlideBy           *int                     `json:"slideBy"`
+}
+
+// signalFields selects all fields of a Signal
+const signalFields = `signal {
+	aggregationWindow
+	aggregationMethod
+	aggregationDelay
+	aggregationTimer
+	evaluationOffset
+	fillOption
+	fillValue
+	slideBy
+}`
+
+// Signals is a NerdGraph client for the signal of NRQL conditions
+type Signals struct {
+	client nerdgraph.NerdGraph
+}
+
+// New returns a client sending its requests through the NerdGraph client of newrelic-client-go
+func New(client nerdgraph.NerdGraph) *Signals {
+	return &Signals{client: client}
+}
+
+// UpdateNrqlConditionSignal replaces the signal of an existing NRQL condition of the given type
+func (s *Signals) UpdateNrqlConditionSignal(accountID int, conditionType alerts.NrqlConditionType, conditionID string, signal Signal) (*Signal, error) {
+	mutationType, err := mutationTypeName(conditionType)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`mutation($accountId: Int!, $id: ID!, $condition: AlertsNrqlConditionUpdate%[1]sInput!) {
+	alertsNrqlCondition%[1]sUpdate(accountId: $accountId, id: $id, condition: $condition) { %[2]s }
+}`, mutationType, signalFields)
+
+	vars := map[string]interface{}{
+		"accountId": accountID,
+		"id":        conditionID,
+		"condition": map[string]interface{}{"signal": signal},
+	}
+
+	resp := map[string]*struct {
+		Signal *Signal `json:"signal"`
+	}{}
+	if err := s.client.QueryWithResponse(query, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	updated := resp["alertsNrqlCondition"+mutationType+"Update"]
+	if updated == nil {
+		return nil, nrErrors.NewNotFound(fmt.Sprintf("NRQL condition %s not found", conditionID))
+	}
+
+	return updated.Signal, nil
+}
+
+// GetNrqlConditionSignal returns the signal of a NRQL condition
+func (s *Signals) GetNrqlConditionSignal(accountID int, conditionID string) (*Signal, error) {
+	query := `query($accountId: Int!, $id: ID!) {
+	actor { account(id: $accountId) { alerts { nrqlCondition(id: $id) { ` + signalFields + ` } } } }
+}`
+
+	vars := map[string]interface{}{
+		"accountId": accountID,
+		"id":        conditionID,
+	}
+
+	var resp struct {
+		Actor struct {
+			Account struct {
+				Alerts struct {
+					NrqlCondition *struct {
+						Signal *Signal `json:"signal"`
+					} `json:"nrqlCondition"`
+				} `json:"alerts"`
+			} `json:"account"`
+		} `json:"actor"`
+	}
+	if err := s.client.QueryWithResponse(query, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	condition := resp.Actor.Account.Alerts.NrqlCondition
+	if condition == nil {
+		return nil, nrErrors.NewNotFound(fmt.Sprintf("NRQL condition %s not found", conditionID))
+	}
+
+	return condition.Signal, nil
+}
+
+// mutationTypeName returns the name of the condition type used in the names of the NerdGraph mutations
+func mutationTypeName(conditionType alerts.NrqlConditionType) (string, error) {
+	switch conditionType {
+	case alerts.NrqlConditionTypes.Static:
+		return "Static", nil
+	case alerts.NrqlConditionTypes.Baseline:
+		return "Baseline", nil
+	case alerts.NrqlConditionTypes.Outlier:
+		return "Outlier", nil
+	}
+
+	return "", fmt.Errorf("unknown NRQL condition type %q", conditionType)
+}
@@ -0,0 +1,123 @@
+package nrqlsignal
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// graphQLRequest is the body of a request sent to NerdGraph
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// newTestClient returns a client whose requests are answered with response, the request
+// sent last is stored in sent. The returned server has to be closed by the test.
+func newTestClient(t *testing.T, response string, sent *graphQLRequest) (*Signals, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "api-key", r.Header.Get("Api-Key"))
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, sent))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+
+	client, err := newrelic.New(
+		newrelic.ConfigPersonalAPIKey("api-key"),
+		newrelic.ConfigNerdGraphBaseURL(server.URL),
+	)
+	require.NoError(t, err)
+
+	return New(client.NerdGraph), server
+}
+
+func TestUpdateNrqlConditionSignal(t *testing.T) {
+	var sent graphQLRequest
+	s, server := newTestClient(t, `{"data": {"alertsNrqlConditionBaselineUpdate": {
+		"signal": {"aggregationWindow": 60, "aggregationMethod": "EVENT_TIMER", "aggregationTimer": 120, "slideBy": 30}
+	}}}`, &sent)
+	defer server.Close()
+
+	window, timer, slideBy := 60, 120, 30
+	method := AggregationMethodEventTimer
+	signal, err := s.UpdateNrqlConditionSignal(1, alerts.NrqlConditionTypes.Baseline, "42", Signal{
+		AggregationWindow: &window,
+		AggregationMethod: &method,
+		AggregationTimer:  &timer,
+		SlideBy:           &slideBy,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, AggregationMethodEventTimer, *signal.AggregationMethod)
+	assert.Equal(t, 120, *signal.AggregationTimer)
+	assert.Contains(t, sent.Query, "alertsNrqlConditionBaselineUpdate")
+	assert.Contains(t, sent.Query, "AlertsNrqlConditionUpdateBaselineInput!")
+	assert.Equal(t, "42", sent.Variables["id"])
+	assert.Equal(t, map[string]interface{}{
+		"signal": map[string]interface{}{
+			"aggregationWindow": float64(60),
+			"aggregationMethod": "EVENT_TIMER",
+			"aggregationDelay":  nil,
+			"aggregationTimer":  float64(120),
+			"slideBy":           float64(30),
+		},
+	}, sent.Variables["condition"])
+}
+
+func TestUpdateNrqlConditionSignalUnknownType(t *testing.T) {
+	s, server := newTestClient(t, `{}`, &graphQLRequest{})
+	defer server.Close()
+
+	_, err := s.UpdateNrqlConditionSignal(1, "UNKNOWN", "42", Signal{})
+
+	assert.EqualError(t, err, `unknown NRQL condition type "UNKNOWN"`)
+}
+
+func TestGetNrqlConditionSignal(t *testing.T) {
+	var sent graphQLRequest
+	s, server := newTestClient(t, `{"data": {"actor": {"account": {"alerts": {"nrqlCondition": {
+		"signal": {"aggregationWindow": 60, "aggregationMethod": "EVENT_FLOW", "aggregationDelay": 120, "fillOption": "NONE"}
+	}}}}}}`, &sent)
+	defer server.Close()
+
+	signal, err := s.GetNrqlConditionSignal(1, "42")
+
+	require.NoError(t, err)
+	assert.Equal(t, AggregationMethodEventFlow, *signal.AggregationMethod)
+	assert.Equal(t, 120, *signal.AggregationDelay)
+	assert.Nil(t, signal.AggregationTimer)
+	assert.Equal(t, alerts.AlertsFillOptionTypes.NONE, *signal.FillOption)
+	assert.Equal(t, float64(1), sent.Variables["accountId"])
+}
+
+func TestGetNrqlConditionSignalNotFound(t *testing.T) {
+	var sent graphQLRequest
+	s, server := newTestClient(t, `{"data": {"actor": {"account": {"alerts": {"nrqlCondition": null}}}}}`, &sent)
+	defer server.Close()
+
+	_, err := s.GetNrqlConditionSignal(1, "42")
+
+	assert.IsType(t, &nrErrors.NotFound{}, err)
+}
+
+func TestQueryErrors(t *testing.T) {
+	var sent graphQLRequest
+	s, server := newTestClient(t, `{"data": null, "errors": [{"message": "invalid aggregationTimer"}]}`, &sent)
+	defer server.Close()
+
+	_, err := s.GetNrqlConditionSignal(1, "42")
+
+	assert.EqualError(t, err, "invalid aggregationTimer")
+}
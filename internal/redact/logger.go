@@ -0,0 +1,91 @@
+package redact
+
+import (
+	"errors"
+
+	"github.com/go-logr/logr"
+)
+
+// logger redacts the credentials in the values logged with the wrapped logger
+type logger struct {
+	logger logr.Logger
+}
+
+// infoLogger redacts the credentials in the values logged with the wrapped verbosity level
+type infoLogger struct {
+	logger logr.InfoLogger
+}
+
+// NewLogger returns a logger redacting the credentials held in any logged value, e.g. a whole spec
+func NewLogger(l logr.Logger) logr.Logger {
+	return &logger{logger: l}
+}
+
+// Info implements logr.InfoLogger
+func (l *logger) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, values(keysAndValues)...)
+}
+
+// Enabled implements logr.InfoLogger
+func (l *logger) Enabled() bool {
+	return l.logger.Enabled()
+}
+
+// Error implements logr.Logger. The message of err is redacted as well, it may quote the credentials of
+// the logged values or a New Relic key.
+func (l *logger) Error(err error, msg string, keysAndValues ...interface{}) {
+	l.logger.Error(redactError(err, keysAndValues), msg, values(keysAndValues)...)
+}
+
+// V implements logr.Logger
+func (l *logger) V(level int) logr.InfoLogger {
+	return &infoLogger{logger: l.logger.V(level)}
+}
+
+// WithValues implements logr.Logger
+func (l *logger) WithValues(keysAndValues ...interface{}) logr.Logger {
+	return &logger{logger: l.logger.WithValues(values(keysAndValues)...)}
+}
+
+// WithName implements logr.Logger
+func (l *logger) WithName(name string) logr.Logger {
+	return &logger{logger: l.logger.WithName(name)}
+}
+
+// Info implements logr.InfoLogger
+func (l *infoLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, values(keysAndValues)...)
+}
+
+// Enabled implements logr.InfoLogger
+func (l *infoLogger) Enabled() bool {
+	return l.logger.Enabled()
+}
+
+// values returns the key/value pairs with their values redacted
+func values(keysAndValues []interface{}) []interface{} {
+	redacted := make([]interface{}, len(keysAndValues))
+	for i, v := range keysAndValues {
+		if i%2 == 1 {
+			v = Value(v)
+		}
+		redacted[i] = v
+	}
+
+	return redacted
+}
+
+// redactError returns err with the credentials held in keysAndValues and the New Relic keys redacted from
+// its message. Errors without credentials are returned as they are.
+func redactError(err error, keysAndValues []interface{}) error {
+	if err == nil {
+		return nil
+	}
+
+	message := Keys(Text(err.Error(), keysAndValues))
+	if message == err.Error() {
+		return err
+	}
+
+	return errors.New(message)
+}
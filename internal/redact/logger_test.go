@@ -0,0 +1,82 @@
+package redact
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingLogger records the error and the values of the last logged message
+type recordingLogger struct {
+	err    error
+	values []interface{}
+}
+
+func (l *recordingLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.values = keysAndValues
+}
+
+func (l *recordingLogger) Enabled() bool {
+	return true
+}
+
+func (l *recordingLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	l.err = err
+	l.values = keysAndValues
+}
+
+func (l *recordingLogger) V(level int) logr.InfoLogger {
+	return l
+}
+
+func (l *recordingLogger) WithValues(keysAndValues ...interface{}) logr.Logger {
+	l.values = keysAndValues
+	return l
+}
+
+func (l *recordingLogger) WithName(name string) logr.Logger {
+	return l
+}
+
+func TestLogger(t *testing.T) {
+	recorder := &recordingLogger{}
+	logger := NewLogger(recorder).WithName("alertschannel")
+	spec := testSpec{Name: "checkout", APIKey: "NRAK-123456"}
+
+	logger.Info("creating channel", "name", "checkout", "spec", spec)
+	assert.Equal(t, "checkout", recorder.values[1])
+	assert.Equal(t, String("NRAK-123456"), recorder.values[3].(testSpec).APIKey)
+
+	logger.Error(nil, "failed to create channel", "spec", &spec)
+	assert.Equal(t, String("NRAK-123456"), recorder.values[1].(*testSpec).APIKey)
+
+	logger.V(1).Info("created channel", "spec", spec)
+	assert.Equal(t, String("NRAK-123456"), recorder.values[1].(testSpec).APIKey)
+
+	logger.WithValues("spec", spec)
+	assert.Equal(t, String("NRAK-123456"), recorder.values[1].(testSpec).APIKey)
+
+	assert.Equal(t, "NRAK-123456", spec.APIKey)
+}
+
+func TestLoggerError(t *testing.T) {
+	recorder := &recordingLogger{}
+	logger := NewLogger(recorder)
+	spec := testSpec{Name: "checkout", APIKey: "NRAK-123456"}
+	userKey := "NRAK-ABCDEFGHIJKLMNOPQRSTUVWXYZ0"
+
+	logger.Error(errors.New("invalid api key NRAK-123456"), "failed to create channel", "spec", spec)
+	assert.EqualError(t, recorder.err, "invalid api key "+String("NRAK-123456"))
+
+	logger.Error(errors.New("401 for key "+userKey), "failed to create channel")
+	assert.EqualError(t, recorder.err, "401 for key "+String(userKey))
+
+	notFound := errors.New("not found")
+	logger.Error(notFound, "failed to create channel", "spec", spec)
+	assert.Equal(t, notFound, recorder.err)
+
+	logger.Error(nil, "failed to create channel")
+	assert.Nil(t, recorder.err)
+}
@@ -0,0 +1,330 @@
+// Package redact keeps credentials held in the specs of the operator out of logs, events and statuses.
+// Credentials are replaced by a short fingerprint, so a changed credential can still be told apart from
+// the previous one without revealing either. Fingerprints are keyed with a key of the install, so they
+// can't be matched against the fingerprints of guessed credentials.
+package redact
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// prefix starts every redacted value
+const prefix = "redacted:"
+
+// secretFields are the names of the fields holding credentials, either strings or maps of strings. Names
+// that are only secret in a single type are qualified with the name of the type.
+var secretFields = map[string]bool{
+	"APIKey":       true,
+	"AuthToken":    true,
+	"AuthPassword": true,
+	"ServiceKey":   true,
+	"RouteKey":     true,
+
+	"AlertsChannelConfiguration.Key":     true,
+	"AlertsChannelConfiguration.URL":     true,
+	"AlertsChannelConfiguration.Payload": true,
+	"ChannelHeader.Value":                true,
+}
+
+// String returns the redacted form of secret. Empty strings are kept so unset credentials remain visible.
+func String(secret string) string {
+	if secret == "" || strings.HasPrefix(secret, prefix) {
+		return secret
+	}
+
+	return prefix + Fingerprint(secret)
+}
+
+// FingerprintKeySize is the size of the keys of the fingerprints in bytes
+const FingerprintKeySize = 32
+
+var (
+	fingerprintKeyLock sync.RWMutex
+	// fingerprintKey is random until the key of the install is set
+	fingerprintKey = NewFingerprintKey()
+)
+
+// NewFingerprintKey returns a random fingerprint key
+func NewFingerprintKey() []byte {
+	key := make([]byte, FingerprintKeySize)
+	if _, err := rand.Read(key); err != nil {
+		panic(err)
+	}
+
+	return key
+}
+
+// SetFingerprintKey sets the key of the fingerprints. Fingerprints are kept in statuses and name the secrets
+// of moved credentials, so the key has to be the same across restarts and set before any credential is redacted.
+func SetFingerprintKey(key []byte) {
+	fingerprintKeyLock.Lock()
+	defer fingerprintKeyLock.Unlock()
+
+	fingerprintKey = append([]byte{}, key...)
+}
+
+// Fingerprint returns a short keyed hash identifying secret without revealing it
+func Fingerprint(secret string) string {
+	fingerprintKeyLock.RLock()
+	mac := hmac.New(sha256.New, fingerprintKey)
+	fingerprintKeyLock.RUnlock()
+
+	mac.Write([]byte(secret)) //nolint
+
+	return hex.EncodeToString(mac.Sum(nil)[:8])
+}
+
+// Secrets redacts the credentials in the value obj points to in place
+func Secrets(obj interface{}) {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return
+	}
+
+	v.Elem().Set(redactValue(v.Elem()))
+}
+
+// Value returns v with its credentials redacted. Values holding credentials are copied, so v itself is
+// never changed and values without credentials are returned as they are.
+func Value(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+
+	value := reflect.ValueOf(v)
+	if !holdsSecrets(value.Type()) {
+		return v
+	}
+
+	return redactValue(value).Interface()
+}
+
+// Text replaces the credentials of obj found in text, e.g. in an error message built from its spec
+func Text(text string, obj interface{}) string {
+	for _, secret := range collectSecrets(reflect.ValueOf(obj), nil) {
+		text = strings.Replace(text, secret, String(secret), -1)
+	}
+
+	return text
+}
+
+// keyPattern matches New Relic keys, e.g. User keys starting with NRAK- and license keys ending with NRAL
+var keyPattern = regexp.MustCompile(`NR[A-Z]{2}-[0-9A-Za-z]{16,}|[0-9a-f]{36}NRAL`)
+
+// Keys replaces the New Relic keys found in text, e.g. in an error message of a New Relic client
+func Keys(text string) string {
+	return keyPattern.ReplaceAllStringFunc(text, String)
+}
+
+// redactValue returns a copy of v with its credentials redacted. Parts of v without credentials are shared.
+func redactValue(v reflect.Value) reflect.Value {
+	if !v.IsValid() || !holdsSecrets(v.Type()) {
+		return v
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+
+		copied := reflect.New(v.Type().Elem())
+		copied.Elem().Set(redactValue(v.Elem()))
+
+		return copied
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(redactValue(v.Elem()))
+
+		return copied
+	case reflect.Struct:
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(v)
+
+		for i := 0; i < v.NumField(); i++ {
+			field := copied.Field(i)
+			if !field.CanSet() {
+				continue
+			}
+
+			if isSecretField(v.Type(), i) {
+				field.Set(redactSecret(field))
+				continue
+			}
+
+			field.Set(redactValue(field))
+		}
+
+		return copied
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+
+		copied := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			copied.Index(i).Set(redactValue(v.Index(i)))
+		}
+
+		return copied
+	case reflect.Array:
+		copied := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			copied.Index(i).Set(redactValue(v.Index(i)))
+		}
+
+		return copied
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+
+		copied := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for _, key := range v.MapKeys() {
+			copied.SetMapIndex(key, redactValue(v.MapIndex(key)))
+		}
+
+		return copied
+	default:
+		return v
+	}
+}
+
+// collectSecrets appends the non-empty credentials held in v to secrets
+func collectSecrets(v reflect.Value, secrets []string) []string {
+	if !v.IsValid() || !holdsSecrets(v.Type()) {
+		return secrets
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			secrets = collectSecrets(v.Elem(), secrets)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if isSecretField(v.Type(), i) {
+				secrets = appendSecret(v.Field(i), secrets)
+				continue
+			}
+
+			secrets = collectSecrets(v.Field(i), secrets)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			secrets = collectSecrets(v.Index(i), secrets)
+		}
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			secrets = collectSecrets(v.MapIndex(key), secrets)
+		}
+	}
+
+	return secrets
+}
+
+// redactSecret returns a copy of the credential v, a string or the values of a map of strings, redacted
+func redactSecret(v reflect.Value) reflect.Value {
+	if v.Kind() == reflect.Map {
+		if v.IsNil() {
+			return v
+		}
+
+		copied := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for _, key := range v.MapKeys() {
+			copied.SetMapIndex(key, redactSecret(v.MapIndex(key)))
+		}
+
+		return copied
+	}
+
+	copied := reflect.New(v.Type()).Elem()
+	copied.SetString(String(v.String()))
+
+	return copied
+}
+
+// appendSecret appends the non-empty credentials held in v, a string or a map of strings, to secrets
+func appendSecret(v reflect.Value, secrets []string) []string {
+	if v.Kind() == reflect.Map {
+		for _, key := range v.MapKeys() {
+			secrets = appendSecret(v.MapIndex(key), secrets)
+		}
+
+		return secrets
+	}
+
+	if secret := v.String(); secret != "" {
+		secrets = append(secrets, secret)
+	}
+
+	return secrets
+}
+
+// isSecretField returns true if the i-th field of the struct type t holds a credential
+func isSecretField(t reflect.Type, i int) bool {
+	field := t.Field(i)
+	if field.PkgPath != "" || !isStringOrStringMap(field.Type) {
+		return false
+	}
+
+	return secretFields[field.Name] || secretFields[t.Name()+"."+field.Name]
+}
+
+// isStringOrStringMap returns true for the types a credential can be held in
+func isStringOrStringMap(t reflect.Type) bool {
+	return t.Kind() == reflect.String || (t.Kind() == reflect.Map && t.Elem().Kind() == reflect.String)
+}
+
+// secretTypes caches whether a type can hold credentials
+var secretTypes sync.Map
+
+// holdsSecrets returns true if values of t can hold credentials. Interfaces can hold any value.
+func holdsSecrets(t reflect.Type) bool {
+	if cached, ok := secretTypes.Load(t); ok {
+		return cached.(bool)
+	}
+
+	result := computeHoldsSecrets(t, map[reflect.Type]bool{})
+	secretTypes.Store(t, result)
+
+	return result
+}
+
+// computeHoldsSecrets walks t, visiting holds the types already being walked to end recursive types
+func computeHoldsSecrets(t reflect.Type, visiting map[reflect.Type]bool) bool {
+	if visiting[t] {
+		return false
+	}
+	visiting[t] = true
+
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return computeHoldsSecrets(t.Elem(), visiting)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).PkgPath != "" {
+				continue
+			}
+
+			if isSecretField(t, i) || computeHoldsSecrets(t.Field(i).Type, visiting) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,149 @@
+package redact
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testHeader struct {
+	Name  string
+	Value string
+}
+
+type testConfiguration struct {
+	AuthToken string
+	URL       string
+	Headers   []testHeader
+	Payload   map[string]string
+}
+
+// AlertsChannelConfiguration has the name of the channel configuration of the API, whose URL and Payload
+// are only secret in that type
+type AlertsChannelConfiguration struct {
+	Channel string
+	URL     string
+	Payload map[string]string
+}
+
+type testSpec struct {
+	Name          string
+	APIKey        string
+	Configuration *testConfiguration
+	Extra         interface{}
+	CreatedAt     time.Time
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "", String(""))
+	assert.Regexp(t, "^redacted:[0-9a-f]{16}$", String("NRAK-123456"))
+	assert.Equal(t, String("NRAK-123456"), String("NRAK-123456"))
+	assert.NotEqual(t, String("NRAK-123456"), String("NRAK-654321"))
+	assert.Equal(t, String("NRAK-123456"), String(String("NRAK-123456")))
+}
+
+func TestFingerprintKey(t *testing.T) {
+	defer SetFingerprintKey(NewFingerprintKey())
+
+	key := NewFingerprintKey()
+	SetFingerprintKey(key)
+	fingerprint := Fingerprint("NRAK-123456")
+
+	SetFingerprintKey(NewFingerprintKey())
+	assert.NotEqual(t, fingerprint, Fingerprint("NRAK-123456"))
+
+	SetFingerprintKey(key)
+	assert.Equal(t, fingerprint, Fingerprint("NRAK-123456"))
+}
+
+func TestKeys(t *testing.T) {
+	userKey := "NRAK-ABCDEFGHIJKLMNOPQRSTUVWXYZ0"
+	licenseKey := "0123456789abcdef0123456789abcdef0123NRAL"
+
+	assert.Equal(t, "invalid key "+String(userKey)+" or "+String(licenseKey),
+		Keys("invalid key "+userKey+" or "+licenseKey))
+	assert.Equal(t, "policy NRAK not found", Keys("policy NRAK not found"))
+}
+
+func TestSecrets(t *testing.T) {
+	spec := testSpec{
+		Name:          "checkout",
+		APIKey:        "NRAK-123456",
+		Configuration: &testConfiguration{AuthToken: "token", URL: "https://example.com"},
+	}
+
+	Secrets(&spec)
+
+	assert.Equal(t, "checkout", spec.Name)
+	assert.Equal(t, String("NRAK-123456"), spec.APIKey)
+	assert.Equal(t, String("token"), spec.Configuration.AuthToken)
+	assert.Equal(t, "https://example.com", spec.Configuration.URL)
+}
+
+func TestValue(t *testing.T) {
+	configuration := &testConfiguration{AuthToken: "token", Headers: []testHeader{{Name: "X-Token", Value: "header"}}}
+	spec := testSpec{
+		Name:          "checkout",
+		APIKey:        "NRAK-123456",
+		Configuration: configuration,
+		Extra:         testSpec{APIKey: "NRAK-654321"},
+		CreatedAt:     time.Now(),
+	}
+
+	redacted, ok := Value(spec).(testSpec)
+	assert.True(t, ok)
+	assert.Equal(t, String("NRAK-123456"), redacted.APIKey)
+	assert.Equal(t, String("token"), redacted.Configuration.AuthToken)
+	assert.Equal(t, String("NRAK-654321"), redacted.Extra.(testSpec).APIKey)
+	assert.Equal(t, spec.CreatedAt, redacted.CreatedAt)
+
+	// the logged value is copied, not changed
+	assert.Equal(t, "NRAK-123456", spec.APIKey)
+	assert.Equal(t, "token", configuration.AuthToken)
+
+	pointer, ok := Value(&spec).(*testSpec)
+	assert.True(t, ok)
+	assert.Equal(t, String("NRAK-123456"), pointer.APIKey)
+	assert.Equal(t, "NRAK-123456", spec.APIKey)
+}
+
+func TestValueWithoutSecrets(t *testing.T) {
+	err := errors.New("not found")
+
+	assert.Equal(t, "checkout", Value("checkout"))
+	assert.Equal(t, 123, Value(123))
+	assert.Equal(t, err, Value(err))
+	assert.Nil(t, Value(nil))
+}
+
+func TestText(t *testing.T) {
+	spec := &testSpec{APIKey: "NRAK-123456", Configuration: &testConfiguration{AuthToken: "secret-token"}}
+
+	assert.Equal(t, "invalid api key "+String("NRAK-123456")+" or token "+String("secret-token"),
+		Text("invalid api key NRAK-123456 or token secret-token", spec))
+	assert.Equal(t, "not found", Text("not found", spec))
+}
+
+func TestValueOfChannelConfiguration(t *testing.T) {
+	url := "https://hooks.slack.com/services/T000/B000/XXXX"
+	configuration := AlertsChannelConfiguration{
+		Channel: "#alerts",
+		URL:     url,
+		Payload: map[string]string{"token": "payload-token", "empty": ""},
+	}
+
+	redacted, ok := Value(configuration).(AlertsChannelConfiguration)
+	assert.True(t, ok)
+	assert.Equal(t, "#alerts", redacted.Channel)
+	assert.Equal(t, String(url), redacted.URL)
+	assert.Equal(t, map[string]string{"token": String("payload-token"), "empty": ""}, redacted.Payload)
+
+	// the payload is copied, not changed
+	assert.Equal(t, "payload-token", configuration.Payload["token"])
+
+	assert.Nil(t, Value(AlertsChannelConfiguration{}).(AlertsChannelConfiguration).Payload)
+	assert.Equal(t, "posting to "+String(url)+" with "+String("payload-token")+" failed",
+		Text("posting to "+url+" with payload-token failed", &configuration))
+}
@@ -0,0 +1,192 @@
+// Package servicelevels manages service level indicators and objectives through NerdGraph.
+package servicelevels
+
+import (
+	"fmt"
+	"strings"
+
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/newrelic/newrelic-client-go/pkg/nerdgraph"
+)
+
+// TimeWindowUnitDay is the only unit of the rolling time window of an objective supported by New Relic
+const TimeWindowUnitDay = "DAY"
+
+// IndicatorInput is a service level indicator with its objectives
+type IndicatorInput struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Events      EventsInput      `json:"events"`
+	Objectives  []ObjectiveInput `json:"objectives"`
+}
+
+// EventsInput are the queries selecting the events of an indicator. Either the good or the bad
+// events are set.
+type EventsInput struct {
+	// AccountID is the account the events are queried from, it can't be changed after creation
+	AccountID   int               `json:"accountId,omitempty"`
+	ValidEvents EventsQueryInput  `json:"validEvents"`
+	GoodEvents  *EventsQueryInput `json:"goodEvents,omitempty"`
+	BadEvents   *EventsQueryInput `json:"badEvents,omitempty"`
+}
+
+// EventsQueryInput selects events with the FROM and WHERE clauses of a NRQL query
+type EventsQueryInput struct {
+	From  string `json:"from"`
+	Where string `json:"where,omitempty"`
+}
+
+// ObjectiveInput is the target percentage of good events over a rolling time window
+type ObjectiveInput struct {
+	Name        string          `json:"name,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Target      float64         `json:"target"`
+	TimeWindow  TimeWindowInput `json:"timeWindow"`
+}
+
+// TimeWindowInput is the time window of an objective
+type TimeWindowInput struct {
+	Rolling RollingTimeWindowInput `json:"rolling"`
+}
+
+// RollingTimeWindowInput is a rolling time window of count units
+type RollingTimeWindowInput struct {
+	Count int    `json:"count"`
+	Unit  string `json:"unit"`
+}
+
+// Indicator is a service level indicator in New Relic
+type Indicator struct {
+	ID   string `json:"id"`
+	GUID string `json:"guid"`
+	Name string `json:"name"`
+}
+
+// indicatorFields selects the fields of an Indicator
+const indicatorFields = `id guid name`
+
+// ServiceLevels is a NerdGraph client for the service level management API
+type ServiceLevels struct {
+	client nerdgraph.NerdGraph
+}
+
+// New returns a client sending its requests through the NerdGraph client of newrelic-client-go
+func New(client nerdgraph.NerdGraph) *ServiceLevels {
+	return &ServiceLevels{client: client}
+}
+
+// CreateServiceLevel creates a service level indicator on the entity
+func (s *ServiceLevels) CreateServiceLevel(entityGUID string, indicator IndicatorInput) (*Indicator, error) {
+	query := `mutation($entityGuid: EntityGuid!, $indicator: ServiceLevelIndicatorCreateInput!) {
+	serviceLevelCreate(entityGuid: $entityGuid, indicator: $indicator) { ` + indicatorFields + ` }
+}`
+
+	vars := map[string]interface{}{
+		"entityGuid": entityGUID,
+		"indicator":  indicator,
+	}
+
+	var resp struct {
+		ServiceLevelCreate *Indicator `json:"serviceLevelCreate"`
+	}
+	if err := s.client.QueryWithResponse(query, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.ServiceLevelCreate == nil {
+		return nil, fmt.Errorf("no service level was created on entity %s", entityGUID)
+	}
+
+	return resp.ServiceLevelCreate, nil
+}
+
+// UpdateServiceLevel updates a service level indicator. The account of the events can't be changed
+// and is not sent.
+func (s *ServiceLevels) UpdateServiceLevel(guid string, indicator IndicatorInput) (*Indicator, error) {
+	query := `mutation($guid: EntityGuid!, $indicator: ServiceLevelIndicatorUpdateInput!) {
+	serviceLevelUpdate(guid: $guid, indicator: $indicator) { ` + indicatorFields + ` }
+}`
+
+	indicator.Events.AccountID = 0
+
+	vars := map[string]interface{}{
+		"guid":      guid,
+		"indicator": indicator,
+	}
+
+	var resp struct {
+		ServiceLevelUpdate *Indicator `json:"serviceLevelUpdate"`
+	}
+	if err := s.client.QueryWithResponse(query, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.ServiceLevelUpdate == nil {
+		return nil, nrErrors.NewNotFound(fmt.Sprintf("service level %s not found", guid))
+	}
+
+	return resp.ServiceLevelUpdate, nil
+}
+
+// DeleteServiceLevel deletes a service level indicator
+func (s *ServiceLevels) DeleteServiceLevel(guid string) error {
+	query := `mutation($guid: EntityGuid!) {
+	serviceLevelDelete(guid: $guid) { id }
+}`
+
+	vars := map[string]interface{}{
+		"guid": guid,
+	}
+
+	var resp struct {
+		ServiceLevelDelete *struct {
+			ID string `json:"id"`
+		} `json:"serviceLevelDelete"`
+	}
+	if err := s.client.QueryWithResponse(query, vars, &resp); err != nil {
+		return err
+	}
+
+	if resp.ServiceLevelDelete == nil {
+		return nrErrors.NewNotFound(fmt.Sprintf("service level %s not found", guid))
+	}
+
+	return nil
+}
+
+// FindAPMApplicationGUID returns the entity GUID of the APM application with the name in the account
+func (s *ServiceLevels) FindAPMApplicationGUID(accountID int, name string) (string, error) {
+	query := `query($query: String!) {
+	actor { entitySearch(query: $query) { results { entities { guid name } } } }
+}`
+
+	vars := map[string]interface{}{
+		"query": fmt.Sprintf("domain = 'APM' AND type = 'APPLICATION' AND accountId = %d AND name = '%s'",
+			accountID, strings.ReplaceAll(name, "'", "\\'")),
+	}
+
+	var resp struct {
+		Actor struct {
+			EntitySearch struct {
+				Results struct {
+					Entities []struct {
+						GUID string `json:"guid"`
+						Name string `json:"name"`
+					} `json:"entities"`
+				} `json:"results"`
+			} `json:"entitySearch"`
+		} `json:"actor"`
+	}
+	if err := s.client.QueryWithResponse(query, vars, &resp); err != nil {
+		return "", err
+	}
+
+	// the search matches names like a LIKE clause, only an exact match is accepted
+	for _, entity := range resp.Actor.EntitySearch.Results.Entities {
+		if entity.Name == name {
+			return entity.GUID, nil
+		}
+	}
+
+	return "", nrErrors.NewNotFound(fmt.Sprintf("APM application %q not found in account %d", name, accountID))
+}
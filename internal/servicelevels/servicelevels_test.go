@@ -0,0 +1,122 @@
+package servicelevels
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/newrelic"
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// graphQLRequest is the body of a request sent to NerdGraph
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// newTestClient returns a client whose requests are answered with response, the request
+// sent last is stored in sent. The returned server has to be closed by the test.
+func newTestClient(t *testing.T, response string, sent *graphQLRequest) (*ServiceLevels, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "api-key", r.Header.Get("Api-Key"))
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, sent))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+
+	client, err := newrelic.New(
+		newrelic.ConfigPersonalAPIKey("api-key"),
+		newrelic.ConfigNerdGraphBaseURL(server.URL),
+	)
+	require.NoError(t, err)
+
+	return New(client.NerdGraph), server
+}
+
+func testIndicator() IndicatorInput {
+	return IndicatorInput{
+		Name: "checkout latency",
+		Events: EventsInput{
+			AccountID:   1,
+			ValidEvents: EventsQueryInput{From: "Transaction", Where: "appName = 'checkout'"},
+			GoodEvents:  &EventsQueryInput{From: "Transaction", Where: "appName = 'checkout' AND duration < 0.5"},
+		},
+		Objectives: []ObjectiveInput{
+			{Target: 99.5, TimeWindow: TimeWindowInput{Rolling: RollingTimeWindowInput{Count: 7, Unit: TimeWindowUnitDay}}},
+		},
+	}
+}
+
+func TestCreateServiceLevel(t *testing.T) {
+	var sent graphQLRequest
+	s, server := newTestClient(t, `{"data": {"serviceLevelCreate": {"id": "sli-id", "guid": "sli-guid", "name": "checkout latency"}}}`, &sent)
+	defer server.Close()
+
+	indicator, err := s.CreateServiceLevel("entity-guid", testIndicator())
+
+	require.NoError(t, err)
+	assert.Equal(t, "sli-guid", indicator.GUID)
+	assert.Equal(t, "entity-guid", sent.Variables["entityGuid"])
+	assert.Contains(t, sent.Query, "serviceLevelCreate")
+
+	events := sent.Variables["indicator"].(map[string]interface{})["events"].(map[string]interface{})
+	assert.Equal(t, float64(1), events["accountId"])
+	assert.NotContains(t, events, "badEvents")
+}
+
+func TestUpdateServiceLevelOmitsAccount(t *testing.T) {
+	var sent graphQLRequest
+	s, server := newTestClient(t, `{"data": {"serviceLevelUpdate": {"id": "sli-id", "guid": "sli-guid"}}}`, &sent)
+	defer server.Close()
+
+	_, err := s.UpdateServiceLevel("sli-guid", testIndicator())
+
+	require.NoError(t, err)
+	assert.Equal(t, "sli-guid", sent.Variables["guid"])
+	events := sent.Variables["indicator"].(map[string]interface{})["events"].(map[string]interface{})
+	assert.NotContains(t, events, "accountId")
+}
+
+func TestDeleteServiceLevelNotFound(t *testing.T) {
+	var sent graphQLRequest
+	s, server := newTestClient(t, `{"data": {"serviceLevelDelete": null}}`, &sent)
+	defer server.Close()
+
+	err := s.DeleteServiceLevel("sli-guid")
+
+	assert.IsType(t, &nrErrors.NotFound{}, err)
+}
+
+func TestFindAPMApplicationGUID(t *testing.T) {
+	var sent graphQLRequest
+	s, server := newTestClient(t, `{"data": {"actor": {"entitySearch": {"results": {"entities": [
+		{"guid": "other-guid", "name": "checkout-worker"},
+		{"guid": "entity-guid", "name": "checkout"}
+	]}}}}}`, &sent)
+	defer server.Close()
+
+	guid, err := s.FindAPMApplicationGUID(1, "checkout")
+
+	require.NoError(t, err)
+	assert.Equal(t, "entity-guid", guid)
+	assert.Equal(t, "domain = 'APM' AND type = 'APPLICATION' AND accountId = 1 AND name = 'checkout'", sent.Variables["query"])
+}
+
+func TestFindAPMApplicationGUIDNotFound(t *testing.T) {
+	var sent graphQLRequest
+	s, server := newTestClient(t, `{"data": {"actor": {"entitySearch": {"results": {"entities": []}}}}}`, &sent)
+	defer server.Close()
+
+	_, err := s.FindAPMApplicationGUID(1, "checkout")
+
+	assert.IsType(t, &nrErrors.NotFound{}, err)
+}
@@ -0,0 +1,187 @@
+// Package syntheticsmonitors manages ping monitors through NerdGraph, which newrelic-client-go only
+// supports through the Synthetics REST API.
+package syntheticsmonitors
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/newrelic/newrelic-client-go/pkg/nerdgraph"
+)
+
+// periods maps the minutes between checks to the period of a monitor
+var periods = map[uint]string{
+	1:    "EVERY_MINUTE",
+	5:    "EVERY_5_MINUTES",
+	10:   "EVERY_10_MINUTES",
+	15:   "EVERY_15_MINUTES",
+	30:   "EVERY_30_MINUTES",
+	60:   "EVERY_HOUR",
+	360:  "EVERY_6_HOURS",
+	720:  "EVERY_12_HOURS",
+	1440: "EVERY_DAY",
+}
+
+// Period returns the period of a monitor checking every frequency minutes
+func Period(frequency uint) (string, error) {
+	period, ok := periods[frequency]
+	if !ok {
+		return "", fmt.Errorf("no monitor period runs every %d minutes", frequency)
+	}
+
+	return period, nil
+}
+
+// MonitorGUID returns the entity GUID of the monitor with the given ID
+func MonitorGUID(accountID int, monitorID string) string {
+	return base64.RawStdEncoding.EncodeToString([]byte(fmt.Sprintf("%d|SYNTH|MONITOR|%s", accountID, monitorID)))
+}
+
+// Locations are the locations a monitor checks from
+type Locations struct {
+	Public []string `json:"public"`
+}
+
+// AdvancedOptions are the options of a ping monitor
+type AdvancedOptions struct {
+	RedirectIsFailure       bool   `json:"redirectIsFailure"`
+	ResponseValidationText  string `json:"responseValidationText,omitempty"`
+	ShouldBypassHeadRequest bool   `json:"shouldBypassHeadRequest"`
+	UseTLSValidation        bool   `json:"useTlsValidation"`
+}
+
+// SimpleMonitorInput is a ping monitor to create or update
+type SimpleMonitorInput struct {
+	Name            string          `json:"name"`
+	URI             string          `json:"uri"`
+	Period          string          `json:"period"`
+	Status          string          `json:"status"`
+	Locations       Locations       `json:"locations"`
+	AdvancedOptions AdvancedOptions `json:"advancedOptions"`
+	ApdexTarget     *float64        `json:"apdexTarget,omitempty"`
+}
+
+// SimpleMonitor is a ping monitor in New Relic
+type SimpleMonitor struct {
+	GUID string `json:"guid"`
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// simpleMonitorFields selects the fields of a SimpleMonitor and the errors of a mutation
+const simpleMonitorFields = `errors { description type }
+	monitor { guid id name }`
+
+// mutationError is an error New Relic reports in the result of a monitor mutation
+type mutationError struct {
+	Description string `json:"description"`
+	Type        string `json:"type"`
+}
+
+// simpleMonitorResult is the result of a ping monitor mutation
+type simpleMonitorResult struct {
+	Errors  []mutationError `json:"errors"`
+	Monitor *SimpleMonitor  `json:"monitor"`
+}
+
+// Monitors is a NerdGraph client for ping monitors
+type Monitors struct {
+	client nerdgraph.NerdGraph
+}
+
+// New returns a client sending its requests through the NerdGraph client of newrelic-client-go
+func New(client nerdgraph.NerdGraph) *Monitors {
+	return &Monitors{client: client}
+}
+
+// CreateSimpleMonitor creates a ping monitor in the account
+func (m *Monitors) CreateSimpleMonitor(accountID int, monitor SimpleMonitorInput) (*SimpleMonitor, error) {
+	query := `mutation($accountId: Int!, $monitor: SyntheticsCreateSimpleMonitorInput!) {
+	syntheticsCreateSimpleMonitor(accountId: $accountId, monitor: $monitor) { ` + simpleMonitorFields + ` }
+}`
+
+	vars := map[string]interface{}{
+		"accountId": accountID,
+		"monitor":   monitor,
+	}
+
+	var resp struct {
+		SyntheticsCreateSimpleMonitor simpleMonitorResult `json:"syntheticsCreateSimpleMonitor"`
+	}
+	if err := m.client.QueryWithResponse(query, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	return monitorResult(resp.SyntheticsCreateSimpleMonitor, monitor.Name)
+}
+
+// UpdateSimpleMonitor replaces the settings of the ping monitor with the given entity GUID
+func (m *Monitors) UpdateSimpleMonitor(guid string, monitor SimpleMonitorInput) (*SimpleMonitor, error) {
+	query := `mutation($guid: EntityGuid!, $monitor: SyntheticsUpdateSimpleMonitorInput!) {
+	syntheticsUpdateSimpleMonitor(guid: $guid, monitor: $monitor) { ` + simpleMonitorFields + ` }
+}`
+
+	vars := map[string]interface{}{
+		"guid":    guid,
+		"monitor": monitor,
+	}
+
+	var resp struct {
+		SyntheticsUpdateSimpleMonitor simpleMonitorResult `json:"syntheticsUpdateSimpleMonitor"`
+	}
+	if err := m.client.QueryWithResponse(query, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	return monitorResult(resp.SyntheticsUpdateSimpleMonitor, guid)
+}
+
+// DeleteMonitorMutation deletes the monitor with the given entity GUID
+func (m *Monitors) DeleteMonitorMutation(guid string) error {
+	query := `mutation($guid: EntityGuid!) {
+	syntheticsDeleteMonitor(guid: $guid) { deletedGuid }
+}`
+
+	vars := map[string]interface{}{
+		"guid": guid,
+	}
+
+	var resp struct {
+		SyntheticsDeleteMonitor *struct {
+			DeletedGUID string `json:"deletedGuid"`
+		} `json:"syntheticsDeleteMonitor"`
+	}
+	if err := m.client.QueryWithResponse(query, vars, &resp); err != nil {
+		return err
+	}
+
+	if resp.SyntheticsDeleteMonitor == nil || resp.SyntheticsDeleteMonitor.DeletedGUID == "" {
+		return nrErrors.NewNotFound(fmt.Sprintf("monitor %s not found", guid))
+	}
+
+	return nil
+}
+
+// monitorResult returns the monitor of a mutation result, or the errors New Relic reported for it
+func monitorResult(result simpleMonitorResult, monitor string) (*SimpleMonitor, error) {
+	if len(result.Errors) > 0 {
+		descriptions := make([]string, 0, len(result.Errors))
+		for _, e := range result.Errors {
+			if e.Type == "NOT_FOUND" {
+				return nil, nrErrors.NewNotFound(fmt.Sprintf("monitor %s not found: %s", monitor, e.Description))
+			}
+
+			descriptions = append(descriptions, fmt.Sprintf("%s: %s", e.Type, e.Description))
+		}
+
+		return nil, fmt.Errorf("failed to write monitor %s: %s", monitor, strings.Join(descriptions, ", "))
+	}
+
+	if result.Monitor == nil {
+		return nil, nrErrors.NewNotFound(fmt.Sprintf("monitor %s not found", monitor))
+	}
+
+	return result.Monitor, nil
+}
@@ -0,0 +1,149 @@
+package syntheticsmonitors
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/newrelic"
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// graphQLRequest is the body of a request sent to NerdGraph
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// newTestClient returns a client whose requests are answered with response, the request
+// sent last is stored in sent. The returned server has to be closed by the test.
+func newTestClient(t *testing.T, response string, sent *graphQLRequest) (*Monitors, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "api-key", r.Header.Get("Api-Key"))
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, sent))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+
+	client, err := newrelic.New(
+		newrelic.ConfigPersonalAPIKey("api-key"),
+		newrelic.ConfigNerdGraphBaseURL(server.URL),
+	)
+	require.NoError(t, err)
+
+	return New(client.NerdGraph), server
+}
+
+func TestPeriod(t *testing.T) {
+	period, err := Period(10)
+	require.NoError(t, err)
+	assert.Equal(t, "EVERY_10_MINUTES", period)
+
+	period, err = Period(1440)
+	require.NoError(t, err)
+	assert.Equal(t, "EVERY_DAY", period)
+
+	_, err = Period(7)
+	assert.EqualError(t, err, "no monitor period runs every 7 minutes")
+}
+
+func TestMonitorGUID(t *testing.T) {
+	guid := MonitorGUID(12345, "5b1a2c3d-0000-4000-8000-000000000001")
+
+	decoded, err := base64.RawStdEncoding.DecodeString(guid)
+	require.NoError(t, err)
+	assert.Equal(t, "12345|SYNTH|MONITOR|5b1a2c3d-0000-4000-8000-000000000001", string(decoded))
+}
+
+func TestCreateSimpleMonitor(t *testing.T) {
+	var sent graphQLRequest
+	m, server := newTestClient(t, `{"data": {"syntheticsCreateSimpleMonitor": {
+		"errors": [],
+		"monitor": {"guid": "MTIzNDV8U1lOVEh8TU9OSVRPUnxhYmM", "id": "abc", "name": "shop"}
+	}}}`, &sent)
+	defer server.Close()
+
+	monitor, err := m.CreateSimpleMonitor(12345, SimpleMonitorInput{
+		Name:      "shop",
+		URI:       "https://shop.example.com/",
+		Period:    "EVERY_10_MINUTES",
+		Status:    "ENABLED",
+		Locations: Locations{Public: []string{"AWS_US_EAST_1"}},
+		AdvancedOptions: AdvancedOptions{
+			UseTLSValidation: true,
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, &SimpleMonitor{GUID: "MTIzNDV8U1lOVEh8TU9OSVRPUnxhYmM", ID: "abc", Name: "shop"}, monitor)
+	assert.Contains(t, sent.Query, "syntheticsCreateSimpleMonitor")
+	assert.Equal(t, float64(12345), sent.Variables["accountId"])
+	assert.Equal(t, map[string]interface{}{
+		"name":      "shop",
+		"uri":       "https://shop.example.com/",
+		"period":    "EVERY_10_MINUTES",
+		"status":    "ENABLED",
+		"locations": map[string]interface{}{"public": []interface{}{"AWS_US_EAST_1"}},
+		"advancedOptions": map[string]interface{}{
+			"redirectIsFailure":       false,
+			"shouldBypassHeadRequest": false,
+			"useTlsValidation":        true,
+		},
+	}, sent.Variables["monitor"])
+}
+
+func TestCreateSimpleMonitorErrors(t *testing.T) {
+	var sent graphQLRequest
+	m, server := newTestClient(t, `{"data": {"syntheticsCreateSimpleMonitor": {
+		"errors": [{"description": "location is not valid", "type": "BAD_REQUEST"}],
+		"monitor": null
+	}}}`, &sent)
+	defer server.Close()
+
+	_, err := m.CreateSimpleMonitor(12345, SimpleMonitorInput{Name: "shop"})
+
+	assert.EqualError(t, err, "failed to write monitor shop: BAD_REQUEST: location is not valid")
+}
+
+func TestUpdateSimpleMonitorNotFound(t *testing.T) {
+	var sent graphQLRequest
+	m, server := newTestClient(t, `{"data": {"syntheticsUpdateSimpleMonitor": {
+		"errors": [{"description": "monitor does not exist", "type": "NOT_FOUND"}],
+		"monitor": null
+	}}}`, &sent)
+	defer server.Close()
+
+	_, err := m.UpdateSimpleMonitor("guid", SimpleMonitorInput{Name: "shop"})
+
+	assert.IsType(t, &nrErrors.NotFound{}, err)
+	assert.Equal(t, "guid", sent.Variables["guid"])
+}
+
+func TestDeleteMonitorMutation(t *testing.T) {
+	var sent graphQLRequest
+	m, server := newTestClient(t, `{"data": {"syntheticsDeleteMonitor": {"deletedGuid": "guid"}}}`, &sent)
+	defer server.Close()
+
+	require.NoError(t, m.DeleteMonitorMutation("guid"))
+	assert.Contains(t, sent.Query, "syntheticsDeleteMonitor")
+	assert.Equal(t, "guid", sent.Variables["guid"])
+}
+
+func TestDeleteMonitorMutationNotFound(t *testing.T) {
+	var sent graphQLRequest
+	m, server := newTestClient(t, `{"data": {"syntheticsDeleteMonitor": null}}`, &sent)
+	defer server.Close()
+
+	err := m.DeleteMonitorMutation("guid")
+
+	assert.IsType(t, &nrErrors.NotFound{}, err)
+}
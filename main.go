@@ -16,18 +16,24 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/controllers"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/concurrency"
 	"github.com/newrelic/newrelic-kubernetes-operator/internal/info"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/redact"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -48,11 +54,20 @@ func main() {
 	var enableLeaderElection bool
 	var showVersion bool
 	var devMode bool
+	maxConcurrentReconciles := &concurrency.MaxConcurrentReconciles{}
+	var resyncInterval time.Duration
+	var correctDrift bool
+	var operatorNamespace string
 
 	flag.StringVar(&metricsAddr, "metrics-addr", ":8080", "The address the metric endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "enable-leader-election", false, "Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
 	flag.BoolVar(&showVersion, "version", false, "Show version information.")
 	flag.BoolVar(&devMode, "dev-mode", false, "Enable development level logging (stacktraces on warnings, no sampling)")
+	flag.IntVar(&maxConcurrentReconciles.Default, "max-concurrent-reconciles", 1, "The maximum number of concurrent reconciles per controller.")
+	flag.Var(maxConcurrentReconciles, "max-concurrent-reconciles-per-controller", "Overrides of --max-concurrent-reconciles for single controllers, named after their kind, e.g. AlertsPolicy=4,AlertsNrqlCondition=8.")
+	flag.DurationVar(&resyncInterval, "resync-interval", 0, "How often resources are compared against New Relic to detect drift, e.g. 10m. Drift detection is disabled when 0.")
+	flag.BoolVar(&correctDrift, "correct-drift", false, "Re-apply the desired state when drift from New Relic is detected. Requires --resync-interval.")
+	flag.StringVar(&operatorNamespace, "operator-namespace", os.Getenv("POD_NAMESPACE"), "The namespace of the secret holding the key of the credential fingerprints. Defaults to $POD_NAMESPACE, or default when unset.")
 	flag.Parse()
 
 	if showVersion {
@@ -60,7 +75,9 @@ func main() {
 		os.Exit(0)
 	}
 
-	logger := zap.New(zap.UseDevMode(devMode))
+	// every logger of the operator and controller-runtime derives from this one, so no credential
+	// held in a logged spec reaches the logs
+	logger := redact.NewLogger(zap.New(zap.UseDevMode(devMode)))
 	ctrl.SetLogger(logger)
 
 	opts := ctrl.Options{
@@ -71,22 +88,92 @@ func main() {
 		Port:               9443,
 	}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), opts)
+	cfg := ctrl.GetConfigOrDie()
+
+	mgr, err := ctrl.NewManager(cfg, opts)
 	if err != nil {
 		setupLog.Error(err, "unable to create manager")
 		os.Exit(1)
 	}
 
+	// the fingerprints of credentials are keyed per install, the key is read before anything is redacted
+	if operatorNamespace == "" {
+		operatorNamespace = "default"
+	}
+
+	setupClient, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		setupLog.Error(err, "unable to create client")
+		os.Exit(1)
+	}
+
+	if err := controllers.LoadFingerprintKey(context.Background(), setupClient, operatorNamespace); err != nil {
+		setupLog.Error(err, "unable to load fingerprint key", "namespace", operatorNamespace)
+		os.Exit(1)
+	}
+
 	// initialize NR go agent
 	nrApp := InitializeNRAgent()
 
+	// the indexes are shared by all controllers and can only be registered once
+	if err := controllers.SetupFieldIndexes(context.Background(), mgr); err != nil {
+		setupLog.Error(err, "unable to set up field indexes")
+		os.Exit(1)
+	}
+
 	//Register Alerts
-	err = registerAlerts(&mgr, &nrApp)
+	err = registerAlerts(&mgr, &nrApp, maxConcurrentReconciles, resyncInterval, correctDrift)
 	if err != nil {
 		setupLog.Error(err, "unable to register alerts")
 		os.Exit(1)
 	}
 
+	//Register Synthetics
+	err = registerSynthetics(&mgr, &nrApp, maxConcurrentReconciles, resyncInterval, correctDrift)
+	if err != nil {
+		setupLog.Error(err, "unable to register synthetics")
+		os.Exit(1)
+	}
+
+	//Register Dashboards
+	err = registerDashboards(&mgr, &nrApp, maxConcurrentReconciles, resyncInterval, correctDrift)
+	if err != nil {
+		setupLog.Error(err, "unable to register dashboards")
+		os.Exit(1)
+	}
+
+	//Register APM
+	err = registerAPM(&mgr, &nrApp, maxConcurrentReconciles)
+	if err != nil {
+		setupLog.Error(err, "unable to register apm")
+		os.Exit(1)
+	}
+
+	//Register Notifications
+	err = registerNotifications(&mgr, &nrApp, maxConcurrentReconciles)
+	if err != nil {
+		setupLog.Error(err, "unable to register notifications")
+		os.Exit(1)
+	}
+
+	//Register Service Levels
+	err = registerServiceLevels(&mgr, &nrApp, maxConcurrentReconciles)
+	if err != nil {
+		setupLog.Error(err, "unable to register service levels")
+		os.Exit(1)
+	}
+
+	if unknown := maxConcurrentReconciles.Unknown(); len(unknown) > 0 {
+		setupLog.Error(fmt.Errorf("unknown controllers %v", unknown), "invalid --max-concurrent-reconciles-per-controller")
+		os.Exit(1)
+	}
+
+	// Move inline API keys of every kind to secrets on admission
+	if err := nrv1.SetupAPIKeyWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "APIKey")
+		os.Exit(1)
+	}
+
 	// This marker is used by kubebuilder and must remain in main.go but generated code should be refactored to another class as appropriate
 	// This can likely be refactored once https://github.com/kubernetes-sigs/kubebuilder/blob/master/designs/simplified-scaffolding.md is completed
 	// +kubebuilder:scaffold:builder
@@ -0,0 +1,97 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"os"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/controllers"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/concurrency"
+)
+
+func registerNotifications(mgr *ctrl.Manager, nrApp *newrelic.Application, maxConcurrentReconciles *concurrency.MaxConcurrentReconciles) error {
+
+	// notification destination
+	destinationReconciler := &controllers.NotificationDestinationReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("NotificationDestination"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("notificationdestination-controller"),
+		NotificationsClientFunc: interfaces.InitializeNotificationsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("NotificationDestination"),
+	}
+
+	if err := destinationReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NotificationDestination")
+		os.Exit(1)
+	}
+
+	destination := &nrv1.NotificationDestination{}
+	if err := destination.SetupWebhookWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "NotificationDestination")
+		os.Exit(1)
+	}
+
+	// notification channel
+	channelReconciler := &controllers.NotificationChannelReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("NotificationChannel"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("notificationchannel-controller"),
+		NotificationsClientFunc: interfaces.InitializeNotificationsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("NotificationChannel"),
+	}
+
+	if err := channelReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NotificationChannel")
+		os.Exit(1)
+	}
+
+	channel := &nrv1.NotificationChannel{}
+	if err := channel.SetupWebhookWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "NotificationChannel")
+		os.Exit(1)
+	}
+
+	// workflow
+	workflowReconciler := &controllers.WorkflowReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("Workflow"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("workflow-controller"),
+		NotificationsClientFunc: interfaces.InitializeNotificationsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("Workflow"),
+	}
+
+	if err := workflowReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Workflow")
+		os.Exit(1)
+	}
+
+	workflow := &nrv1.Workflow{}
+	if err := workflow.SetupWebhookWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "Workflow")
+		os.Exit(1)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"os"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/controllers"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/concurrency"
+)
+
+func registerServiceLevels(mgr *ctrl.Manager, nrApp *newrelic.Application, maxConcurrentReconciles *concurrency.MaxConcurrentReconciles) error {
+
+	// service level
+	serviceLevelReconciler := &controllers.ServiceLevelReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("ServiceLevel"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("servicelevel-controller"),
+		ServiceLevelsClientFunc: interfaces.InitializeServiceLevelsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("ServiceLevel"),
+	}
+
+	if err := serviceLevelReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ServiceLevel")
+		os.Exit(1)
+	}
+
+	serviceLevel := &nrv1.ServiceLevel{}
+	if err := serviceLevel.SetupWebhookWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ServiceLevel")
+		os.Exit(1)
+	}
+
+	// burn rate alert
+	burnRateAlertReconciler := &controllers.BurnRateAlertReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("BurnRateAlert"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("burnratealert-controller"),
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("BurnRateAlert"),
+	}
+
+	if err := burnRateAlertReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "BurnRateAlert")
+		os.Exit(1)
+	}
+
+	burnRateAlert := &nrv1.BurnRateAlert{}
+	if err := burnRateAlert.SetupWebhookWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "BurnRateAlert")
+		os.Exit(1)
+	}
+
+	return nil
+}
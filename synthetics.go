@@ -0,0 +1,88 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nrv1 "github.com/newrelic/newrelic-kubernetes-operator/api/v1"
+	"github.com/newrelic/newrelic-kubernetes-operator/controllers"
+	"github.com/newrelic/newrelic-kubernetes-operator/interfaces"
+	"github.com/newrelic/newrelic-kubernetes-operator/internal/concurrency"
+)
+
+func registerSynthetics(mgr *ctrl.Manager, nrApp *newrelic.Application, maxConcurrentReconciles *concurrency.MaxConcurrentReconciles, resyncInterval time.Duration, correctDrift bool) error {
+
+	// syntheticsmonitor
+	syntheticsMonitorReconciler := &controllers.SyntheticsMonitorReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("SyntheticsMonitor"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("syntheticsmonitor-controller"),
+		SyntheticsClientFunc:    interfaces.InitializeSyntheticsClient,
+		AlertClientFunc:         interfaces.InitializeAlertsClient,
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("SyntheticsMonitor"),
+		ResyncInterval:          resyncInterval,
+		CorrectDrift:            correctDrift,
+	}
+
+	if err := syntheticsMonitorReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SyntheticsMonitor")
+		os.Exit(1)
+	}
+
+	syntheticsMonitor := &nrv1.SyntheticsMonitor{}
+	if err := syntheticsMonitor.SetupWebhookWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "SyntheticsMonitor")
+		os.Exit(1)
+	}
+
+	// monitors derived from Ingress and Service annotations
+	ingressReconciler := &controllers.IngressSyntheticsReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("IngressSynthetics"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("ingress-synthetics-controller"),
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("IngressSynthetics"),
+	}
+
+	if err := ingressReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "IngressSynthetics")
+		os.Exit(1)
+	}
+
+	serviceReconciler := &controllers.ServiceSyntheticsReconciler{
+		Client:                  (*mgr).GetClient(),
+		Log:                     ctrl.Log.WithName("controllers").WithName("ServiceSynthetics"),
+		Scheme:                  (*mgr).GetScheme(),
+		Recorder:                (*mgr).GetEventRecorderFor("service-synthetics-controller"),
+		NewRelicAgent:           *nrApp,
+		MaxConcurrentReconciles: maxConcurrentReconciles.For("ServiceSynthetics"),
+	}
+
+	if err := serviceReconciler.SetupWithManager(*mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ServiceSynthetics")
+		os.Exit(1)
+	}
+
+	return nil
+}